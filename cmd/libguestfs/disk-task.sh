@@ -0,0 +1,87 @@
+#!/bin/bash
+#
+# Runs the libguestfs operation of a VirtualMachineDiskTask.
+#
+# DISK_TASK_OPERATION  Sparsify, Sysprep, Customize or ResizeFilesystem
+# DISK_TASK_DISKS      whitespace separated disk images or block devices, in the order of the VM volumes
+# SYSPREP_OPERATIONS   optional comma separated virt-sysprep operations
+# CUSTOMIZE_COMMANDS   virt-customize commands, one per line
+# RESIZE_DEVICE        filesystem to grow, e.g. /dev/sda2
+
+set -euo pipefail
+
+disks=(${DISK_TASK_DISKS})
+add_disks=()
+for disk in "${disks[@]}"; do
+    add_disks+=(-a "${disk}")
+done
+
+resize_filesystem() {
+    local device="${RESIZE_DEVICE}"
+    local disk="${device%%[0-9]*}"
+    local partnum="${device#"${disk}"}"
+    local commands=(run)
+
+    if [ -n "${partnum}" ]; then
+        # GPT keeps a backup header at the end of the disk, move it there first
+        if [ "$(guestfish --ro "${add_disks[@]}" run : part-get-parttype "${disk}")" == "gpt" ]; then
+            commands+=(: part-expand-gpt "${disk}" : part-resize "${disk}" "${partnum}" -34)
+        else
+            commands+=(: part-resize "${disk}" "${partnum}" -1)
+        fi
+    fi
+
+    local fstype
+    fstype=$(guestfish --ro "${add_disks[@]}" run : vfs-type "${device}")
+    case "${fstype}" in
+    ext2 | ext3 | ext4)
+        commands+=(: e2fsck-f "${device}" : resize2fs "${device}")
+        ;;
+    xfs)
+        commands+=(: mount "${device}" / : xfs-growfs / : umount /)
+        ;;
+    btrfs)
+        commands+=(: mount "${device}" / : btrfs-filesystem-resize / : umount /)
+        ;;
+    ntfs)
+        commands+=(: ntfsresize "${device}")
+        ;;
+    *)
+        echo "Unsupported filesystem ${fstype} on ${device}" >&2
+        exit 1
+        ;;
+    esac
+
+    echo "Growing ${fstype} filesystem on ${device}"
+    guestfish --rw "${add_disks[@]}" "${commands[@]}"
+}
+
+case "${DISK_TASK_OPERATION}" in
+Sparsify)
+    for disk in "${disks[@]}"; do
+        echo "Sparsifying ${disk}"
+        virt-sparsify --in-place "${disk}"
+    done
+    ;;
+Sysprep)
+    operations=()
+    if [ -n "${SYSPREP_OPERATIONS:-}" ]; then
+        operations=(--operations "${SYSPREP_OPERATIONS}")
+    fi
+    virt-sysprep "${add_disks[@]}" ${operations[@]+"${operations[@]}"}
+    ;;
+Customize)
+    commands_file=$(mktemp)
+    printf '%s\n' "${CUSTOMIZE_COMMANDS}" >"${commands_file}"
+    virt-customize "${add_disks[@]}" --commands-from-file "${commands_file}"
+    ;;
+ResizeFilesystem)
+    resize_filesystem
+    ;;
+*)
+    echo "Unsupported operation ${DISK_TASK_OPERATION}" >&2
+    exit 1
+    ;;
+esac
+
+echo "${DISK_TASK_OPERATION} completed successfully"
@@ -48,6 +48,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/storage/backend-storage/encryption"
 	putil "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
@@ -62,13 +64,52 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 )
 
-const defaultStartTimeout = 3 * time.Minute
+const (
+	defaultStartTimeout   = 3 * time.Minute
+	vmStateResyncInterval = 30 * time.Second
+)
 
 func init() {
 	// must registry the event impl before doing anything else.
 	libvirt.EventRegisterDefaultImpl()
 }
 
+func restoreEncryptedVMState(mode string, dirs map[string]string) *encryption.StateStore {
+	keys, err := encryption.NewKeySource(mode, backendstorage.EncryptionKeysMountPath)
+	if err != nil {
+		panic(err)
+	}
+	store := encryption.NewStateStore(keys, backendstorage.EncryptedStateMountPath, dirs)
+	if err := store.Restore(); err != nil {
+		panic(err)
+	}
+	log.Log.Info("Restored the encrypted VM state")
+	return store
+}
+
+// startVMStatePersistence keeps the VM state encrypted on the backend-storage PVC while the VM runs
+func startVMStatePersistence(store *encryption.StateStore, notifier *notifyclient.Notifier, metadataCache *metadata.Cache, stopChan chan struct{}) chan struct{} {
+	// Once the VM migrated away, the target owns the state
+	store.SetPersistCondition(func() bool {
+		migration, exists := metadataCache.Migration.Load()
+		return !exists || migration.StartTimestamp == nil || migration.EndTimestamp == nil || migration.Failed
+	})
+	// Persist the migrated state on the target before the migration is reported as completed,
+	// the source backend-storage PVC is removed afterwards
+	notifier.SetMigrationCompletedHook(func() {
+		if err := store.Persist(); err != nil {
+			log.Log.Reason(err).Error("Failed to persist the migrated encrypted VM state")
+		}
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		store.Run(stopChan, vmStateResyncInterval)
+	}()
+	return done
+}
+
 func markReady() {
 	err := os.Rename(cmdclient.UninitializedSocketOnGuest(), cmdclient.SocketOnGuest())
 	if err != nil {
@@ -135,11 +176,7 @@ func startDomainEventMonitoring(
 	vmi *v1.VirtualMachineInstance,
 	domainName string,
 	agentStore *agentpoller.AsyncAgentStore,
-	qemuAgentSysInterval time.Duration,
-	qemuAgentFileInterval time.Duration,
-	qemuAgentUserInterval time.Duration,
-	qemuAgentVersionInterval time.Duration,
-	qemuAgentFSFreezeStatusInterval time.Duration,
+	agentPollerIntervals agentpoller.PollerIntervals,
 	metadataCache *metadata.Cache,
 ) {
 	go func() {
@@ -151,7 +188,7 @@ func startDomainEventMonitoring(
 		}
 	}()
 
-	err := notifier.StartDomainNotifier(domainConn, deleteNotificationSent, vmi, domainName, agentStore, qemuAgentSysInterval, qemuAgentFileInterval, qemuAgentUserInterval, qemuAgentVersionInterval, qemuAgentFSFreezeStatusInterval, metadataCache)
+	err := notifier.StartDomainNotifier(domainConn, deleteNotificationSent, vmi, domainName, agentStore, agentPollerIntervals, metadataCache)
 	if err != nil {
 		panic(err)
 	}
@@ -356,8 +393,14 @@ func main() {
 	qemuAgentUserInterval := pflag.Duration("qemu-agent-user-interval", 10*time.Second, "Interval between consecutive qemu agent calls for user command")
 	qemuAgentVersionInterval := pflag.Duration("qemu-agent-version-interval", 300*time.Second, "Interval between consecutive qemu agent calls for version command")
 	qemuAgentFSFreezeStatusInterval := pflag.Duration("qemu-fsfreeze-status-interval", 5*time.Second, "Interval between consecutive qemu agent calls for fsfreeze status command")
+	qemuAgentInterfacesInterval := pflag.Duration("qemu-agent-interfaces-interval", 0, "Interval between consecutive qemu agent calls for network interfaces, defaults to the sys interval")
+	qemuAgentDisksInterval := pflag.Duration("qemu-agent-disks-interval", 0, "Interval between consecutive qemu agent calls for disks command, disabled when zero")
+	qemuAgentCPUStatsInterval := pflag.Duration("qemu-agent-cpustats-interval", 0, "Interval between consecutive qemu agent calls for CPU stats command, disabled when zero")
+	qemuAgentDiskStatsInterval := pflag.Duration("qemu-agent-diskstats-interval", 0, "Interval between consecutive qemu agent calls for disk stats command, disabled when zero")
 	simulateCrash := pflag.Bool("simulate-crash", false, "Causes virt-launcher to immediately crash. This is used by functional tests to simulate crash loop scenarios.")
 	libvirtLogFilters := pflag.String("libvirt-log-filters", "", "Set custom log filters for libvirt")
+	vmStateEncryption := pflag.String("vm-state-encryption", "", "Encrypt the VM state on the backend-storage PVC with the keys of a Secret (secret) or with data keys wrapped by the local KMS (local-kms)")
+	vmStateDirs := pflag.StringToString("vm-state-dirs", nil, "Directories of the VM state kept encrypted on the backend-storage PVC, by name")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.Parse()
@@ -397,6 +440,11 @@ func main() {
 
 	vmi := v1.NewVMIReferenceWithUUID(*namespace, *name, types.UID(*uid))
 
+	var vmStateStore *encryption.StateStore
+	if *vmStateEncryption != "" {
+		vmStateStore = restoreEncryptedVMState(*vmStateEncryption, *vmStateDirs)
+	}
+
 	ephemeralDiskCreator := ephemeraldisk.NewEphemeralDiskCreator(filepath.Join(*ephemeralDiskDir, "disk-data"))
 	if err := ephemeralDiskCreator.Init(); err != nil {
 		panic(err)
@@ -427,6 +475,12 @@ func main() {
 
 	metadataCache := metadata.NewCache()
 
+	vmStateStopChan := make(chan struct{})
+	var vmStateDone chan struct{}
+	if vmStateStore != nil {
+		vmStateDone = startVMStatePersistence(vmStateStore, notifier, metadataCache, vmStateStopChan)
+	}
+
 	signalStopChan := make(chan struct{})
 	domainManager, err := virtwrap.NewLibvirtDomainManager(domainConn, *virtShareDir, *ephemeralDiskDir, &agentStore, *ovmfPath, ephemeralDiskCreator, metadataCache, signalStopChan, *diskMemoryLimitBytes, util.GetPodCPUSet, *imageVolumeEnabled)
 	if err != nil {
@@ -459,7 +513,21 @@ func main() {
 
 	events := make(chan watch.Event, 2)
 	// Send domain notifications to virt-handler
-	startDomainEventMonitoring(notifier, domainConn, events, vmi, domainName, &agentStore, *qemuAgentSysInterval, *qemuAgentFileInterval, *qemuAgentUserInterval, *qemuAgentVersionInterval, *qemuAgentFSFreezeStatusInterval, metadataCache)
+	if *qemuAgentInterfacesInterval == 0 {
+		*qemuAgentInterfacesInterval = *qemuAgentSysInterval
+	}
+	agentPollerIntervals := agentpoller.PollerIntervals{
+		Sys:            *qemuAgentSysInterval,
+		Interfaces:     *qemuAgentInterfacesInterval,
+		File:           *qemuAgentFileInterval,
+		User:           *qemuAgentUserInterval,
+		Version:        *qemuAgentVersionInterval,
+		FSFreezeStatus: *qemuAgentFSFreezeStatusInterval,
+		Disks:          *qemuAgentDisksInterval,
+		CPUStats:       *qemuAgentCPUStatsInterval,
+		DiskStats:      *qemuAgentDiskStatsInterval,
+	}
+	startDomainEventMonitoring(notifier, domainConn, events, vmi, domainName, &agentStore, agentPollerIntervals, metadataCache)
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt,
@@ -507,6 +575,11 @@ func main() {
 		waitForFinalNotify(events, domainManager, vmi)
 	}
 
+	close(vmStateStopChan)
+	if vmStateDone != nil {
+		<-vmStateDone
+	}
+
 	close(stopChan)
 	<-cmdServerDone
 
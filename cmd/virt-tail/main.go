@@ -38,8 +38,9 @@ import (
 )
 
 type VirtTail struct {
-	ctx     context.Context
-	logFile string
+	ctx      context.Context
+	logFile  string
+	recorder *recorder
 }
 
 func (v *VirtTail) tailLogsWrapper() error {
@@ -91,6 +92,10 @@ func (v *VirtTail) tailLogs(location tail.SeekInfo) (*tail.SeekInfo, error) {
 				location = line.SeekInfo
 				if line.Err != nil {
 					log.Log.V(3).Infof("tail error: %v", line.Err)
+				} else if v.recorder != nil {
+					if err := v.recorder.record(line.Text, line.Time); err != nil {
+						return nil, err
+					}
 				} else {
 					fmt.Println(line.Text)
 				}
@@ -105,6 +110,7 @@ func main() {
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.CommandLine.ParseErrorsWhitelist = pflag.ParseErrorsWhitelist{UnknownFlags: true}
 	logFile := pflag.String("logfile", "", "path of the logfile to be streamed")
+	recording := pflag.String("recording", "", "path of the file the logfile is recorded to as an asciicast, or - to record it to stdout")
 	pflag.Parse()
 
 	log.InitializeLogging("virt-tail")
@@ -126,6 +132,15 @@ func main() {
 		logFile: *logFile,
 	}
 
+	if recording != nil && *recording != "" {
+		var err error
+		v.recorder, err = newRecorder(*recording, time.Now())
+		if err != nil {
+			log.Log.V(3).Infof("failed to start the recording: %v", err)
+			os.Exit(1)
+		}
+	}
+
 	g.Go(v.tailLogsWrapper)
 
 	// wait for all errgroup goroutines
@@ -0,0 +1,115 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	// The serial console has no size, the recording uses the default size of a terminal
+	recordingWidth  = 80
+	recordingHeight = 24
+)
+
+// asciicastHeader is the header of a recording in the asciicast v2 format of asciinema,
+// see https://docs.asciinema.org/manual/asciicast/v2/
+type asciicastHeader struct {
+	Version   int   `json:"version"`
+	Width     int   `json:"width"`
+	Height    int   `json:"height"`
+	Timestamp int64 `json:"timestamp"`
+}
+
+// recorder writes the lines of the serial console as output events of an asciicast
+type recorder struct {
+	out   io.Writer
+	start time.Time
+}
+
+// newRecorder starts a recording on stdout for "-", or in the file at path otherwise.
+// An existing recording in the file is continued.
+func newRecorder(path string, now time.Time) (*recorder, error) {
+	// The header only has the start in seconds, the events are relative to it
+	now = now.Truncate(time.Second)
+	if path == "-" {
+		r := &recorder{out: os.Stdout, start: now}
+		return r, r.writeHeader()
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return nil, err
+	}
+	header, err := readHeader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if header != nil {
+		return &recorder{out: f, start: time.Unix(header.Timestamp, 0)}, nil
+	}
+	r := &recorder{out: f, start: now}
+	return r, r.writeHeader()
+}
+
+// readHeader returns the header of the recording in f, or nil if f is empty
+func readHeader(f *os.File) (*asciicastHeader, error) {
+	line, err := bufio.NewReader(f).ReadBytes('\n')
+	if err == io.EOF && len(line) == 0 {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read the recording header: %v", err)
+	}
+	header := &asciicastHeader{}
+	if err := json.Unmarshal(line, header); err != nil {
+		return nil, fmt.Errorf("failed to parse the recording header: %v", err)
+	}
+	return header, nil
+}
+
+func (r *recorder) writeHeader() error {
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     recordingWidth,
+		Height:    recordingHeight,
+		Timestamp: r.start.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(r.out, "%s\n", header)
+	return err
+}
+
+// record writes a line of the serial console, as read at t
+func (r *recorder) record(line string, t time.Time) error {
+	event, err := json.Marshal([]interface{}{t.Sub(r.start).Seconds(), "o", line + "\r\n"})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(r.out, "%s\n", event)
+	return err
+}
@@ -61,6 +61,10 @@ var (
 	ConfigMapSourceDir = filepath.Join(mountBaseDir, "config-map")
 	// SysprepSourceDir represents a location where a Sysprep is attached to the pod
 	SysprepSourceDir = filepath.Join(mountBaseDir, "sysprep")
+	// SecureBootKeysSourceDir represents a location where the SecureBoot keys are attached to the pod
+	SecureBootKeysSourceDir = filepath.Join(mountBaseDir, "secureboot-keys")
+	// HibernationStateDir represents a location where the hibernation PVC is attached to the pod
+	HibernationStateDir = filepath.Join(mountBaseDir, "hibernation")
 	// SecretSourceDir represents a location where Secrets is attached to the pod
 	SecretSourceDir = filepath.Join(mountBaseDir, "secret")
 	// DownwardAPISourceDir represents a location where downwardapi is attached to the pod
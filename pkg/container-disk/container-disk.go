@@ -364,12 +364,21 @@ func CreateEphemeralImages(
 				return fmt.Errorf("no disk info provided for volume %s", volume.Name)
 			}
 			backingFile := GetDiskTargetPathFromLauncherView(i)
+			if IsLayered(volume.ContainerDisk) {
+				backingFile = info.Filename
+			}
 			exists, err := diskutils.FileExists(backingFile)
 			if err != nil {
 				return err
 			} else if !exists {
 				return fmt.Errorf("no supported file disk found for volume found in: %s", backingFile)
 			}
+			if HasPersistentOverlay(volume.ContainerDisk) {
+				if err := createPersistentOverlay(volume, diskCreator, backingFile, info.Format); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := diskCreator.CreateBackedImageForVolume(volume, backingFile, info.Format); err != nil {
 				return err
 			}
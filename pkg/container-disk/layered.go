@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdisk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/os/disk"
+	"kubevirt.io/kubevirt/pkg/safepath"
+)
+
+// maxLayeredChainLength bounds the backing chain of layered containerDisks
+const maxLayeredChainLength = 64
+
+var (
+	getDiskInfo               = disk.GetDiskInfo
+	getDiskInfoWithValidation = disk.GetDiskInfoWithValidation
+)
+
+// IsLayered returns true if the containerDisk is served as a qcow2 backing chain spread over the image layers
+func IsLayered(containerDisk *v1.ContainerDiskSource) bool {
+	return containerDisk != nil && containerDisk.Mode == v1.ContainerDiskModeLayered
+}
+
+// GetLayeredDiskTargetName returns the name of the directory the layers of a layered containerDisk are mounted at
+func GetLayeredDiskTargetName(volumeIndex int) string {
+	return fmt.Sprintf("disk_%d.layers", volumeIndex)
+}
+
+func GetLayeredDiskTargetDirFromLauncherView(volumeIndex int) string {
+	return filepath.Join(mountBaseDir, GetLayeredDiskTargetName(volumeIndex))
+}
+
+// GetImageDir returns the directory holding the layers of a layered containerDisk.
+// The directory of imagePath is used if set, disk.DiskSourceFallbackPath otherwise.
+func GetImageDir(root *safepath.Path, imagePath string) (*safepath.Path, error) {
+	dir := disk.DiskSourceFallbackPath
+	if imagePath != "" {
+		dir = filepath.Dir(imagePath)
+	}
+	resolvedPath, err := root.AppendAndResolveWithRelativeRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine image directory %s: %v", dir, err)
+	}
+	return resolvedPath, nil
+}
+
+// ResolveLayeredImage returns the top of the backing chain in dir.
+// If imagePath is set its base name is used, otherwise the top is the only image not used as backing file by another one.
+func ResolveLayeredImage(dir string, imagePath string) (string, error) {
+	if imagePath != "" {
+		return filepath.Join(dir, filepath.Base(imagePath)), nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to list layers in %s: %v", dir, err)
+	}
+	backingFiles := map[string]bool{}
+	var images []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := getDiskInfo(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return "", fmt.Errorf("failed to inspect layer %s: %v", entry.Name(), err)
+		}
+		images = append(images, entry.Name())
+		if info.BackingFile != "" {
+			backingFiles[info.BackingFile] = true
+		}
+	}
+
+	var tops []string
+	for _, image := range images {
+		if !backingFiles[image] {
+			tops = append(tops, image)
+		}
+	}
+	if len(tops) != 1 {
+		return "", fmt.Errorf("expected exactly one image on top of the backing chain in %s, found %d", dir, len(tops))
+	}
+	return filepath.Join(dir, tops[0]), nil
+}
+
+// VerifyLayeredImage walks the backing chain of the image at topPath and returns the disk info of its top.
+// Every backing file must be recorded with its format and must live next to the image referencing it,
+// so that the chain can not escape the image.
+func VerifyLayeredImage(topPath string, diskMemoryLimitBytes int64) (*disk.DiskInfo, error) {
+	dir := filepath.Dir(topPath)
+	var topInfo *disk.DiskInfo
+	path := topPath
+	for i := 0; i < maxLayeredChainLength; i++ {
+		info, err := getDiskInfoWithValidation(path, diskMemoryLimitBytes)
+		if err != nil {
+			return nil, err
+		}
+		if topInfo == nil {
+			topInfo = info
+		}
+		if info.Format != "qcow2" && info.Format != "raw" {
+			return nil, fmt.Errorf("unsupported image format %v in layer %s", info.Format, filepath.Base(path))
+		}
+		if info.BackingFile == "" {
+			return topInfo, nil
+		}
+		if filepath.Base(info.BackingFile) != info.BackingFile {
+			return nil, fmt.Errorf("backing file %s of layer %s must be in the same directory", info.BackingFile, filepath.Base(path))
+		}
+		if info.BackingFormat == "" {
+			return nil, fmt.Errorf("backing file %s of layer %s has no recorded format", info.BackingFile, filepath.Base(path))
+		}
+		path = filepath.Join(dir, info.BackingFile)
+	}
+	return nil, fmt.Errorf("backing chain of %s is longer than %d layers", filepath.Base(topPath), maxLayeredChainLength)
+}
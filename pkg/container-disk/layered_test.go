@@ -0,0 +1,194 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdisk
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
+	"kubevirt.io/kubevirt/pkg/os/disk"
+)
+
+type touchingDiskCreator struct {
+	fake.MockEphemeralDiskImageCreator
+	created []string
+}
+
+func (c *touchingDiskCreator) CreateBackedImage(_ string, _ string, imagePath string) error {
+	if _, err := os.Stat(imagePath); err == nil {
+		return nil
+	}
+	c.created = append(c.created, imagePath)
+	return os.WriteFile(imagePath, []byte("overlay"), 0640)
+}
+
+var _ = Describe("Layered containerDisks", func() {
+	var dir string
+
+	chain := map[string]*disk.DiskInfo{
+		"base.qcow2":  {Format: "qcow2"},
+		"mid.qcow2":   {Format: "qcow2", BackingFile: "base.qcow2", BackingFormat: "qcow2"},
+		"top.qcow2":   {Format: "qcow2", BackingFile: "mid.qcow2", BackingFormat: "qcow2"},
+		"other.qcow2": {Format: "qcow2"},
+	}
+
+	fakeDiskInfo := func(path string) (*disk.DiskInfo, error) {
+		info := *chain[filepath.Base(path)]
+		info.Filename = path
+		return &info, nil
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		getDiskInfo = fakeDiskInfo
+		getDiskInfoWithValidation = func(path string, _ int64) (*disk.DiskInfo, error) {
+			return fakeDiskInfo(path)
+		}
+		DeferCleanup(func() {
+			getDiskInfo = disk.GetDiskInfo
+			getDiskInfoWithValidation = disk.GetDiskInfoWithValidation
+		})
+	})
+
+	writeLayers := func(names ...string) {
+		for _, name := range names {
+			Expect(os.WriteFile(filepath.Join(dir, name), nil, 0640)).To(Succeed())
+		}
+	}
+
+	Context("ResolveLayeredImage", func() {
+		It("should use the base name of the path if set", func() {
+			top, err := ResolveLayeredImage(dir, "/disk/top.qcow2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(top).To(Equal(filepath.Join(dir, "top.qcow2")))
+		})
+
+		It("should find the top of the chain", func() {
+			writeLayers("base.qcow2", "mid.qcow2", "top.qcow2")
+			top, err := ResolveLayeredImage(dir, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(top).To(Equal(filepath.Join(dir, "top.qcow2")))
+		})
+
+		It("should fail if the chain has more than one top", func() {
+			writeLayers("base.qcow2", "mid.qcow2", "top.qcow2", "other.qcow2")
+			_, err := ResolveLayeredImage(dir, "")
+			Expect(err).To(MatchError(ContainSubstring("expected exactly one image on top of the backing chain")))
+		})
+	})
+
+	Context("VerifyLayeredImage", func() {
+		It("should return the info of the top of a valid chain", func() {
+			info, err := VerifyLayeredImage(filepath.Join(dir, "top.qcow2"), 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Filename).To(Equal(filepath.Join(dir, "top.qcow2")))
+			Expect(info.BackingFile).To(Equal("mid.qcow2"))
+		})
+
+		DescribeTable("should reject", func(info *disk.DiskInfo, expectedError string) {
+			chain["invalid.qcow2"] = info
+			DeferCleanup(func() {
+				delete(chain, "invalid.qcow2")
+			})
+			_, err := VerifyLayeredImage(filepath.Join(dir, "invalid.qcow2"), 0)
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+			Entry("backing files outside of the image directory",
+				&disk.DiskInfo{Format: "qcow2", BackingFile: "/etc/shadow", BackingFormat: "raw"}, "must be in the same directory"),
+			Entry("backing files in parent directories",
+				&disk.DiskInfo{Format: "qcow2", BackingFile: "../base.qcow2", BackingFormat: "qcow2"}, "must be in the same directory"),
+			Entry("backing files without format",
+				&disk.DiskInfo{Format: "qcow2", BackingFile: "base.qcow2"}, "has no recorded format"),
+			Entry("unsupported formats",
+				&disk.DiskInfo{Format: "vmdk"}, "unsupported image format"),
+			Entry("chains referencing themselves",
+				&disk.DiskInfo{Format: "qcow2", BackingFile: "invalid.qcow2", BackingFormat: "qcow2"}, "longer than"),
+		)
+	})
+
+	Context("persistent overlay", func() {
+		var creator *touchingDiskCreator
+		var volume v1.Volume
+
+		BeforeEach(func() {
+			creator = &touchingDiskCreator{}
+			origOverlayBaseDir := overlayBaseDir
+			overlayBaseDir = dir
+			DeferCleanup(func() {
+				overlayBaseDir = origOverlayBaseDir
+			})
+			volume = v1.Volume{
+				Name: "disk0",
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{
+						Image:   "registry/image@sha256:1234",
+						Overlay: &v1.ContainerDiskOverlay{ClaimName: "overlay"},
+					},
+				},
+			}
+			Expect(os.MkdirAll(GetOverlayDirFromLauncherView(volume.Name), 0750)).To(Succeed())
+		})
+
+		It("should create the overlay and reuse it for the same image", func() {
+			Expect(createPersistentOverlay(volume, creator, "/backing", "qcow2")).To(Succeed())
+			Expect(creator.created).To(ConsistOf(GetOverlayPathFromLauncherView(volume.Name)))
+
+			Expect(createPersistentOverlay(volume, creator, "/backing", "qcow2")).To(Succeed())
+			Expect(creator.created).To(HaveLen(1))
+		})
+
+		It("should refuse an overlay created for another image", func() {
+			Expect(createPersistentOverlay(volume, creator, "/backing", "qcow2")).To(Succeed())
+
+			volume.ContainerDisk.Image = "registry/image@sha256:5678"
+			err := createPersistentOverlay(volume, creator, "/backing", "qcow2")
+			Expect(err).To(MatchError(ContainSubstring("refusing to use it with image registry/image@sha256:5678")))
+		})
+
+		It("should reuse the overlay for the same image digest from another registry", func() {
+			Expect(createPersistentOverlay(volume, creator, "/backing", "qcow2")).To(Succeed())
+
+			volume.ContainerDisk.Image = "mirror/image@sha256:1234"
+			Expect(createPersistentOverlay(volume, creator, "/backing", "qcow2")).To(Succeed())
+			Expect(creator.created).To(HaveLen(1))
+		})
+
+		It("should refuse an image referenced by tag", func() {
+			volume.ContainerDisk.Image = "registry/image:latest"
+			err := createPersistentOverlay(volume, creator, "/backing", "qcow2")
+			Expect(err).To(MatchError(ContainSubstring("must reference its image by digest")))
+			Expect(creator.created).To(BeEmpty())
+		})
+
+		It("should recreate an overlay left without record", func() {
+			Expect(os.WriteFile(GetOverlayPathFromLauncherView(volume.Name), []byte("partial"), 0640)).To(Succeed())
+
+			Expect(createPersistentOverlay(volume, creator, "/backing", "qcow2")).To(Succeed())
+			Expect(creator.created).To(HaveLen(1))
+			Expect(os.ReadFile(GetOverlayPathFromLauncherView(volume.Name))).To(BeEquivalentTo("overlay"))
+		})
+	})
+})
@@ -0,0 +1,131 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdisk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	overlayDiskName   = "disk.qcow2"
+	overlayRecordName = "overlay.json"
+)
+
+var overlayBaseDir = filepath.Join(util.VirtPrivateDir, "container-disk-overlays")
+
+var imageDigestRegex = regexp.MustCompile(`@(sha256:[a-fA-F0-9]+)$`)
+
+// overlayRecord is kept next to a persistent overlay to bind it to the image digest it was created for
+type overlayRecord struct {
+	Image  string `json:"image"`
+	Digest string `json:"digest"`
+}
+
+// ImageDigest returns the digest of an image referenced by digest, like registry/image@sha256:<digest>.
+// A persistent overlay is only valid on top of the exact image it was created for, which a tag can't guarantee.
+func ImageDigest(image string) (string, bool) {
+	matches := imageDigestRegex.FindStringSubmatch(image)
+	if len(matches) < 2 {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// HasPersistentOverlay returns true if the writable layer of the containerDisk is kept on a PVC
+func HasPersistentOverlay(containerDisk *v1.ContainerDiskSource) bool {
+	return containerDisk != nil && containerDisk.Overlay != nil && containerDisk.Overlay.ClaimName != ""
+}
+
+// GetOverlayDirFromLauncherView returns where the overlay PVC of a containerDisk volume is mounted
+func GetOverlayDirFromLauncherView(volumeName string) string {
+	return filepath.Join(overlayBaseDir, volumeName)
+}
+
+func GetOverlayPathFromLauncherView(volumeName string) string {
+	return filepath.Join(GetOverlayDirFromLauncherView(volumeName), overlayDiskName)
+}
+
+// createPersistentOverlay creates the overlay of the containerDisk volume on its PVC, or reuses the one
+// left by a previous run if it was created for the same image digest.
+func createPersistentOverlay(volume v1.Volume, diskCreator ephemeraldisk.EphemeralDiskCreatorInterface, backingFile string, backingFormat string) error {
+	dir := GetOverlayDirFromLauncherView(volume.Name)
+	recordPath := filepath.Join(dir, overlayRecordName)
+	overlayPath := GetOverlayPathFromLauncherView(volume.Name)
+
+	digest, isDigest := ImageDigest(volume.ContainerDisk.Image)
+	if !isDigest {
+		return fmt.Errorf("containerDisk %s with an overlay must reference its image by digest, refusing to use the overlay with image %s",
+			volume.Name, volume.ContainerDisk.Image)
+	}
+
+	record, err := readOverlayRecord(recordPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if record != nil && record.Digest != digest {
+		return fmt.Errorf("overlay of containerDisk %s on PVC %s was created for image %s, refusing to use it with image %s",
+			volume.Name, volume.ContainerDisk.Overlay.ClaimName, record.Image, volume.ContainerDisk.Image)
+	}
+	if record == nil {
+		// The record is written first, an overlay without record is a leftover of an interrupted creation
+		if err := os.Remove(overlayPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		if err := writeOverlayRecord(recordPath, &overlayRecord{Image: volume.ContainerDisk.Image, Digest: digest}); err != nil {
+			return err
+		}
+	}
+
+	return diskCreator.CreateBackedImage(backingFile, backingFormat, overlayPath)
+}
+
+func readOverlayRecord(path string) (*overlayRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	record := &overlayRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("failed to parse overlay record %s: %v", path, err)
+	}
+	return record, nil
+}
+
+func writeOverlayRecord(path string, record *overlayRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0640); err != nil {
+		return fmt.Errorf("failed to write overlay record %s: %v", path, err)
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -112,6 +112,9 @@ const (
 	// MigrationBackoffReason is set when an error has occured while migrating
 	// and virt-controller is backing off before retrying.
 	MigrationBackoffReason = "MigrationBackoff"
+	// SharedDiskGroupMismatchReason is set when a VMI does not use the same reservation LUNs as the other
+	// members of its shared disk group
+	SharedDiskGroupMismatchReason = "SharedDiskGroupMismatch"
 )
 
 type PodCacheStore struct {
@@ -124,6 +124,9 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineInstanceMigration objects
 	VirtualMachineInstanceMigration() cache.SharedIndexInformer
 
+	// Watches VirtualMachineDiskTask objects
+	VirtualMachineDiskTask() cache.SharedIndexInformer
+
 	// Watches VirtualMachineExport objects
 	VirtualMachineExport() cache.SharedIndexInformer
 
@@ -529,6 +532,22 @@ func (f *kubeInformerFactory) VirtualMachineInstanceMigration() cache.SharedInde
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineDiskTask() cache.SharedIndexInformer {
+	return f.getInformer("vmDiskTaskInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachinedisktasks", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachineDiskTask{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			"vm": func(obj interface{}) ([]string, error) {
+				task, ok := obj.(*kubev1.VirtualMachineDiskTask)
+				if !ok {
+					return nil, unexpectedObjectError
+				}
+				return []string{fmt.Sprintf("%s/%s", task.Namespace, task.Spec.VMName)}, nil
+			},
+		})
+	})
+}
+
 func (f *kubeInformerFactory) KubeVirtPod() cache.SharedIndexInformer {
 	return f.getInformer("kubeVirtPodInformer", func() cache.SharedIndexInformer {
 		// Watch all pods with the kubevirt app label
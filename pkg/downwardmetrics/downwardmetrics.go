@@ -17,6 +17,28 @@ const (
 	DownwardMetricsChannelSocket    = DownwardMetricsChannelDir + "/downwardmetrics.sock"
 )
 
+// SchemaVersion is published to the guest with every report. It is increased whenever
+// metrics are added, or when a published metric changes its name, unit or meaning.
+const SchemaVersion = 2
+
+// DefaultMetricGroups are published when the VMI does not select any group
+var DefaultMetricGroups = []v1.DownwardMetricsGroup{
+	v1.DownwardMetricsGroupHost,
+	v1.DownwardMetricsGroupCPU,
+	v1.DownwardMetricsGroupMemory,
+}
+
+// SupportedMetricGroups lists all the groups in the order they are published
+var SupportedMetricGroups = []v1.DownwardMetricsGroup{
+	v1.DownwardMetricsGroupCPU,
+	v1.DownwardMetricsGroupMemory,
+	v1.DownwardMetricsGroupHost,
+	v1.DownwardMetricsGroupDisk,
+	v1.DownwardMetricsGroupNetwork,
+	v1.DownwardMetricsGroupCPUSteal,
+	v1.DownwardMetricsGroupNUMA,
+}
+
 func CreateDownwardMetricDisk(vmi *v1.VirtualMachineInstance) error {
 	for _, volume := range vmi.Spec.Volumes {
 		if volume.DownwardMetrics != nil {
@@ -43,6 +65,40 @@ func HasDevice(spec *v1.VirtualMachineInstanceSpec) bool {
 	return spec.Domain.Devices.DownwardMetrics != nil
 }
 
+// DiskMetricGroups returns the groups published on the downward metrics disk of the VMI
+func DiskMetricGroups(vmi *v1.VirtualMachineInstance) []v1.DownwardMetricsGroup {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.DownwardMetrics != nil {
+			return metricGroupsOrDefault(volume.DownwardMetrics.MetricGroups)
+		}
+	}
+	return DefaultMetricGroups
+}
+
+// DeviceMetricGroups returns the groups published on the downward metrics virtio serial device of the VMI
+func DeviceMetricGroups(spec *v1.VirtualMachineInstanceSpec) []v1.DownwardMetricsGroup {
+	if !HasDevice(spec) {
+		return DefaultMetricGroups
+	}
+	return metricGroupsOrDefault(spec.Domain.Devices.DownwardMetrics.MetricGroups)
+}
+
+func IsSupportedMetricGroup(group v1.DownwardMetricsGroup) bool {
+	for _, supported := range SupportedMetricGroups {
+		if group == supported {
+			return true
+		}
+	}
+	return false
+}
+
+func metricGroupsOrDefault(groups []v1.DownwardMetricsGroup) []v1.DownwardMetricsGroup {
+	if len(groups) == 0 {
+		return DefaultMetricGroups
+	}
+	return groups
+}
+
 func ChannelSocketPathOnHost(pid int) string {
 	return filepath.Join("/proc", strconv.Itoa(pid), "root", DownwardMetricsChannelSocket)
 }
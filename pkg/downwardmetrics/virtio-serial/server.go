@@ -35,6 +35,7 @@ import (
 
 	"golang.org/x/time/rate"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
@@ -54,8 +55,8 @@ const (
 // (will also fail for `maxRequestsBurst` > 256)
 const _ = uint8(maxRequestsBurst - 1)
 
-func RunDownwardMetricsVirtioServer(ctx context.Context, nodeName, channelSocketPath, launcherSocketPath string) error {
-	report, err := newMetricsReporter(nodeName, launcherSocketPath)
+func RunDownwardMetricsVirtioServer(ctx context.Context, nodeName, channelSocketPath, launcherSocketPath string, groups []v1.DownwardMetricsGroup) error {
+	report, err := newMetricsReporter(nodeName, launcherSocketPath, groups)
 	if err != nil {
 		return err
 	}
@@ -72,7 +73,7 @@ func RunDownwardMetricsVirtioServer(ctx context.Context, nodeName, channelSocket
 
 type metricsReporter func() (*api.Metrics, error)
 
-func newMetricsReporter(nodeName, launcherSocketPath string) (metricsReporter, error) {
+func newMetricsReporter(nodeName, launcherSocketPath string, groups []v1.DownwardMetricsGroup) (metricsReporter, error) {
 	exists, err := diskutils.FileExists(launcherSocketPath)
 	if err != nil {
 		return nil, err
@@ -84,7 +85,7 @@ func newMetricsReporter(nodeName, launcherSocketPath string) (metricsReporter, e
 	scraper := metricsScraper.NewReporter(nodeName)
 
 	return func() (*api.Metrics, error) {
-		return scraper.Report(launcherSocketPath)
+		return scraper.Report(launcherSocketPath, groups)
 	}, nil
 }
 
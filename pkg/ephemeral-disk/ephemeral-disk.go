@@ -41,6 +41,7 @@ const (
 
 type EphemeralDiskCreatorInterface interface {
 	CreateBackedImageForVolume(volume v1.Volume, backingFile string, backingFormat string) error
+	CreateBackedImage(backingFile string, backingFormat string, imagePath string) error
 	CreateEphemeralImages(vmi *v1.VirtualMachineInstance, domain *api.Domain) error
 	GetFilePath(volumeName string) string
 	Init() error
@@ -99,8 +100,11 @@ func (c *ephemeralDiskCreator) CreateBackedImageForVolume(volume v1.Volume, back
 		return err
 	}
 
-	imagePath := c.GetFilePath(volume.Name)
+	return c.CreateBackedImage(backingFile, backingFormat, c.GetFilePath(volume.Name))
+}
 
+// CreateBackedImage creates a qcow2 image at imagePath on top of backingFile, unless it already exists.
+func (c *ephemeralDiskCreator) CreateBackedImage(backingFile string, backingFormat string, imagePath string) error {
 	if _, err := os.Stat(imagePath); err == nil {
 		return nil
 	} else if !errors.Is(err, os.ErrNotExist) {
@@ -109,8 +113,9 @@ func (c *ephemeralDiskCreator) CreateBackedImageForVolume(volume v1.Volume, back
 
 	output, err := c.discCreateFunc(backingFile, backingFormat, imagePath)
 
-	// Cleanup of previous images isn't really necessary as they're all on EmptyDir.
+	// Images may live on persistent storage, remove partial ones so they are not picked up on the next attempt.
 	if err != nil {
+		_ = os.Remove(imagePath)
 		return fmt.Errorf("qemu-img failed with output '%s': %v", string(output), err)
 	}
 
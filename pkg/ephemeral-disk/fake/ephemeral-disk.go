@@ -16,6 +16,10 @@ func (m *MockEphemeralDiskImageCreator) CreateBackedImageForVolume(_ v1.Volume,
 	return nil
 }
 
+func (m *MockEphemeralDiskImageCreator) CreateBackedImage(_ string, _ string, _ string) error {
+	return nil
+}
+
 func (m *MockEphemeralDiskImageCreator) CreateEphemeralImages(_ *v1.VirtualMachineInstance, _ *api.Domain) error {
 	return nil
 }
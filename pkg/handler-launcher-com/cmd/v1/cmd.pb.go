@@ -42,6 +42,20 @@ It has these top-level messages:
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
 	DirtyRateStatsResponse
+	GuestFileOpenRequest
+	GuestFileOpenResponse
+	GuestFileReadRequest
+	GuestFileReadResponse
+	GuestFileWriteRequest
+	GuestFileCloseRequest
+	GuestExecStartRequest
+	GuestExecStartResponse
+	GuestExecStatusRequest
+	GuestExecStatusResponse
+	GuestMemoryTargetRequest
+	TDXQuoteRequest
+	TDXQuoteResponse
+	SEVSNPAttestationInfoResponse
 */
 package v1
 
@@ -957,6 +971,406 @@ func (m *DirtyRateStatsResponse) GetDirtyRateMbs() int64 {
 	return 0
 }
 
+type GuestFileOpenRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Mode       string `protobuf:"bytes,3,opt,name=mode" json:"mode,omitempty"`
+}
+
+func (m *GuestFileOpenRequest) Reset()                    { *m = GuestFileOpenRequest{} }
+func (m *GuestFileOpenRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileOpenRequest) ProtoMessage()               {}
+func (*GuestFileOpenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *GuestFileOpenRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileOpenRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileOpenRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+type GuestFileOpenResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Handle   int64     `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+}
+
+func (m *GuestFileOpenResponse) Reset()                    { *m = GuestFileOpenResponse{} }
+func (m *GuestFileOpenResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileOpenResponse) ProtoMessage()               {}
+func (*GuestFileOpenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *GuestFileOpenResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileOpenResponse) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+type GuestFileReadRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Handle     int64  `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+	Count      int32  `protobuf:"varint,3,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *GuestFileReadRequest) Reset()                    { *m = GuestFileReadRequest{} }
+func (m *GuestFileReadRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileReadRequest) ProtoMessage()               {}
+func (*GuestFileReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *GuestFileReadRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileReadRequest) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+func (m *GuestFileReadRequest) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GuestFileReadResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Data     []byte    `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	Eof      bool      `protobuf:"varint,3,opt,name=eof" json:"eof,omitempty"`
+}
+
+func (m *GuestFileReadResponse) Reset()                    { *m = GuestFileReadResponse{} }
+func (m *GuestFileReadResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileReadResponse) ProtoMessage()               {}
+func (*GuestFileReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *GuestFileReadResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileReadResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GuestFileReadResponse) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
+}
+
+type GuestFileWriteRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Handle     int64  `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+	Data       []byte `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+}
+
+func (m *GuestFileWriteRequest) Reset()                    { *m = GuestFileWriteRequest{} }
+func (m *GuestFileWriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileWriteRequest) ProtoMessage()               {}
+func (*GuestFileWriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *GuestFileWriteRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileWriteRequest) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+func (m *GuestFileWriteRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GuestFileCloseRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Handle     int64  `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+}
+
+func (m *GuestFileCloseRequest) Reset()                    { *m = GuestFileCloseRequest{} }
+func (m *GuestFileCloseRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileCloseRequest) ProtoMessage()               {}
+func (*GuestFileCloseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *GuestFileCloseRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileCloseRequest) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+type GuestExecStartRequest struct {
+	DomainName string   `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Command    string   `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Args       []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *GuestExecStartRequest) Reset()                    { *m = GuestExecStartRequest{} }
+func (m *GuestExecStartRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStartRequest) ProtoMessage()               {}
+func (*GuestExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *GuestExecStartRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestExecStartRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *GuestExecStartRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type GuestExecStartResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Pid      int64     `protobuf:"varint,2,opt,name=pid" json:"pid,omitempty"`
+}
+
+func (m *GuestExecStartResponse) Reset()                    { *m = GuestExecStartResponse{} }
+func (m *GuestExecStartResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStartResponse) ProtoMessage()               {}
+func (*GuestExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *GuestExecStartResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestExecStartResponse) GetPid() int64 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+type GuestExecStatusRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Pid        int64  `protobuf:"varint,2,opt,name=pid" json:"pid,omitempty"`
+}
+
+func (m *GuestExecStatusRequest) Reset()                    { *m = GuestExecStatusRequest{} }
+func (m *GuestExecStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStatusRequest) ProtoMessage()               {}
+func (*GuestExecStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *GuestExecStatusRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestExecStatusRequest) GetPid() int64 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+type GuestExecStatusResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Exited   bool      `protobuf:"varint,2,opt,name=exited" json:"exited,omitempty"`
+	ExitCode int32     `protobuf:"varint,3,opt,name=exitCode" json:"exitCode,omitempty"`
+	Stdout   []byte    `protobuf:"bytes,4,opt,name=stdout" json:"stdout,omitempty"`
+	Stderr   []byte    `protobuf:"bytes,5,opt,name=stderr" json:"stderr,omitempty"`
+}
+
+func (m *GuestExecStatusResponse) Reset()                    { *m = GuestExecStatusResponse{} }
+func (m *GuestExecStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecStatusResponse) ProtoMessage()               {}
+func (*GuestExecStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *GuestExecStatusResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestExecStatusResponse) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *GuestExecStatusResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *GuestExecStatusResponse) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
+}
+
+func (m *GuestExecStatusResponse) GetStderr() []byte {
+	if m != nil {
+		return m.Stderr
+	}
+	return nil
+}
+
+type GuestMemoryTargetRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	TargetKiB  uint64 `protobuf:"varint,2,opt,name=targetKiB" json:"targetKiB,omitempty"`
+}
+
+func (m *GuestMemoryTargetRequest) Reset()                    { *m = GuestMemoryTargetRequest{} }
+func (m *GuestMemoryTargetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestMemoryTargetRequest) ProtoMessage()               {}
+func (*GuestMemoryTargetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *GuestMemoryTargetRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestMemoryTargetRequest) GetTargetKiB() uint64 {
+	if m != nil {
+		return m.TargetKiB
+	}
+	return 0
+}
+
+type TDXQuoteRequest struct {
+	Vmi    *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Report []byte `protobuf:"bytes,2,opt,name=report" json:"report,omitempty"`
+}
+
+func (m *TDXQuoteRequest) Reset()                    { *m = TDXQuoteRequest{} }
+func (m *TDXQuoteRequest) String() string            { return proto.CompactTextString(m) }
+func (*TDXQuoteRequest) ProtoMessage()               {}
+func (*TDXQuoteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *TDXQuoteRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *TDXQuoteRequest) GetReport() []byte {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+type TDXQuoteResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	TdxQuote []byte    `protobuf:"bytes,2,opt,name=tdxQuote" json:"tdxQuote,omitempty"`
+}
+
+func (m *TDXQuoteResponse) Reset()                    { *m = TDXQuoteResponse{} }
+func (m *TDXQuoteResponse) String() string            { return proto.CompactTextString(m) }
+func (*TDXQuoteResponse) ProtoMessage()               {}
+func (*TDXQuoteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *TDXQuoteResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *TDXQuoteResponse) GetTdxQuote() []byte {
+	if m != nil {
+		return m.TdxQuote
+	}
+	return nil
+}
+
+type SEVSNPAttestationInfoResponse struct {
+	Response              *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	SevSNPAttestationInfo []byte    `protobuf:"bytes,2,opt,name=sevSNPAttestationInfo" json:"sevSNPAttestationInfo,omitempty"`
+}
+
+func (m *SEVSNPAttestationInfoResponse) Reset()                    { *m = SEVSNPAttestationInfoResponse{} }
+func (m *SEVSNPAttestationInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*SEVSNPAttestationInfoResponse) ProtoMessage()               {}
+func (*SEVSNPAttestationInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *SEVSNPAttestationInfoResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *SEVSNPAttestationInfoResponse) GetSevSNPAttestationInfo() []byte {
+	if m != nil {
+		return m.SevSNPAttestationInfo
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -990,6 +1404,20 @@ func init() {
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
 	proto.RegisterType((*DirtyRateStatsResponse)(nil), "kubevirt.cmd.v1.DirtyRateStatsResponse")
+	proto.RegisterType((*GuestFileOpenRequest)(nil), "kubevirt.cmd.v1.GuestFileOpenRequest")
+	proto.RegisterType((*GuestFileOpenResponse)(nil), "kubevirt.cmd.v1.GuestFileOpenResponse")
+	proto.RegisterType((*GuestFileReadRequest)(nil), "kubevirt.cmd.v1.GuestFileReadRequest")
+	proto.RegisterType((*GuestFileReadResponse)(nil), "kubevirt.cmd.v1.GuestFileReadResponse")
+	proto.RegisterType((*GuestFileWriteRequest)(nil), "kubevirt.cmd.v1.GuestFileWriteRequest")
+	proto.RegisterType((*GuestFileCloseRequest)(nil), "kubevirt.cmd.v1.GuestFileCloseRequest")
+	proto.RegisterType((*GuestExecStartRequest)(nil), "kubevirt.cmd.v1.GuestExecStartRequest")
+	proto.RegisterType((*GuestExecStartResponse)(nil), "kubevirt.cmd.v1.GuestExecStartResponse")
+	proto.RegisterType((*GuestExecStatusRequest)(nil), "kubevirt.cmd.v1.GuestExecStatusRequest")
+	proto.RegisterType((*GuestExecStatusResponse)(nil), "kubevirt.cmd.v1.GuestExecStatusResponse")
+	proto.RegisterType((*GuestMemoryTargetRequest)(nil), "kubevirt.cmd.v1.GuestMemoryTargetRequest")
+	proto.RegisterType((*TDXQuoteRequest)(nil), "kubevirt.cmd.v1.TDXQuoteRequest")
+	proto.RegisterType((*TDXQuoteResponse)(nil), "kubevirt.cmd.v1.TDXQuoteResponse")
+	proto.RegisterType((*SEVSNPAttestationInfoResponse)(nil), "kubevirt.cmd.v1.SEVSNPAttestationInfoResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1035,6 +1463,16 @@ type CmdClient interface {
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
 	GetDomainDirtyRateStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DirtyRateStatsResponse, error)
+	GuestFileOpen(ctx context.Context, in *GuestFileOpenRequest, opts ...grpc.CallOption) (*GuestFileOpenResponse, error)
+	GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error)
+	GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error)
+	GuestFileClose(ctx context.Context, in *GuestFileCloseRequest, opts ...grpc.CallOption) (*Response, error)
+	GuestExecStart(ctx context.Context, in *GuestExecStartRequest, opts ...grpc.CallOption) (*GuestExecStartResponse, error)
+	GuestExecStatus(ctx context.Context, in *GuestExecStatusRequest, opts ...grpc.CallOption) (*GuestExecStatusResponse, error)
+	SetGuestMemoryTarget(ctx context.Context, in *GuestMemoryTargetRequest, opts ...grpc.CallOption) (*Response, error)
+	GetTDXQuote(ctx context.Context, in *TDXQuoteRequest, opts ...grpc.CallOption) (*TDXQuoteResponse, error)
+	GetSEVSNPAttestationInfo(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*SEVSNPAttestationInfoResponse, error)
+	HibernateVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1333,6 +1771,96 @@ func (c *cmdClient) GetDomainDirtyRateStats(ctx context.Context, in *EmptyReques
 	return out, nil
 }
 
+func (c *cmdClient) GuestFileOpen(ctx context.Context, in *GuestFileOpenRequest, opts ...grpc.CallOption) (*GuestFileOpenResponse, error) {
+	out := new(GuestFileOpenResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileOpen", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error) {
+	out := new(GuestFileReadResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileRead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileWrite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileClose(ctx context.Context, in *GuestFileCloseRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileClose", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestExecStart(ctx context.Context, in *GuestExecStartRequest, opts ...grpc.CallOption) (*GuestExecStartResponse, error) {
+	out := new(GuestExecStartResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestExecStart", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestExecStatus(ctx context.Context, in *GuestExecStatusRequest, opts ...grpc.CallOption) (*GuestExecStatusResponse, error) {
+	out := new(GuestExecStatusResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestExecStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) SetGuestMemoryTarget(ctx context.Context, in *GuestMemoryTargetRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SetGuestMemoryTarget", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GetTDXQuote(ctx context.Context, in *TDXQuoteRequest, opts ...grpc.CallOption) (*TDXQuoteResponse, error) {
+	out := new(TDXQuoteResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetTDXQuote", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GetSEVSNPAttestationInfo(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*SEVSNPAttestationInfoResponse, error) {
+	out := new(SEVSNPAttestationInfoResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetSEVSNPAttestationInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) HibernateVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/HibernateVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1368,6 +1896,16 @@ type CmdServer interface {
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
 	GetDomainDirtyRateStats(context.Context, *EmptyRequest) (*DirtyRateStatsResponse, error)
+	GuestFileOpen(context.Context, *GuestFileOpenRequest) (*GuestFileOpenResponse, error)
+	GuestFileRead(context.Context, *GuestFileReadRequest) (*GuestFileReadResponse, error)
+	GuestFileWrite(context.Context, *GuestFileWriteRequest) (*Response, error)
+	GuestFileClose(context.Context, *GuestFileCloseRequest) (*Response, error)
+	GuestExecStart(context.Context, *GuestExecStartRequest) (*GuestExecStartResponse, error)
+	GuestExecStatus(context.Context, *GuestExecStatusRequest) (*GuestExecStatusResponse, error)
+	SetGuestMemoryTarget(context.Context, *GuestMemoryTargetRequest) (*Response, error)
+	GetTDXQuote(context.Context, *TDXQuoteRequest) (*TDXQuoteResponse, error)
+	GetSEVSNPAttestationInfo(context.Context, *VMIRequest) (*SEVSNPAttestationInfoResponse, error)
+	HibernateVirtualMachine(context.Context, *VMIRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1950,6 +2488,186 @@ func _Cmd_GetDomainDirtyRateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GuestFileOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileOpen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileOpen(ctx, req.(*GuestFileOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileRead(ctx, req.(*GuestFileReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileWrite(ctx, req.(*GuestFileWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileClose(ctx, req.(*GuestFileCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestExecStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestExecStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestExecStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestExecStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestExecStart(ctx, req.(*GuestExecStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestExecStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestExecStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestExecStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestExecStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestExecStatus(ctx, req.(*GuestExecStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_SetGuestMemoryTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestMemoryTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SetGuestMemoryTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SetGuestMemoryTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SetGuestMemoryTarget(ctx, req.(*GuestMemoryTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GetTDXQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TDXQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetTDXQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetTDXQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetTDXQuote(ctx, req.(*TDXQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GetSEVSNPAttestationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetSEVSNPAttestationInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetSEVSNPAttestationInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetSEVSNPAttestationInfo(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_HibernateVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).HibernateVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/HibernateVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).HibernateVirtualMachine(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2082,6 +2800,46 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDomainDirtyRateStats",
 			Handler:    _Cmd_GetDomainDirtyRateStats_Handler,
 		},
+		{
+			MethodName: "GuestFileOpen",
+			Handler:    _Cmd_GuestFileOpen_Handler,
+		},
+		{
+			MethodName: "GuestFileRead",
+			Handler:    _Cmd_GuestFileRead_Handler,
+		},
+		{
+			MethodName: "GuestFileWrite",
+			Handler:    _Cmd_GuestFileWrite_Handler,
+		},
+		{
+			MethodName: "GuestFileClose",
+			Handler:    _Cmd_GuestFileClose_Handler,
+		},
+		{
+			MethodName: "GuestExecStart",
+			Handler:    _Cmd_GuestExecStart_Handler,
+		},
+		{
+			MethodName: "GuestExecStatus",
+			Handler:    _Cmd_GuestExecStatus_Handler,
+		},
+		{
+			MethodName: "SetGuestMemoryTarget",
+			Handler:    _Cmd_SetGuestMemoryTarget_Handler,
+		},
+		{
+			MethodName: "GetTDXQuote",
+			Handler:    _Cmd_GetTDXQuote_Handler,
+		},
+		{
+			MethodName: "GetSEVSNPAttestationInfo",
+			Handler:    _Cmd_GetSEVSNPAttestationInfo_Handler,
+		},
+		{
+			MethodName: "HibernateVirtualMachine",
+			Handler:    _Cmd_HibernateVirtualMachine_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2090,121 +2848,149 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1852 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
-	0x11, 0x17, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x12, 0xc3, 0x92, 0x7c, 0x56, 0x6b, 0x5b, 0xc5, 0x74,
-	0x5c, 0xa5, 0x93, 0x48, 0xb5, 0xe3, 0x64, 0x3a, 0x9e, 0x4e, 0xc6, 0x11, 0x45, 0x29, 0x4a, 0x4c,
-	0x9b, 0x39, 0x4a, 0xf2, 0x34, 0x6d, 0x26, 0x03, 0xdd, 0x41, 0x14, 0xaa, 0x3b, 0x80, 0x39, 0xe0,
-	0x58, 0x33, 0x4f, 0x9d, 0x49, 0xa7, 0x0f, 0x9d, 0xe9, 0x57, 0xe9, 0xd7, 0xe9, 0x5b, 0xbf, 0x45,
-	0xdf, 0x3b, 0xc0, 0xe1, 0xa8, 0x23, 0xef, 0x4e, 0xb2, 0x86, 0x7c, 0xe2, 0x01, 0xd8, 0xfd, 0xed,
-	0x62, 0xb1, 0xbb, 0xf8, 0xdd, 0x11, 0x3e, 0xea, 0x5f, 0xf6, 0x76, 0x2f, 0x08, 0xf7, 0x03, 0x1a,
-	0x7d, 0x12, 0x90, 0x98, 0x7b, 0x17, 0x34, 0xfa, 0xc4, 0x13, 0xe1, 0xae, 0x17, 0xfa, 0xbb, 0x83,
-	0xa7, 0xfa, 0x67, 0xa7, 0x1f, 0x09, 0x25, 0xd0, 0x07, 0x97, 0xf1, 0x19, 0x1d, 0xb0, 0x48, 0xed,
-	0xe8, 0xb9, 0xc1, 0x53, 0x7c, 0x0e, 0xf7, 0xbe, 0xa5, 0x61, 0x7c, 0x4a, 0x23, 0xc9, 0x04, 0x77,
-	0xa9, 0xec, 0x0b, 0x2e, 0x29, 0xfa, 0x0c, 0xea, 0x91, 0x7d, 0x76, 0x2a, 0x5b, 0x95, 0xed, 0xa5,
-	0x67, 0x0f, 0x76, 0x26, 0x54, 0x77, 0x52, 0x61, 0x77, 0x24, 0x8a, 0x1c, 0xb8, 0x33, 0x48, 0x90,
-	0x9c, 0xf9, 0xad, 0xca, 0x76, 0xc3, 0x4d, 0x87, 0xf8, 0x31, 0x54, 0x4f, 0xdb, 0x47, 0x46, 0x20,
-	0x64, 0x5f, 0x4b, 0xc1, 0x0d, 0xec, 0xb2, 0x9b, 0x0e, 0xf1, 0x53, 0xa8, 0x36, 0x3b, 0x27, 0x68,
-	0x15, 0xe6, 0x99, 0x6f, 0xd6, 0x56, 0xdc, 0x79, 0xe6, 0xa3, 0x4d, 0xa8, 0x4b, 0x76, 0x16, 0x30,
-	0xde, 0x93, 0xce, 0xfc, 0x56, 0x75, 0x7b, 0xc5, 0x1d, 0x8d, 0xf1, 0x2e, 0xdc, 0xe9, 0x26, 0xcf,
-	0x39, 0xb5, 0x35, 0x58, 0x18, 0x90, 0x20, 0xa6, 0xc6, 0x8d, 0x9a, 0x9b, 0x0c, 0x70, 0x0b, 0x16,
-	0x3a, 0xa4, 0x47, 0xa5, 0x5e, 0xf6, 0x44, 0xcc, 0x95, 0xd1, 0xa8, 0xb9, 0xc9, 0x00, 0x21, 0xa8,
-	0xc5, 0x9c, 0x29, 0xeb, 0xba, 0x79, 0xd6, 0x73, 0x92, 0xfd, 0x44, 0x9d, 0xaa, 0x81, 0x36, 0xcf,
-	0xf8, 0x39, 0x2c, 0xb6, 0x69, 0x28, 0xa2, 0x21, 0xda, 0x80, 0x45, 0x12, 0x66, 0x80, 0xec, 0xa8,
-	0x08, 0x09, 0xff, 0xa7, 0x02, 0xb5, 0x26, 0x0d, 0x82, 0x9c, 0xaf, 0xbb, 0xb0, 0x18, 0x1a, 0x38,
-	0x23, 0xbe, 0xf4, 0xec, 0x7e, 0x2e, 0xd2, 0x89, 0x35, 0xd7, 0x8a, 0xa1, 0x8f, 0x61, 0xa1, 0xaf,
-	0xb7, 0xe1, 0x54, 0xb7, 0xaa, 0xdb, 0x4b, 0xcf, 0x36, 0x72, 0xf2, 0x66, 0x93, 0x6e, 0x22, 0x84,
-	0x3e, 0x87, 0x86, 0xcf, 0xa4, 0x22, 0xdc, 0xa3, 0xd2, 0xa9, 0x19, 0x0d, 0x27, 0xa7, 0x61, 0xe3,
-	0xe8, 0x5e, 0x89, 0xa2, 0x6d, 0xa8, 0x79, 0xfd, 0x58, 0x3a, 0x0b, 0x46, 0x65, 0x2d, 0xa7, 0xd2,
-	0xec, 0x9c, 0xb8, 0x46, 0x02, 0xbf, 0x84, 0xfa, 0xb1, 0xe8, 0x8b, 0x40, 0xf4, 0x86, 0xe8, 0x39,
-	0x00, 0x8f, 0x43, 0xf2, 0x83, 0x47, 0x83, 0x40, 0x3a, 0x15, 0xa3, 0xbb, 0x9e, 0xd7, 0xa5, 0x41,
-	0xe0, 0x36, 0xb4, 0xa0, 0x7e, 0x92, 0xf8, 0x9f, 0x15, 0x58, 0xec, 0xb6, 0xf7, 0x98, 0x90, 0x08,
-	0xc3, 0x72, 0x48, 0x78, 0x7c, 0x4e, 0x3c, 0x15, 0x47, 0x34, 0x32, 0x71, 0x6a, 0xb8, 0x63, 0x73,
-	0x3a, 0x8b, 0xfa, 0x91, 0xf0, 0x63, 0x2f, 0x8d, 0x70, 0x3a, 0xcc, 0x26, 0x60, 0x75, 0x2c, 0x01,
-	0xd1, 0x87, 0x50, 0x95, 0x97, 0xb1, 0x53, 0x33, 0xb3, 0xfa, 0x51, 0x1f, 0xde, 0x39, 0x09, 0x59,
-	0x30, 0x74, 0x16, 0xcc, 0xa4, 0x1d, 0xe1, 0x7f, 0x54, 0xa0, 0xbe, 0xcf, 0xe4, 0xe5, 0x11, 0x3f,
-	0x17, 0x46, 0x48, 0x44, 0x21, 0x51, 0xd6, 0x11, 0x3b, 0x42, 0x5b, 0xb0, 0x74, 0x46, 0xbc, 0x4b,
-	0xc6, 0x7b, 0x07, 0x2c, 0xa0, 0xd6, 0x8d, 0xec, 0x14, 0x7a, 0x04, 0xa0, 0xfd, 0x25, 0x41, 0x37,
-	0xcd, 0x9f, 0x9a, 0x9b, 0x99, 0xd1, 0x08, 0x3a, 0x24, 0xa9, 0x40, 0xcd, 0x08, 0x64, 0xa7, 0xf0,
-	0xff, 0x2a, 0xb0, 0xd2, 0x0c, 0x62, 0xa9, 0x68, 0xd4, 0x14, 0xfc, 0x9c, 0xf5, 0xd0, 0x0e, 0xa0,
-	0xd6, 0xbb, 0x3e, 0xe1, 0xbe, 0xf6, 0x4f, 0xb6, 0x38, 0x39, 0x0b, 0x68, 0x92, 0x4a, 0x75, 0xb7,
-	0x60, 0x05, 0xfd, 0x01, 0x1e, 0x1c, 0x44, 0x94, 0xea, 0x7c, 0x70, 0x69, 0x5f, 0x44, 0x8a, 0xf1,
-	0xde, 0x3e, 0x93, 0x89, 0xda, 0xbc, 0x51, 0x2b, 0x17, 0x40, 0x2f, 0xc0, 0xd9, 0x13, 0xde, 0x85,
-	0xdc, 0x67, 0xb2, 0x1f, 0x90, 0xe1, 0x81, 0x88, 0x5a, 0x07, 0x47, 0x87, 0x31, 0x95, 0x4a, 0x9a,
-	0xfd, 0xd4, 0xdd, 0xd2, 0x75, 0xad, 0xdb, 0xa5, 0x11, 0x23, 0x41, 0x53, 0x70, 0x29, 0x02, 0xfa,
-	0x4a, 0x5c, 0x19, 0xae, 0x25, 0xba, 0x65, 0xeb, 0xf8, 0x53, 0x78, 0x70, 0xc4, 0x15, 0x8d, 0xce,
-	0x89, 0x47, 0xf7, 0x18, 0xf7, 0x19, 0xef, 0xb5, 0x59, 0x2f, 0x22, 0x4a, 0x9f, 0xe3, 0x86, 0x2e,
-	0x3e, 0x75, 0x21, 0xfc, 0xf4, 0x40, 0x92, 0x11, 0xfe, 0xef, 0x1d, 0x58, 0x3f, 0x4d, 0x82, 0xd7,
-	0x26, 0xde, 0x05, 0xe3, 0xf4, 0x4d, 0x5f, 0x2b, 0x48, 0xf4, 0x0d, 0xac, 0x8d, 0x2f, 0x24, 0x99,
-	0x66, 0xfb, 0x5a, 0xbe, 0xda, 0x92, 0x65, 0xb7, 0x50, 0x09, 0x3d, 0x87, 0xf5, 0x36, 0x0d, 0xf7,
-	0x48, 0x10, 0x08, 0xc1, 0xbb, 0x8a, 0x28, 0xd9, 0xa1, 0x11, 0x13, 0x49, 0x34, 0x57, 0xdc, 0xe2,
-	0x45, 0xf4, 0x3b, 0xb8, 0xd7, 0x89, 0xa8, 0x9e, 0xf7, 0x88, 0xa2, 0xfe, 0xa9, 0x08, 0xe2, 0xd0,
-	0xd6, 0x6f, 0xc3, 0x2d, 0x5a, 0xd2, 0x0d, 0x58, 0xd9, 0x9a, 0x32, 0xf1, 0x2a, 0x6a, 0xc0, 0x69,
-	0xd1, 0xb9, 0x23, 0x51, 0xd4, 0x85, 0x86, 0x49, 0x00, 0x9d, 0xbb, 0xb6, 0x72, 0x3f, 0xcb, 0xe9,
-	0x15, 0x86, 0x69, 0x67, 0xa4, 0xd7, 0xe2, 0x2a, 0x1a, 0xba, 0x57, 0x38, 0x25, 0x59, 0xb7, 0x58,
-	0x9a, 0x75, 0xfb, 0xb0, 0xe2, 0x65, 0xd3, 0xd6, 0xb9, 0x63, 0x36, 0xf0, 0x28, 0xdf, 0x06, 0xb2,
-	0x52, 0xee, 0xb8, 0x12, 0xfa, 0xb9, 0x02, 0x0f, 0x58, 0x9a, 0x06, 0xfb, 0x22, 0x24, 0x8c, 0x7f,
-	0xa9, 0x14, 0xf1, 0x2e, 0x42, 0xca, 0x95, 0x53, 0x37, 0x7b, 0x6b, 0xbd, 0xe7, 0xde, 0x8e, 0xca,
-	0x70, 0x92, 0xbd, 0x96, 0xdb, 0x41, 0x1c, 0xd0, 0x68, 0x71, 0x94, 0x84, 0x4e, 0xc3, 0x58, 0xff,
-	0xe2, 0xb6, 0xd6, 0x47, 0x00, 0x89, 0xd9, 0x02, 0xe4, 0xcd, 0xb7, 0xb0, 0x3a, 0x7e, 0x10, 0xba,
-	0x71, 0x5d, 0xd2, 0xa1, 0xcd, 0x76, 0xfd, 0x88, 0x76, 0xb3, 0x97, 0x5b, 0x51, 0x62, 0xa4, 0xdd,
-	0xcb, 0xde, 0x7b, 0x2f, 0xe6, 0x7f, 0x5f, 0xd9, 0x7c, 0x05, 0x8f, 0xae, 0x8f, 0x42, 0x81, 0xa1,
-	0xb1, 0x5b, 0xb4, 0x91, 0x45, 0xfb, 0x11, 0xee, 0x97, 0xec, 0xaa, 0x00, 0xe6, 0xe5, 0xb8, 0xbf,
-	0xbf, 0xcd, 0xf9, 0x5b, 0x5a, 0xed, 0x19, 0x93, 0x78, 0x00, 0x70, 0xda, 0x3e, 0x72, 0xe9, 0x8f,
-	0xba, 0xc1, 0xa0, 0x27, 0x50, 0x1d, 0x84, 0xcc, 0xd6, 0x70, 0xfe, 0x72, 0xd2, 0x92, 0x5a, 0x00,
-	0xbd, 0x84, 0x3b, 0x22, 0x39, 0x06, 0x6b, 0xfd, 0xc9, 0xfb, 0x1d, 0x9a, 0x9b, 0xaa, 0xe1, 0x63,
-	0xf8, 0xf0, 0xca, 0x9f, 0x5b, 0x5a, 0x77, 0xc6, 0xad, 0x2f, 0x5f, 0xa1, 0xfe, 0x5c, 0x81, 0xa5,
-	0xd6, 0x3b, 0xea, 0xa5, 0x88, 0x8f, 0x00, 0x7c, 0x73, 0x2a, 0xaf, 0x49, 0x48, 0x6d, 0xf0, 0x32,
-	0x33, 0x1a, 0xa9, 0x29, 0xc2, 0x90, 0x70, 0x3f, 0xbd, 0xf2, 0xec, 0x50, 0x73, 0x8d, 0x2f, 0xa3,
-	0x5e, 0xda, 0x4c, 0xcc, 0x33, 0x7a, 0x02, 0xab, 0x8a, 0x85, 0x54, 0xc4, 0xaa, 0x4b, 0x3d, 0xc1,
-	0x7d, 0x69, 0x7a, 0xc8, 0x82, 0x3b, 0x31, 0x8b, 0x57, 0x61, 0xb9, 0x15, 0xf6, 0xd5, 0xd0, 0x7a,
-	0x81, 0xbf, 0x80, 0xba, 0x9b, 0xe1, 0x72, 0x32, 0xf6, 0x3c, 0x2a, 0xa5, 0xbd, 0x60, 0xd2, 0xa1,
-	0x5e, 0x09, 0xa9, 0x94, 0xa4, 0x97, 0x26, 0x46, 0x3a, 0xc4, 0x3f, 0xc0, 0x6a, 0x92, 0x5b, 0xd3,
-	0x12, 0xc9, 0x0d, 0x58, 0x4c, 0x36, 0x6f, 0x2d, 0xd8, 0x11, 0xe6, 0x70, 0x2f, 0x31, 0x60, 0xba,
-	0xeb, 0xb4, 0x56, 0xb6, 0x60, 0xc9, 0xbf, 0x42, 0x4b, 0x2f, 0xf1, 0xcc, 0x14, 0x7e, 0x07, 0x77,
-	0xcd, 0x85, 0x66, 0xaa, 0x69, 0x4a, 0x6b, 0x1f, 0xc3, 0xdd, 0xde, 0x24, 0x96, 0xb5, 0x99, 0x5f,
-	0xc0, 0x7f, 0xaf, 0xc0, 0xba, 0x31, 0x7d, 0x22, 0x69, 0xf4, 0x8a, 0x49, 0x35, 0xad, 0xf9, 0xe7,
-	0xb0, 0xde, 0x2b, 0xc2, 0xb3, 0x2e, 0x14, 0x2f, 0xe2, 0x7f, 0x55, 0xc0, 0x31, 0x6e, 0x68, 0x4e,
-	0x23, 0x87, 0x52, 0xd1, 0x70, 0xea, 0xb0, 0xbf, 0x00, 0xa7, 0x57, 0x02, 0x69, 0x9d, 0x29, 0x5d,
-	0xc7, 0x43, 0x58, 0x4e, 0xca, 0x66, 0x3a, 0x17, 0x36, 0xa1, 0x4e, 0xdf, 0x31, 0xd5, 0x14, 0x7e,
-	0x62, 0x72, 0xc1, 0x1d, 0x8d, 0x75, 0xee, 0x49, 0xe5, 0xbf, 0x89, 0x95, 0xa5, 0x90, 0x76, 0x84,
-	0xbf, 0x83, 0x0f, 0x4d, 0x24, 0x3a, 0x9a, 0x28, 0xbf, 0x67, 0xd9, 0xe6, 0x0b, 0x71, 0xbe, 0xb0,
-	0x10, 0xbf, 0xb6, 0x79, 0x96, 0x60, 0x4f, 0xb5, 0x37, 0x2c, 0x60, 0x45, 0x73, 0xba, 0x9f, 0xe8,
-	0x6d, 0xbb, 0xd5, 0xe7, 0xb0, 0x11, 0xf3, 0x73, 0xa3, 0x7a, 0x5c, 0xe4, 0x74, 0xc9, 0x2a, 0x7e,
-	0x0b, 0x77, 0x93, 0x37, 0x94, 0xfd, 0x38, 0xec, 0xdf, 0xd6, 0xe8, 0x26, 0xd4, 0xfd, 0x38, 0xec,
-	0x77, 0x88, 0xba, 0xb0, 0x87, 0x3f, 0x1a, 0xe3, 0x33, 0xf8, 0xa0, 0xdb, 0x3a, 0x9d, 0x45, 0xed,
-	0xe9, 0x66, 0x46, 0x07, 0x86, 0x15, 0xd9, 0x46, 0x6c, 0x87, 0xf8, 0x6f, 0x15, 0x78, 0xf0, 0xca,
-	0xbc, 0x33, 0xb7, 0x29, 0x91, 0x71, 0x44, 0xf5, 0x85, 0x38, 0x83, 0x52, 0x0f, 0x26, 0x31, 0xad,
-	0xe1, 0xfc, 0x02, 0xfe, 0x5e, 0xf3, 0xdd, 0xbf, 0x50, 0x4f, 0x25, 0x7e, 0x74, 0xa9, 0x17, 0x51,
-	0x35, 0xbb, 0xab, 0x46, 0xc2, 0xc6, 0x3e, 0x8b, 0xd4, 0xd0, 0x25, 0x8a, 0xce, 0xa4, 0x6d, 0x62,
-	0x58, 0xf6, 0x53, 0xc0, 0xf6, 0x59, 0x62, 0xaf, 0xea, 0x8e, 0xcd, 0x3d, 0xfb, 0xf7, 0x3a, 0x54,
-	0x9b, 0xa1, 0x8f, 0x5e, 0x03, 0xea, 0x0e, 0xb9, 0x37, 0x7e, 0xc7, 0xa2, 0x5f, 0x14, 0xee, 0x23,
-	0xd9, 0xf1, 0x66, 0xb9, 0x0f, 0x78, 0x0e, 0xbd, 0x81, 0x7b, 0x1d, 0x12, 0x4b, 0x3a, 0x33, 0xc0,
-	0x6f, 0x61, 0xfd, 0x84, 0xf7, 0x67, 0x0a, 0xd9, 0x85, 0xb5, 0xa4, 0x00, 0x27, 0x10, 0xf3, 0x04,
-	0x78, 0xac, 0x4e, 0xaf, 0x07, 0x75, 0x61, 0xe3, 0xc4, 0x96, 0xdf, 0x2c, 0x83, 0xe9, 0x52, 0x49,
-	0xd5, 0xcc, 0x00, 0x8f, 0xc1, 0xe9, 0x8a, 0x73, 0xe5, 0xd2, 0x33, 0x21, 0x66, 0x87, 0xea, 0xc2,
-	0x46, 0xf7, 0x22, 0x56, 0xbe, 0xf8, 0x2b, 0x9f, 0x19, 0xe6, 0x6b, 0x40, 0xdf, 0xb0, 0x20, 0x98,
-	0x19, 0x5e, 0x07, 0xd6, 0xf6, 0x69, 0x40, 0xd5, 0xec, 0x0e, 0xe7, 0x2d, 0xac, 0x27, 0xbc, 0x73,
-	0x12, 0xf2, 0x57, 0xf9, 0xef, 0x43, 0x13, 0xfc, 0xf4, 0xc6, 0x53, 0xd7, 0x25, 0x39, 0x52, 0x3a,
-	0x26, 0x51, 0x8f, 0xaa, 0x29, 0x3c, 0xfd, 0x23, 0x3c, 0x6c, 0x12, 0xee, 0xd1, 0x89, 0x68, 0x5e,
-	0xbd, 0xb3, 0x4f, 0x77, 0xf4, 0xac, 0xc7, 0x49, 0x90, 0x38, 0xd9, 0x11, 0x7e, 0x33, 0xa0, 0x84,
-	0xc7, 0xfd, 0x29, 0x30, 0xff, 0x04, 0x8f, 0x0f, 0x18, 0x27, 0x01, 0x9b, 0xac, 0xa4, 0x59, 0x38,
-	0xfc, 0x1a, 0xd0, 0x57, 0x42, 0xf5, 0x83, 0xb8, 0xf7, 0x95, 0x90, 0x6a, 0x9f, 0x0e, 0x98, 0x47,
-	0xe5, 0x14, 0x78, 0x6d, 0x68, 0x1c, 0x52, 0x95, 0x70, 0x5e, 0xf4, 0x30, 0x27, 0x99, 0x65, 0xef,
-	0x9b, 0x8f, 0xf3, 0x2f, 0x82, 0x63, 0x64, 0xdc, 0x24, 0xd5, 0xea, 0x08, 0xce, 0xdc, 0x05, 0x37,
-	0x61, 0xfe, 0xba, 0x04, 0x73, 0xec, 0x22, 0x31, 0x3d, 0x6f, 0xf9, 0x90, 0xaa, 0x11, 0x57, 0xbe,
-	0x09, 0x16, 0xe7, 0x96, 0x73, 0x34, 0xdb, 0x80, 0xd6, 0x0f, 0xa9, 0xe1, 0xa4, 0x37, 0xfa, 0xf9,
-	0xa4, 0x18, 0x30, 0xc7, 0x67, 0xe7, 0xd0, 0x9f, 0x4d, 0x08, 0x32, 0xdc, 0xf2, 0x26, 0xe8, 0x8f,
-	0x8a, 0xa1, 0x8b, 0xd8, 0xe9, 0x1c, 0xda, 0x83, 0x9a, 0xe6, 0x70, 0x37, 0x61, 0x5e, 0x7b, 0xe6,
-	0x2d, 0xa8, 0x69, 0x8e, 0x8b, 0x7e, 0x99, 0xc7, 0xb8, 0x7a, 0x63, 0xdc, 0x7c, 0x58, 0xb2, 0x9a,
-	0x69, 0xc6, 0x8d, 0x11, 0xa7, 0x2c, 0x68, 0x1a, 0x93, 0x5c, 0xb6, 0xec, 0x4c, 0xb2, 0x94, 0xd4,
-	0x54, 0x8f, 0x33, 0x51, 0x35, 0x23, 0xea, 0x87, 0x70, 0xc9, 0x97, 0xeb, 0x0c, 0x2f, 0xbc, 0xa9,
-	0xe7, 0xe9, 0xb3, 0xc9, 0xfc, 0x21, 0x71, 0xfb, 0xf4, 0x2c, 0xf8, 0x37, 0xc3, 0xf6, 0x91, 0x1c,
-	0x0d, 0x69, 0x76, 0x4e, 0xe4, 0x94, 0x97, 0x5d, 0x0e, 0xd3, 0xfe, 0x31, 0x30, 0xcd, 0x9d, 0x0c,
-	0x87, 0x54, 0x59, 0xda, 0x7b, 0xd3, 0xf6, 0xb7, 0xf2, 0x1f, 0x2f, 0xc7, 0xf9, 0x32, 0x9e, 0x43,
-	0x04, 0xd6, 0x0e, 0xa9, 0xca, 0x51, 0xdc, 0xeb, 0x5d, 0xcc, 0x7f, 0xa3, 0x29, 0xe5, 0xc8, 0x78,
-	0x0e, 0x7d, 0x0f, 0x28, 0x4f, 0x60, 0x51, 0xd1, 0x77, 0x9e, 0x12, 0x96, 0x7b, 0x7d, 0x48, 0x3c,
-	0xb8, 0x3f, 0x6a, 0x5a, 0xe3, 0x4c, 0xf6, 0xa6, 0xf8, 0xfc, 0xa6, 0xe0, 0xd3, 0x58, 0x11, 0x13,
-	0xc6, 0x73, 0x7b, 0xb5, 0xef, 0xe6, 0x07, 0x4f, 0xcf, 0x16, 0xcd, 0xdf, 0x64, 0x9f, 0xfe, 0x3f,
-	0x00, 0x00, 0xff, 0xff, 0xf4, 0x5f, 0x67, 0x81, 0x53, 0x1b, 0x00, 0x00,
+	// 2298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xdf, 0x73, 0xdb, 0xb8,
+	0xf1, 0xb7, 0x2c, 0xd9, 0xb1, 0xd7, 0x3f, 0x92, 0x20, 0xb6, 0xc3, 0xe8, 0x9b, 0x1f, 0x3e, 0xcc,
+	0xb7, 0x3e, 0x5f, 0xe7, 0xce, 0x6e, 0x72, 0xb9, 0x9b, 0x4e, 0xa6, 0x73, 0x93, 0x58, 0x56, 0x1c,
+	0x27, 0x71, 0xa2, 0x50, 0xb6, 0x73, 0xbd, 0xf6, 0xee, 0x0a, 0x93, 0x90, 0xcc, 0x9a, 0x24, 0x74,
+	0x04, 0xa8, 0x46, 0x79, 0xea, 0xcc, 0x75, 0xfa, 0xd0, 0x69, 0xff, 0x9b, 0xfe, 0x2f, 0x7d, 0xeb,
+	0x4b, 0xff, 0x86, 0xbe, 0x77, 0x00, 0x82, 0x14, 0x25, 0x92, 0x92, 0x3d, 0xd2, 0x93, 0xb9, 0xc0,
+	0xee, 0x67, 0x17, 0xc0, 0x2e, 0x76, 0xb1, 0x32, 0x7c, 0xd6, 0xb9, 0x68, 0xef, 0x9e, 0x13, 0xdf,
+	0x76, 0x69, 0xf0, 0x85, 0x4b, 0x42, 0xdf, 0x3a, 0xa7, 0xc1, 0x17, 0x16, 0xf3, 0x76, 0x2d, 0xcf,
+	0xde, 0xed, 0x3e, 0x94, 0x7f, 0x76, 0x3a, 0x01, 0x13, 0x0c, 0x5d, 0xbf, 0x08, 0xcf, 0x68, 0xd7,
+	0x09, 0xc4, 0x8e, 0x1c, 0xeb, 0x3e, 0xc4, 0x2d, 0xb8, 0xf5, 0x8e, 0x7a, 0xe1, 0x29, 0x0d, 0xb8,
+	0xc3, 0x7c, 0x93, 0xf2, 0x0e, 0xf3, 0x39, 0x45, 0x5f, 0xc1, 0x42, 0xa0, 0xbf, 0x8d, 0xd2, 0x66,
+	0x69, 0x7b, 0xe9, 0xd1, 0x9d, 0x9d, 0x21, 0xd1, 0x9d, 0x98, 0xd9, 0x4c, 0x58, 0x91, 0x01, 0xd7,
+	0xba, 0x11, 0x92, 0x31, 0xbb, 0x59, 0xda, 0x5e, 0x34, 0x63, 0x12, 0x3f, 0x80, 0xf2, 0xe9, 0xd1,
+	0xa1, 0x62, 0xf0, 0x9c, 0x97, 0x9c, 0xf9, 0x0a, 0x76, 0xd9, 0x8c, 0x49, 0xfc, 0x10, 0xca, 0xb5,
+	0xc6, 0x09, 0x5a, 0x85, 0x59, 0xc7, 0x56, 0x73, 0x2b, 0xe6, 0xac, 0x63, 0xa3, 0x2a, 0x2c, 0x70,
+	0xe7, 0xcc, 0x75, 0xfc, 0x36, 0x37, 0x66, 0x37, 0xcb, 0xdb, 0x2b, 0x66, 0x42, 0xe3, 0x5d, 0xb8,
+	0xd6, 0x8c, 0xbe, 0x33, 0x62, 0x6b, 0x30, 0xd7, 0x25, 0x6e, 0x48, 0x95, 0x19, 0x15, 0x33, 0x22,
+	0x70, 0x1d, 0xe6, 0x1a, 0xa4, 0x4d, 0xb9, 0x9c, 0xb6, 0x58, 0xe8, 0x0b, 0x25, 0x51, 0x31, 0x23,
+	0x02, 0x21, 0xa8, 0x84, 0xbe, 0x23, 0xb4, 0xe9, 0xea, 0x5b, 0x8e, 0x71, 0xe7, 0x23, 0x35, 0xca,
+	0x0a, 0x5a, 0x7d, 0xe3, 0xc7, 0x30, 0x7f, 0x44, 0x3d, 0x16, 0xf4, 0xd0, 0x06, 0xcc, 0x13, 0x2f,
+	0x05, 0xa4, 0xa9, 0x3c, 0x24, 0xfc, 0xaf, 0x12, 0x54, 0x6a, 0xd4, 0x75, 0x33, 0xb6, 0xee, 0xc2,
+	0xbc, 0xa7, 0xe0, 0x14, 0xfb, 0xd2, 0xa3, 0xdb, 0x99, 0x9d, 0x8e, 0xb4, 0x99, 0x9a, 0x0d, 0x7d,
+	0x0e, 0x73, 0x1d, 0xb9, 0x0c, 0xa3, 0xbc, 0x59, 0xde, 0x5e, 0x7a, 0xb4, 0x91, 0xe1, 0x57, 0x8b,
+	0x34, 0x23, 0x26, 0xf4, 0x35, 0x2c, 0xda, 0x0e, 0x17, 0xc4, 0xb7, 0x28, 0x37, 0x2a, 0x4a, 0xc2,
+	0xc8, 0x48, 0xe8, 0x7d, 0x34, 0xfb, 0xac, 0x68, 0x1b, 0x2a, 0x56, 0x27, 0xe4, 0xc6, 0x9c, 0x12,
+	0x59, 0xcb, 0x88, 0xd4, 0x1a, 0x27, 0xa6, 0xe2, 0xc0, 0x4f, 0x61, 0xe1, 0x98, 0x75, 0x98, 0xcb,
+	0xda, 0x3d, 0xf4, 0x18, 0xc0, 0x0f, 0x3d, 0xf2, 0xa3, 0x45, 0x5d, 0x97, 0x1b, 0x25, 0x25, 0xbb,
+	0x9e, 0x95, 0xa5, 0xae, 0x6b, 0x2e, 0x4a, 0x46, 0xf9, 0xc5, 0xf1, 0xdf, 0x4a, 0x30, 0xdf, 0x3c,
+	0xda, 0x73, 0x18, 0x47, 0x18, 0x96, 0x3d, 0xe2, 0x87, 0x2d, 0x62, 0x89, 0x30, 0xa0, 0x81, 0xda,
+	0xa7, 0x45, 0x73, 0x60, 0x4c, 0x7a, 0x51, 0x27, 0x60, 0x76, 0x68, 0xc5, 0x3b, 0x1c, 0x93, 0x69,
+	0x07, 0x2c, 0x0f, 0x38, 0x20, 0xba, 0x01, 0x65, 0x7e, 0x11, 0x1a, 0x15, 0x35, 0x2a, 0x3f, 0xe5,
+	0xe1, 0xb5, 0x88, 0xe7, 0xb8, 0x3d, 0x63, 0x4e, 0x0d, 0x6a, 0x0a, 0xff, 0xb5, 0x04, 0x0b, 0xfb,
+	0x0e, 0xbf, 0x38, 0xf4, 0x5b, 0x4c, 0x31, 0xb1, 0xc0, 0x23, 0x42, 0x1b, 0xa2, 0x29, 0xb4, 0x09,
+	0x4b, 0x67, 0xc4, 0xba, 0x70, 0xfc, 0xf6, 0x73, 0xc7, 0xa5, 0xda, 0x8c, 0xf4, 0x10, 0xba, 0x0f,
+	0x20, 0xed, 0x25, 0x6e, 0x33, 0xf6, 0x9f, 0x8a, 0x99, 0x1a, 0x91, 0x08, 0x72, 0x4b, 0x62, 0x86,
+	0x8a, 0x62, 0x48, 0x0f, 0xe1, 0xff, 0x96, 0x60, 0xa5, 0xe6, 0x86, 0x5c, 0xd0, 0xa0, 0xc6, 0xfc,
+	0x96, 0xd3, 0x46, 0x3b, 0x80, 0xea, 0x1f, 0x3a, 0xc4, 0xb7, 0xa5, 0x7d, 0xbc, 0xee, 0x93, 0x33,
+	0x97, 0x46, 0xae, 0xb4, 0x60, 0xe6, 0xcc, 0xa0, 0xdf, 0xc0, 0x9d, 0xe7, 0x01, 0xa5, 0xd2, 0x1f,
+	0x4c, 0xda, 0x61, 0x81, 0x70, 0xfc, 0xf6, 0xbe, 0xc3, 0x23, 0xb1, 0x59, 0x25, 0x56, 0xcc, 0x80,
+	0x9e, 0x80, 0xb1, 0xc7, 0xac, 0x73, 0xbe, 0xef, 0xf0, 0x8e, 0x4b, 0x7a, 0xcf, 0x59, 0x50, 0x7f,
+	0x7e, 0x78, 0x10, 0x52, 0x2e, 0xb8, 0x5a, 0xcf, 0x82, 0x59, 0x38, 0x2f, 0x65, 0x9b, 0x34, 0x70,
+	0x88, 0x5b, 0x63, 0x3e, 0x67, 0x2e, 0x7d, 0xcd, 0xfa, 0x8a, 0x2b, 0x91, 0x6c, 0xd1, 0x3c, 0xfe,
+	0x12, 0xee, 0x1c, 0xfa, 0x82, 0x06, 0x2d, 0x62, 0xd1, 0x3d, 0xc7, 0xb7, 0x1d, 0xbf, 0x7d, 0xe4,
+	0xb4, 0x03, 0x22, 0xe4, 0x39, 0x6e, 0xc8, 0xe0, 0x13, 0xe7, 0xcc, 0x8e, 0x0f, 0x24, 0xa2, 0xf0,
+	0xbf, 0xaf, 0xc1, 0xfa, 0x69, 0xb4, 0x79, 0x47, 0xc4, 0x3a, 0x77, 0x7c, 0xfa, 0xb6, 0x23, 0x05,
+	0x38, 0x7a, 0x05, 0x6b, 0x83, 0x13, 0x91, 0xa7, 0x19, 0xa5, 0x82, 0x68, 0x8b, 0xa6, 0xcd, 0x5c,
+	0x21, 0xf4, 0x18, 0xd6, 0x8f, 0xa8, 0xb7, 0x47, 0x5c, 0x97, 0x31, 0xbf, 0x29, 0x88, 0xe0, 0x0d,
+	0x1a, 0x38, 0x2c, 0xda, 0xcd, 0x15, 0x33, 0x7f, 0x12, 0xfd, 0x0a, 0x6e, 0x35, 0x02, 0x2a, 0xc7,
+	0x2d, 0x22, 0xa8, 0x7d, 0xca, 0xdc, 0xd0, 0xd3, 0xf1, 0xbb, 0x68, 0xe6, 0x4d, 0xc9, 0x0b, 0x58,
+	0xe8, 0x98, 0x32, 0x2a, 0x05, 0x17, 0x70, 0x1c, 0x74, 0x66, 0xc2, 0x8a, 0x9a, 0xb0, 0xa8, 0x1c,
+	0x40, 0xfa, 0xae, 0x8e, 0xdc, 0xaf, 0x32, 0x72, 0xb9, 0xdb, 0xb4, 0x93, 0xc8, 0xd5, 0x7d, 0x11,
+	0xf4, 0xcc, 0x3e, 0x4e, 0x81, 0xd7, 0xcd, 0x17, 0x7a, 0xdd, 0x3e, 0xac, 0x58, 0x69, 0xb7, 0x35,
+	0xae, 0xa9, 0x05, 0xdc, 0xcf, 0x5e, 0x03, 0x69, 0x2e, 0x73, 0x50, 0x08, 0xfd, 0x5c, 0x82, 0x3b,
+	0x4e, 0xec, 0x06, 0xfb, 0xcc, 0x23, 0x8e, 0xff, 0x4c, 0x08, 0x62, 0x9d, 0x7b, 0xd4, 0x17, 0xc6,
+	0x82, 0x5a, 0x5b, 0xfd, 0x92, 0x6b, 0x3b, 0x2c, 0xc2, 0x89, 0xd6, 0x5a, 0xac, 0x07, 0xf9, 0x80,
+	0x92, 0xc9, 0xc4, 0x09, 0x8d, 0x45, 0xa5, 0xfd, 0x9b, 0xab, 0x6a, 0x4f, 0x00, 0x22, 0xb5, 0x39,
+	0xc8, 0xd5, 0xf7, 0xb0, 0x3a, 0x78, 0x10, 0xf2, 0xe2, 0xba, 0xa0, 0x3d, 0xed, 0xed, 0xf2, 0x13,
+	0xed, 0xa6, 0x93, 0x5b, 0x9e, 0x63, 0xc4, 0xb7, 0x97, 0xce, 0x7b, 0x4f, 0x66, 0x7f, 0x5d, 0xaa,
+	0xbe, 0x86, 0xfb, 0xa3, 0x77, 0x21, 0x47, 0xd1, 0x40, 0x16, 0x5d, 0x4c, 0xa3, 0xfd, 0x04, 0xb7,
+	0x0b, 0x56, 0x95, 0x03, 0xf3, 0x74, 0xd0, 0xde, 0x5f, 0x66, 0xec, 0x2d, 0x8c, 0xf6, 0x94, 0x4a,
+	0xdc, 0x05, 0x38, 0x3d, 0x3a, 0x34, 0xe9, 0x4f, 0xf2, 0x82, 0x41, 0x5b, 0x50, 0xee, 0x7a, 0x8e,
+	0x8e, 0xe1, 0x6c, 0x72, 0x92, 0x9c, 0x92, 0x01, 0x3d, 0x85, 0x6b, 0x2c, 0x3a, 0x06, 0xad, 0x7d,
+	0xeb, 0x72, 0x87, 0x66, 0xc6, 0x62, 0xf8, 0x18, 0x6e, 0xf4, 0xed, 0xb9, 0xa2, 0x76, 0x63, 0x50,
+	0xfb, 0x72, 0x1f, 0xf5, 0xe7, 0x12, 0x2c, 0xd5, 0x3f, 0x50, 0x2b, 0x46, 0xbc, 0x0f, 0x60, 0xab,
+	0x53, 0x79, 0x43, 0x3c, 0xaa, 0x37, 0x2f, 0x35, 0x22, 0x91, 0x6a, 0xcc, 0xf3, 0x88, 0x6f, 0xc7,
+	0x29, 0x4f, 0x93, 0xb2, 0xd6, 0x78, 0x16, 0xb4, 0xe3, 0xcb, 0x44, 0x7d, 0xa3, 0x2d, 0x58, 0x15,
+	0x8e, 0x47, 0x59, 0x28, 0x9a, 0xd4, 0x62, 0xbe, 0xcd, 0xd5, 0x1d, 0x32, 0x67, 0x0e, 0x8d, 0xe2,
+	0x55, 0x58, 0xae, 0x7b, 0x1d, 0xd1, 0xd3, 0x56, 0xe0, 0x6f, 0x60, 0xc1, 0x4c, 0xd5, 0x72, 0x3c,
+	0xb4, 0x2c, 0xca, 0xb9, 0x4e, 0x30, 0x31, 0x29, 0x67, 0x3c, 0xca, 0x39, 0x69, 0xc7, 0x8e, 0x11,
+	0x93, 0xf8, 0x47, 0x58, 0x8d, 0x7c, 0x6b, 0xd2, 0x42, 0x72, 0x03, 0xe6, 0xa3, 0xc5, 0x6b, 0x0d,
+	0x9a, 0xc2, 0x3e, 0xdc, 0x8a, 0x14, 0xa8, 0xdb, 0x75, 0x52, 0x2d, 0x9b, 0xb0, 0x64, 0xf7, 0xd1,
+	0xe2, 0x24, 0x9e, 0x1a, 0xc2, 0x1f, 0xe0, 0xa6, 0x4a, 0x68, 0x2a, 0x9a, 0x26, 0xd4, 0xf6, 0x39,
+	0xdc, 0x6c, 0x0f, 0x63, 0x69, 0x9d, 0xd9, 0x09, 0xfc, 0x97, 0x12, 0xac, 0x2b, 0xd5, 0x27, 0x9c,
+	0x06, 0xaf, 0x1d, 0x2e, 0x26, 0x55, 0xff, 0x18, 0xd6, 0xdb, 0x79, 0x78, 0xda, 0x84, 0xfc, 0x49,
+	0xfc, 0x8f, 0x12, 0x18, 0xca, 0x0c, 0x59, 0xd3, 0xf0, 0x1e, 0x17, 0xd4, 0x9b, 0x78, 0xdb, 0x9f,
+	0x80, 0xd1, 0x2e, 0x80, 0xd4, 0xc6, 0x14, 0xce, 0xe3, 0x1e, 0x2c, 0x47, 0x61, 0x33, 0x99, 0x09,
+	0x55, 0x58, 0xa0, 0x1f, 0x1c, 0x51, 0x63, 0x76, 0xa4, 0x72, 0xce, 0x4c, 0x68, 0xe9, 0x7b, 0x5c,
+	0xd8, 0x6f, 0x43, 0xa1, 0x4b, 0x48, 0x4d, 0xe1, 0xef, 0xe0, 0x86, 0xda, 0x89, 0x86, 0x2c, 0x94,
+	0x2f, 0x19, 0xb6, 0xd9, 0x40, 0x9c, 0xcd, 0x0d, 0xc4, 0x97, 0x70, 0x33, 0x85, 0x3d, 0xd1, 0xda,
+	0x30, 0x83, 0x15, 0x59, 0xd3, 0x7d, 0xa4, 0x57, 0xbd, 0xad, 0xbe, 0x86, 0x8d, 0xd0, 0x6f, 0x29,
+	0xd1, 0xe3, 0x3c, 0xa3, 0x0b, 0x66, 0xf1, 0x7b, 0xb8, 0x19, 0xbd, 0x50, 0xf6, 0x43, 0xaf, 0x73,
+	0x55, 0xa5, 0x55, 0x58, 0xb0, 0x43, 0xaf, 0xd3, 0x20, 0xe2, 0x5c, 0x1f, 0x7e, 0x42, 0xe3, 0x33,
+	0xb8, 0xde, 0xac, 0x9f, 0x4e, 0x23, 0xf6, 0xe4, 0x65, 0x46, 0xbb, 0xaa, 0x2a, 0xd2, 0x17, 0xb1,
+	0x26, 0xf1, 0x9f, 0x4b, 0x70, 0xe7, 0xb5, 0x7a, 0x33, 0x1f, 0x51, 0xc2, 0xc3, 0x80, 0xca, 0x84,
+	0x38, 0x85, 0x50, 0x77, 0x87, 0x31, 0xb5, 0xe2, 0xec, 0x04, 0xfe, 0x5e, 0xd6, 0xbb, 0x7f, 0xa4,
+	0x96, 0x88, 0xec, 0x68, 0x52, 0x2b, 0xa0, 0x62, 0x7a, 0xa9, 0x86, 0xc3, 0xc6, 0xbe, 0x13, 0x88,
+	0x9e, 0x49, 0x04, 0x9d, 0xca, 0xb5, 0x89, 0x61, 0xd9, 0x8e, 0x01, 0x8f, 0xce, 0x22, 0x7d, 0x65,
+	0x73, 0x60, 0x0c, 0xff, 0x00, 0x6b, 0xc9, 0xb5, 0xf1, 0xb6, 0x43, 0xfd, 0xcb, 0x06, 0x0c, 0x82,
+	0x4a, 0xa7, 0xef, 0x0a, 0xea, 0x5b, 0x8e, 0x79, 0x32, 0x50, 0xa3, 0x70, 0x54, 0xdf, 0xb8, 0x05,
+	0xeb, 0x43, 0xf8, 0x13, 0x27, 0x9c, 0xa8, 0x83, 0xa2, 0x57, 0xa3, 0x29, 0x6c, 0xa7, 0xd6, 0x61,
+	0x52, 0x62, 0x5f, 0x76, 0x1d, 0x05, 0x78, 0xfd, 0xce, 0x43, 0x59, 0x85, 0x54, 0x44, 0x60, 0x01,
+	0xeb, 0x43, 0x5a, 0x26, 0x5b, 0x0d, 0x82, 0x8a, 0x4d, 0x04, 0xd1, 0x9e, 0xa0, 0xbe, 0x65, 0x5d,
+	0x46, 0x59, 0x4b, 0x3f, 0xdc, 0xe4, 0x27, 0xb6, 0x52, 0x5a, 0xdf, 0x07, 0x8e, 0xa0, 0x93, 0x2e,
+	0x2e, 0x56, 0x5b, 0xee, 0xab, 0xc5, 0x6f, 0x53, 0x4a, 0x6a, 0x2e, 0xe3, 0x93, 0x2a, 0xc1, 0x54,
+	0x03, 0xca, 0x34, 0xd0, 0x14, 0x24, 0x10, 0x57, 0x28, 0xa1, 0xac, 0xc1, 0x12, 0xca, 0xea, 0x97,
+	0x50, 0x24, 0x55, 0x42, 0xc9, 0x6f, 0x4c, 0x60, 0x63, 0x58, 0xcd, 0x64, 0x67, 0x72, 0x03, 0xca,
+	0x1d, 0xc7, 0xd6, 0x8b, 0x91, 0x9f, 0xf8, 0xe5, 0xa0, 0x0a, 0x11, 0xf2, 0xcb, 0x2e, 0x25, 0x8b,
+	0xf5, 0xcf, 0x12, 0xdc, 0xce, 0x80, 0x4d, 0x1c, 0x12, 0x32, 0x27, 0x26, 0x9d, 0x02, 0x4d, 0x0d,
+	0xe4, 0xce, 0x72, 0x6e, 0xee, 0x64, 0xa1, 0x50, 0x05, 0xe7, 0xb2, 0xa9, 0x29, 0x3d, 0x4e, 0x83,
+	0xc0, 0x98, 0x4b, 0xc6, 0x69, 0x10, 0xe0, 0x6f, 0x75, 0x75, 0x11, 0xe5, 0x8f, 0x63, 0x12, 0xb4,
+	0xe9, 0xa5, 0xcf, 0xf3, 0x2e, 0x2c, 0x0a, 0x25, 0xf0, 0xca, 0xd9, 0xd3, 0x7d, 0xbe, 0xfe, 0x00,
+	0x7e, 0x07, 0xd7, 0x8f, 0xf7, 0xbf, 0x7d, 0x17, 0x32, 0x71, 0xe5, 0x3c, 0xb8, 0x01, 0xf3, 0x81,
+	0x6a, 0x86, 0xe8, 0xf8, 0xd1, 0x14, 0xa6, 0x70, 0xa3, 0x0f, 0x39, 0x71, 0xfd, 0x21, 0xec, 0x0f,
+	0x0a, 0x4a, 0x2b, 0x49, 0x68, 0xfc, 0xf7, 0x12, 0xdc, 0x6b, 0xd6, 0x4f, 0x9b, 0x6f, 0x1a, 0xcf,
+	0x84, 0xa0, 0x5c, 0xa8, 0x97, 0xc7, 0x34, 0x92, 0xe0, 0x63, 0x58, 0xe7, 0xb4, 0x9b, 0xc5, 0xd5,
+	0x16, 0xe4, 0x4f, 0x3e, 0xfa, 0xcf, 0x5d, 0x28, 0xd7, 0x3c, 0x1b, 0xbd, 0x01, 0xd4, 0xec, 0xf9,
+	0xd6, 0xe0, 0x6b, 0x09, 0xfd, 0x5f, 0xee, 0x36, 0x46, 0x1b, 0x5e, 0x2d, 0xb6, 0x0a, 0xcf, 0xa0,
+	0xb7, 0x70, 0xab, 0x41, 0x42, 0x4e, 0xa7, 0x06, 0xf8, 0x0e, 0xd6, 0x4f, 0xfc, 0xce, 0x54, 0x21,
+	0x9b, 0xb0, 0x16, 0x95, 0x52, 0x43, 0x88, 0xd9, 0x56, 0xc6, 0x40, 0xc5, 0x35, 0x1a, 0xd4, 0x84,
+	0x8d, 0x13, 0xbf, 0x95, 0x07, 0x3b, 0xd1, 0x66, 0x9a, 0x94, 0x53, 0x31, 0x35, 0xc0, 0x63, 0x30,
+	0x9a, 0xac, 0x25, 0x4c, 0x7a, 0xc6, 0xd8, 0xf4, 0x50, 0x4d, 0xd8, 0x68, 0x9e, 0x87, 0xc2, 0x66,
+	0x7f, 0xf2, 0xa7, 0x86, 0xf9, 0x06, 0xd0, 0x2b, 0xc7, 0x75, 0xa7, 0x86, 0xd7, 0x80, 0xb5, 0x7d,
+	0xea, 0x52, 0x31, 0xbd, 0xc3, 0x79, 0x0f, 0xeb, 0x51, 0x07, 0x61, 0x18, 0xf2, 0x93, 0x8c, 0xd4,
+	0x70, 0xa7, 0x61, 0xec, 0xa9, 0xcb, 0x90, 0x4c, 0x84, 0xa2, 0xfb, 0x73, 0x02, 0x4b, 0x7f, 0x0b,
+	0xf7, 0x6a, 0xb2, 0xfb, 0x3f, 0xb4, 0x9b, 0x89, 0x82, 0x09, 0x8f, 0xde, 0x69, 0xfb, 0xc4, 0x8d,
+	0x8c, 0x6c, 0x30, 0xbb, 0xe6, 0x52, 0xe2, 0x87, 0x9d, 0x09, 0x30, 0x7f, 0x07, 0x0f, 0x9e, 0x3b,
+	0x3e, 0x71, 0x9d, 0x8f, 0x74, 0xfa, 0x06, 0xbf, 0x01, 0xf4, 0x82, 0x89, 0x8e, 0x1b, 0xb6, 0x5f,
+	0x30, 0x2e, 0xf6, 0x69, 0xd7, 0xb1, 0x28, 0x9f, 0x00, 0xef, 0x08, 0x16, 0x0f, 0xa8, 0x88, 0xba,
+	0x17, 0xe8, 0x5e, 0x86, 0x33, 0xdd, 0x87, 0xa9, 0x3e, 0xc8, 0x4c, 0x0f, 0xb6, 0x55, 0x94, 0x53,
+	0xad, 0x26, 0x70, 0xaa, 0xaa, 0x1f, 0x87, 0xf9, 0xff, 0x05, 0x98, 0x03, 0x4f, 0x02, 0x75, 0xe7,
+	0x2d, 0x1f, 0x50, 0x91, 0x74, 0x3d, 0xc6, 0xc1, 0xe2, 0xcc, 0x74, 0xa6, 0x61, 0xa2, 0x40, 0x17,
+	0x0e, 0xa8, 0xea, 0x2e, 0x8c, 0xb5, 0x73, 0x2b, 0x1f, 0x30, 0xd3, 0x99, 0x98, 0x41, 0xbf, 0x57,
+	0x5b, 0x90, 0xea, 0x12, 0x8c, 0x83, 0xfe, 0x2c, 0x1f, 0x3a, 0xaf, 0xcf, 0x30, 0x83, 0xf6, 0xa0,
+	0x22, 0x5f, 0xe3, 0xe3, 0x30, 0x47, 0x9e, 0x79, 0x1d, 0x2a, 0xb2, 0x1e, 0x43, 0x77, 0xb3, 0x18,
+	0xfd, 0xde, 0x5f, 0xf5, 0x5e, 0xc1, 0x6c, 0xea, 0x32, 0x5e, 0x4c, 0xba, 0x03, 0x39, 0x97, 0xc6,
+	0x70, 0x57, 0xa2, 0x8a, 0x47, 0xb1, 0xa4, 0xa2, 0xc7, 0x18, 0x8a, 0x9a, 0xe4, 0x11, 0x8f, 0x70,
+	0xc1, 0x6f, 0x90, 0xa9, 0x17, 0xfe, 0xb8, 0x3b, 0x4f, 0x9e, 0x4d, 0xea, 0xa7, 0xe5, 0xab, 0xbb,
+	0x67, 0xce, 0xef, 0xd2, 0xfa, 0x1e, 0xc9, 0x94, 0x21, 0xb5, 0xc6, 0x09, 0x9f, 0x30, 0xd9, 0x65,
+	0x30, 0xa3, 0x05, 0x4f, 0x94, 0x93, 0xe1, 0x80, 0x0a, 0xdd, 0xc0, 0x18, 0xb7, 0xfc, 0xcd, 0xcc,
+	0xf4, 0x50, 0xe7, 0x03, 0xcf, 0x20, 0x02, 0x6b, 0x07, 0x54, 0x64, 0x9a, 0x15, 0xa3, 0x4d, 0xcc,
+	0x76, 0xdb, 0x0b, 0xbb, 0x1d, 0x78, 0x06, 0x7d, 0x0f, 0x28, 0xdb, 0x8a, 0x40, 0x79, 0x1d, 0xfb,
+	0x82, 0x7e, 0xc5, 0xb8, 0x8d, 0x5e, 0x3a, 0xa0, 0x22, 0x2e, 0xa2, 0x51, 0x76, 0xd1, 0x43, 0x25,
+	0x7b, 0xf5, 0x93, 0x11, 0x1c, 0x09, 0xaa, 0x03, 0x46, 0xb4, 0xd1, 0xd9, 0xea, 0x75, 0xf4, 0xde,
+	0xec, 0xe4, 0x6d, 0x7a, 0x71, 0xdd, 0x8d, 0x67, 0x90, 0x05, 0xb7, 0x93, 0x5b, 0x77, 0xb0, 0xa9,
+	0x32, 0xee, 0x80, 0x3f, 0xcd, 0xf9, 0x95, 0x26, 0xaf, 0x29, 0x83, 0x67, 0xd0, 0x1f, 0x60, 0x65,
+	0xa0, 0xb7, 0x81, 0x7e, 0x51, 0x7c, 0x6f, 0xa5, 0x7a, 0x2b, 0xd5, 0xad, 0x71, 0x6c, 0xb9, 0x1a,
+	0x64, 0xbf, 0x61, 0x94, 0x86, 0x54, 0xd7, 0xa3, 0xba, 0x35, 0x8e, 0x2d, 0xd1, 0x70, 0x02, 0xab,
+	0x83, 0xbd, 0x05, 0x34, 0x42, 0x36, 0xdd, 0x7c, 0x18, 0xed, 0x40, 0x69, 0x58, 0xd5, 0x4d, 0x18,
+	0x05, 0x9b, 0x6e, 0x37, 0x8c, 0x86, 0xb5, 0x34, 0x6c, 0xf2, 0xd8, 0x2f, 0x82, 0x1d, 0x6e, 0x3a,
+	0x54, 0x3f, 0x1d, 0xcb, 0x97, 0x28, 0x69, 0xc1, 0xf5, 0xa1, 0x17, 0x3a, 0x1a, 0x2d, 0xdd, 0x6f,
+	0x08, 0x54, 0xb7, 0xc7, 0x33, 0x26, 0x7a, 0x7e, 0x80, 0xb5, 0xa6, 0x4e, 0xe0, 0xe9, 0x67, 0x35,
+	0x2a, 0xc8, 0x7e, 0x39, 0x4f, 0xef, 0x71, 0x8f, 0xa2, 0xdb, 0x2f, 0x9c, 0x33, 0x1a, 0xf8, 0x64,
+	0x7a, 0x35, 0xf2, 0x5e, 0xe5, 0xbb, 0xd9, 0xee, 0xc3, 0xb3, 0x79, 0xf5, 0x5f, 0x4a, 0x5f, 0xfe,
+	0x6f, 0x00, 0x3f, 0x02, 0xcd, 0x14, 0xd2, 0x24, 0x00, 0x00,
 }
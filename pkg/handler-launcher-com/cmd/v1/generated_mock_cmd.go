@@ -301,6 +301,46 @@ func (mr *MockCmdClientMockRecorder) GetSEVInfo(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockCmdClient)(nil).GetSEVInfo), varargs...)
 }
 
+// GetSEVSNPAttestationInfo mocks base method.
+func (m *MockCmdClient) GetSEVSNPAttestationInfo(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*SEVSNPAttestationInfoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationInfo", varargs...)
+	ret0, _ := ret[0].(*SEVSNPAttestationInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationInfo indicates an expected call of GetSEVSNPAttestationInfo.
+func (mr *MockCmdClientMockRecorder) GetSEVSNPAttestationInfo(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationInfo", reflect.TypeOf((*MockCmdClient)(nil).GetSEVSNPAttestationInfo), varargs...)
+}
+
+// GetTDXQuote mocks base method.
+func (m *MockCmdClient) GetTDXQuote(ctx context.Context, in *TDXQuoteRequest, opts ...grpc.CallOption) (*TDXQuoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTDXQuote", varargs...)
+	ret0, _ := ret[0].(*TDXQuoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTDXQuote indicates an expected call of GetTDXQuote.
+func (mr *MockCmdClientMockRecorder) GetTDXQuote(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTDXQuote", reflect.TypeOf((*MockCmdClient)(nil).GetTDXQuote), varargs...)
+}
+
 // GetUsers mocks base method.
 func (m *MockCmdClient) GetUsers(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestUserListResponse, error) {
 	m.ctrl.T.Helper()
@@ -321,6 +361,126 @@ func (mr *MockCmdClientMockRecorder) GetUsers(ctx, in any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockCmdClient)(nil).GetUsers), varargs...)
 }
 
+// GuestExecStart mocks base method.
+func (m *MockCmdClient) GuestExecStart(ctx context.Context, in *GuestExecStartRequest, opts ...grpc.CallOption) (*GuestExecStartResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestExecStart", varargs...)
+	ret0, _ := ret[0].(*GuestExecStartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStart indicates an expected call of GuestExecStart.
+func (mr *MockCmdClientMockRecorder) GuestExecStart(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStart", reflect.TypeOf((*MockCmdClient)(nil).GuestExecStart), varargs...)
+}
+
+// GuestExecStatus mocks base method.
+func (m *MockCmdClient) GuestExecStatus(ctx context.Context, in *GuestExecStatusRequest, opts ...grpc.CallOption) (*GuestExecStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestExecStatus", varargs...)
+	ret0, _ := ret[0].(*GuestExecStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStatus indicates an expected call of GuestExecStatus.
+func (mr *MockCmdClientMockRecorder) GuestExecStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStatus", reflect.TypeOf((*MockCmdClient)(nil).GuestExecStatus), varargs...)
+}
+
+// GuestFileClose mocks base method.
+func (m *MockCmdClient) GuestFileClose(ctx context.Context, in *GuestFileCloseRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileClose", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileClose indicates an expected call of GuestFileClose.
+func (mr *MockCmdClientMockRecorder) GuestFileClose(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileClose", reflect.TypeOf((*MockCmdClient)(nil).GuestFileClose), varargs...)
+}
+
+// GuestFileOpen mocks base method.
+func (m *MockCmdClient) GuestFileOpen(ctx context.Context, in *GuestFileOpenRequest, opts ...grpc.CallOption) (*GuestFileOpenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileOpen", varargs...)
+	ret0, _ := ret[0].(*GuestFileOpenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileOpen indicates an expected call of GuestFileOpen.
+func (mr *MockCmdClientMockRecorder) GuestFileOpen(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileOpen", reflect.TypeOf((*MockCmdClient)(nil).GuestFileOpen), varargs...)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockCmdClient) GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileRead", varargs...)
+	ret0, _ := ret[0].(*GuestFileReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockCmdClientMockRecorder) GuestFileRead(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockCmdClient)(nil).GuestFileRead), varargs...)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockCmdClient) GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileWrite", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockCmdClientMockRecorder) GuestFileWrite(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockCmdClient)(nil).GuestFileWrite), varargs...)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdClient) GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -341,6 +501,26 @@ func (mr *MockCmdClientMockRecorder) GuestPing(ctx, in any, opts ...any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestPing", reflect.TypeOf((*MockCmdClient)(nil).GuestPing), varargs...)
 }
 
+// HibernateVirtualMachine mocks base method.
+func (m *MockCmdClient) HibernateVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HibernateVirtualMachine", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HibernateVirtualMachine indicates an expected call of HibernateVirtualMachine.
+func (mr *MockCmdClientMockRecorder) HibernateVirtualMachine(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HibernateVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).HibernateVirtualMachine), varargs...)
+}
+
 // HotplugHostDevices mocks base method.
 func (m *MockCmdClient) HotplugHostDevices(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -481,6 +661,26 @@ func (mr *MockCmdClientMockRecorder) ResetVirtualMachine(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).ResetVirtualMachine), varargs...)
 }
 
+// SetGuestMemoryTarget mocks base method.
+func (m *MockCmdClient) SetGuestMemoryTarget(ctx context.Context, in *GuestMemoryTargetRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetGuestMemoryTarget", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetGuestMemoryTarget indicates an expected call of SetGuestMemoryTarget.
+func (mr *MockCmdClientMockRecorder) SetGuestMemoryTarget(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuestMemoryTarget", reflect.TypeOf((*MockCmdClient)(nil).SetGuestMemoryTarget), varargs...)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockCmdClient) ShutdownVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -900,6 +1100,36 @@ func (mr *MockCmdServerMockRecorder) GetSEVInfo(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockCmdServer)(nil).GetSEVInfo), arg0, arg1)
 }
 
+// GetSEVSNPAttestationInfo mocks base method.
+func (m *MockCmdServer) GetSEVSNPAttestationInfo(arg0 context.Context, arg1 *VMIRequest) (*SEVSNPAttestationInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationInfo", arg0, arg1)
+	ret0, _ := ret[0].(*SEVSNPAttestationInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationInfo indicates an expected call of GetSEVSNPAttestationInfo.
+func (mr *MockCmdServerMockRecorder) GetSEVSNPAttestationInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationInfo", reflect.TypeOf((*MockCmdServer)(nil).GetSEVSNPAttestationInfo), arg0, arg1)
+}
+
+// GetTDXQuote mocks base method.
+func (m *MockCmdServer) GetTDXQuote(arg0 context.Context, arg1 *TDXQuoteRequest) (*TDXQuoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTDXQuote", arg0, arg1)
+	ret0, _ := ret[0].(*TDXQuoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTDXQuote indicates an expected call of GetTDXQuote.
+func (mr *MockCmdServerMockRecorder) GetTDXQuote(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTDXQuote", reflect.TypeOf((*MockCmdServer)(nil).GetTDXQuote), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockCmdServer) GetUsers(arg0 context.Context, arg1 *EmptyRequest) (*GuestUserListResponse, error) {
 	m.ctrl.T.Helper()
@@ -915,6 +1145,96 @@ func (mr *MockCmdServerMockRecorder) GetUsers(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockCmdServer)(nil).GetUsers), arg0, arg1)
 }
 
+// GuestExecStart mocks base method.
+func (m *MockCmdServer) GuestExecStart(arg0 context.Context, arg1 *GuestExecStartRequest) (*GuestExecStartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStart", arg0, arg1)
+	ret0, _ := ret[0].(*GuestExecStartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStart indicates an expected call of GuestExecStart.
+func (mr *MockCmdServerMockRecorder) GuestExecStart(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStart", reflect.TypeOf((*MockCmdServer)(nil).GuestExecStart), arg0, arg1)
+}
+
+// GuestExecStatus mocks base method.
+func (m *MockCmdServer) GuestExecStatus(arg0 context.Context, arg1 *GuestExecStatusRequest) (*GuestExecStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStatus", arg0, arg1)
+	ret0, _ := ret[0].(*GuestExecStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStatus indicates an expected call of GuestExecStatus.
+func (mr *MockCmdServerMockRecorder) GuestExecStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStatus", reflect.TypeOf((*MockCmdServer)(nil).GuestExecStatus), arg0, arg1)
+}
+
+// GuestFileClose mocks base method.
+func (m *MockCmdServer) GuestFileClose(arg0 context.Context, arg1 *GuestFileCloseRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileClose", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileClose indicates an expected call of GuestFileClose.
+func (mr *MockCmdServerMockRecorder) GuestFileClose(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileClose", reflect.TypeOf((*MockCmdServer)(nil).GuestFileClose), arg0, arg1)
+}
+
+// GuestFileOpen mocks base method.
+func (m *MockCmdServer) GuestFileOpen(arg0 context.Context, arg1 *GuestFileOpenRequest) (*GuestFileOpenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileOpen", arg0, arg1)
+	ret0, _ := ret[0].(*GuestFileOpenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileOpen indicates an expected call of GuestFileOpen.
+func (mr *MockCmdServerMockRecorder) GuestFileOpen(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileOpen", reflect.TypeOf((*MockCmdServer)(nil).GuestFileOpen), arg0, arg1)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockCmdServer) GuestFileRead(arg0 context.Context, arg1 *GuestFileReadRequest) (*GuestFileReadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", arg0, arg1)
+	ret0, _ := ret[0].(*GuestFileReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockCmdServerMockRecorder) GuestFileRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockCmdServer)(nil).GuestFileRead), arg0, arg1)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockCmdServer) GuestFileWrite(arg0 context.Context, arg1 *GuestFileWriteRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockCmdServerMockRecorder) GuestFileWrite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockCmdServer)(nil).GuestFileWrite), arg0, arg1)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdServer) GuestPing(arg0 context.Context, arg1 *GuestPingRequest) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -930,6 +1250,21 @@ func (mr *MockCmdServerMockRecorder) GuestPing(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestPing", reflect.TypeOf((*MockCmdServer)(nil).GuestPing), arg0, arg1)
 }
 
+// HibernateVirtualMachine mocks base method.
+func (m *MockCmdServer) HibernateVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HibernateVirtualMachine", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HibernateVirtualMachine indicates an expected call of HibernateVirtualMachine.
+func (mr *MockCmdServerMockRecorder) HibernateVirtualMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HibernateVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).HibernateVirtualMachine), arg0, arg1)
+}
+
 // HotplugHostDevices mocks base method.
 func (m *MockCmdServer) HotplugHostDevices(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -1035,6 +1370,21 @@ func (mr *MockCmdServerMockRecorder) ResetVirtualMachine(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).ResetVirtualMachine), arg0, arg1)
 }
 
+// SetGuestMemoryTarget mocks base method.
+func (m *MockCmdServer) SetGuestMemoryTarget(arg0 context.Context, arg1 *GuestMemoryTargetRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuestMemoryTarget", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetGuestMemoryTarget indicates an expected call of SetGuestMemoryTarget.
+func (mr *MockCmdServerMockRecorder) SetGuestMemoryTarget(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuestMemoryTarget", reflect.TypeOf((*MockCmdServer)(nil).SetGuestMemoryTarget), arg0, arg1)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockCmdServer) ShutdownVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -17,6 +17,7 @@ limitations under the License.
 package libvmi
 
 import (
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -50,6 +51,17 @@ func WithUefi(secureBoot bool) Option {
 	}
 }
 
+// WithSecureBootKeysSecret configures SecureBoot with persistent EFI and the custom keys of the Secret.
+func WithSecureBootKeysSecret(secretName string) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		WithUefi(true)(vmi)
+		vmi.Spec.Domain.Firmware.Bootloader.EFI.Persistent = pointer.P(true)
+		vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBootKeys = &v1.SecureBootKeysSource{
+			Secret: &k8sv1.LocalObjectReference{Name: secretName},
+		}
+	}
+}
+
 func WithKernelBootContainer(imageName string) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Spec.Domain.Firmware = &v1.Firmware{
@@ -16,7 +16,11 @@ limitations under the License.
 
 package libvmi
 
-import v1 "kubevirt.io/api/core/v1"
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
 
 // WithSEV adds `launchSecurity` with `sev`.
 func WithSEV(isESEnabled bool) Option {
@@ -31,6 +35,27 @@ func WithSEV(isESEnabled bool) Option {
 	}
 }
 
+// WithSEVSNP adds `launchSecurity` with `sev` using SEV-SNP.
+func WithSEVSNP() Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+			SEV: &v1.SEV{
+				Policy: &v1.SEVPolicy{
+					SecureNestedPaging: pointer.P(true),
+				},
+			},
+		}
+	}
+}
+
+// WithSEVSNPAttestation requests attestation for a SEV-SNP VMI, which does not need to start paused.
+func WithSEVSNPAttestation() Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		WithSEVSNP()(vmi)
+		vmi.Spec.Domain.LaunchSecurity.SEV.Attestation = &v1.SEVAttestation{}
+	}
+}
+
 func WithSEVAttestation() Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		startStrategy := v1.StartStrategyPaused
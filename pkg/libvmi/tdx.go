@@ -0,0 +1,41 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvmi
+
+import v1 "kubevirt.io/api/core/v1"
+
+// WithTDX adds `launchSecurity` with `tdx`.
+func WithTDX() Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+			TDX: &v1.TDX{},
+		}
+	}
+}
+
+// WithTDXAttestation requests the quote generation service for a `tdx` VMI.
+func WithTDXAttestation() Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.LaunchSecurity == nil {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{}
+		}
+		if vmi.Spec.Domain.LaunchSecurity.TDX == nil {
+			vmi.Spec.Domain.LaunchSecurity.TDX = &v1.TDX{}
+		}
+		vmi.Spec.Domain.LaunchSecurity.TDX.Attestation = &v1.TDXAttestation{}
+	}
+}
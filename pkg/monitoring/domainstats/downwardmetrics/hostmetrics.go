@@ -22,6 +22,9 @@ package downwardmetrics
 import (
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/prometheus/procfs"
@@ -30,8 +33,12 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
 	metricspkg "kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/metrics"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
 )
 
+// maxHostCPUs bounds the host CPU lists read from sysfs
+const maxHostCPUs = 8192
+
 type hostMetricsCollector struct {
 	procPath string
 	sysPath  string
@@ -141,6 +148,76 @@ func (h *hostMetricsCollector) Collect() (metrics []api.Metric) {
 	return
 }
 
+// numaMetrics reports the number of host NUMA nodes and the nodes the vCPUs of the VM are allowed to run on
+func (h *hostMetricsCollector) numaMetrics(cpuMap [][]bool) []api.Metric {
+	nodeCPUs, err := h.numaNodeCPUs()
+	if err != nil {
+		log.Log.Reason(err).Info("failed to collect the NUMA nodes of the node")
+		return nil
+	}
+
+	metrics := []api.Metric{
+		metricspkg.MustToUnitlessHostMetric(len(nodeCPUs), "NumberOfNUMANodes"),
+	}
+	if len(cpuMap) == 0 {
+		return metrics
+	}
+
+	var vmNodes []int
+	for node, cpus := range nodeCPUs {
+		if vcpusAllowedOnAny(cpuMap, cpus) {
+			vmNodes = append(vmNodes, node)
+		}
+	}
+	sort.Ints(vmNodes)
+
+	nodeIDs := make([]string, 0, len(vmNodes))
+	for _, node := range vmNodes {
+		nodeIDs = append(nodeIDs, strconv.Itoa(node))
+	}
+	return append(metrics, metricspkg.MustToVMMetric(strings.Join(nodeIDs, ","), "NUMANodes", ""))
+}
+
+// numaNodeCPUs returns the host CPUs of each NUMA node
+func (h *hostMetricsCollector) numaNodeCPUs() (map[int][]int, error) {
+	nodeDirs, err := filepath.Glob(filepath.Join(h.sysPath, "devices", "system", "node", "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	nodeCPUs := map[int][]int{}
+	for _, nodeDir := range nodeDirs {
+		node, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(nodeDir), "node"))
+		if err != nil {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(nodeDir, "cpulist"))
+		if err != nil {
+			return nil, err
+		}
+		// memory only nodes have no CPUs
+		var cpus []int
+		if cpuList := strings.TrimSpace(string(content)); cpuList != "" {
+			if cpus, err = hardware.ParseCPUSetLine(cpuList, maxHostCPUs); err != nil {
+				return nil, err
+			}
+		}
+		nodeCPUs[node] = cpus
+	}
+	return nodeCPUs, nil
+}
+
+func vcpusAllowedOnAny(cpuMap [][]bool, hostCPUs []int) bool {
+	for _, vcpuAffinity := range cpuMap {
+		for _, hostCPU := range hostCPUs {
+			if hostCPU < len(vcpuAffinity) && vcpuAffinity[hostCPU] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func defaultHostMetricsCollector() *hostMetricsCollector {
 	return &hostMetricsCollector{
 		procPath: "/proc",
@@ -182,6 +182,40 @@ var _ = Describe("Hostmetrics", func() {
 		})
 	})
 
+	Context("NUMA", func() {
+		BeforeEach(func() {
+			for node, cpuList := range []string{"0-3", "4-7", ""} {
+				nodeDir := filepath.Join(tempSysDir, "devices", "system", "node", fmt.Sprintf("node%d", node))
+				Expect(os.MkdirAll(nodeDir, os.ModePerm)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(nodeDir, "cpulist"), []byte(cpuList+"\n"), os.ModePerm)).To(Succeed())
+			}
+		})
+
+		It("should report the host NUMA nodes the vCPUs are allowed to run on", func() {
+			hostmetrics := &hostMetricsCollector{sysPath: tempSysDir}
+			cpuMap := [][]bool{
+				{false, true, false, false, false, false, false, false},
+				{false, false, false, false, false, true, true, false},
+			}
+
+			metrics := hostmetrics.numaMetrics(cpuMap)
+			Expect(metrics).To(HaveLen(2))
+			Expect(metrics[0].Name).To(Equal("NumberOfNUMANodes"))
+			Expect(metrics[0].Value).To(Equal("3"))
+			Expect(metrics[1].Name).To(Equal("NUMANodes"))
+			Expect(metrics[1].Context).To(BeEquivalentTo("vm"))
+			Expect(metrics[1].Value).To(Equal("0,1"))
+		})
+
+		It("should only report the host NUMA nodes without the vCPU affinity", func() {
+			hostmetrics := &hostMetricsCollector{sysPath: tempSysDir}
+
+			metrics := hostmetrics.numaMetrics(nil)
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Name).To(Equal("NumberOfNUMANodes"))
+		})
+	})
+
 	It("should parse vmstat correctly", func() {
 		vmstat, err := readVMStat("testdata/vmstat")
 		Expect(err).ToNot(HaveOccurred())
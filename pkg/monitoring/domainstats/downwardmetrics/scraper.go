@@ -61,7 +61,7 @@ func (s *Scraper) Scrape(socketFile string, vmi *k6sv1.VirtualMachineInstance) {
 		return
 	}
 
-	metrics, err := s.reporter.Report(socketFile)
+	metrics, err := s.reporter.Report(socketFile, downwardmetrics.DiskMetricGroups(vmi))
 	if err != nil {
 		log.Log.Reason(err).Infof("failed to collect the metrics")
 		return
@@ -81,12 +81,18 @@ func (s *Scraper) Scrape(socketFile string, vmi *k6sv1.VirtualMachineInstance) {
 	}
 }
 
+// metricGroupCollector collects the metrics of a group out of the stats of the VM
+type metricGroupCollector func(vmStats *stats.DomainStats) []api.Metric
+
 type DownwardMetricsReporter struct {
 	staticHostInfo       *StaticHostMetrics
 	hostMetricsCollector *hostMetricsCollector
+	groupCollectors      map[k6sv1.DownwardMetricsGroup]metricGroupCollector
 }
 
-func (r *DownwardMetricsReporter) Report(socketFile string) (*api.Metrics, error) {
+// Report collects the metrics of the requested groups, the static host information
+// and the schema version are always reported
+func (r *DownwardMetricsReporter) Report(socketFile string, groups []k6sv1.DownwardMetricsGroup) (*api.Metrics, error) {
 	ts := time.Now()
 	cli, err := cmdclient.NewClient(socketFile)
 	if err != nil {
@@ -132,15 +138,29 @@ func (r *DownwardMetricsReporter) Report(socketFile string) (*api.Metrics, error
 			metricspkg.MustToUnitlessHostMetric(r.staticHostInfo.HostSystemInfo, "HostSystemInfo"),
 			metricspkg.MustToUnitlessHostMetric(r.staticHostInfo.VirtualizationVendor, "VirtualizationVendor"),
 			metricspkg.MustToUnitlessHostMetric(version, "VirtProductInfo"),
+			metricspkg.MustToUnitlessHostMetric(downwardmetrics.SchemaVersion, "SchemaVersion"),
 		},
 	}
-	metrics.Metrics = append(metrics.Metrics, guestCPUMetrics(vmStats)...)
-	metrics.Metrics = append(metrics.Metrics, guestMemoryMetrics(vmStats)...)
-	metrics.Metrics = append(metrics.Metrics, r.hostMetricsCollector.Collect()...)
+	metrics.Metrics = append(metrics.Metrics, r.collectGroups(vmStats, groups)...)
 
 	return metrics, nil
 }
 
+func (r *DownwardMetricsReporter) collectGroups(vmStats *stats.DomainStats, groups []k6sv1.DownwardMetricsGroup) []api.Metric {
+	requested := map[k6sv1.DownwardMetricsGroup]bool{}
+	for _, group := range groups {
+		requested[group] = true
+	}
+
+	var metrics []api.Metric
+	for _, group := range downwardmetrics.SupportedMetricGroups {
+		if collect, exists := r.groupCollectors[group]; exists && requested[group] {
+			metrics = append(metrics, collect(vmStats)...)
+		}
+	}
+	return metrics
+}
+
 func guestCPUMetrics(vmStats *stats.DomainStats) []api.Metric {
 	var cpuTimeTotal uint64
 	for _, vcpu := range vmStats.Vcpu {
@@ -167,13 +187,30 @@ type Collector struct {
 }
 
 func NewReporter(nodeName string) *DownwardMetricsReporter {
+	return newReporter(nodeName, defaultHostMetricsCollector())
+}
+
+func newReporter(nodeName string, hostMetrics *hostMetricsCollector) *DownwardMetricsReporter {
 	return &DownwardMetricsReporter{
 		staticHostInfo: &StaticHostMetrics{
 			HostName:             nodeName,
 			HostSystemInfo:       "linux",
 			VirtualizationVendor: "kubevirt.io",
 		},
-		hostMetricsCollector: defaultHostMetricsCollector(),
+		hostMetricsCollector: hostMetrics,
+		groupCollectors: map[k6sv1.DownwardMetricsGroup]metricGroupCollector{
+			k6sv1.DownwardMetricsGroupCPU:    guestCPUMetrics,
+			k6sv1.DownwardMetricsGroupMemory: guestMemoryMetrics,
+			k6sv1.DownwardMetricsGroupHost: func(_ *stats.DomainStats) []api.Metric {
+				return hostMetrics.Collect()
+			},
+			k6sv1.DownwardMetricsGroupDisk:     guestDiskMetrics,
+			k6sv1.DownwardMetricsGroupNetwork:  guestNetworkMetrics,
+			k6sv1.DownwardMetricsGroupCPUSteal: guestCPUStealMetrics,
+			k6sv1.DownwardMetricsGroupNUMA: func(vmStats *stats.DomainStats) []api.Metric {
+				return hostMetrics.numaMetrics(vmStats.CPUMap)
+			},
+		},
 	}
 }
 
@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package downwardmetrics
+
+import (
+	"fmt"
+
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
+	metricspkg "kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/metrics"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// deviceMetricName scopes a metric to a device of the VM, e.g. DiskReadBytes:rootdisk
+func deviceMetricName(name, device string) string {
+	return fmt.Sprintf("%s:%s", name, device)
+}
+
+func guestDiskMetrics(vmStats *stats.DomainStats) []api.Metric {
+	var metrics []api.Metric
+	for _, block := range vmStats.Block {
+		if !block.NameSet {
+			continue
+		}
+		drive := block.Name
+		if block.Alias != "" {
+			drive = block.Alias
+		}
+
+		metrics = append(metrics,
+			metricspkg.MustToVMMetric(block.RdBytes, deviceMetricName("DiskReadBytes", drive), "B"),
+			metricspkg.MustToVMMetric(block.WrBytes, deviceMetricName("DiskWriteBytes", drive), "B"),
+			metricspkg.MustToVMMetric(block.RdReqs, deviceMetricName("DiskReadRequests", drive), ""),
+			metricspkg.MustToVMMetric(block.WrReqs, deviceMetricName("DiskWriteRequests", drive), ""),
+			metricspkg.MustToVMMetric(averageLatency(block.RdTimes, block.RdReqs), deviceMetricName("DiskReadLatency", drive), "ms"),
+			metricspkg.MustToVMMetric(averageLatency(block.WrTimes, block.WrReqs), deviceMetricName("DiskWriteLatency", drive), "ms"),
+		)
+	}
+	return metrics
+}
+
+// averageLatency converts the total time in nanoseconds spent on the requests to the average latency in milliseconds
+func averageLatency(totalTime, requests uint64) float64 {
+	if requests == 0 {
+		return 0
+	}
+	return float64(totalTime) / float64(requests) / 1000000
+}
+
+func guestNetworkMetrics(vmStats *stats.DomainStats) []api.Metric {
+	var metrics []api.Metric
+	for _, net := range vmStats.Net {
+		if !net.NameSet {
+			continue
+		}
+		iface := net.Name
+		if net.AliasSet {
+			iface = net.Alias
+		}
+
+		metrics = append(metrics,
+			metricspkg.MustToVMMetric(net.RxBytes, deviceMetricName("NetworkReceivedBytes", iface), "B"),
+			metricspkg.MustToVMMetric(net.TxBytes, deviceMetricName("NetworkTransmittedBytes", iface), "B"),
+			metricspkg.MustToVMMetric(net.RxPkts, deviceMetricName("NetworkReceivedPackets", iface), ""),
+			metricspkg.MustToVMMetric(net.TxPkts, deviceMetricName("NetworkTransmittedPackets", iface), ""),
+			metricspkg.MustToVMMetric(net.RxDrop, deviceMetricName("NetworkReceiveDrops", iface), ""),
+			metricspkg.MustToVMMetric(net.TxDrop, deviceMetricName("NetworkTransmitDrops", iface), ""),
+		)
+	}
+	return metrics
+}
+
+// guestCPUStealMetrics reports the time the vCPUs were runnable but waiting for a host CPU,
+// which is what the guest accounts as steal time
+func guestCPUStealMetrics(vmStats *stats.DomainStats) []api.Metric {
+	var delayTotal uint64
+	for _, vcpu := range vmStats.Vcpu {
+		if vcpu.DelaySet {
+			delayTotal += vcpu.Delay
+		}
+	}
+
+	return []api.Metric{
+		metricspkg.MustToVMMetric(float64(delayTotal)/float64(1000000000), "TotalCPUStealTime", "s"),
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package downwardmetrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k6sv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("VM metrics", func() {
+	var vmStats *stats.DomainStats
+
+	BeforeEach(func() {
+		vmStats = &stats.DomainStats{
+			NrVirtCpu: 2,
+			Memory:    &stats.DomainStatsMemory{ActualBalloon: 1024},
+			Vcpu: []stats.DomainStatsVcpu{
+				{Time: 1000000000, DelaySet: true, Delay: 500000000},
+				{Time: 2000000000, DelaySet: true, Delay: 1500000000},
+			},
+			Block: []stats.DomainStatsBlock{{
+				NameSet: true, Name: "vda", Alias: "rootdisk",
+				RdBytes: 4096, RdReqs: 4, RdTimes: 8000000,
+				WrBytes: 2048, WrReqs: 0,
+			}},
+			Net: []stats.DomainStatsNet{{
+				NameSet: true, Name: "tap0", AliasSet: true, Alias: "default",
+				RxBytes: 100, TxBytes: 200, RxPkts: 3, TxPkts: 4,
+			}},
+		}
+	})
+
+	metricValues := func(metrics []api.Metric) map[string]string {
+		values := map[string]string{}
+		for _, metric := range metrics {
+			values[metric.Name] = metric.Value
+		}
+		return values
+	}
+
+	It("should report the disk throughput and latency", func() {
+		values := metricValues(guestDiskMetrics(vmStats))
+		Expect(values).To(HaveKeyWithValue("DiskReadBytes:rootdisk", "4096"))
+		Expect(values).To(HaveKeyWithValue("DiskWriteBytes:rootdisk", "2048"))
+		Expect(values).To(HaveKeyWithValue("DiskReadRequests:rootdisk", "4"))
+		Expect(values).To(HaveKeyWithValue("DiskReadLatency:rootdisk", "2.000000"))
+		Expect(values).To(HaveKeyWithValue("DiskWriteLatency:rootdisk", "0.000000"))
+	})
+
+	It("should report the network throughput", func() {
+		values := metricValues(guestNetworkMetrics(vmStats))
+		Expect(values).To(HaveKeyWithValue("NetworkReceivedBytes:default", "100"))
+		Expect(values).To(HaveKeyWithValue("NetworkTransmittedBytes:default", "200"))
+		Expect(values).To(HaveKeyWithValue("NetworkReceivedPackets:default", "3"))
+		Expect(values).To(HaveKeyWithValue("NetworkTransmittedPackets:default", "4"))
+	})
+
+	It("should report the steal time of all vCPUs", func() {
+		metrics := guestCPUStealMetrics(vmStats)
+		Expect(metrics).To(HaveLen(1))
+		Expect(metrics[0].Name).To(Equal("TotalCPUStealTime"))
+		Expect(metrics[0].Unit).To(Equal("s"))
+		Expect(metrics[0].Value).To(Equal("2.000000"))
+	})
+
+	DescribeTable("should only collect the requested groups", func(groups []k6sv1.DownwardMetricsGroup, expectedNames []string) {
+		reporter := newReporter("testnode", &hostMetricsCollector{})
+		var names []string
+		for _, metric := range reporter.collectGroups(vmStats, groups) {
+			names = append(names, metric.Name)
+		}
+		Expect(names).To(Equal(expectedNames))
+	},
+		Entry("CPU and memory", []k6sv1.DownwardMetricsGroup{k6sv1.DownwardMetricsGroupMemory, k6sv1.DownwardMetricsGroupCPU},
+			[]string{"TotalCPUTime", "ResourceProcessorLimit", "PhysicalMemoryAllocatedToVirtualSystem", "ResourceMemoryLimit"}),
+		Entry("CPU steal", []k6sv1.DownwardMetricsGroup{k6sv1.DownwardMetricsGroupCPUSteal},
+			[]string{"TotalCPUStealTime"}),
+		Entry("no group", nil, nil),
+	)
+})
@@ -40,6 +40,7 @@ var (
 		networkMetrics{},
 		cpuAffinityMetrics{},
 		filesystemMetrics{},
+		guestAgentMetrics{},
 	}
 
 	Collector = operatormetrics.Collector{
@@ -0,0 +1,161 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	"strconv"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+// guest block devices report their counters in 512 bytes sectors
+const guestDiskSectorSize = 512
+
+var (
+	guestCPUSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_cpu_seconds_total",
+			Help: "Time the guest CPUs spent in each mode as reported by the guest agent.",
+		},
+	)
+
+	guestDiskReadBytes = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_read_bytes_total",
+			Help: "Total bytes read from the guest block device as reported by the guest agent.",
+		},
+	)
+
+	guestDiskWrittenBytes = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_written_bytes_total",
+			Help: "Total bytes written to the guest block device as reported by the guest agent.",
+		},
+	)
+
+	guestDiskReads = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_reads_completed_total",
+			Help: "Total reads completed on the guest block device as reported by the guest agent.",
+		},
+	)
+
+	guestDiskWrites = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_writes_completed_total",
+			Help: "Total writes completed on the guest block device as reported by the guest agent.",
+		},
+	)
+
+	guestDiskFlushes = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_flushes_completed_total",
+			Help: "Total flushes completed on the guest block device as reported by the guest agent.",
+		},
+	)
+
+	guestDiskReadTimeSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_read_time_seconds_total",
+			Help: "Total time spent on reads of the guest block device as reported by the guest agent.",
+		},
+	)
+
+	guestDiskWriteTimeSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_write_time_seconds_total",
+			Help: "Total time spent on writes of the guest block device as reported by the guest agent.",
+		},
+	)
+
+	guestDiskIOTimeSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_io_time_seconds_total",
+			Help: "Total time the guest block device was busy with I/O as reported by the guest agent.",
+		},
+	)
+
+	guestDiskIOsInProgress = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_disk_io_now",
+			Help: "I/O requests in progress on the guest block device as reported by the guest agent.",
+		},
+	)
+)
+
+type guestAgentMetrics struct{}
+
+func (guestAgentMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{
+		guestCPUSeconds,
+		guestDiskReadBytes,
+		guestDiskWrittenBytes,
+		guestDiskReads,
+		guestDiskWrites,
+		guestDiskFlushes,
+		guestDiskReadTimeSeconds,
+		guestDiskWriteTimeSeconds,
+		guestDiskIOTimeSeconds,
+		guestDiskIOsInProgress,
+	}
+}
+
+func (guestAgentMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	if vmiReport.vmiStats.DomainStats == nil {
+		return crs
+	}
+
+	for _, cpu := range vmiReport.vmiStats.DomainStats.GuestCPU {
+		cpuLabel := strconv.Itoa(cpu.CPU)
+		modes := map[string]uint64{
+			"user":    cpu.User,
+			"nice":    cpu.Nice,
+			"system":  cpu.System,
+			"idle":    cpu.Idle,
+			"iowait":  cpu.IOWait,
+			"irq":     cpu.IRQ,
+			"softirq": cpu.SoftIRQ,
+			"steal":   cpu.Steal,
+		}
+		for mode, value := range modes {
+			crs = append(crs, vmiReport.newCollectorResultWithLabels(guestCPUSeconds, millisecondsToSeconds(value),
+				map[string]string{"cpu": cpuLabel, "mode": mode}))
+		}
+	}
+
+	for _, disk := range vmiReport.vmiStats.DomainStats.GuestDisk {
+		diskLabels := map[string]string{"device": disk.Name}
+		crs = append(crs,
+			vmiReport.newCollectorResultWithLabels(guestDiskReadBytes, float64(disk.ReadSectors*guestDiskSectorSize), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskWrittenBytes, float64(disk.WriteSectors*guestDiskSectorSize), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskReads, float64(disk.ReadIOs), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskWrites, float64(disk.WriteIOs), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskFlushes, float64(disk.FlushIOs), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskReadTimeSeconds, millisecondsToSeconds(disk.ReadTicks), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskWriteTimeSeconds, millisecondsToSeconds(disk.WriteTicks), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskIOTimeSeconds, millisecondsToSeconds(disk.TotalTicks), diskLabels),
+			vmiReport.newCollectorResultWithLabels(guestDiskIOsInProgress, float64(disk.IOsInProgress), diskLabels),
+		)
+	}
+
+	return crs
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("guest agent metrics", func() {
+	Context("on Collect", func() {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi-1",
+				Namespace: "test-ns-1",
+			},
+		}
+
+		vmiStats := &VirtualMachineInstanceStats{
+			DomainStats: &stats.DomainStats{
+				GuestCPU: []stats.DomainStatsGuestCPU{
+					{CPU: 1, User: 1500, System: 2000, Idle: 30000, Steal: 10},
+				},
+				GuestDisk: []stats.DomainStatsGuestDisk{
+					{
+						Name:          "vda",
+						ReadSectors:   2,
+						WriteSectors:  4,
+						ReadIOs:       5,
+						WriteIOs:      6,
+						FlushIOs:      7,
+						ReadTicks:     8000,
+						WriteTicks:    9000,
+						TotalTicks:    10000,
+						IOsInProgress: 3,
+					},
+				},
+			},
+		}
+
+		vmiReport := newVirtualMachineInstanceReport(vmi, vmiStats)
+
+		DescribeTable("should collect metrics values", func(metric operatormetrics.Metric, expectedValue float64) {
+			crs := guestAgentMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(metric, expectedValue)))
+		},
+			Entry("kubevirt_vmi_guest_cpu_seconds_total", guestCPUSeconds, 1.5),
+			Entry("kubevirt_vmi_guest_disk_read_bytes_total", guestDiskReadBytes, 1024.0),
+			Entry("kubevirt_vmi_guest_disk_written_bytes_total", guestDiskWrittenBytes, 2048.0),
+			Entry("kubevirt_vmi_guest_disk_reads_completed_total", guestDiskReads, 5.0),
+			Entry("kubevirt_vmi_guest_disk_writes_completed_total", guestDiskWrites, 6.0),
+			Entry("kubevirt_vmi_guest_disk_flushes_completed_total", guestDiskFlushes, 7.0),
+			Entry("kubevirt_vmi_guest_disk_read_time_seconds_total", guestDiskReadTimeSeconds, 8.0),
+			Entry("kubevirt_vmi_guest_disk_write_time_seconds_total", guestDiskWriteTimeSeconds, 9.0),
+			Entry("kubevirt_vmi_guest_disk_io_time_seconds_total", guestDiskIOTimeSeconds, 10.0),
+			Entry("kubevirt_vmi_guest_disk_io_now", guestDiskIOsInProgress, 3.0),
+		)
+
+		It("should label the CPU time with the CPU and the mode", func() {
+			crs := guestAgentMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(And(
+				HaveField("Metric", guestCPUSeconds),
+				HaveField("Value", 2.0),
+				HaveField("ConstLabels", HaveKeyWithValue("cpu", "1")),
+				HaveField("ConstLabels", HaveKeyWithValue("mode", "system")),
+			)))
+		})
+
+		It("result should be empty if the guest agent statistics are not polled", func() {
+			vmiStats.DomainStats.GuestCPU = nil
+			vmiStats.DomainStats.GuestDisk = nil
+			crs := guestAgentMetrics{}.Collect(vmiReport)
+			Expect(crs).To(BeEmpty())
+		})
+	})
+})
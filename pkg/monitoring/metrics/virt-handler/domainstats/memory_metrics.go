@@ -98,6 +98,20 @@ var (
 			Help: "The amount of memory in bytes allocated to the domain. The `memory` value in domain xml file.",
 		},
 	)
+
+	memoryKSMMergedBytes = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_ksm_merged_bytes",
+			Help: "The amount of memory of the process running the domain which KSM merged with identical pages.",
+		},
+	)
+
+	memoryKSMProfitBytes = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_ksm_profit_bytes",
+			Help: "The amount of memory KSM saved by merging the pages of the process running the domain, net of the KSM metadata. Negative when the metadata outweighs the merged pages.",
+		},
+	)
 )
 
 type memoryMetrics struct{}
@@ -115,6 +129,8 @@ func (memoryMetrics) Describe() []operatormetrics.Metric {
 		memoryActualBallon,
 		memoryUsableBytes,
 		memoryDomainBytes,
+		memoryKSMMergedBytes,
+		memoryKSMProfitBytes,
 	}
 }
 
@@ -171,5 +187,13 @@ func (memoryMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operator
 		crs = append(crs, vmiReport.newCollectorResult(memoryDomainBytes, kibibytesToBytes(mem.Total)))
 	}
 
+	if mem.KSMMergedSet {
+		crs = append(crs, vmiReport.newCollectorResult(memoryKSMMergedBytes, kibibytesToBytes(mem.KSMMerged)))
+	}
+
+	if mem.KSMProfitSet {
+		crs = append(crs, vmiReport.newCollectorResult(memoryKSMProfitBytes, float64(mem.KSMProfit)*1024))
+	}
+
 	return crs
 }
@@ -65,6 +65,10 @@ var _ = Describe("memory metrics", func() {
 					Usable:           10,
 					TotalSet:         true,
 					Total:            11,
+					KSMMergedSet:     true,
+					KSMMerged:        12,
+					KSMProfitSet:     true,
+					KSMProfit:        -13,
 				},
 			},
 		}
@@ -86,6 +90,8 @@ var _ = Describe("memory metrics", func() {
 			Entry("kubevirt_vmi_memory_actual_ballon_bytes", memoryActualBallon, kibibytesToBytes(9)),
 			Entry("kubevirt_vmi_memory_usable_bytes", memoryUsableBytes, kibibytesToBytes(10)),
 			Entry("kubevirt_vmi_memory_domain_bytes", memoryDomainBytes, kibibytesToBytes(11)),
+			Entry("kubevirt_vmi_memory_ksm_merged_bytes", memoryKSMMergedBytes, kibibytesToBytes(12)),
+			Entry("kubevirt_vmi_memory_ksm_profit_bytes", memoryKSMProfitBytes, -13.0*1024),
 		)
 
 		It("result should be empty if stat not populated or set is false", func() {
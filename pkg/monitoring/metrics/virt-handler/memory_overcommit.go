@@ -0,0 +1,46 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	memoryOvercommitMetrics = []operatormetrics.Metric{
+		reclaimedGuestMemoryMetric,
+	}
+
+	reclaimedGuestMemoryMetric = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_reclaimed_bytes",
+			Help: "Amount of guest memory reclaimed through the memory balloon by the memory overcommit controller of virt-handler.",
+		},
+		[]string{"namespace", "name", "node"},
+	)
+)
+
+func SetReclaimedGuestMemory(namespace, name, nodeName string, bytes float64) {
+	reclaimedGuestMemoryMetric.WithLabelValues(namespace, name, nodeName).Set(bytes)
+}
+
+func DeleteReclaimedGuestMemory(namespace, name, nodeName string) {
+	reclaimedGuestMemoryMetric.DeleteLabelValues(namespace, name, nodeName)
+}
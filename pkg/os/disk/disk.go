@@ -8,10 +8,12 @@ import (
 )
 
 type DiskInfo struct {
-	Format      string `json:"format"`
-	BackingFile string `json:"backing-filename"`
-	ActualSize  int64  `json:"actual-size"`
-	VirtualSize int64  `json:"virtual-size"`
+	Filename      string `json:"filename"`
+	Format        string `json:"format"`
+	BackingFile   string `json:"backing-filename"`
+	BackingFormat string `json:"backing-filename-format"`
+	ActualSize    int64  `json:"actual-size"`
+	VirtualSize   int64  `json:"virtual-size"`
 }
 
 const (
@@ -0,0 +1,42 @@
+package disk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var qcow2Magic = []byte{'Q', 'F', 'I', 0xfb}
+
+const (
+	qcow2SizeOffset = 24
+	qcow2HeaderLen  = qcow2SizeOffset + 8
+)
+
+// GetVirtualSize returns the virtual size of a raw or qcow2 image. Unlike GetDiskInfo, it only reads the header of
+// the image and doesn't need qemu-img.
+func GetVirtualSize(imagePath string) (int64, error) {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	header := make([]byte, qcow2HeaderLen)
+	n, err := io.ReadFull(f, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return 0, fmt.Errorf("failed to read the header of %s: %v", imagePath, err)
+	}
+	if n == qcow2HeaderLen && bytes.Equal(header[:len(qcow2Magic)], qcow2Magic) {
+		return int64(binary.BigEndian.Uint64(header[qcow2SizeOffset:])), nil
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
@@ -0,0 +1,42 @@
+package disk
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Virtual size", func() {
+	var imagePath string
+
+	BeforeEach(func() {
+		imagePath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+	})
+
+	It("should return the size of a raw image", func() {
+		Expect(os.WriteFile(imagePath, make([]byte, 4096), 0644)).To(Succeed())
+		Expect(GetVirtualSize(imagePath)).To(BeEquivalentTo(4096))
+	})
+
+	It("should return the virtual size of a qcow2 image", func() {
+		header := make([]byte, 512)
+		copy(header, qcow2Magic)
+		binary.BigEndian.PutUint32(header[4:], 3)
+		binary.BigEndian.PutUint64(header[qcow2SizeOffset:], 10737418240)
+		Expect(os.WriteFile(imagePath, header, 0644)).To(Succeed())
+		Expect(GetVirtualSize(imagePath)).To(BeEquivalentTo(10737418240))
+	})
+
+	It("should return the size of a raw image smaller than the qcow2 header", func() {
+		Expect(os.WriteFile(imagePath, []byte("QFI"), 0644)).To(Succeed())
+		Expect(GetVirtualSize(imagePath)).To(BeEquivalentTo(3))
+	})
+
+	It("should fail if the image doesn't exist", func() {
+		_, err := GetVirtualSize(imagePath)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -21,6 +21,7 @@ package filter
 
 import (
 	"net"
+	"net/url"
 
 	restful "github.com/emicklei/go-restful/v3"
 
@@ -41,9 +42,22 @@ func RequestLoggingFilter() restful.FilterFunction {
 			With("remoteAddress", remoteAddr).
 			With("username", username).
 			With("method", req.Request.Method).
-			With("url", req.Request.URL.RequestURI()).
+			With("url", redactedRequestURI(req.Request.URL)).
 			With("proto", req.Request.Proto).
 			With("statusCode", resp.StatusCode()).
 			Log("contentLength", resp.ContentLength())
 	}
 }
+
+// redactedRequestURI hides the value of the token query parameter,
+// which authenticates the connections to the console gateway
+func redactedRequestURI(u *url.URL) string {
+	query := u.Query()
+	if !query.Has("token") {
+		return u.RequestURI()
+	}
+	query.Set("token", "REDACTED")
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.RequestURI()
+}
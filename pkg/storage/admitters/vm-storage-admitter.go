@@ -58,6 +58,11 @@ func (a Admitter) AdmitStatus() []metav1.StatusCause {
 	if len(causes) > 0 {
 		return causes
 	}
+
+	causes = a.validateDiskTaskStatus()
+	if len(causes) > 0 {
+		return causes
+	}
 	return causes
 }
 
@@ -458,6 +458,27 @@ var _ = Describe("Validating VM Admitter", func() {
 				return true
 			}, false),
 		)
+
+		It("should reject starting the VM while a disk task is in progress", func() {
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyHalted),
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: api.NewMinimalVMI("testvmi").Spec,
+					},
+				},
+				Status: v1.VirtualMachineStatus{
+					DiskTaskInProgress: pointer.P("sparsify"),
+				},
+			}
+			oldVM := vm.DeepCopy()
+			vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+
+			causes, err := admitVm(virtClient, admissionv1.Update, config, vm, oldVM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal(`Cannot update VM runStrategy until disk task "sparsify" completes`))
+		})
 	})
 })
 
@@ -35,6 +35,18 @@ func (a *Admitter) validateRestoreStatus() []metav1.StatusCause {
 		return nil
 	}
 
+	return a.validateRunStrategyUnchanged(fmt.Sprintf("Cannot update VM runStrategy until restore %q completes", *a.vm.Status.RestoreInProgress))
+}
+
+func (a *Admitter) validateDiskTaskStatus() []metav1.StatusCause {
+	if a.ar.Operation != admissionv1.Update || a.vm.Status.DiskTaskInProgress == nil {
+		return nil
+	}
+
+	return a.validateRunStrategyUnchanged(fmt.Sprintf("Cannot update VM runStrategy until disk task %q completes", *a.vm.Status.DiskTaskInProgress))
+}
+
+func (a *Admitter) validateRunStrategyUnchanged(message string) []metav1.StatusCause {
 	oldVM := &v1.VirtualMachine{}
 	if err := json.Unmarshal(a.ar.OldObject.Raw, oldVM); err != nil {
 		return []metav1.StatusCause{{
@@ -49,7 +61,7 @@ func (a *Admitter) validateRestoreStatus() []metav1.StatusCause {
 		if newStrategy != oldStrategy {
 			return []metav1.StatusCause{{
 				Type:    metav1.CauseTypeFieldValueNotSupported,
-				Message: fmt.Sprintf("Cannot update VM runStrategy until restore %q completes", *a.vm.Status.RestoreInProgress),
+				Message: message,
 				Field:   k8sfield.NewPath("spec").String(),
 			}}
 		}
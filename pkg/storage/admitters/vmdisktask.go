@@ -0,0 +1,201 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var (
+	diskTaskDeviceRegex           = regexp.MustCompile(`^/dev/[a-z]+[0-9]*$`)
+	diskTaskSysprepOperationRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+)
+
+// VMDiskTaskAdmitter validates VirtualMachineDiskTasks
+type VMDiskTaskAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMDiskTaskAdmitter creates a VMDiskTaskAdmitter
+func NewVMDiskTaskAdmitter(config *virtconfig.ClusterConfig) *VMDiskTaskAdmitter {
+	return &VMDiskTaskAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMDiskTaskAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != core.GroupName ||
+		ar.Request.Resource.Resource != "virtualmachinedisktasks" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.VMDiskTasksEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("vm disk tasks feature gate not enabled"))
+	}
+
+	task := &v1.VirtualMachineDiskTask{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, task); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes = validateDiskTaskSpec(k8sfield.NewPath("spec"), &task.Spec)
+	case admissionv1.Update:
+		prevObj := &v1.VirtualMachineDiskTask{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, prevObj); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, task.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
+
+func validateDiskTaskSpec(field *k8sfield.Path, spec *v1.VirtualMachineDiskTaskSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.VMName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "Virtual Machine name must not be empty",
+			Field:   field.Child("vmName").String(),
+		})
+	}
+
+	seen := map[string]bool{}
+	for i, volume := range spec.Volumes {
+		if volume == "" || seen[volume] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume name %q must not be empty or repeated", volume),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		}
+		seen[volume] = true
+	}
+
+	configured := map[v1.VirtualMachineDiskTaskOperation]bool{
+		v1.DiskTaskOperationSysprep:          spec.Sysprep != nil,
+		v1.DiskTaskOperationCustomize:        spec.Customize != nil,
+		v1.DiskTaskOperationResizeFilesystem: spec.ResizeFilesystem != nil,
+	}
+	for _, operation := range []v1.VirtualMachineDiskTaskOperation{v1.DiskTaskOperationSysprep, v1.DiskTaskOperationCustomize, v1.DiskTaskOperationResizeFilesystem} {
+		if configured[operation] && operation != spec.Operation {
+			name := strings.ToLower(string(operation[:1])) + string(operation[1:])
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s can only be set with the %s operation", name, operation),
+				Field:   field.Child(name).String(),
+			})
+		}
+	}
+
+	switch spec.Operation {
+	case v1.DiskTaskOperationSparsify:
+	case v1.DiskTaskOperationSysprep:
+		if spec.Sysprep != nil {
+			for i, operation := range spec.Sysprep.Operations {
+				if !diskTaskSysprepOperationRegex.MatchString(operation) {
+					causes = append(causes, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("invalid virt-sysprep operation %q", operation),
+						Field:   field.Child("sysprep", "operations").Index(i).String(),
+					})
+				}
+			}
+		}
+	case v1.DiskTaskOperationCustomize:
+		causes = append(causes, validateDiskTaskCustomize(field.Child("customize"), spec.Customize)...)
+	case v1.DiskTaskOperationResizeFilesystem:
+		if spec.ResizeFilesystem == nil || !diskTaskDeviceRegex.MatchString(spec.ResizeFilesystem.Device) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "the ResizeFilesystem operation requires a device such as /dev/sda2",
+				Field:   field.Child("resizeFilesystem", "device").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("operation %q is not supported", spec.Operation),
+			Field:   field.Child("operation").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateDiskTaskCustomize(field *k8sfield.Path, customize *v1.DiskTaskCustomize) []metav1.StatusCause {
+	if customize == nil || len(customize.Commands) == 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "the Customize operation requires at least one command",
+			Field:   field.Child("commands").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for i, command := range customize.Commands {
+		if strings.TrimSpace(command) == "" || strings.ContainsAny(command, "\n\r") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "commands must not be empty and must fit on a single line",
+				Field:   field.Child("commands").Index(i).String(),
+			})
+		}
+	}
+	return causes
+}
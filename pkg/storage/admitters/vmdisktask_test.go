@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Validating VirtualMachineDiskTask Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	setFeatureGates := func(featureGates ...string) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+				},
+			},
+		})
+	}
+
+	newTask := func(spec v1.VirtualMachineDiskTaskSpec) *v1.VirtualMachineDiskTask {
+		spec.VMName = "testvm"
+		return &v1.VirtualMachineDiskTask{Spec: spec}
+	}
+
+	It("should reject tasks with the feature gate disabled", func() {
+		ar := createDiskTaskAdmissionReview(newTask(v1.VirtualMachineDiskTaskSpec{Operation: v1.DiskTaskOperationSparsify}), nil)
+		resp := NewVMDiskTaskAdmitter(config).Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("vm disk tasks feature gate not enabled"))
+	})
+
+	Context("with the feature gate enabled", func() {
+		BeforeEach(func() {
+			setFeatureGates(featuregate.VMDiskTasks)
+			DeferCleanup(setFeatureGates)
+		})
+
+		DescribeTable("should accept", func(spec v1.VirtualMachineDiskTaskSpec) {
+			resp := NewVMDiskTaskAdmitter(config).Admit(context.Background(), createDiskTaskAdmissionReview(newTask(spec), nil))
+			Expect(resp.Allowed).To(BeTrue())
+		},
+			Entry("sparsify of selected volumes", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationSparsify,
+				Volumes:   []string{"rootdisk", "datadisk"},
+			}),
+			Entry("sysprep with the default operations", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationSysprep,
+			}),
+			Entry("sysprep with selected operations", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationSysprep,
+				Sysprep:   &v1.DiskTaskSysprep{Operations: []string{"machine-id", "ssh-hostkeys"}},
+			}),
+			Entry("customize commands", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationCustomize,
+				Customize: &v1.DiskTaskCustomize{Commands: []string{"install qemu-guest-agent", "run-command systemctl enable qemu-guest-agent"}},
+			}),
+			Entry("filesystem resize", v1.VirtualMachineDiskTaskSpec{
+				Operation:        v1.DiskTaskOperationResizeFilesystem,
+				ResizeFilesystem: &v1.DiskTaskResizeFilesystem{Device: "/dev/sda2"},
+			}),
+		)
+
+		DescribeTable("should reject", func(spec v1.VirtualMachineDiskTaskSpec, field string) {
+			resp := NewVMDiskTaskAdmitter(config).Admit(context.Background(), createDiskTaskAdmissionReview(newTask(spec), nil))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("unknown operations", v1.VirtualMachineDiskTaskSpec{
+				Operation: "Defrag",
+			}, "spec.operation"),
+			Entry("repeated volumes", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationSparsify,
+				Volumes:   []string{"rootdisk", "rootdisk"},
+			}, "spec.volumes[1]"),
+			Entry("configuration of another operation", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationSparsify,
+				Customize: &v1.DiskTaskCustomize{Commands: []string{"update"}},
+			}, "spec.customize"),
+			Entry("invalid sysprep operations", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationSysprep,
+				Sysprep:   &v1.DiskTaskSysprep{Operations: []string{"machine-id; reboot"}},
+			}, "spec.sysprep.operations[0]"),
+			Entry("customize without commands", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationCustomize,
+			}, "spec.customize.commands"),
+			Entry("customize commands spanning several lines", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationCustomize,
+				Customize: &v1.DiskTaskCustomize{Commands: []string{"update\nrun-command reboot"}},
+			}, "spec.customize.commands[0]"),
+			Entry("filesystem resize without device", v1.VirtualMachineDiskTaskSpec{
+				Operation: v1.DiskTaskOperationResizeFilesystem,
+			}, "spec.resizeFilesystem.device"),
+			Entry("filesystem resize of a path", v1.VirtualMachineDiskTaskSpec{
+				Operation:        v1.DiskTaskOperationResizeFilesystem,
+				ResizeFilesystem: &v1.DiskTaskResizeFilesystem{Device: "/dev/../etc/passwd"},
+			}, "spec.resizeFilesystem.device"),
+		)
+
+		It("should reject spec updates", func() {
+			oldTask := newTask(v1.VirtualMachineDiskTaskSpec{Operation: v1.DiskTaskOperationSparsify})
+			task := oldTask.DeepCopy()
+			task.Spec.Operation = v1.DiskTaskOperationSysprep
+
+			resp := NewVMDiskTaskAdmitter(config).Admit(context.Background(), createDiskTaskAdmissionReview(task, oldTask))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should accept status updates", func() {
+			oldTask := newTask(v1.VirtualMachineDiskTaskSpec{Operation: v1.DiskTaskOperationSparsify})
+			task := oldTask.DeepCopy()
+			task.Status.Phase = v1.DiskTaskRunning
+
+			resp := NewVMDiskTaskAdmitter(config).Admit(context.Background(), createDiskTaskAdmissionReview(task, oldTask))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createDiskTaskAdmissionReview(task, oldTask *v1.VirtualMachineDiskTask) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(task)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "kubevirt.io",
+				Resource: "virtualmachinedisktasks",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+	if oldTask != nil {
+		oldBytes, _ := json.Marshal(oldTask)
+		ar.Request.Operation = admissionv1.Update
+		ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+	}
+
+	return ar
+}
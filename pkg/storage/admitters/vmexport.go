@@ -98,6 +98,7 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 				},
 			}
 		}
+		causes = append(causes, admitter.validatePersistentState(k8sfield.NewPath("spec", "persistentState"), vmExport.Spec.PersistentState)...)
 
 	case admissionv1.Update:
 		prevObj := &exportv1.VirtualMachineExport{}
@@ -212,3 +213,17 @@ func (admitter *VMExportAdmitter) validateVMApiGroup(field *k8sfield.Path, apigr
 
 	return []metav1.StatusCause{}
 }
+
+func (admitter *VMExportAdmitter) validatePersistentState(field *k8sfield.Path, policy *exportv1.PersistentStatePolicy) []metav1.StatusCause {
+	if policy == nil || *policy == exportv1.PersistentStateInclude || *policy == exportv1.PersistentStateExclude {
+		return []metav1.StatusCause{}
+	}
+
+	return []metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("persistent state policy %q doesn't exist", *policy),
+			Field:   field.String(),
+		},
+	}
+}
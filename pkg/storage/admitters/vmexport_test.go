@@ -34,6 +34,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -257,6 +258,31 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 			Entry("virtual machine snapshot", "invalid", vmSnapshotKind),
 			Entry("virtual machine", "invalid", vmKind),
 		)
+
+		DescribeTable("it should validate the persistent state policy", func(policy exportv1.PersistentStatePolicy, allowed bool) {
+			export := &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &kubevirtApiGroup,
+						Kind:     vmKind,
+						Name:     "test",
+					},
+					PersistentState: pointer.P(policy),
+				},
+			}
+
+			ar := createExportAdmissionReview(export)
+			resp := createTestVMExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.persistentState"))
+			}
+		},
+			Entry("with Include", exportv1.PersistentStateInclude, true),
+			Entry("with Exclude", exportv1.PersistentStateExclude, true),
+			Entry("with an unknown policy", exportv1.PersistentStatePolicy("Regenerate"), false),
+		)
 	})
 })
 
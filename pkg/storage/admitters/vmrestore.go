@@ -27,17 +27,17 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
-	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 
 	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
 
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
-	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -95,10 +95,7 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 			case core.GroupName:
 				switch vmRestore.Spec.Target.Kind {
 				case "VirtualMachine":
-					causes, err = admitter.validateTargetVM(ctx, k8sfield.NewPath("spec"), vmRestore)
-					if err != nil {
-						return webhookutils.ToAdmissionResponseError(err)
-					}
+					causes = admitter.validateTargetVM(k8sfield.NewPath("spec"), vmRestore)
 
 					newCauses := admitter.validateVolumeOverrides(ctx, vmRestore)
 					if newCauses != nil {
@@ -109,6 +106,8 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 					if newCauses != nil {
 						causes = append(causes, newCauses...)
 					}
+
+					causes = append(causes, validatePersistentStatePolicy(vmRestore.Spec.PersistentState)...)
 				default:
 					causes = []metav1.StatusCause{
 						{
@@ -177,58 +176,14 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 	return &reviewResponse
 }
 
-func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause, err error) {
-	targetName := vmRestore.Spec.Target.Name
-	namespace := vmRestore.Namespace
-
-	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
-
-	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
-	if err != nil {
-		if errors.IsNotFound(err) {
-			return nil, nil
-		}
-		return nil, err
-	}
-
-	target, err := admitter.Client.VirtualMachine(namespace).Get(ctx, targetName, metav1.GetOptions{})
-	if err != nil && !errors.IsNotFound(err) {
-		return nil, err
-	}
-
-	sourceTargetVmsAreDifferent := errors.IsNotFound(err) || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
-	if sourceTargetVmsAreDifferent {
-		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
-		if contentName == nil {
-			return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
-		}
-
-		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
-		if err != nil {
-			return nil, err
-		}
-
-		snapshotVM := vmSnapshotContent.Spec.Source.VirtualMachine
-		if snapshotVM == nil {
-			return nil, fmt.Errorf("unexpected snapshot source")
-		}
-
-		if backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
-			causes = append(causes, metav1.StatusCause{
-				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Restore to a different VM not supported when using backend storage",
-				Field:   field.String(),
-			})
-		}
-	}
-
-	return causes, nil
+func (admitter *VMRestoreAdmitter) validateTargetVM(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) []metav1.StatusCause {
+	return admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
 }
 
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
 	// Validate patches are either on labels/annotations or on elements under "/spec/" path only
-	for _, patch := range patches {
-		for _, patchKeyValue := range strings.Split(strings.Trim(patch, "{}"), ",") {
+	for _, patchOp := range patches {
+		for _, patchKeyValue := range strings.Split(strings.Trim(patchOp, "{}"), ",") {
 			// For example, if the original patch is {"op": "replace", "path": "/metadata/name", "value": "someValue"}
 			// now we're iterating on [`"op": "replace"`, `"path": "/metadata/name"`, `"value": "someValue"`]
 			keyValSlice := strings.Split(patchKeyValue, ":")
@@ -245,6 +200,15 @@ func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sf
 			value := strings.TrimSpace(keyValSlice[1])
 
 			if key == `"path"` {
+				// the identity reset command of a live fork is run as root in the guest, only a clone may pass it on
+				if strings.Contains(value, patch.EscapeJSONPointer(v1.LiveForkIdentityResetCommandAnnotation)) {
+					causes = append(causes, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueNotSupported,
+						Message: fmt.Sprintf("patching the %s annotation is not allowed: %s", v1.LiveForkIdentityResetCommandAnnotation, patchKeyValue),
+						Field:   field.String(),
+					})
+					continue
+				}
 				if strings.HasPrefix(value, `"/metadata/labels/`) || strings.HasPrefix(value, `"/metadata/annotations/`) {
 					continue
 				}
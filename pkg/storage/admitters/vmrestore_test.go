@@ -503,7 +503,38 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumeRestorePolicy"))
 			})
 
-			DescribeTable("Should reject restore when using backend storage and restoring to different VM", func(doesTargetExist bool) {
+			DescribeTable("should validate the persistent state policy", func(policy snapshotv1.PersistentStatePolicy, allowed bool) {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						PersistentState:            pointer.P(policy),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.persistentState"))
+				}
+			},
+				Entry("with Include", snapshotv1.PersistentStateInclude, true),
+				Entry("with Exclude", snapshotv1.PersistentStateExclude, true),
+				Entry("with an unknown policy", snapshotv1.PersistentStatePolicy("Regenerate"), false),
+			)
+
+			DescribeTable("should allow restore when using backend storage and restoring to different VM", func(doesTargetExist bool) {
 				const targetVMName = "new-test-vm"
 				targetVM := &v1.VirtualMachine{}
 
@@ -561,10 +592,7 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				ar := createRestoreAdmissionReview(restore)
 				resp := createTestVMRestoreAdmitter(config, snapshot, vmSnapshotContent, targetVM).Admit(context.Background(), ar)
 
-				Expect(resp.Allowed).To(BeFalse())
-				Expect(resp.Result.Details.Causes).To(HaveLen(1))
-				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
-				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("Restore to a different VM not supported when using backend storage"))
+				Expect(resp.Allowed).To(BeTrue())
 			},
 				Entry("target doesn't exist", false),
 				Entry("target exists", true),
@@ -626,6 +654,20 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 					Entry("patch to remove an annotation", patch.New(patch.WithRemove("/metadata/annotations/key"))),
 				)
 
+				It("should reject patching the live fork identity reset command", func() {
+					patchBytes, err := patch.New(patch.WithAdd(
+						"/spec/template/metadata/annotations/"+patch.EscapeJSONPointer(v1.LiveForkIdentityResetCommandAnnotation), `["/bin/true"]`,
+					)).GeneratePayload()
+					Expect(err).ToNot(HaveOccurred())
+					restore.Spec.Patches = []string{string(patchBytes)}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(v1.LiveForkIdentityResetCommandAnnotation))
+				})
+
 				It("should reject an invalid patch", func() {
 					const invalidPatch = `{"op": "remove", "path": "/spec/running" : "illegal-field"}`
 					restore.Spec.Patches = []string{invalidPatch}
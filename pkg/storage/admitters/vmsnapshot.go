@@ -108,6 +108,8 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 			}
 		}
 
+		causes = append(causes, validatePersistentStatePolicy(vmSnapshot.Spec.PersistentState)...)
+
 	case admissionv1.Update:
 		prevObj := &snapshotv1.VirtualMachineSnapshot{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
@@ -137,3 +139,20 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 	}
 	return &reviewResponse
 }
+
+func validatePersistentStatePolicy(policy *snapshotv1.PersistentStatePolicy) []metav1.StatusCause {
+	if policy == nil {
+		return nil
+	}
+
+	switch *policy {
+	case snapshotv1.PersistentStateInclude, snapshotv1.PersistentStateExclude:
+		return nil
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("persistent state policy \"%s\" doesn't exist", *policy),
+			Field:   k8sfield.NewPath("spec", "persistentState").String(),
+		}}
+	}
+}
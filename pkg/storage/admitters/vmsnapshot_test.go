@@ -293,6 +293,31 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 				Entry("when VM is halted", v1.RunStrategyHalted),
 			)
 
+			DescribeTable("should validate the persistent state policy", func(policy snapshotv1.PersistentStatePolicy, allowed bool) {
+				snapshot := &snapshotv1.VirtualMachineSnapshot{
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						PersistentState: pointer.P(policy),
+					},
+				}
+
+				ar := createSnapshotAdmissionReview(snapshot)
+				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.persistentState"))
+				}
+			},
+				Entry("with Include", snapshotv1.PersistentStateInclude, true),
+				Entry("with Exclude", snapshotv1.PersistentStateExclude, true),
+				Entry("with an unknown policy", snapshotv1.PersistentStatePolicy("Regenerate"), false),
+			)
+
 			It("should accept when VM is not running", func() {
 				snapshot := &snapshotv1.VirtualMachineSnapshot{
 					Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -41,6 +41,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/backend-storage/encryption"
 	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -53,6 +54,15 @@ const (
 	// LabelApplyStorageProfile is a label used by the CDI mutating webhook
 	// to modify the PVC according to the storage profile.
 	LabelApplyStorageProfile = "cdi.kubevirt.io/applyStorageProfile"
+
+	// EncryptionAnnotation is set on encrypted backend-storage PVCs, to the Secret holding the keys
+	EncryptionAnnotation = "kubevirt.io/vm-state-encryption-secret"
+	// EncryptionModeAnnotation is set on encrypted backend-storage PVCs, to the virt-launcher encryption mode
+	EncryptionModeAnnotation = "kubevirt.io/vm-state-encryption-mode"
+	// EncryptedStateMountPath is where virt-launcher mounts the backend-storage PVC when its content is encrypted
+	EncryptedStateMountPath = "/run/kubevirt-private/backend-storage"
+	// EncryptionKeysMountPath is where virt-launcher mounts the Secret holding the keys of the backend-storage
+	EncryptionKeysMountPath = "/run/kubevirt-private/backend-storage-keys"
 )
 
 func basePVC(vmi *corev1.VirtualMachineInstance) string {
@@ -284,6 +294,63 @@ func IsBackendStorageNeededForVMI(vmiSpec *corev1.VirtualMachineInstanceSpec) bo
 	return tpm.HasPersistentDevice(vmiSpec) || HasPersistentEFI(vmiSpec)
 }
 
+// EncryptionMode returns the virt-launcher encryption mode of the VM state encryption configuration
+func EncryptionMode(vmStateEncryption *corev1.VMStateEncryption) string {
+	if vmStateEncryption.KMS != nil {
+		return encryption.ModeLocalKMS
+	}
+	return encryption.ModeSecret
+}
+
+// EncryptionKeySecretName returns the name of the Secret holding the keys of the VM state encryption configuration
+func EncryptionKeySecretName(vmStateEncryption *corev1.VMStateEncryption) string {
+	if vmStateEncryption.KMS != nil {
+		return vmStateEncryption.KMS.KeySecretName
+	}
+	return vmStateEncryption.SecretName
+}
+
+// EncryptionAnnotations returns the annotations recording the VM state encryption of a backend-storage PVC
+func EncryptionAnnotations(vmStateEncryption *corev1.VMStateEncryption) map[string]string {
+	if vmStateEncryption == nil {
+		return nil
+	}
+	return map[string]string{
+		EncryptionAnnotation:     EncryptionKeySecretName(vmStateEncryption),
+		EncryptionModeAnnotation: EncryptionMode(vmStateEncryption),
+	}
+}
+
+// PVCEncryption returns the VM state encryption of a backend-storage PVC.
+// An encrypted PVC keeps the encryption mode and keys it was encrypted with, even when the cluster-wide
+// encryption is disabled or moved to other keys, since its state can only be decrypted with them.
+// A PVC without encryption adopts the cluster-wide encryption, its plain text state is encrypted by virt-launcher.
+// Switching an encrypted PVC to another encryption mode is rejected.
+func PVCEncryption(pvc *v1.PersistentVolumeClaim, clusterEncryption *corev1.VMStateEncryption) (*corev1.VMStateEncryption, error) {
+	secretName, encrypted := pvc.Annotations[EncryptionAnnotation]
+	if !encrypted {
+		return clusterEncryption, nil
+	}
+	mode, exists := pvc.Annotations[EncryptionModeAnnotation]
+	if !exists {
+		mode = encryption.ModeSecret
+	}
+	if clusterEncryption != nil && EncryptionMode(clusterEncryption) != mode {
+		return nil, fmt.Errorf("the VM state on backend-storage PVC %s/%s is encrypted in %s mode, switching it to %s mode is not supported",
+			pvc.Namespace, pvc.Name, mode, EncryptionMode(clusterEncryption))
+	}
+
+	switch mode {
+	case encryption.ModeSecret:
+		return &corev1.VMStateEncryption{SecretName: secretName}, nil
+	case encryption.ModeLocalKMS:
+		return &corev1.VMStateEncryption{
+			KMS: &corev1.VMStateKMS{Provider: corev1.VMStateKMSProviderLocal, KeySecretName: secretName},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown VM state encryption mode %q on backend-storage PVC %s/%s", mode, pvc.Namespace, pvc.Name)
+}
+
 func IsBackendStorageNeededForVM(vm *corev1.VirtualMachine) bool {
 	if vm.Spec.Template == nil {
 		return false
@@ -293,6 +360,8 @@ func IsBackendStorageNeededForVM(vm *corev1.VirtualMachine) bool {
 
 // MigrationHandoff runs at the end of a successful live migration.
 // It labels the target backend-storage PVC as current for the VM and deletes the source backend-storage PVC.
+// When the backend-storage is encrypted, the target virt-launcher encrypts the migrated state onto the target PVC
+// before it reports the domain as ready, so the source PVC is never the only copy of the state.
 func MigrationHandoff(client kubecli.KubevirtClient, pvcStore cache.Store, migration *corev1.VirtualMachineInstanceMigration) error {
 	if migration == nil || migration.Status.MigrationState == nil ||
 		migration.Status.MigrationState.SourcePersistentStatePVCName == "" ||
@@ -486,7 +555,7 @@ func (bs *BackendStorage) UpdateVolumeStatus(vmi *corev1.VirtualMachineInstance,
 	})
 }
 
-func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels map[string]string) (*v1.PersistentVolumeClaim, error) {
+func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels map[string]string, vmStateEncryption *corev1.VMStateEncryption) (*v1.PersistentVolumeClaim, error) {
 	storageClass, err := bs.getStorageClass()
 	if err != nil {
 		return nil, err
@@ -517,6 +586,7 @@ func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels m
 			GenerateName:    basePVC(vmi) + "-",
 			OwnerReferences: ownerReferences,
 			Labels:          labels,
+			Annotations:     EncryptionAnnotations(vmStateEncryption),
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
 			AccessModes: []v1.PersistentVolumeAccessMode{accessMode},
@@ -537,18 +607,45 @@ func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels m
 }
 
 func (bs *BackendStorage) CreatePVCForVMI(vmi *corev1.VirtualMachineInstance) (*v1.PersistentVolumeClaim, error) {
+	vmStateEncryption := bs.clusterConfig.GetVMStateEncryption()
 	pvc := PVCForVMI(bs.pvcStore, vmi)
 	if pvc == nil {
-		return bs.createPVC(vmi, map[string]string{PVCPrefix: vmi.Name})
+		return bs.createPVC(vmi, map[string]string{PVCPrefix: vmi.Name}, vmStateEncryption)
 	}
 
 	if _, exists := pvc.Labels[PVCPrefix]; !exists {
 		bs.labelLegacyPVC(pvc, vmi.Name)
 	}
+	if _, encrypted := pvc.Annotations[EncryptionAnnotation]; !encrypted && vmStateEncryption != nil {
+		// The plain text state of the PVC is encrypted by virt-launcher from now on
+		if err := bs.annotateEncryptedPVC(pvc, vmStateEncryption); err != nil {
+			return nil, err
+		}
+	}
 
 	return pvc, nil
 }
 
+func (bs *BackendStorage) annotateEncryptedPVC(pvc *v1.PersistentVolumeClaim, vmStateEncryption *corev1.VMStateEncryption) error {
+	annotationPatch := patch.New()
+	if len(pvc.Annotations) == 0 {
+		annotationPatch.AddOption(patch.WithAdd("/metadata/annotations", EncryptionAnnotations(vmStateEncryption)))
+	} else {
+		for key, value := range EncryptionAnnotations(vmStateEncryption) {
+			annotationPatch.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(key), value))
+		}
+	}
+	annotationPatchPayload, err := annotationPatch.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = bs.client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, annotationPatchPayload, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to annotate the encrypted backend-storage PVC %s/%s: %w", pvc.Namespace, pvc.Name, err)
+	}
+	return nil
+}
+
 func (bs *BackendStorage) CreatePVCForMigrationTarget(vmi *corev1.VirtualMachineInstance, migrationName string) (*v1.PersistentVolumeClaim, error) {
 	pvc := PVCForVMI(bs.pvcStore, vmi)
 
@@ -557,7 +654,12 @@ func (bs *BackendStorage) CreatePVCForMigrationTarget(vmi *corev1.VirtualMachine
 		return pvc, nil
 	}
 
-	return bs.createPVC(vmi, map[string]string{corev1.MigrationNameLabel: migrationName})
+	// The target PVC is encrypted like the source PVC, the migrated state is encrypted with the same keys
+	vmStateEncryption, err := PVCEncryption(pvc, bs.clusterConfig.GetVMStateEncryption())
+	if err != nil {
+		return nil, err
+	}
+	return bs.createPVC(vmi, map[string]string{corev1.MigrationNameLabel: migrationName}, vmStateEncryption)
 }
 
 // IsPVCReady returns true if either:
@@ -284,10 +284,61 @@ var _ = Describe("Backend Storage", func() {
 			err := storageClassStore.Add(&sc)
 			Expect(err).NotTo(HaveOccurred())
 
-			pvc, err := backendStorage.createPVC(vmi, map[string]string{})
+			pvc, err := backendStorage.createPVC(vmi, map[string]string{}, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pvc).NotTo(BeNil())
 			Expect(pvc.Labels).To(HaveKeyWithValue(LabelApplyStorageProfile, "true"))
+			Expect(pvc.Annotations).ToNot(HaveKey(EncryptionAnnotation))
+		})
+
+		DescribeTable("Should annotate the PVC with the encryption keys Secret and mode", func(encryption *virtv1.VMStateEncryption, expectedSecret, expectedMode string) {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.VMStateStorageClass = "sc"
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			vmi := &virtv1.VirtualMachineInstance{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      vmiName,
+					Namespace: nsName,
+				},
+			}
+
+			pvc, err := backendStorage.createPVC(vmi, map[string]string{}, encryption)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionAnnotation, expectedSecret))
+			Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionModeAnnotation, expectedMode))
+		},
+			Entry("with a Secret", &virtv1.VMStateEncryption{SecretName: "keys"}, "keys", "secret"),
+			Entry("with the local KMS",
+				&virtv1.VMStateEncryption{KMS: &virtv1.VMStateKMS{Provider: virtv1.VMStateKMSProviderLocal, KeySecretName: "kek"}}, "kek", "local-kms"),
+		)
+
+		It("Should encrypt the migration target PVC like the source PVC", func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.VMStateStorageClass = "sc"
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			vmi := &virtv1.VirtualMachineInstance{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      vmiName,
+					Namespace: nsName,
+				},
+			}
+			sourcePVC := &v1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:        pvcName,
+					Namespace:   nsName,
+					Labels:      map[string]string{PVCPrefix: vmiName},
+					Annotations: EncryptionAnnotations(&virtv1.VMStateEncryption{SecretName: "keys"}),
+				},
+			}
+			Expect(pvcStore.Add(sourcePVC)).To(Succeed())
+
+			pvc, err := backendStorage.CreatePVCForMigrationTarget(vmi, "migration")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Labels).To(HaveKeyWithValue(virtv1.MigrationNameLabel, "migration"))
+			Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionAnnotation, "keys"))
+			Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionModeAnnotation, "secret"))
 		})
 	})
 
@@ -329,6 +380,70 @@ var _ = Describe("Backend Storage", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pvc.Labels).To(HaveKeyWithValue(PVCPrefix, vmiName))
 		})
+
+		It("Should get annotated as encrypted by CreatePVCForVMI when the VM state encryption is enabled", func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.VMStateEncryption = &virtv1.VMStateEncryption{SecretName: "keys"}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			vmi := &virtv1.VirtualMachineInstance{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      vmiName,
+					Namespace: nsName,
+				},
+			}
+			_, err := backendStorage.CreatePVCForVMI(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(nsName).Get(context.TODO(), pvcName, k8smetav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionAnnotation, "keys"))
+			Expect(pvc.Annotations).To(HaveKeyWithValue(EncryptionModeAnnotation, "secret"))
+		})
+	})
+
+	Context("PVCEncryption", func() {
+		secretEncryption := &virtv1.VMStateEncryption{SecretName: "keys"}
+		otherSecretEncryption := &virtv1.VMStateEncryption{SecretName: "other-keys"}
+		kmsEncryption := &virtv1.VMStateEncryption{
+			KMS: &virtv1.VMStateKMS{Provider: virtv1.VMStateKMSProviderLocal, KeySecretName: "kek"},
+		}
+
+		pvcWithAnnotations := func(annotations map[string]string) *v1.PersistentVolumeClaim {
+			return &v1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:        "persistent-state-for-testvmi",
+					Namespace:   "testns",
+					Annotations: annotations,
+				},
+			}
+		}
+
+		DescribeTable("should return the encryption", func(pvc *v1.PersistentVolumeClaim, clusterEncryption, expected *virtv1.VMStateEncryption) {
+			encryption, err := PVCEncryption(pvc, clusterEncryption)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encryption).To(Equal(expected))
+		},
+			Entry("of the cluster for a PVC without encryption",
+				pvcWithAnnotations(nil), secretEncryption, secretEncryption),
+			Entry("of the PVC when the encryption is disabled",
+				pvcWithAnnotations(EncryptionAnnotations(secretEncryption)), nil, secretEncryption),
+			Entry("of the PVC when the cluster uses other keys",
+				pvcWithAnnotations(EncryptionAnnotations(secretEncryption)), otherSecretEncryption, secretEncryption),
+			Entry("of the PVC with the local KMS",
+				pvcWithAnnotations(EncryptionAnnotations(kmsEncryption)), kmsEncryption, kmsEncryption),
+			Entry("of a PVC encrypted before its mode was recorded",
+				pvcWithAnnotations(map[string]string{EncryptionAnnotation: "keys"}), nil, secretEncryption),
+			Entry("disabled for a PVC without encryption",
+				pvcWithAnnotations(nil), nil, nil),
+		)
+
+		DescribeTable("should reject switching the encryption mode", func(pvcEncryption, clusterEncryption *virtv1.VMStateEncryption) {
+			_, err := PVCEncryption(pvcWithAnnotations(EncryptionAnnotations(pvcEncryption)), clusterEncryption)
+			Expect(err).To(MatchError(ContainSubstring("switching it to")))
+		},
+			Entry("from a Secret to the local KMS", secretEncryption, kmsEncryption),
+			Entry("from the local KMS to a Secret", kmsEncryption, secretEncryption),
+		)
 	})
 	Context("IsBackendStorageNeeded", func() {
 		var vm *virtv1.VirtualMachine
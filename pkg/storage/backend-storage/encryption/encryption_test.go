@@ -0,0 +1,286 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package encryption
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func writeKey(dir, id string, fill byte) {
+	ExpectWithOffset(1, os.WriteFile(filepath.Join(dir, id), bytes.Repeat([]byte{fill}, KeySize), 0600)).To(Succeed())
+}
+
+func activateKey(dir, id string) {
+	ExpectWithOffset(1, os.WriteFile(filepath.Join(dir, ActiveKeyEntry), []byte(id+"\n"), 0600)).To(Succeed())
+}
+
+var _ = Describe("Keyring", func() {
+	var keysDir string
+
+	BeforeEach(func() {
+		keysDir = GinkgoT().TempDir()
+	})
+
+	It("should use the only key as the active key", func() {
+		writeKey(keysDir, "key1", 1)
+		keyring, err := LoadKeyring(keysDir)
+		Expect(err).ToNot(HaveOccurred())
+		id, key := keyring.Active()
+		Expect(id).To(Equal("key1"))
+		Expect(key).To(HaveLen(KeySize))
+	})
+
+	It("should use the key named by the active entry", func() {
+		writeKey(keysDir, "key1", 1)
+		writeKey(keysDir, "key2", 2)
+		activateKey(keysDir, "key2")
+		Expect(os.Mkdir(filepath.Join(keysDir, "..data"), 0755)).To(Succeed())
+		keyring, err := LoadKeyring(keysDir)
+		Expect(err).ToNot(HaveOccurred())
+		id, _ := keyring.Active()
+		Expect(id).To(Equal("key2"))
+		_, err = keyring.Key("key1")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("should fail", func(setup func(), expectedErr string) {
+		setup()
+		_, err := LoadKeyring(keysDir)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("with several keys and no active entry", func() {
+			writeKey(keysDir, "key1", 1)
+			writeKey(keysDir, "key2", 2)
+		}, "no active encryption key"),
+		Entry("with a missing active key", func() {
+			writeKey(keysDir, "key1", 1)
+			activateKey(keysDir, "key2")
+		}, "active encryption key key2 does not exist"),
+		Entry("with a key of the wrong size", func() {
+			Expect(os.WriteFile(filepath.Join(keysDir, "key1"), []byte("short"), 0600)).To(Succeed())
+		}, "has 5 bytes"),
+	)
+})
+
+var _ = Describe("Envelope", func() {
+	var keysDir string
+
+	BeforeEach(func() {
+		keysDir = GinkgoT().TempDir()
+		writeKey(keysDir, "key1", 1)
+	})
+
+	DescribeTable("should decrypt what it encrypted", func(mode string) {
+		keys, err := NewKeySource(mode, keysDir)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := Encrypt(keys, []byte("tpm state"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).ToNot(ContainSubstring("tpm state"))
+
+		plaintext, header, err := Decrypt(keys, data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("tpm state")))
+		Expect(header.KeyID).To(Equal("key1"))
+		Expect(keys.IsCurrent(header)).To(BeTrue())
+	},
+		Entry("with a Secret", ModeSecret),
+		Entry("with the local KMS", ModeLocalKMS),
+	)
+
+	DescribeTable("should decrypt with a rotated key", func(mode string) {
+		keys, err := NewKeySource(mode, keysDir)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := Encrypt(keys, []byte("tpm state"))
+		Expect(err).ToNot(HaveOccurred())
+
+		writeKey(keysDir, "key2", 2)
+		activateKey(keysDir, "key2")
+
+		plaintext, header, err := Decrypt(keys, data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("tpm state")))
+		Expect(keys.IsCurrent(header)).To(BeFalse())
+	},
+		Entry("with a Secret", ModeSecret),
+		Entry("with the local KMS", ModeLocalKMS),
+	)
+
+	It("should detect tampering with the header", func() {
+		keys := NewSecretKeySource(keysDir)
+		writeKey(keysDir, "key2", 1)
+		activateKey(keysDir, "key1")
+		data, err := Encrypt(keys, []byte("tpm state"))
+		Expect(err).ToNot(HaveOccurred())
+
+		tampered := bytes.Replace(data, []byte(`"key1"`), []byte(`"key2"`), 1)
+		_, _, err = Decrypt(keys, tampered)
+		Expect(err).To(MatchError(ContainSubstring("failed to decrypt")))
+	})
+})
+
+var _ = Describe("StateStore", func() {
+	var keysDir, stateDir, swtpmDir, nvramDir string
+	var store *StateStore
+
+	newStore := func() *StateStore {
+		return NewStateStore(NewSecretKeySource(keysDir), stateDir, map[string]string{
+			"swtpm": swtpmDir,
+			"nvram": nvramDir,
+		})
+	}
+
+	BeforeEach(func() {
+		keysDir = GinkgoT().TempDir()
+		stateDir = GinkgoT().TempDir()
+		swtpmDir = GinkgoT().TempDir()
+		nvramDir = GinkgoT().TempDir()
+		writeKey(keysDir, "key1", 1)
+		store = newStore()
+	})
+
+	It("should restore the persisted state", func() {
+		Expect(os.MkdirAll(filepath.Join(swtpmDir, "vm-uuid", "tpm2"), 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(swtpmDir, "vm-uuid", "tpm2", "tpm2-00.permall"), []byte("tpm"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(nvramDir, "vm_VARS.fd"), []byte("efi"), 0600)).To(Succeed())
+		Expect(store.Persist()).To(Succeed())
+
+		encrypted, err := os.ReadFile(filepath.Join(stateDir, stateFileName))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encrypted).ToNot(ContainSubstring("tpm2-00.permall"))
+
+		swtpmDir = GinkgoT().TempDir()
+		nvramDir = GinkgoT().TempDir()
+		Expect(newStore().Restore()).To(Succeed())
+		Expect(filepath.Join(swtpmDir, "vm-uuid", "tpm2", "tpm2-00.permall")).To(BeARegularFile())
+		Expect(os.ReadFile(filepath.Join(nvramDir, "vm_VARS.fd"))).To(Equal([]byte("efi")))
+	})
+
+	It("should only persist changed state", func() {
+		Expect(os.WriteFile(filepath.Join(nvramDir, "vm_VARS.fd"), []byte("efi"), 0600)).To(Succeed())
+		Expect(store.Persist()).To(Succeed())
+		stateFile := filepath.Join(stateDir, stateFileName)
+		Expect(os.Remove(stateFile)).To(Succeed())
+
+		Expect(store.Persist()).To(Succeed())
+		Expect(stateFile).ToNot(BeAnExistingFile())
+
+		Expect(os.WriteFile(filepath.Join(nvramDir, "vm_VARS.fd"), []byte("changed"), 0600)).To(Succeed())
+		Expect(store.Persist()).To(Succeed())
+		Expect(stateFile).To(BeARegularFile())
+	})
+
+	It("should start without state", func() {
+		Expect(store.Restore()).To(Succeed())
+		Expect(filepath.Join(stateDir, stateFileName)).ToNot(BeAnExistingFile())
+	})
+
+	It("should encrypt plain text state", func() {
+		Expect(os.MkdirAll(filepath.Join(stateDir, "nvram"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(stateDir, "nvram", "vm_VARS.fd"), []byte("efi"), 0600)).To(Succeed())
+
+		Expect(store.Restore()).To(Succeed())
+		Expect(os.ReadFile(filepath.Join(nvramDir, "vm_VARS.fd"))).To(Equal([]byte("efi")))
+		Expect(filepath.Join(stateDir, "nvram")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(stateDir, stateFileName)).To(BeARegularFile())
+	})
+
+	Context("while running", func() {
+		var stopCh chan struct{}
+		var done chan struct{}
+
+		stateFile := func() string {
+			return filepath.Join(stateDir, stateFileName)
+		}
+
+		restored := func(name string) func() ([]byte, error) {
+			return func() ([]byte, error) {
+				swtpmDir = GinkgoT().TempDir()
+				nvramDir = GinkgoT().TempDir()
+				if err := newStore().Restore(); err != nil {
+					return nil, err
+				}
+				return os.ReadFile(filepath.Join(swtpmDir, name))
+			}
+		}
+
+		BeforeEach(func() {
+			stopCh = make(chan struct{})
+			done = make(chan struct{})
+			go func() {
+				defer close(done)
+				// The resync interval is long enough to only see the changes persisted on write
+				store.Run(stopCh, time.Hour)
+			}()
+		})
+
+		AfterEach(func() {
+			select {
+			case <-stopCh:
+			default:
+				close(stopCh)
+			}
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("should persist the state when it is written", func() {
+			tpmDir := filepath.Join(swtpmDir, "vm-uuid", "tpm2")
+			Expect(os.MkdirAll(tpmDir, 0700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(tpmDir, "tpm2-00.permall"), []byte("tpm"), 0600)).To(Succeed())
+			Eventually(stateFile()).Should(BeARegularFile())
+			Eventually(restored(filepath.Join("vm-uuid", "tpm2", "tpm2-00.permall"))).Should(Equal([]byte("tpm")))
+		})
+
+		It("should persist every change of the state", func() {
+			Expect(os.WriteFile(filepath.Join(swtpmDir, "tpm2-00.permall"), []byte("first"), 0600)).To(Succeed())
+			Eventually(stateFile()).Should(BeARegularFile())
+			Expect(os.WriteFile(filepath.Join(swtpmDir, "tpm2-00.permall.tmp"), []byte("second"), 0600)).To(Succeed())
+			Expect(os.Rename(filepath.Join(swtpmDir, "tpm2-00.permall.tmp"), filepath.Join(swtpmDir, "tpm2-00.permall"))).To(Succeed())
+			Eventually(restored("tpm2-00.permall")).Should(Equal([]byte("second")))
+		})
+
+		It("should persist the state when it stops", func() {
+			Expect(os.WriteFile(filepath.Join(swtpmDir, "tpm2-00.permall"), []byte("tpm"), 0600)).To(Succeed())
+			close(stopCh)
+			Eventually(done).Should(BeClosed())
+			Expect(restored("tpm2-00.permall")()).To(Equal([]byte("tpm")))
+		})
+	})
+
+	It("should re-encrypt state with a rotated key", func() {
+		Expect(os.WriteFile(filepath.Join(nvramDir, "vm_VARS.fd"), []byte("efi"), 0600)).To(Succeed())
+		Expect(store.Persist()).To(Succeed())
+
+		writeKey(keysDir, "key2", 2)
+		activateKey(keysDir, "key2")
+		nvramDir = GinkgoT().TempDir()
+		Expect(newStore().Restore()).To(Succeed())
+
+		Expect(os.Remove(filepath.Join(keysDir, "key1"))).To(Succeed())
+		nvramDir = GinkgoT().TempDir()
+		Expect(newStore().Restore()).To(Succeed())
+		Expect(os.ReadFile(filepath.Join(nvramDir, "vm_VARS.fd"))).To(Equal([]byte("efi")))
+	})
+})
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+)
+
+var magic = []byte("KVSTATE1")
+
+// Header is stored in front of the encrypted state and identifies the key which encrypted it
+type Header struct {
+	KeyID      string `json:"keyID"`
+	WrappedKey []byte `json:"wrappedKey,omitempty"`
+}
+
+// KeySource provides the keys encrypting the VM state
+type KeySource interface {
+	// EncryptionKey returns the key to encrypt new state with and the header to find it again
+	EncryptionKey() ([]byte, Header, error)
+	// DecryptionKey returns the key which encrypted the state with the given header
+	DecryptionKey(header Header) ([]byte, error)
+	// IsCurrent reports whether state with the given header is encrypted with the active key
+	IsCurrent(header Header) bool
+}
+
+// SecretKeySource encrypts the state directly with the active key of a mounted key Secret
+type SecretKeySource struct {
+	keysDir string
+}
+
+func NewSecretKeySource(keysDir string) *SecretKeySource {
+	return &SecretKeySource{keysDir: keysDir}
+}
+
+func (s *SecretKeySource) EncryptionKey() ([]byte, Header, error) {
+	keyring, err := LoadKeyring(s.keysDir)
+	if err != nil {
+		return nil, Header{}, err
+	}
+	id, key := keyring.Active()
+	return key, Header{KeyID: id}, nil
+}
+
+func (s *SecretKeySource) DecryptionKey(header Header) ([]byte, error) {
+	if len(header.WrappedKey) != 0 {
+		return nil, fmt.Errorf("the state was encrypted with a KMS data key")
+	}
+	keyring, err := LoadKeyring(s.keysDir)
+	if err != nil {
+		return nil, err
+	}
+	return keyring.Key(header.KeyID)
+}
+
+func (s *SecretKeySource) IsCurrent(header Header) bool {
+	keyring, err := LoadKeyring(s.keysDir)
+	if err != nil {
+		return false
+	}
+	id, _ := keyring.Active()
+	return id == header.KeyID && len(header.WrappedKey) == 0
+}
+
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+// Encrypt encrypts the plaintext with a key of the key source. The header is authenticated with the ciphertext.
+func Encrypt(keys KeySource, plaintext []byte) ([]byte, error) {
+	key, header, err := keys.EncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	rawHeader, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(key, plaintext, rawHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(nil)
+	buf.Write(magic)
+	if err := binary.Write(buf, binary.BigEndian, uint32(len(rawHeader))); err != nil {
+		return nil, err
+	}
+	buf.Write(rawHeader)
+	buf.Write(ciphertext)
+	return buf.Bytes(), nil
+}
+
+// Decrypt decrypts data written by Encrypt and returns the plaintext with the header of the key which encrypted it
+func Decrypt(keys KeySource, data []byte) ([]byte, Header, error) {
+	if !bytes.HasPrefix(data, magic) || len(data) < len(magic)+4 {
+		return nil, Header{}, fmt.Errorf("not an encrypted VM state")
+	}
+	data = data[len(magic):]
+	headerLength := binary.BigEndian.Uint32(data[:4])
+	data = data[4:]
+	if uint64(headerLength) > uint64(len(data)) {
+		return nil, Header{}, fmt.Errorf("truncated encrypted VM state")
+	}
+	rawHeader, ciphertext := data[:headerLength], data[headerLength:]
+
+	header := Header{}
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return nil, Header{}, fmt.Errorf("invalid encrypted VM state header: %w", err)
+	}
+	key, err := keys.DecryptionKey(header)
+	if err != nil {
+		return nil, Header{}, err
+	}
+	plaintext, err := open(key, ciphertext, rawHeader)
+	if err != nil {
+		return nil, Header{}, fmt.Errorf("failed to decrypt the VM state with key %s: %w", header.KeyID, err)
+	}
+	return plaintext, header, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package encryption
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// KeySize is the size of the keys in a key Secret, AES-256 is used everywhere
+	KeySize = 32
+	// ActiveKeyEntry is the entry of a key Secret naming the key used to encrypt new state
+	ActiveKeyEntry = "active"
+)
+
+// Keyring holds the keys of a key Secret mounted into virt-launcher
+type Keyring struct {
+	activeID string
+	keys     map[string][]byte
+}
+
+// LoadKeyring reads the keys of a key Secret from the directory it is mounted to.
+// The Secret is read on every call to pick up key rotations of the mounted Secret.
+func LoadKeyring(dir string) (*Keyring, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the encryption keys: %w", err)
+	}
+
+	keyring := &Keyring{keys: map[string][]byte{}}
+	for _, entry := range entries {
+		// Skip the internal ..data links of the Secret volume
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the encryption key %s: %w", entry.Name(), err)
+		}
+		if entry.Name() == ActiveKeyEntry {
+			keyring.activeID = strings.TrimSpace(string(content))
+			continue
+		}
+		if len(content) != KeySize {
+			return nil, fmt.Errorf("encryption key %s has %d bytes, expected %d", entry.Name(), len(content), KeySize)
+		}
+		keyring.keys[entry.Name()] = content
+	}
+
+	if keyring.activeID == "" && len(keyring.keys) == 1 {
+		for id := range keyring.keys {
+			keyring.activeID = id
+		}
+	}
+	if keyring.activeID == "" {
+		return nil, fmt.Errorf("no active encryption key, set the %q entry of the key Secret", ActiveKeyEntry)
+	}
+	if _, exists := keyring.keys[keyring.activeID]; !exists {
+		return nil, fmt.Errorf("active encryption key %s does not exist", keyring.activeID)
+	}
+	return keyring, nil
+}
+
+// Active returns the ID and the key used to encrypt new state
+func (k *Keyring) Active() (string, []byte) {
+	return k.activeID, k.keys[k.activeID]
+}
+
+// Key returns the key with the given ID, it may have been rotated out already
+func (k *Keyring) Key(id string) ([]byte, error) {
+	key, exists := k.keys[id]
+	if !exists {
+		return nil, fmt.Errorf("encryption key %s does not exist anymore", id)
+	}
+	return key, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package encryption
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// KMS wraps and unwraps data keys with key encryption keys it never hands out, like a KMS plugin
+type KMS interface {
+	// Wrap encrypts the data key with the active key encryption key and returns its ID with the wrapped key
+	Wrap(dataKey []byte) (string, []byte, error)
+	// Unwrap decrypts a data key wrapped by the key encryption key with the given ID
+	Unwrap(keyID string, wrappedKey []byte) ([]byte, error)
+	// ActiveKeyID returns the ID of the key encryption key wrapping new data keys
+	ActiveKeyID() (string, error)
+}
+
+// LocalKMS is a stand-in for an external KMS plugin, it wraps the data keys with the keys of a mounted Secret
+type LocalKMS struct {
+	keysDir string
+}
+
+func NewLocalKMS(keysDir string) *LocalKMS {
+	return &LocalKMS{keysDir: keysDir}
+}
+
+func (l *LocalKMS) Wrap(dataKey []byte) (string, []byte, error) {
+	keyring, err := LoadKeyring(l.keysDir)
+	if err != nil {
+		return "", nil, err
+	}
+	id, key := keyring.Active()
+	wrapped, err := seal(key, dataKey, []byte(id))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, wrapped, nil
+}
+
+func (l *LocalKMS) Unwrap(keyID string, wrappedKey []byte) ([]byte, error) {
+	keyring, err := LoadKeyring(l.keysDir)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keyring.Key(keyID)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := open(key, wrappedKey, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap the data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+func (l *LocalKMS) ActiveKeyID() (string, error) {
+	keyring, err := LoadKeyring(l.keysDir)
+	if err != nil {
+		return "", err
+	}
+	id, _ := keyring.Active()
+	return id, nil
+}
+
+// KMSKeySource encrypts every state with a new data key, wrapped by the KMS and stored next to the state
+type KMSKeySource struct {
+	kms KMS
+}
+
+func NewKMSKeySource(kms KMS) *KMSKeySource {
+	return &KMSKeySource{kms: kms}
+}
+
+func (k *KMSKeySource) EncryptionKey() ([]byte, Header, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, Header{}, err
+	}
+	id, wrapped, err := k.kms.Wrap(dataKey)
+	if err != nil {
+		return nil, Header{}, err
+	}
+	return dataKey, Header{KeyID: id, WrappedKey: wrapped}, nil
+}
+
+func (k *KMSKeySource) DecryptionKey(header Header) ([]byte, error) {
+	if len(header.WrappedKey) == 0 {
+		return nil, fmt.Errorf("the state was not encrypted with a KMS data key")
+	}
+	return k.kms.Unwrap(header.KeyID, header.WrappedKey)
+}
+
+func (k *KMSKeySource) IsCurrent(header Header) bool {
+	id, err := k.kms.ActiveKeyID()
+	return err == nil && id == header.KeyID && len(header.WrappedKey) != 0
+}
@@ -0,0 +1,447 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package encryption
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// ModeSecret encrypts the VM state with the keys of a Secret
+	ModeSecret = "secret"
+	// ModeLocalKMS encrypts the VM state with data keys wrapped by the local KMS
+	ModeLocalKMS = "local-kms"
+
+	stateFileName = "state.enc"
+)
+
+// NewKeySource returns the key source of the given encryption mode, reading the keys from keysDir
+func NewKeySource(mode, keysDir string) (KeySource, error) {
+	switch mode {
+	case ModeSecret:
+		return NewSecretKeySource(keysDir), nil
+	case ModeLocalKMS:
+		return NewKMSKeySource(NewLocalKMS(keysDir)), nil
+	}
+	return nil, fmt.Errorf("unknown VM state encryption mode %q", mode)
+}
+
+// StateStore keeps the VM state directories of virt-launcher encrypted on the backend-storage PVC.
+// The state is decrypted into the directories used by libvirt at startup and written back whenever it changes.
+type StateStore struct {
+	keys     KeySource
+	stateDir string
+	dirs     map[string]string
+
+	lock       sync.Mutex
+	lastDigest [sha256.Size]byte
+	condition  func() bool
+}
+
+// NewStateStore returns a state store persisting the given directories, by name, encrypted into stateDir
+func NewStateStore(keys KeySource, stateDir string, dirs map[string]string) *StateStore {
+	return &StateStore{
+		keys:     keys,
+		stateDir: stateDir,
+		dirs:     dirs,
+	}
+}
+
+// SetPersistCondition sets a condition which has to hold for the state to be persisted, e.g. that
+// the VM did not migrate away
+func (s *StateStore) SetPersistCondition(condition func() bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.condition = condition
+}
+
+func (s *StateStore) stateFile() string {
+	return filepath.Join(s.stateDir, stateFileName)
+}
+
+// Restore decrypts the persisted state into the state directories. State which is still stored in plain text,
+// from before encryption was enabled, is imported and encrypted. State encrypted with a rotated key is re-encrypted
+// with the active key.
+func (s *StateStore) Restore() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	data, err := os.ReadFile(s.stateFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return s.importPlaintext()
+	} else if err != nil {
+		return fmt.Errorf("failed to read the encrypted VM state: %w", err)
+	}
+
+	plaintext, header, err := Decrypt(s.keys, data)
+	if err != nil {
+		return err
+	}
+	if err := s.extract(plaintext); err != nil {
+		return err
+	}
+	s.lastDigest = sha256.Sum256(plaintext)
+
+	if !s.keys.IsCurrent(header) {
+		log.Log.Infof("Re-encrypting the VM state encrypted with the rotated key %s", header.KeyID)
+		return s.persist(true)
+	}
+	return nil
+}
+
+// importPlaintext imports the state directories of a backend-storage PVC which was written without encryption
+func (s *StateStore) importPlaintext() error {
+	var imported []string
+	for _, name := range s.sortedNames() {
+		src := filepath.Join(s.stateDir, name)
+		if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := copyDir(src, s.dirs[name]); err != nil {
+			return fmt.Errorf("failed to import the plain text VM state %s: %w", name, err)
+		}
+		imported = append(imported, src)
+	}
+	if len(imported) == 0 {
+		return nil
+	}
+
+	log.Log.Info("Encrypting the plain text VM state")
+	if err := s.persist(true); err != nil {
+		return err
+	}
+	for _, dir := range imported {
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("failed to remove the plain text VM state %s: %w", dir, err)
+		}
+	}
+	return nil
+}
+
+// Persist encrypts the state directories onto the backend-storage PVC, if they changed since they were last persisted
+func (s *StateStore) Persist() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.persist(false)
+}
+
+func (s *StateStore) persist(force bool) error {
+	if s.condition != nil && !s.condition() {
+		return nil
+	}
+	plaintext, err := s.archive()
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(plaintext)
+	if !force && digest == s.lastDigest {
+		return nil
+	}
+
+	data, err := Encrypt(s.keys, plaintext)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomically(s.stateFile(), data); err != nil {
+		return fmt.Errorf("failed to write the encrypted VM state: %w", err)
+	}
+	s.lastDigest = digest
+	return nil
+}
+
+// Run persists the state whenever a file of the state directories changes until stopCh is closed, and a last time
+// before returning. The state directories are checked every resyncInterval as well, for the changes made to
+// directories which did not exist yet when they were last watched.
+func (s *StateStore) Run(stopCh <-chan struct{}, resyncInterval time.Duration) {
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to watch the VM state, persisting it every %s", resyncInterval)
+	} else {
+		defer watcher.Close()
+		events, errs = watcher.Events, watcher.Errors
+	}
+	watchAndPersist := func() {
+		if watcher != nil {
+			s.watch(watcher)
+		}
+		if err := s.Persist(); err != nil {
+			log.Log.Reason(err).Error("Failed to persist the encrypted VM state")
+		}
+	}
+
+	watchAndPersist()
+	ticker := time.NewTicker(resyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			// A single write of the state usually comes with a burst of events, persist once for all of them
+			drainEvents(events)
+			watchAndPersist()
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			log.Log.Reason(err).Warning("An error occurred while watching the VM state")
+		case <-ticker.C:
+			watchAndPersist()
+		case <-stopCh:
+			if err := s.Persist(); err != nil {
+				log.Log.Reason(err).Error("Failed to persist the encrypted VM state")
+			}
+			return
+		}
+	}
+}
+
+// watch watches every directory of the state directories, the directories created since the last call included
+func (s *StateStore) watch(watcher *fsnotify.Watcher) {
+	for _, name := range s.sortedNames() {
+		root := s.dirs[name]
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if errors.Is(err, os.ErrNotExist) {
+				// The directory is watched once it is created, or was removed meanwhile
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				return nil
+			}
+			return watcher.Add(path)
+		})
+		if err != nil {
+			log.Log.Reason(err).Warningf("Failed to watch the VM state %s", name)
+		}
+	}
+}
+
+func drainEvents(events <-chan fsnotify.Event) {
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (s *StateStore) sortedNames() []string {
+	names := make([]string, 0, len(s.dirs))
+	for name := range s.dirs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// archive packs the state directories into a tar archive, with every directory under its name
+func (s *StateStore) archive() ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	tw := tar.NewWriter(buf)
+	for _, name := range s.sortedNames() {
+		root := s.dirs[name]
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) && path == root {
+					return filepath.SkipDir
+				}
+				return err
+			}
+			if !d.IsDir() && !d.Type().IsRegular() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			hdr, err := tar.FileInfoHeader(info, "")
+			if err != nil {
+				return err
+			}
+			hdr.Name = filepath.ToSlash(filepath.Join(name, rel))
+			// Only the content matters, keep the archive stable to detect changes
+			hdr.ModTime, hdr.AccessTime, hdr.ChangeTime = time.Unix(0, 0), time.Time{}, time.Time{}
+			if d.IsDir() {
+				hdr.Name += "/"
+			}
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = io.Copy(tw, f)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to archive the VM state %s: %w", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// extract unpacks an archive written by archive into the state directories
+func (s *StateStore) extract(plaintext []byte) error {
+	tr := tar.NewReader(bytes.NewReader(plaintext))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read the VM state archive: %w", err)
+		}
+
+		name, rel, _ := strings.Cut(strings.TrimSuffix(hdr.Name, "/"), "/")
+		root, exists := s.dirs[name]
+		if !exists {
+			log.Log.Warningf("Ignoring the VM state %s which is not used anymore", name)
+			continue
+		}
+		if !filepath.IsLocal(rel) && rel != "" {
+			return fmt.Errorf("invalid path %s in the VM state archive", hdr.Name)
+		}
+		path := filepath.Join(root, rel)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, hdr.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, hdr.FileInfo().Mode().Perm())
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+		if os.Geteuid() == 0 {
+			if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, content, info.Mode().Perm())
+	})
+}
+
+func writeFileAtomically(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
@@ -56,6 +56,7 @@ import (
 	instancetypefind "kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/storage/status"
 	"kubevirt.io/kubevirt/pkg/storage/types"
@@ -1117,7 +1118,7 @@ func (ctrl *VMExportController) createDataManifestConfigMap(vmExport *exportv1.V
 	}
 	data[vmManifest] = string(vmBytes)
 
-	datavolumes, err := ctrl.generateDataVolumesFromVm(vm)
+	datavolumes, err := ctrl.generateDataVolumesFromVm(vmExport, vm)
 	if err != nil {
 		return nil, err
 	}
@@ -1477,10 +1478,10 @@ func (ctrl *VMExportController) generateVMDefinitionFromVm(vm *virtv1.VirtualMac
 	return vmBytes, nil
 }
 
-func (ctrl *VMExportController) generateDataVolumesFromVm(vm *virtv1.VirtualMachine) ([]*cdiv1.DataVolume, error) {
+func (ctrl *VMExportController) generateDataVolumesFromVm(vmExport *exportv1.VirtualMachineExport, vm *virtv1.VirtualMachine) ([]*cdiv1.DataVolume, error) {
 	res := make([]*cdiv1.DataVolume, 0)
-	volumes, err := storageutils.GetVolumes(vm, nil)
-	if err != nil {
+	volumes, err := storageutils.GetVolumes(vm, ctrl.Client, exportVolumeOption(vmExport))
+	if err != nil && !storageutils.IsErrNoBackendPVC(err) {
 		return nil, err
 	}
 	for _, volume := range volumes {
@@ -1499,7 +1500,13 @@ func (ctrl *VMExportController) generateDataVolumesFromVm(vm *virtv1.VirtualMach
 				}
 			}
 			if !found {
-				res = append(res, ctrl.createExportHttpDvFromPVC(vm.Namespace, volumeName))
+				dv := ctrl.createExportHttpDvFromPVC(vm.Namespace, volumeName)
+				if dv != nil && volume.Name == storageutils.BackendPVCVolumeName(vm.Name) {
+					// The imported PVC holds the persistent state of the VM once it is labeled as its backend storage
+					dv.Labels = map[string]string{backendstorage.PVCPrefix: vm.Name}
+					dv.Spec.ContentType = cdiv1.DataVolumeArchive
+				}
+				res = append(res, dv)
 			}
 		}
 	}
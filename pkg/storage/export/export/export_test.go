@@ -1493,7 +1493,7 @@ var _ = Describe("Export controller", func() {
 		pvcCopy := pvc.DeepCopy()
 		pvcInformer.GetStore().Add(pvc)
 		vm := createVMWithDVTemplateAndPVC()
-		dvs, err := controller.generateDataVolumesFromVm(vm)
+		dvs, err := controller.generateDataVolumesFromVm(createVMVMExport(), vm)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(dvs).To(HaveLen(1))
 		Expect(dvs[0]).ToNot(BeNil())
@@ -1517,6 +1517,48 @@ var _ = Describe("Export controller", func() {
 			}),
 		)
 	})
+
+	DescribeTable("Should generate DataVolumes for the persistent state of the VM", func(policy *exportv1.PersistentStatePolicy, expectBackendDV bool) {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVmName,
+				Namespace: testNamespace,
+			},
+			Spec: virtv1.VirtualMachineSpec{
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{
+						Domain: virtv1.DomainSpec{
+							Devices: virtv1.Devices{
+								TPM: &virtv1.TPMDevice{Persistent: pointer.P(true)},
+							},
+						},
+					},
+				},
+			},
+		}
+		backendPVC := createBackendPVC(vm.Name)
+		pvcInformer.GetStore().Add(backendPVC)
+		k8sClient.Fake.PrependReactor("list", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			return true, &k8sv1.PersistentVolumeClaimList{Items: []k8sv1.PersistentVolumeClaim{*backendPVC}}, nil
+		})
+		vmExport := createVMVMExport()
+		vmExport.Spec.PersistentState = policy
+
+		dvs, err := controller.generateDataVolumesFromVm(vmExport, vm)
+		Expect(err).ToNot(HaveOccurred())
+		if !expectBackendDV {
+			Expect(dvs).To(BeEmpty())
+			return
+		}
+		Expect(dvs).To(HaveLen(1))
+		Expect(dvs[0].Name).To(Equal(backendPVC.Name))
+		Expect(dvs[0].Labels).To(HaveKeyWithValue(backendstorage.PVCPrefix, vm.Name))
+		Expect(dvs[0].Spec.ContentType).To(Equal(cdiv1.DataVolumeArchive))
+	},
+		Entry("by default", nil, true),
+		Entry("when included", pointer.P(exportv1.PersistentStateInclude), true),
+		Entry("not when excluded", pointer.P(exportv1.PersistentStateExclude), false),
+	)
 })
 
 func verifyLinksEmpty(vmExport *exportv1.VirtualMachineExport) {
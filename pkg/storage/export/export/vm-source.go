@@ -161,7 +161,7 @@ func (ctrl *VMExportController) isSourceInUseVM(vmExport *exportv1.VirtualMachin
 }
 
 func (ctrl *VMExportController) getPVCFromSourceVM(vmExport *exportv1.VirtualMachineExport) (*sourceVolumes, error) {
-	pvcs, allPopulated, err := ctrl.getPVCsFromVM(vmExport.Namespace, vmExport.Spec.Source.Name)
+	pvcs, allPopulated, err := ctrl.getPVCsFromVM(vmExport.Namespace, vmExport.Spec.Source.Name, exportVolumeOption(vmExport))
 	if err != nil {
 		return &sourceVolumes{}, err
 	}
@@ -184,7 +184,7 @@ func (ctrl *VMExportController) getPVCFromSourceVM(vmExport *exportv1.VirtualMac
 		availableMessage: availableMessage}, nil
 }
 
-func (ctrl *VMExportController) getPVCsFromVM(vmNamespace, vmName string) ([]*corev1.PersistentVolumeClaim, bool, error) {
+func (ctrl *VMExportController) getPVCsFromVM(vmNamespace, vmName string, volumeOption storageutils.VolumeOption) ([]*corev1.PersistentVolumeClaim, bool, error) {
 	var pvcs []*corev1.PersistentVolumeClaim
 	vm, exists, err := ctrl.getVm(vmNamespace, vmName)
 	if err != nil {
@@ -195,7 +195,7 @@ func (ctrl *VMExportController) getPVCsFromVM(vmNamespace, vmName string) ([]*co
 	}
 	allPopulated := true
 
-	volumes, err := storageutils.GetVolumes(vm, ctrl.Client, storageutils.WithAllVolumes)
+	volumes, err := storageutils.GetVolumes(vm, ctrl.Client, volumeOption)
 	if err != nil {
 		if storageutils.IsErrNoBackendPVC(err) {
 			// No backend pvc when we should have one, lets wait
@@ -234,6 +234,18 @@ func (ctrl *VMExportController) getPVCsFromVM(vmNamespace, vmName string) ([]*co
 	return pvcs, allPopulated, nil
 }
 
+// includesPersistentState returns whether the vTPM and EFI NVRAM state on the backend storage is exported
+func includesPersistentState(vmExport *exportv1.VirtualMachineExport) bool {
+	return vmExport.Spec.PersistentState == nil || *vmExport.Spec.PersistentState == exportv1.PersistentStateInclude
+}
+
+func exportVolumeOption(vmExport *exportv1.VirtualMachineExport) storageutils.VolumeOption {
+	if includesPersistentState(vmExport) {
+		return storageutils.WithAllVolumes
+	}
+	return storageutils.WithRegularVolumes
+}
+
 func (ctrl *VMExportController) updateVMExportVMStatus(vmExport *exportv1.VirtualMachineExport, exporterPod *corev1.Pod, service *corev1.Service, sourceVolumes *sourceVolumes) (time.Duration, error) {
 	var requeue time.Duration
 
@@ -38,7 +38,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/controller"
 
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
 const (
@@ -126,9 +128,12 @@ func (ctrl *VMExportController) handlePVCsForVirtualMachineSnapshot(vmExport *ex
 		}
 		if exists {
 			sourceVm := content.Spec.Source.VirtualMachine
-			totalVolumes = len(content.Status.VolumeSnapshotStatus)
+			totalVolumes = exportedSnapshotVolumes(vmExport, content)
 
 			for _, volumeBackup := range content.Spec.VolumeBackups {
+				if !includesPersistentState(vmExport) && isBackendVolumeBackup(&volumeBackup, sourceVm) {
+					continue
+				}
 				if pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, &volumeBackup, sourceVm); err != nil {
 					return nil, 0, err
 				} else {
@@ -163,6 +168,8 @@ func (ctrl *VMExportController) getOrCreatePVCFromSnapshot(vmExport *exportv1.Vi
 	if err != nil {
 		return nil, err
 	}
+	// The restored PVC must not be taken for the backend storage of the source VM
+	delete(pvc.Labels, backendstorage.PVCPrefix)
 	if volumeBackupIsKubeVirtContent(volumeBackup, sourceVm) {
 		if len(pvc.GetAnnotations()) == 0 {
 			pvc.SetAnnotations(make(map[string]string))
@@ -233,11 +240,11 @@ func (ctrl *VMExportController) updateVMSnapshotExportStatusConditions(vmExportC
 			return err
 		}
 		if exists {
-			if len(content.Status.VolumeSnapshotStatus) == 0 {
+			if exportedSnapshotVolumes(vmExportCopy, content) == 0 {
 				vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newVolumesCreatedCondition(corev1.ConditionFalse, noVolumeSnapshotReason, availableMessage))
 				vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, initializingReason, ""))
 				vmExportCopy.Status.Phase = exportv1.Skipped
-			} else if len(content.Status.VolumeSnapshotStatus) != len(pvcs) {
+			} else if exportedSnapshotVolumes(vmExportCopy, content) != len(pvcs) {
 				vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newVolumesCreatedCondition(corev1.ConditionFalse, notAllPVCsCreated, availableMessage))
 			} else {
 				readyCount := 0
@@ -290,6 +297,24 @@ func volumeBackupIsKubeVirtContent(volumeBackup *snapshotv1.VolumeBackup, source
 	return false
 }
 
+// exportedSnapshotVolumes returns the number of volume snapshots of the content that are exported
+func exportedSnapshotVolumes(vmExport *exportv1.VirtualMachineExport, content *snapshotv1.VirtualMachineSnapshotContent) int {
+	total := len(content.Status.VolumeSnapshotStatus)
+	if includesPersistentState(vmExport) {
+		return total
+	}
+	for i := range content.Spec.VolumeBackups {
+		if isBackendVolumeBackup(&content.Spec.VolumeBackups[i], content.Spec.Source.VirtualMachine) {
+			total--
+		}
+	}
+	return total
+}
+
+func isBackendVolumeBackup(volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) bool {
+	return sourceVm != nil && volumeBackup.VolumeName == storageutils.BackendPVCVolumeName(sourceVm.Name)
+}
+
 func getSnapshotVolumeName(pvc *corev1.PersistentVolumeClaim, vmExport *exportv1.VirtualMachineExport) string {
 	// When exporting snapshots, we change the name of the
 	// restore PVC to match the volume name of the source VM
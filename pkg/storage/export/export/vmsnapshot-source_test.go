@@ -54,6 +54,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
@@ -499,6 +501,50 @@ var _ = Describe("VMSnapshot source", func() {
 		Expect(retry).To(BeEquivalentTo(0))
 	})
 
+	DescribeTable("Should handle the persistent state of the VMSnapshot", func(policy *exportv1.PersistentStatePolicy, expectedPVCs []string) {
+		testVMExport := createSnapshotVMExport()
+		testVMExport.Spec.PersistentState = policy
+		content := createTestVMSnapshotContent("snapshot-content")
+		content.Spec.Source.VirtualMachine.Name = testVmName
+		content.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{Persistent: pointer.P(true)}
+		backendVolumeBackup := content.Spec.VolumeBackups[0].DeepCopy()
+		backendVolumeBackup.VolumeName = storageutils.BackendPVCVolumeName(testVmName)
+		backendVolumeBackup.PersistentVolumeClaim.Name = "backend"
+		backendVolumeBackup.VolumeSnapshotName = pointer.P("backend-snapshot")
+		backendVolumeBackup.PersistentVolumeClaim.Labels = map[string]string{backendstorage.PVCPrefix: testVmName}
+		content.Spec.VolumeBackups = append(content.Spec.VolumeBackups, *backendVolumeBackup)
+		content.Status.VolumeSnapshotStatus = append(content.Status.VolumeSnapshotStatus, content.Status.VolumeSnapshotStatus[0])
+
+		var createdPVCs []string
+		k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			pvc := action.(testing.CreateAction).GetObject().(*k8sv1.PersistentVolumeClaim)
+			Expect(pvc.Labels).ToNot(HaveKey(backendstorage.PVCPrefix))
+			createdPVCs = append(createdPVCs, pvc.Name)
+			return true, pvc, nil
+		})
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			vmExport := action.(testing.UpdateAction).GetObject().(*exportv1.VirtualMachineExport)
+			for _, condition := range vmExport.Status.Conditions {
+				if condition.Type == exportv1.ConditionVolumesCreated {
+					Expect(condition.Reason).To(Equal(notAllPVCsReady))
+				}
+			}
+			return true, vmExport, nil
+		})
+		expectExporterCreate(k8sClient, k8sv1.PodPending)
+
+		vmSnapshotInformer.GetStore().Add(createTestVMSnapshot(true))
+		vmSnapshotContentInformer.GetStore().Add(content)
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot("backend-snapshot"))
+		_, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(createdPVCs).To(Equal(expectedPVCs))
+	},
+		Entry("by exporting it by default", nil, []string{"test-test-snapshot", "test-backend"}),
+		Entry("by leaving it out when excluded", pointer.P(exportv1.PersistentStateExclude), []string{"test-test-snapshot"}),
+	)
+
 	It("Should not re-create restored PVCs from VMSnapshot if pvc already exists", func() {
 		testVMExport := createSnapshotVMExport()
 		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -641,6 +687,7 @@ var _ = Describe("VMSnapshot source", func() {
 		content.Spec.Source.VirtualMachine.Spec.Template.Spec.Volumes[0].MemoryDump = &virtv1.MemoryDumpVolumeSource{}
 		vmSnapshotContentInformer.GetStore().Add(content)
 		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot("backend-snapshot"))
 		retry, err := controller.updateVMExport(testVMExport)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(retry).To(BeEquivalentTo(0))
@@ -683,6 +730,7 @@ var _ = Describe("VMSnapshot source", func() {
 		content := createTestVMSnapshotContent("snapshot-content")
 		vmSnapshotContentInformer.GetStore().Add(content)
 		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot("backend-snapshot"))
 		retry, err := controller.updateVMExport(testVMExport)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(retry).To(BeEquivalentTo(0))
@@ -639,8 +639,13 @@ func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCm
 				APIVersion: "cdi.kubevirt.io/v1beta1",
 			}
 			for _, info := range vi {
-				if strings.Contains(info.RawGzURI, dv.Name) {
-					dv.Spec.Source.HTTP.URL = fmt.Sprintf("https://%s", filepath.Join(path, info.RawGzURI))
+				uri := info.RawGzURI
+				if dv.Spec.ContentType == cdiv1.DataVolumeArchive {
+					// Volumes without disk images, like the backend storage, are imported from their archive
+					uri = info.ArchiveURI
+				}
+				if uri != "" && strings.Contains(uri, dv.Name) {
+					dv.Spec.Source.HTTP.URL = fmt.Sprintf("https://%s", filepath.Join(path, uri))
 				}
 			}
 			dv.Spec.Source.HTTP.CertConfigMap = certCm.Name
@@ -675,6 +675,51 @@ var _ = Describe("exportserver", func() {
 			Expect(resDv.Spec.Source.HTTP).ToNot(BeNil())
 			Expect(resDv.Spec.Source.HTTP.URL).To(Equal("https://base_path/test-dv-volume0"))
 		})
+
+		It("Should use the archive URI for archive datavolumes", func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return &virtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-vm",
+						Namespace: testNamespace,
+					},
+				}
+			}
+			getDataVolumes = func(vm *virtv1.VirtualMachine) ([]*cdiv1.DataVolume, error) {
+				return []*cdiv1.DataVolume{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "persistent-state-for-test-vm",
+							Namespace: testNamespace,
+						},
+						Spec: cdiv1.DataVolumeSpec{
+							Source: &cdiv1.DataVolumeSource{
+								HTTP: &cdiv1.DataVolumeSourceHTTP{},
+							},
+							ContentType: cdiv1.DataVolumeArchive,
+						},
+					},
+				}, nil
+			}
+
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/internal/manifest?x-kubevirt-export-token=bar", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Accept", runtime.ContentTypeYAML)
+			resp := httptest.NewRecorder()
+			handler := vmHandler([]export.VolumeInfo{
+				{
+					ArchiveURI: "volumes/persistent-state-for-test-vm/disk.tar.gz",
+					DirURI:     "volumes/persistent-state-for-test-vm/dir/",
+				},
+			}, getBasePath, getCaConfigMap)
+			handler.ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			out := strings.Split(resp.Body.String(), "---\n")
+			Expect(out).To(HaveLen(4))
+			resDv := &cdiv1.DataVolume{}
+			Expect(yaml.Unmarshal([]byte(out[2]), resDv)).To(Succeed())
+			Expect(resDv.Spec.Source.HTTP.URL).To(Equal("https://base_path/volumes/persistent-state-for-test-vm/disk.tar.gz"))
+		})
 	})
 
 	Context("Secret handler", func() {
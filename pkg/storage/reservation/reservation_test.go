@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package reservation
+
+import (
+	"encoding/binary"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Shared disk groups", func() {
+	newMember := func(name, group string, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		opts = append(opts, libvmi.WithName(name), libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi := libvmi.New(opts...)
+		vmi.Spec.SharedDiskGroup = group
+		for i := range vmi.Spec.Domain.Devices.Disks {
+			shareable := true
+			vmi.Spec.Domain.Devices.Disks[i].Shareable = &shareable
+		}
+		return vmi
+	}
+
+	It("should list the shareable reservation LUNs sorted by claim name", func() {
+		vmi := newMember("vmi", "cluster",
+			libvmi.WithPersistentVolumeClaimLun("quorum", "quorum-pvc", true),
+			libvmi.WithPersistentVolumeClaimLun("data", "data-pvc", true),
+			libvmi.WithPersistentVolumeClaimLun("scratch", "scratch-pvc", false),
+		)
+		Expect(SharedDisksForVMISpec(&vmi.Spec)).To(Equal([]SharedDisk{
+			{ClaimName: "data-pvc", Bus: v1.DiskBusSCSI},
+			{ClaimName: "quorum-pvc", Bus: v1.DiskBusSCSI},
+		}))
+	})
+
+	DescribeTable("should validate the members of the group", func(member *v1.VirtualMachineInstance, expectErr bool) {
+		vmi := newMember("vmi", "cluster", libvmi.WithPersistentVolumeClaimLun("quorum", "quorum-pvc", true))
+		err := ValidateSharedDiskGroupMembers(vmi, []*v1.VirtualMachineInstance{vmi, member})
+		if expectErr {
+			Expect(err).To(MatchError(ContainSubstring("shared disk group cluster")))
+		} else {
+			Expect(err).ToNot(HaveOccurred())
+		}
+	},
+		Entry("with the same LUNs", newMember("member", "cluster", libvmi.WithPersistentVolumeClaimLun("quorum", "quorum-pvc", true)), false),
+		Entry("with a different LUN", newMember("member", "cluster", libvmi.WithPersistentVolumeClaimLun("quorum", "other-pvc", true)), true),
+		Entry("with an additional LUN", newMember("member", "cluster",
+			libvmi.WithPersistentVolumeClaimLun("quorum", "quorum-pvc", true),
+			libvmi.WithPersistentVolumeClaimLun("data", "data-pvc", true),
+		), true),
+		Entry("with a member of another group", newMember("member", "other", libvmi.WithPersistentVolumeClaimLun("quorum", "other-pvc", true)), false),
+	)
+})
+
+var _ = Describe("Persistent reserve in", func() {
+	newResponse := func(descriptors ...[]byte) []byte {
+		data := make([]byte, prInHeaderLength)
+		for _, descriptor := range descriptors {
+			data = append(data, descriptor...)
+		}
+		binary.BigEndian.PutUint32(data[4:8], uint32(len(data)-prInHeaderLength))
+		return data
+	}
+
+	key := func(k uint64) []byte {
+		b := make([]byte, prKeyLength)
+		binary.BigEndian.PutUint64(b, k)
+		return b
+	}
+
+	It("should parse the registered keys", func() {
+		keys, err := parseReadKeys(newResponse(key(0x123abc), key(0x1)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(Equal([]string{"0x123abc", "0x1"}))
+	})
+
+	It("should parse an empty key list", func() {
+		keys, err := parseReadKeys(newResponse())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(BeEmpty())
+	})
+
+	It("should parse the reservation holder and type", func() {
+		descriptor := make([]byte, prReservationLength)
+		copy(descriptor, key(0x123abc))
+		descriptor[13] = 0x05
+		holder, reservationType, err := parseReadReservation(newResponse(descriptor))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(holder).To(Equal("0x123abc"))
+		Expect(reservationType).To(Equal("WriteExclusiveRegistrantsOnly"))
+	})
+
+	It("should report no holder without a reservation", func() {
+		holder, reservationType, err := parseReadReservation(newResponse())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(holder).To(BeEmpty())
+		Expect(reservationType).To(BeEmpty())
+	})
+
+	It("should fail on a short response", func() {
+		_, err := parseReadKeys([]byte{0, 0})
+		Expect(err).To(HaveOccurred())
+	})
+})
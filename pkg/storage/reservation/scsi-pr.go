@@ -0,0 +1,189 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package reservation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	sgIO              = 0x2285
+	sgDxferFromDev    = -3
+	sgInterfaceID     = 'S'
+	sgTimeoutMs       = 10000
+	senseBufferLength = 32
+
+	opPersistentReserveIn = 0x5e
+	prInReadKeys          = 0x00
+	prInReadReservation   = 0x01
+
+	prInAllocationLength = 8192
+	prKeyLength          = 8
+	prInHeaderLength     = 8
+	prReservationLength  = 16
+)
+
+var reservationTypes = map[byte]string{
+	0x1: "WriteExclusive",
+	0x3: "ExclusiveAccess",
+	0x5: "WriteExclusiveRegistrantsOnly",
+	0x6: "ExclusiveAccessRegistrantsOnly",
+	0x7: "WriteExclusiveAllRegistrants",
+	0x8: "ExclusiveAccessAllRegistrants",
+}
+
+// sgIOHdr mirrors struct sg_io_hdr from <scsi/sg.h>
+type sgIOHdr struct {
+	interfaceID    int32
+	dxferDirection int32
+	cmdLen         uint8
+	mxSbLen        uint8
+	iovecCount     uint16
+	dxferLen       uint32
+	dxferp         unsafe.Pointer
+	cmdp           unsafe.Pointer
+	sbp            unsafe.Pointer
+	timeout        uint32
+	flags          uint32
+	packID         int32
+	usrPtr         unsafe.Pointer
+	status         uint8
+	maskedStatus   uint8
+	msgStatus      uint8
+	sbLenWr        uint8
+	hostStatus     uint16
+	driverStatus   uint16
+	resid          int32
+	duration       uint32
+	info           uint32
+}
+
+func persistentReserveIn(f *os.File, serviceAction byte) ([]byte, error) {
+	cdb := make([]byte, 10)
+	cdb[0] = opPersistentReserveIn
+	cdb[1] = serviceAction
+	binary.BigEndian.PutUint16(cdb[7:9], prInAllocationLength)
+	data := make([]byte, prInAllocationLength)
+	sense := make([]byte, senseBufferLength)
+
+	hdr := &sgIOHdr{
+		interfaceID:    sgInterfaceID,
+		dxferDirection: sgDxferFromDev,
+		cmdLen:         uint8(len(cdb)),
+		mxSbLen:        uint8(len(sense)),
+		dxferLen:       uint32(len(data)),
+		dxferp:         unsafe.Pointer(&data[0]),
+		cmdp:           unsafe.Pointer(&cdb[0]),
+		sbp:            unsafe.Pointer(&sense[0]),
+		timeout:        sgTimeoutMs,
+	}
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), sgIO, uintptr(unsafe.Pointer(hdr))); errno != 0 {
+		return nil, fmt.Errorf("SG_IO ioctl failed: %w", errno)
+	}
+	if hdr.status != 0 || hdr.hostStatus != 0 || hdr.driverStatus != 0 {
+		return nil, fmt.Errorf("PERSISTENT RESERVE IN failed: status 0x%x, host status 0x%x, driver status 0x%x",
+			hdr.status, hdr.hostStatus, hdr.driverStatus)
+	}
+	return data[:len(data)-int(hdr.resid)], nil
+}
+
+func formatKey(key []byte) string {
+	return fmt.Sprintf("0x%x", binary.BigEndian.Uint64(key))
+}
+
+func additionalLength(data []byte) (int, error) {
+	if len(data) < prInHeaderLength {
+		return 0, fmt.Errorf("short PERSISTENT RESERVE IN response of %d bytes", len(data))
+	}
+	length := int(binary.BigEndian.Uint32(data[4:8]))
+	if prInHeaderLength+length > len(data) {
+		length = len(data) - prInHeaderLength
+	}
+	return length, nil
+}
+
+// parseReadKeys parses the parameter data of a PERSISTENT RESERVE IN READ KEYS command
+func parseReadKeys(data []byte) ([]string, error) {
+	length, err := additionalLength(data)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for offset := prInHeaderLength; offset+prKeyLength <= prInHeaderLength+length; offset += prKeyLength {
+		keys = append(keys, formatKey(data[offset:offset+prKeyLength]))
+	}
+	return keys, nil
+}
+
+// parseReadReservation parses the parameter data of a PERSISTENT RESERVE IN READ RESERVATION command
+// and returns the key of the reservation holder and the reservation type
+func parseReadReservation(data []byte) (string, string, error) {
+	length, err := additionalLength(data)
+	if err != nil {
+		return "", "", err
+	}
+	if length < prReservationLength {
+		return "", "", nil
+	}
+	descriptor := data[prInHeaderLength : prInHeaderLength+prReservationLength]
+	reservationType, ok := reservationTypes[descriptor[13]&0x0f]
+	if !ok {
+		reservationType = fmt.Sprintf("Unknown(0x%x)", descriptor[13]&0x0f)
+	}
+	return formatKey(descriptor[0:prKeyLength]), reservationType, nil
+}
+
+// ReadPersistentReservation reads the registered keys and the current reservation of the SCSI device at path
+func ReadPersistentReservation(path string) (*v1.PersistentReservationStatus, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := persistentReserveIn(f, prInReadKeys)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseReadKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	data, err = persistentReserveIn(f, prInReadReservation)
+	if err != nil {
+		return nil, err
+	}
+	holder, reservationType, err := parseReadReservation(data)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PersistentReservationStatus{
+		Keys:   keys,
+		Holder: holder,
+		Type:   reservationType,
+	}, nil
+}
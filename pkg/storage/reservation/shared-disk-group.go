@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package reservation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// SharedDisk identifies a reservation LUN which is shared between the members of a shared disk group
+type SharedDisk struct {
+	ClaimName string
+	Bus       v1.DiskBus
+}
+
+func (d SharedDisk) String() string {
+	return fmt.Sprintf("%s(bus=%s)", d.ClaimName, d.Bus)
+}
+
+func claimNameForVolume(volume *v1.Volume) string {
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		return volume.PersistentVolumeClaim.ClaimName
+	case volume.DataVolume != nil:
+		return volume.DataVolume.Name
+	}
+	return ""
+}
+
+// SharedDisksForVMISpec returns the shareable LUN disks with persistent reservation of the VMI spec, sorted by claim name
+func SharedDisksForVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec) []SharedDisk {
+	volumes := map[string]*v1.Volume{}
+	for i := range vmiSpec.Volumes {
+		volumes[vmiSpec.Volumes[i].Name] = &vmiSpec.Volumes[i]
+	}
+
+	var disks []SharedDisk
+	for _, disk := range vmiSpec.Domain.Devices.Disks {
+		if disk.LUN == nil || !disk.LUN.Reservation || disk.Shareable == nil || !*disk.Shareable {
+			continue
+		}
+		volume, ok := volumes[disk.Name]
+		if !ok {
+			continue
+		}
+		claimName := claimNameForVolume(volume)
+		if claimName == "" {
+			continue
+		}
+		disks = append(disks, SharedDisk{ClaimName: claimName, Bus: disk.LUN.Bus})
+	}
+	slices.SortFunc(disks, func(a, b SharedDisk) int {
+		return strings.Compare(a.ClaimName, b.ClaimName)
+	})
+	return disks
+}
+
+// ValidateSharedDiskGroupMembers checks that the VMI uses the same shared reservation LUNs as the other
+// members of its shared disk group
+func ValidateSharedDiskGroupMembers(vmi *v1.VirtualMachineInstance, members []*v1.VirtualMachineInstance) error {
+	disks := SharedDisksForVMISpec(&vmi.Spec)
+	for _, member := range members {
+		if member.Namespace != vmi.Namespace || member.Name == vmi.Name ||
+			member.Spec.SharedDiskGroup != vmi.Spec.SharedDiskGroup || member.IsFinal() {
+			continue
+		}
+		memberDisks := SharedDisksForVMISpec(&member.Spec)
+		if !slices.Equal(disks, memberDisks) {
+			return fmt.Errorf("shared disk group %s: VMI %s uses the reservation LUNs %v but member %s uses %v",
+				vmi.Spec.SharedDiskGroup, vmi.Name, disks, member.Name, memberDisks)
+		}
+	}
+	return nil
+}
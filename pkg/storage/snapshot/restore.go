@@ -447,7 +447,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
-	noRestore, err := ctrl.volumesNotForRestore(content)
+	noRestore, err := ctrl.volumesNotForRestore(vmRestore, content)
 	if err != nil {
 		return false, err
 	}
@@ -690,120 +690,121 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 }
 
 func (t *vmRestoreTarget) reconcileBackendVolume(snapshotVM *snapshotv1.VirtualMachine) (bool, error) {
-	if !backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
+	restore := backendVolumeRestore(t.vmRestore, snapshotVM)
+	if restore == nil {
+		// The persistent state is not part of the snapshot or is excluded from the restore,
+		// the target keeps its current backend storage or gets a new one
 		return true, nil
 	}
 
-	// Retrieve only the backend volume
-	volumes, err := storageutils.GetVolumes(snapshotVM, t.controller.Client, storageutils.WithBackendVolume)
+	// The backend storage is looked up by the name of the VM and not through the VM spec,
+	// so the restore PVC has to take over the backend label from the current backend PVC of the target
+	pvcs, err := t.controller.Client.CoreV1().PersistentVolumeClaims(t.vmRestore.Namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", backendstorage.PVCPrefix, t.vmRestore.Spec.Target.Name),
+	})
 	if err != nil {
-		// Not checking for ErrNoBackendPVC, simply returning
-		// error as backend PVC should exist now
 		return false, err
 	}
 
-	isRestorePVCUpdated := false
-	for _, volume := range volumes {
-		pvc, err := t.controller.getPVC(snapshotVM.Namespace, volume.VolumeSource.PersistentVolumeClaim.ClaimName)
-		if err != nil || pvc == nil {
-			return false, err
-		}
-
-		// Step 1: Remove backend label from the original backend PVC
-		updated, err := t.removeBackendLabelFromPVC(pvc, snapshotVM.Name)
-		if err != nil {
-			return false, err
+	ready := true
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+		if pvc.Name == restore.PersistentVolumeClaimName {
+			continue
 		}
-
-		// Step 2: Update the restore PVC with backend labels
-		isRestorePVCUpdated, err = t.updateRestorePVCWithBackendLabel(pvc)
-		if err != nil {
+		// Step 1: Remove backend label from the current backend PVC of the target
+		if err := t.removeBackendLabelFromPVC(pvc); err != nil {
 			return false, err
 		}
+		ready = false
+	}
 
-		isRestorePVCUpdated = updated || isRestorePVCUpdated
+	// Step 2: Update the restore PVC with backend labels
+	updated, err := t.updateRestorePVCWithBackendLabel(restore.PersistentVolumeClaimName)
+	if err != nil {
+		return false, err
 	}
 
-	return isRestorePVCUpdated, nil
+	return ready && !updated, nil
 }
 
-func (t *vmRestoreTarget) removeBackendLabelFromPVC(pvc *corev1.PersistentVolumeClaim, snapshotVMName string) (bool, error) {
-	if pvc.Labels == nil {
-		return false, nil
+// backendVolumeRestore returns the restore of the backend storage volume, nil if the snapshot does not hold the
+// persistent state or if it is excluded from the restore
+func backendVolumeRestore(vmRestore *snapshotv1.VirtualMachineRestore, snapshotVM *snapshotv1.VirtualMachine) *snapshotv1.VolumeRestore {
+	if !backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) || !includesPersistentState(vmRestore.Spec.PersistentState) {
+		return nil
 	}
-
-	// Only remove label when the VM name is the same since the backend logic filters by VM name + label
-	if t.vmRestore.Spec.Target.Name == snapshotVMName {
-		for _, vr := range t.vmRestore.Status.Restores {
-			if vr.PersistentVolumeClaimName == pvc.Name {
-				log.Log.Object(t.vmRestore).V(3).Infof("Restore PVC %s updated with backend label", pvc.Name)
-				return true, nil
-			}
+	backendVolumeName := storageutils.BackendPVCVolumeName(snapshotVM.Name)
+	for i := range vmRestore.Status.Restores {
+		if vmRestore.Status.Restores[i].VolumeName == backendVolumeName {
+			return &vmRestore.Status.Restores[i]
 		}
+	}
+	return nil
+}
 
-		// Remove the backend label.
-		newLabels := getFilteredLabels(pvc.Labels)
-		// Adding this label to identify the original backend PVC and garbage-collect it.
-		newLabels[restoreCleanupBackendPVCLabel] = getCleanupLabelValue(t.vmRestore)
-
-		// Generate patch to remove the backend label
-		patchBytes, err := patch.New(
-			patch.WithTest("/metadata/labels", pvc.Labels),
-			patch.WithReplace("/metadata/labels", newLabels),
-		).GeneratePayload()
-		if err != nil {
-			return false, err
-		}
+func (t *vmRestoreTarget) removeBackendLabelFromPVC(pvc *corev1.PersistentVolumeClaim) error {
+	// Remove the backend label.
+	newLabels := getFilteredLabels(pvc.Labels)
+	// Adding this label to identify the original backend PVC and garbage-collect it.
+	newLabels[restoreCleanupBackendPVCLabel] = getCleanupLabelValue(t.vmRestore)
 
-		_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
-		return false, err
+	// Generate patch to remove the backend label
+	patchBytes, err := patch.New(
+		patch.WithTest("/metadata/labels", pvc.Labels),
+		patch.WithReplace("/metadata/labels", newLabels),
+	).GeneratePayload()
+	if err != nil {
+		return err
 	}
 
-	return false, nil
+	log.Log.Object(t.vmRestore).V(3).Infof("Removing backend label from PVC %s", pvc.Name)
+	_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
 }
 
-func (t *vmRestoreTarget) updateRestorePVCWithBackendLabel(originalPVC *corev1.PersistentVolumeClaim) (bool, error) {
-	for _, vr := range t.vmRestore.Status.Restores {
-		if vr.VolumeName == storageutils.BackendPVCVolumeName(t.vmRestore.Spec.Target.Name) {
-			restorePVC, err := t.controller.getPVC(t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
-			if err != nil {
-				return false, err
-			}
-
-			// This means the restore PVC is already updated
-			if restorePVC.Name == originalPVC.Name {
-				return true, nil
-			}
+func (t *vmRestoreTarget) updateRestorePVCWithBackendLabel(restorePVCName string) (bool, error) {
+	restorePVC, err := t.controller.getPVC(t.vmRestore.Namespace, restorePVCName)
+	if err != nil {
+		return false, err
+	}
+	if restorePVC == nil {
+		return false, fmt.Errorf("restore PVC %s/%s does not exist and should", t.vmRestore.Namespace, restorePVCName)
+	}
 
-			// Patch restore PVC with backend label
-			patchSet := patch.New()
-			if restorePVC.Labels == nil {
-				patchSet.AddOption(patch.WithAdd("/metadata/labels", map[string]string{
-					backendstorage.PVCPrefix: t.vmRestore.Spec.Target.Name,
-				}))
-			} else {
-				updatedLabels := make(map[string]string, len(restorePVC.Labels))
-				for k, v := range restorePVC.Labels {
-					updatedLabels[k] = v
-				}
-				updatedLabels[backendstorage.PVCPrefix] = t.vmRestore.Spec.Target.Name
+	// This means the restore PVC is already updated
+	if restorePVC.Labels[backendstorage.PVCPrefix] == t.vmRestore.Spec.Target.Name {
+		return false, nil
+	}
 
-				patchSet.AddOption(
-					patch.WithTest("/metadata/labels", restorePVC.Labels),
-					patch.WithReplace("/metadata/labels", updatedLabels),
-				)
-			}
-			patchBytes, err := patchSet.GeneratePayload()
-			if err != nil {
-				return false, err
-			}
-			_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(restorePVC.Namespace).Patch(context.Background(), restorePVC.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
-			if err != nil {
-				return false, err
-			}
+	// Patch restore PVC with backend label
+	patchSet := patch.New()
+	if restorePVC.Labels == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/labels", map[string]string{
+			backendstorage.PVCPrefix: t.vmRestore.Spec.Target.Name,
+		}))
+	} else {
+		updatedLabels := make(map[string]string, len(restorePVC.Labels))
+		for k, v := range restorePVC.Labels {
+			updatedLabels[k] = v
 		}
+		updatedLabels[backendstorage.PVCPrefix] = t.vmRestore.Spec.Target.Name
+
+		patchSet.AddOption(
+			patch.WithTest("/metadata/labels", restorePVC.Labels),
+			patch.WithReplace("/metadata/labels", updatedLabels),
+		)
 	}
-	return false, nil
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return false, err
+	}
+	log.Log.Object(t.vmRestore).V(3).Infof("Restore PVC %s updated with backend label", restorePVC.Name)
+	_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(restorePVC.Namespace).Patch(context.Background(), restorePVC.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func getCleanupLabelValue(vmRestore *snapshotv1.VirtualMachineRestore) string {
@@ -1623,10 +1624,15 @@ func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Co
 }
 
 // Returns a set of volumes not for restore
-// Currently only memory dump volumes should not be restored
-func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
+// Memory dump volumes are never restored, the backend storage volume is not restored when the
+// persistent state is excluded
+func (ctrl *VMRestoreController) volumesNotForRestore(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
 	noRestore := sets.NewString()
 
+	if !includesPersistentState(vmRestore.Spec.PersistentState) {
+		noRestore.Insert(storageutils.BackendPVCVolumeName(content.Spec.Source.VirtualMachine.Name))
+	}
+
 	volumes, err := storageutils.GetVolumes(content.Spec.Source.VirtualMachine, ctrl.Client)
 	if err != nil {
 		return noRestore, err
@@ -38,6 +38,8 @@ import (
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -2042,6 +2044,142 @@ var _ = Describe("Restore controller", func() {
 			Expect(*calls).To(Equal(1))
 		})
 
+		Describe("restore vm with backend storage", func() {
+			const restorePVCName = "restore-uid-backend"
+
+			var snapshotVM *snapshotv1.VirtualMachine
+
+			backendPVC := func(name, vmName string) *corev1.PersistentVolumeClaim {
+				return &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: testNamespace,
+						Labels:    map[string]string{backendstorage.PVCPrefix: vmName},
+					},
+				}
+			}
+
+			expectBackendPVCs := func(pvcs ...*corev1.PersistentVolumeClaim) {
+				k8sClient.Fake.PrependReactor("list", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					list := &corev1.PersistentVolumeClaimList{}
+					for _, pvc := range pvcs {
+						list.Items = append(list.Items, *pvc)
+					}
+					return true, list, nil
+				})
+			}
+
+			expectPVCPatches := func() *[]string {
+				var patched []string
+				k8sClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					patch := action.(testing.PatchAction)
+					patched = append(patched, fmt.Sprintf("%s %s", patch.GetName(), patch.GetPatch()))
+					return true, nil, nil
+				})
+				return &patched
+			}
+
+			BeforeEach(func() {
+				snapshotVM = &snapshotv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      vmName,
+						Namespace: testNamespace,
+					},
+					Spec: kubevirtv1.VirtualMachineSpec{
+						Template: &kubevirtv1.VirtualMachineInstanceTemplateSpec{
+							Spec: kubevirtv1.VirtualMachineInstanceSpec{
+								Domain: kubevirtv1.DomainSpec{
+									Devices: kubevirtv1.Devices{
+										TPM: &kubevirtv1.TPMDevice{Persistent: pointer.P(true)},
+									},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			newTarget := func(targetName string, policy *snapshotv1.PersistentStatePolicy) *vmRestoreTarget {
+				r := createRestoreWithOwner()
+				r.Spec.Target.Name = targetName
+				r.Spec.PersistentState = policy
+				r.Status.Restores = []snapshotv1.VolumeRestore{{
+					VolumeName:                storageutils.BackendPVCVolumeName(vmName),
+					PersistentVolumeClaimName: restorePVCName,
+					VolumeSnapshotName:        "vmsnapshot-snapshot-uid-volume-backend",
+				}}
+				return &vmRestoreTarget{controller: controller, vmRestore: r}
+			}
+
+			It("should label the restored PVC as backend storage of a new target", func() {
+				Expect(pvcInformer.GetStore().Add(&corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: restorePVCName, Namespace: testNamespace},
+				})).To(Succeed())
+				expectBackendPVCs()
+				patched := expectPVCPatches()
+
+				ready, err := newTarget(newVMName, nil).reconcileBackendVolume(snapshotVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ready).To(BeFalse())
+				Expect(*patched).To(ConsistOf(
+					fmt.Sprintf(`%s [{"op":"add","path":"/metadata/labels","value":{"%s":"%s"}}]`, restorePVCName, backendstorage.PVCPrefix, newVMName),
+				))
+			})
+
+			It("should replace the backend storage of the target", func() {
+				current := backendPVC("persistent-state-for-testvm-abcde", vmName)
+				Expect(pvcInformer.GetStore().Add(&corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: restorePVCName, Namespace: testNamespace},
+				})).To(Succeed())
+				expectBackendPVCs(current)
+				patched := expectPVCPatches()
+
+				target := newTarget(vmName, nil)
+				ready, err := target.reconcileBackendVolume(snapshotVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ready).To(BeFalse())
+				Expect(*patched).To(HaveLen(2))
+				Expect((*patched)[0]).To(HavePrefix(current.Name))
+				Expect((*patched)[0]).To(HaveSuffix(fmt.Sprintf(
+					`{"op":"replace","path":"/metadata/labels","value":{"%s":"%s"}}]`,
+					restoreCleanupBackendPVCLabel, getCleanupLabelValue(target.vmRestore),
+				)))
+				Expect((*patched)[1]).To(HavePrefix(restorePVCName))
+			})
+
+			It("should be ready once the restored PVC is the only backend storage of the target", func() {
+				restored := backendPVC(restorePVCName, newVMName)
+				Expect(pvcInformer.GetStore().Add(restored)).To(Succeed())
+				expectBackendPVCs(restored)
+				patched := expectPVCPatches()
+
+				ready, err := newTarget(newVMName, nil).reconcileBackendVolume(snapshotVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ready).To(BeTrue())
+				Expect(*patched).To(BeEmpty())
+			})
+
+			It("should leave the backend storage alone when the persistent state is excluded", func() {
+				ready, err := newTarget(vmName, pointer.P(snapshotv1.PersistentStateExclude)).reconcileBackendVolume(snapshotVM)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ready).To(BeTrue())
+			})
+
+			It("should not restore the backend volume when the persistent state is excluded", func() {
+				r := createRestore()
+				r.Spec.PersistentState = pointer.P(snapshotv1.PersistentStateExclude)
+				content := &snapshotv1.VirtualMachineSnapshotContent{
+					Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+						Source: snapshotv1.SourceSpec{VirtualMachine: snapshotVM},
+					},
+				}
+
+				noRestore, err := controller.volumesNotForRestore(r, content)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(noRestore.List()).To(ConsistOf(storageutils.BackendPVCVolumeName(vmName)))
+			})
+		})
+
 		Describe("restore vm with instancetypes and preferences", func() {
 			var (
 				vmSnapshot             *snapshotv1.VirtualMachineSnapshot
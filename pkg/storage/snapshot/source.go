@@ -467,7 +467,12 @@ func (s *vmSnapshotSource) Unfreeze() error {
 }
 
 func (s *vmSnapshotSource) PersistentVolumeClaims() (map[string]string, error) {
-	volumes, err := storageutils.GetVolumes(s.vm, s.controller.Client, storageutils.WithAllVolumes)
+	volumeOption := storageutils.WithAllVolumes
+	if !includesPersistentState(s.snapshot.Spec.PersistentState) {
+		// The backend storage only holds the persistent state
+		volumeOption = storageutils.WithRegularVolumes
+	}
+	volumes, err := storageutils.GetVolumes(s.vm, s.controller.Client, volumeOption)
 	if err != nil {
 		return map[string]string{}, err
 	}
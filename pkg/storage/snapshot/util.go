@@ -112,6 +112,11 @@ func getFailureDeadline(vmSnapshot *snapshotv1.VirtualMachineSnapshot) time.Dura
 	return failureDeadline
 }
 
+// includesPersistentState returns whether the vTPM and EFI NVRAM state on the backend storage is handled
+func includesPersistentState(policy *snapshotv1.PersistentStatePolicy) bool {
+	return policy == nil || *policy == snapshotv1.PersistentStateInclude
+}
+
 func timeUntilDeadline(vmSnapshot *snapshotv1.VirtualMachineSnapshot) time.Duration {
 	failureDeadline := getFailureDeadline(vmSnapshot)
 	// No Deadline set by user
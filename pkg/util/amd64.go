@@ -27,3 +27,21 @@ func IsSEVVMI(vmi *v1.VirtualMachineInstance) bool {
 func IsSEVAttestationRequested(vmi *v1.VirtualMachineInstance) bool {
 	return IsSEVVMI(vmi) && vmi.Spec.Domain.LaunchSecurity.SEV.Attestation != nil
 }
+
+// Check if a VMI spec requests AMD SEV-SNP
+func IsSEVSNPVMI(vmi *v1.VirtualMachineInstance) bool {
+	return IsSEVVMI(vmi) &&
+		vmi.Spec.Domain.LaunchSecurity.SEV.Policy != nil &&
+		vmi.Spec.Domain.LaunchSecurity.SEV.Policy.SecureNestedPaging != nil &&
+		*vmi.Spec.Domain.LaunchSecurity.SEV.Policy.SecureNestedPaging
+}
+
+// Check if a VMI spec requests Intel TDX
+func IsTDXVMI(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.LaunchSecurity != nil && vmi.Spec.Domain.LaunchSecurity.TDX != nil
+}
+
+// Check if a VMI spec requests TDX with attestation
+func IsTDXAttestationRequested(vmi *v1.VirtualMachineInstance) bool {
+	return IsTDXVMI(vmi) && vmi.Spec.Domain.LaunchSecurity.TDX.Attestation != nil
+}
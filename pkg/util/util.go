@@ -84,7 +84,7 @@ func IsVFIOVMI(vmi *v1.VirtualMachineInstance) bool {
 }
 
 func UseLaunchSecurity(vmi *v1.VirtualMachineInstance) bool {
-	return IsSEVVMI(vmi) || IsSecureExecutionVMI(vmi)
+	return IsSEVVMI(vmi) || IsTDXVMI(vmi) || IsSecureExecutionVMI(vmi)
 }
 
 func IsAutoAttachVSOCK(vmi *v1.VirtualMachineInstance) bool {
@@ -112,6 +112,15 @@ func HasKernelBootContainerImage(vmi *v1.VirtualMachineInstance) bool {
 	return true
 }
 
+// SecureBootKeysSource returns the source of the custom SecureBoot keys of the VMI or nil
+func SecureBootKeysSource(vmiSpec *v1.VirtualMachineInstanceSpec) *v1.SecureBootKeysSource {
+	firmware := vmiSpec.Domain.Firmware
+	if firmware == nil || firmware.Bootloader == nil || firmware.Bootloader.EFI == nil {
+		return nil
+	}
+	return firmware.Bootloader.EFI.SecureBootKeys
+}
+
 // AlignImageSizeTo1MiB rounds down the size to the nearest multiple of 1MiB
 // A warning or an error may get logged
 // The caller is responsible for ensuring the rounded-down size is not 0
@@ -97,6 +97,7 @@ const (
 
 	httpStatusNotFoundMessage     = "Not Found"
 	httpStatusBadRequestMessage   = "Bad Request"
+	httpStatusForbiddenMessage    = "Forbidden"
 	httpStatusInternalServerError = "Internal Server Error"
 )
 
@@ -240,6 +241,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Path(definitions.GroupVersionBasePath(version))
 
 		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+		subresourceApp.SetConsoleGateway(app.authorizor, app.servingCertificate)
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
@@ -289,6 +291,19 @@ func (app *virtAPIApp) composeSubresources() {
 		stopRouteBuilder.ParameterNamed("body").Required(false)
 		subws.Route(stopRouteBuilder)
 
+		hibernateRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("hibernate")).
+			To(subresourceApp.HibernateVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.HibernateOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Hibernate").
+			Doc("Hibernate a VirtualMachine object, saving the state of its VirtualMachineInstance to a PersistentVolumeClaim.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+		hibernateRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(hibernateRouteBuilder)
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("expand-spec")).
 			To(subresourceApp.ExpandSpecVMRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -357,7 +372,7 @@ func (app *virtAPIApp) composeSubresources() {
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("console")).
 			To(subresourceApp.ConsoleRequestHandler).
-			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.ReadOnlyParam(subws)).
 			Operation(version.Version + "Console").
 			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance."))
 
@@ -484,6 +499,70 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("stats")).
+			To(subresourceApp.StatsHandler).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.DirtyRateParam(subws)).
+			Operation(version.Version+"Stats").
+			Doc("Get the CPU, memory, disk and network usage of a running Virtual Machine Instance").
+			Writes(v1.VirtualMachineInstanceStats{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceStats{}))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileRead).
+			Produces("application/octet-stream").
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestFilePathParam(subws)).Param(definitions.GuestFileMaxBytesParam(subws)).
+			Operation(version.Version+"GuestFileRead").
+			Doc("Read a file from the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileWrite).
+			Consumes("application/octet-stream").
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestFilePathParam(subws)).Param(definitions.GuestFileMaxBytesParam(subws)).
+			Operation(version.Version+"GuestFileWrite").
+			Doc("Write a file to the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Reads(v1.GuestExecOptions{}).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestExec").
+			Doc("Execute a command in the guest via guest agent and return its output once it exited").
+			Writes(v1.GuestExecOutput{}).
+			Returns(http.StatusOK, "OK", v1.GuestExecOutput{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("consoletoken")).
+			To(subresourceApp.ConsoleTokenRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Reads(v1.ConsoleTokenOptions{}).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"ConsoleToken").
+			Doc("Get a short-lived token to connect a browser to a console of the specified VirtualMachineInstance through the console gateway").
+			Writes(v1.ConsoleToken{}).
+			Returns(http.StatusOK, "OK", v1.ConsoleToken{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusForbidden, httpStatusForbiddenMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -585,6 +664,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.SEVMeasurementInfo{}).
 			Returns(http.StatusOK, "OK", v1.SEVMeasurementInfo{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchsnpattestationinfo")).
+			To(subresourceApp.SEVFetchSNPAttestationInfoHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"SEVFetchSNPAttestationInfo").
+			Doc("Fetch the SEV-SNP attestation info and VCEK certificate chain of a Virtual Machine").
+			Writes(v1.SEVSNPAttestationInfo{}).
+			Returns(http.StatusOK, "OK", v1.SEVSNPAttestationInfo{}))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/setupsession")).
 			To(subresourceApp.SEVSetupSessionHandler).
 			Consumes(mime.MIME_ANY).
@@ -605,6 +694,19 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		// Intel TDX endpoints
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("tdx/fetchquote")).
+			To(subresourceApp.TDXFetchQuoteHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.TDXReportParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"TDXFetchQuote").
+			Doc("Fetch a TDX quote signing a TD report of a Virtual Machine").
+			Writes(v1.TDXQuoteInfo{}).
+			Returns(http.StatusOK, "OK", v1.TDXQuoteInfo{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -631,6 +733,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/console",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/consoletoken",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/portforward",
 						Namespaced: true,
@@ -671,6 +777,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/restart",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/hibernate",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/migrate",
 						Namespaced: true,
@@ -695,6 +805,18 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/stats",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestfile",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -723,6 +845,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/sev/injectlaunchsecret",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/sev/fetchsnpattestationinfo",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/tdx/fetchquote",
+						Namespaced: true,
+					},
 				}
 
 				response.WriteAsJson(list)
@@ -766,6 +896,21 @@ func (app *virtAPIApp) composeSubresources() {
 	ws.Route(ws.GET("/stop-profiler").To(componentProfiler.HandleStopProfiler).Doc("stop profiler endpoint"))
 	ws.Route(ws.GET("/dump-profiler").To(componentProfiler.HandleDumpProfiler).Doc("dump profiler results endpoint"))
 
+	// The console gateway is served outside of the aggregated API, so that browsers can
+	// connect to it directly. The token in the URL authenticates the connection.
+	gatewayApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+	gatewayApp.SetConsoleGateway(app.authorizor, app.servingCertificate)
+	ws.Route(ws.GET(rest.ConsoleGatewayPath + "/" + string(v1.ConsoleTypeVNC)).
+		To(gatewayApp.ConsoleGatewayVNCHandler).
+		Param(definitions.TokenParam(ws)).
+		Operation("consoleGatewayVNC").
+		Doc("Open a websocket connection to the VNC console of the VirtualMachineInstance of the token"))
+	ws.Route(ws.GET(rest.ConsoleGatewayPath + "/" + string(v1.ConsoleTypeSerial)).
+		To(gatewayApp.ConsoleGatewaySerialHandler).
+		Param(definitions.TokenParam(ws)).
+		Operation("consoleGatewaySerial").
+		Doc("Open a websocket connection to the serial console of the VirtualMachineInstance of the token"))
+
 	// K8s needs the ability to query info about a specific API group
 	ws.Route(ws.GET(definitions.GroupBasePath(v1.SubresourceGroupVersions[0])).
 		Produces(restful.MIME_JSON).Writes(metav1.APIGroup{}).
@@ -905,6 +1050,15 @@ func (app *virtAPIApp) readRequestHeader() error {
 	return nil
 }
 
+// servingCertificate returns the current serving certificate. The certificate manager is only
+// prepared when virt-api runs, after the subresources are composed.
+func (app *virtAPIApp) servingCertificate() *tls.Certificate {
+	if app.certmanager == nil {
+		return nil
+	}
+	return app.certmanager.Current()
+}
+
 func (app *virtAPIApp) prepareCertManager() {
 	app.certmanager = bootstrap.NewFileCertificateManager(app.tlsCertFilePath, app.tlsKeyFilePath)
 	app.handlerCertManager = bootstrap.NewFileCertificateManager(app.handlerCertFilePath, app.handlerKeyFilePath)
@@ -948,6 +1102,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
+	http.HandleFunc(components.VMDiskTaskValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMDiskTasks(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMInstancetypeValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVmInstancetypes(w, r)
 	})
@@ -77,6 +77,7 @@ func kubevirtApiServiceDefinitions() []*restful.WebService {
 	vmipGVR := schema.GroupVersionResource{Group: v1.GroupVersion.Group, Version: v1.GroupVersion.Version, Resource: "virtualmachineinstancepresets"}
 	vmGVR := schema.GroupVersionResource{Group: v1.GroupVersion.Group, Version: v1.GroupVersion.Version, Resource: "virtualmachines"}
 	migrationGVR := schema.GroupVersionResource{Group: v1.GroupVersion.Group, Version: v1.GroupVersion.Version, Resource: "virtualmachineinstancemigrations"}
+	diskTaskGVR := schema.GroupVersionResource{Group: v1.GroupVersion.Group, Version: v1.GroupVersion.Version, Resource: "virtualmachinedisktasks"}
 	kubeVirtGVR := schema.GroupVersionResource{Group: v1.GroupVersion.Group, Version: v1.GroupVersion.Version, Resource: "kubevirt"}
 
 	ws, err := groupVersionProxyBase(v1.GroupVersion)
@@ -113,6 +114,11 @@ func kubevirtApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, diskTaskGVR, &v1.VirtualMachineDiskTask{}, v1.VirtualMachineDiskTaskGroupVersionKind.Kind, &v1.VirtualMachineDiskTaskList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmiGVR)
 	if err != nil {
 		panic(err)
@@ -568,6 +574,12 @@ const (
 	NamespaceParamName  = "namespace"
 	NameParamName       = "name"
 	MoveCursorParamName = "moveCursor"
+	ReadOnlyParamName   = "readOnly"
+	PathParamName       = "path"
+	MaxBytesParamName   = "maxBytes"
+	ReportParamName     = "report"
+	TokenParamName      = "token"
+	DirtyRateParamName  = "dirtyRate"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -582,6 +594,30 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func ReadOnlyParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(ReadOnlyParamName, "Attach to the serial console as a viewer which only receives its output, next to the connection which owns it").DataType("boolean").DefaultValue("false")
+}
+
+func TokenParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(TokenParamName, "Console token returned by the consoletoken subresource").Required(true)
+}
+
+func DirtyRateParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(DirtyRateParamName, "Measure the rate the guest writes to its memory at, which takes a second").DataType("boolean").DefaultValue("false")
+}
+
+func GuestFilePathParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(PathParamName, "Absolute path of the file in the guest").Required(true)
+}
+
+func TDXReportParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(ReportParamName, "Base64 encoded TD report generated in the guest").Required(true)
+}
+
+func GuestFileMaxBytesParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(MaxBytesParamName, "Maximum number of bytes to transfer").DataType("integer").DefaultValue("16777216")
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
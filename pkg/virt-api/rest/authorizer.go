@@ -75,6 +75,10 @@ var noAuthEndpoints = map[string]struct{}{
 	"/apis/subresources.kubevirt.io/v1alpha3/start-cluster-profiler": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/stop-cluster-profiler":  {},
 	"/apis/subresources.kubevirt.io/v1alpha3/dump-cluster-profiler":  {},
+	// the console gateway endpoints are authenticated by the console token
+	// in the URL, as browsers cannot set headers on websocket connections
+	ConsoleGatewayPath + "/vnc":    {},
+	ConsoleGatewayPath + "/serial": {},
 }
 
 type VirtApiAuthorizor interface {
@@ -11,6 +11,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
 func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, response *restful.Response) {
@@ -23,7 +24,7 @@ func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, re
 		app.FetchVirtualMachineInstance,
 		validateVMIForConsole,
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
-			return conn.ConsoleURI(vmi)
+			return conn.ConsoleURI(vmi, request.QueryParameter(definitions.ReadOnlyParamName))
 		}),
 	)
 
@@ -0,0 +1,385 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/websocket"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	// ConsoleGatewayPath is the path of the console gateway on virt-api. It is served
+	// outside of the aggregated API, so that browsers can connect to it directly.
+	ConsoleGatewayPath = "/console-gateway"
+
+	consoleTokenKeyLabel = "kubevirt.io/console-token"
+)
+
+var errInvalidConsoleToken = fmt.Errorf("invalid console token")
+
+// consoleTokenClaims are the signed content of a console token
+type consoleTokenClaims struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	UID       types.UID      `json:"uid"`
+	Console   v1.ConsoleType `json:"console"`
+	User      string         `json:"user,omitempty"`
+	Expires   int64          `json:"exp"`
+}
+
+// consoleGatewayUpgrader accepts the websocket connections of browsers. The token
+// authenticates the connection, so that pages of any origin can embed the console.
+var consoleGatewayUpgrader = websocket.Upgrader{
+	ReadBufferSize:   kvcorev1.WebsocketMessageBufferSize,
+	WriteBufferSize:  kvcorev1.WebsocketMessageBufferSize,
+	HandshakeTimeout: streamTimeout,
+	CheckOrigin:      func(*http.Request) bool { return true },
+	// Older noVNC versions request the binary subprotocol
+	Subprotocols: []string{"binary"},
+}
+
+// SetConsoleGateway configures what the console gateway needs besides the subresource API:
+// the authorizor to check the access to the consoles of a VMI when minting a token, and the
+// serving certificate of virt-api, whose private key is shared by all virt-api replicas and
+// signs the tokens.
+func (app *SubresourceAPIApp) SetConsoleGateway(authorizor VirtApiAuthorizor, servingCertificate func() *tls.Certificate) {
+	app.authorizor = authorizor
+	app.servingCertificate = servingCertificate
+}
+
+func (app *SubresourceAPIApp) ensureConsoleGatewayEnabled(response *restful.Response) bool {
+	if !app.clusterConfig.ConsoleGatewayEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.ConsoleGateway)), response)
+		return false
+	}
+	return true
+}
+
+// ConsoleTokenRequestHandler mints a token for the console gateway, if the user may access the requested console of the VMI
+func (app *SubresourceAPIApp) ConsoleTokenRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.ensureConsoleGatewayEnabled(response) {
+		return
+	}
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("request with no body: the console is required"), response)
+		return
+	}
+	opts := &v1.ConsoleTokenOptions{}
+	if statusErr := decodeBody(request, opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if statusErr := validateConsoleTokenOptions(opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	name := request.PathParameter(definitions.NameParamName)
+	if statusErr := app.authorizeConsole(request, name, opts.Console); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	key, err := app.consoleTokenKey()
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	expiration := time.Now().Add(time.Duration(opts.ExpirationSeconds) * time.Second)
+	token, err := signConsoleToken(key, &consoleTokenClaims{
+		Namespace: namespace,
+		Name:      name,
+		UID:       vmi.UID,
+		Console:   opts.Console,
+		User:      request.HeaderParameter(userHeader),
+		Expires:   expiration.Unix(),
+	})
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Minted a token for the %s console for user %s, valid until %s",
+		opts.Console, request.HeaderParameter(userHeader), expiration.UTC().Format(time.RFC3339))
+
+	response.WriteEntity(v1.ConsoleToken{
+		Token:               token,
+		Path:                fmt.Sprintf("%s/%s?%s=%s", ConsoleGatewayPath, opts.Console, definitions.TokenParamName, url.QueryEscape(token)),
+		ExpirationTimestamp: k8smetav1.NewTime(expiration),
+	})
+}
+
+func validateConsoleTokenOptions(opts *v1.ConsoleTokenOptions) *errors.StatusError {
+	switch opts.Console {
+	case v1.ConsoleTypeVNC, v1.ConsoleTypeSerial:
+	case "":
+		return errors.NewBadRequest("the console is required")
+	default:
+		return errors.NewBadRequest(fmt.Sprintf("unsupported console %q, supported consoles are %q and %q", opts.Console, v1.ConsoleTypeVNC, v1.ConsoleTypeSerial))
+	}
+	if opts.ExpirationSeconds == 0 {
+		opts.ExpirationSeconds = v1.ConsoleTokenDefaultExpirationSeconds
+	}
+	if opts.ExpirationSeconds < v1.ConsoleTokenMinExpirationSeconds || opts.ExpirationSeconds > v1.ConsoleTokenMaxExpirationSeconds {
+		return errors.NewBadRequest(fmt.Sprintf("expirationSeconds must be between %d and %d",
+			v1.ConsoleTokenMinExpirationSeconds, v1.ConsoleTokenMaxExpirationSeconds))
+	}
+	return nil
+}
+
+// authorizeConsole checks with the existing RBAC rules that the user may access the console, by
+// authorizing the request as if it was made to the vnc or console subresource of the VMI.
+func (app *SubresourceAPIApp) authorizeConsole(request *restful.Request, name string, console v1.ConsoleType) *errors.StatusError {
+	subresource := "vnc"
+	if console == v1.ConsoleTypeSerial {
+		subresource = "console"
+	}
+	consoleRequest := request.Request.Clone(request.Request.Context())
+	consoleRequest.Method = http.MethodGet
+	consoleRequest.URL.Path = path.Join(path.Dir(request.Request.URL.Path), subresource)
+
+	allowed, reason, err := app.authorizor.Authorize(restful.NewRequest(consoleRequest))
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if !allowed {
+		return errors.NewForbidden(v1.Resource("virtualmachineinstances/"+subresource), name, fmt.Errorf("%s", reason))
+	}
+	return nil
+}
+
+// consoleTokenKey derives the key signing the console tokens from the private key of the
+// serving certificate. Tokens minted before the certificate is rotated are no longer valid.
+func (app *SubresourceAPIApp) consoleTokenKey() ([]byte, error) {
+	var cert *tls.Certificate
+	if app.servingCertificate != nil {
+		cert = app.servingCertificate()
+	}
+	if cert == nil || cert.PrivateKey == nil {
+		return nil, fmt.Errorf("no serving certificate to sign console tokens with")
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the private key of the serving certificate: %v", err)
+	}
+	mac := hmac.New(sha256.New, der)
+	mac.Write([]byte(consoleTokenKeyLabel))
+	return mac.Sum(nil), nil
+}
+
+// signConsoleToken returns the token for the claims, made of the encoded claims and their signature
+func signConsoleToken(key []byte, claims *consoleTokenClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(consoleTokenSignature(key, encoded)), nil
+}
+
+// verifyConsoleToken returns the claims of a token, if it was signed with the key and has not expired at now
+func verifyConsoleToken(key []byte, token string, now time.Time) (*consoleTokenClaims, error) {
+	encoded, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, errInvalidConsoleToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, consoleTokenSignature(key, encoded)) {
+		return nil, errInvalidConsoleToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errInvalidConsoleToken
+	}
+	claims := &consoleTokenClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, errInvalidConsoleToken
+	}
+	if now.Unix() >= claims.Expires {
+		return nil, fmt.Errorf("console token expired")
+	}
+	return claims, nil
+}
+
+func consoleTokenSignature(key []byte, encoded string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// ConsoleGatewayVNCHandler connects a browser, typically running noVNC, to the VNC console of the VMI of a token
+func (app *SubresourceAPIApp) ConsoleGatewayVNCHandler(request *restful.Request, response *restful.Response) {
+	app.consoleGatewayHandler(request, response, v1.ConsoleTypeVNC, validateVMIForVNC,
+		func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.VNCURI(vmi)
+		},
+	)
+}
+
+// ConsoleGatewaySerialHandler connects a browser, typically running xterm.js, to the serial console of the VMI of a token
+func (app *SubresourceAPIApp) ConsoleGatewaySerialHandler(request *restful.Request, response *restful.Response) {
+	app.consoleGatewayHandler(request, response, v1.ConsoleTypeSerial, validateVMIForConsole,
+		func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.ConsoleURI(vmi, "")
+		},
+	)
+}
+
+func (app *SubresourceAPIApp) consoleGatewayHandler(request *restful.Request, response *restful.Response, console v1.ConsoleType, validate validator, getURL URLResolver) {
+	if !app.ensureConsoleGatewayEnabled(response) {
+		return
+	}
+	claims, statusErr := app.verifyConsoleGatewayRequest(request, console)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	var activeConnectionMetric apimetrics.Decrementer
+	if console == v1.ConsoleTypeVNC {
+		activeConnectionMetric = apimetrics.NewActiveVNCConnection(claims.Namespace, claims.Name)
+	} else {
+		activeConnectionMetric = apimetrics.NewActiveConsoleConnection(claims.Namespace, claims.Name)
+	}
+	defer activeConnectionMetric.Dec()
+	defer apimetrics.SetVMILastConnectionTimestamp(claims.Namespace, claims.Name)
+
+	dialer := NewDirectDialer(
+		app.FetchVirtualMachineInstance,
+		func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+			// The VMI was recreated with the same name since the token was minted
+			if vmi.UID != claims.UID {
+				return errors.NewNotFound(v1.Resource("virtualmachineinstance"), vmi.Name)
+			}
+			return validate(vmi)
+		},
+		app.virtHandlerDialer(getURL),
+	)
+	serverConn, statusErr := dialer.Dial(claims.Namespace, claims.Name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	defer serverConn.Close()
+
+	clientConn, err := consoleGatewayUpgrader.Upgrade(response.ResponseWriter, request.Request, nil)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to upgrade the console gateway connection")
+		return
+	}
+	defer clientConn.Close()
+
+	logger := log.Log.With("namespace", claims.Namespace).With("name", claims.Name).With("user", claims.User)
+	logger.Infof("Console gateway connected to the %s console", console)
+
+	ctx, cancel := context.WithCancel(request.Request.Context())
+	defer cancel()
+	go keepAliveClientStream(ctx, clientConn, cancel)
+
+	results := make(chan error, 2)
+	go func() {
+		results <- copyWebsocketMessages(serverConn, clientConn)
+	}()
+	go func() {
+		results <- copyWebsocketMessages(clientConn, serverConn)
+	}()
+
+	select {
+	case err = <-results:
+	case <-ctx.Done():
+	}
+	logger.Reason(err).Infof("Console gateway disconnected from the %s console", console)
+}
+
+// verifyConsoleGatewayRequest returns the claims of the token of a request, if it is valid for the console
+func (app *SubresourceAPIApp) verifyConsoleGatewayRequest(request *restful.Request, console v1.ConsoleType) (*consoleTokenClaims, *errors.StatusError) {
+	token := request.QueryParameter(definitions.TokenParamName)
+	if token == "" {
+		return nil, errors.NewUnauthorized("the console token is required")
+	}
+	key, err := app.consoleTokenKey()
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	claims, err := verifyConsoleToken(key, token, time.Now())
+	if err != nil {
+		return nil, errors.NewUnauthorized(err.Error())
+	}
+	if claims.Console != console {
+		return nil, errors.NewUnauthorized(fmt.Sprintf("the console token is for the %s console", claims.Console))
+	}
+	return claims, nil
+}
+
+// copyWebsocketMessages forwards the messages of src to dst as binary messages. Browser
+// consoles like xterm.js send the input of the user as text messages, which virt-handler
+// would discard.
+func copyWebsocketMessages(dst, src *websocket.Conn) error {
+	for {
+		_, reader, err := src.NextReader()
+		if err != nil {
+			return err
+		}
+		writer, err := dst.NextWriter(websocket.BinaryMessage)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(writer, reader); err != nil {
+			writer.Close()
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+	}
+}
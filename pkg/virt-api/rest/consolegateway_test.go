@@ -0,0 +1,310 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Console gateway", func() {
+	const consoleTokenPath = "/apis/subresources.kubevirt.io/v1/namespaces/default/virtualmachineinstances/testvmi/consoletoken"
+
+	var (
+		ctrl       *gomock.Controller
+		authorizor *MockVirtApiAuthorizor
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+		cert       *tls.Certificate
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		mockVirtClient := kubecli.NewMockKubevirtClient(ctrl)
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		app := NewSubresourceAPIApp(mockVirtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+		app.SetConsoleGateway(authorizor, func() *tls.Certificate { return cert })
+		return app
+	}
+
+	newConsoleTokenRequest := func(opts *v1.ConsoleTokenOptions) (*restful.Request, *restful.Response, *httptest.ResponseRecorder) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request := restful.NewRequest(httptest.NewRequest(http.MethodPut, consoleTokenPath, bytes.NewReader(body)))
+		request.Request.Header.Set(userHeader, "alice")
+		request.PathParameters()[definitions.NamespaceParamName] = metav1.NamespaceDefault
+		request.PathParameters()[definitions.NameParamName] = testVMIName
+		recorder := httptest.NewRecorder()
+		response := restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+		return request, response, recorder
+	}
+
+	BeforeEach(func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		cert = &tls.Certificate{PrivateKey: key}
+
+		ctrl = gomock.NewController(GinkgoT())
+		authorizor = NewMockVirtApiAuthorizor(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		vmi = libvmi.New(libvmi.WithName(testVMIName), libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi.UID = "vmi-uid"
+		_, err = virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		app = newApp(featuregate.ConsoleGateway)
+	})
+
+	Context("console tokens", func() {
+		var key []byte
+
+		BeforeEach(func() {
+			var err error
+			key, err = app.consoleTokenKey()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		newClaims := func() *consoleTokenClaims {
+			return &consoleTokenClaims{
+				Namespace: metav1.NamespaceDefault,
+				Name:      testVMIName,
+				UID:       vmi.UID,
+				Console:   v1.ConsoleTypeVNC,
+				Expires:   time.Now().Add(time.Minute).Unix(),
+			}
+		}
+
+		It("should be verified with the key they were signed with", func() {
+			claims := newClaims()
+			token, err := signConsoleToken(key, claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			verified, err := verifyConsoleToken(key, token, time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verified).To(Equal(claims))
+		})
+
+		It("should be rejected once expired", func() {
+			token, err := signConsoleToken(key, newClaims())
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = verifyConsoleToken(key, token, time.Now().Add(2*time.Minute))
+			Expect(err).To(MatchError(ContainSubstring("expired")))
+		})
+
+		It("should be rejected when the claims are changed", func() {
+			token, err := signConsoleToken(key, newClaims())
+			Expect(err).ToNot(HaveOccurred())
+			otherClaims := newClaims()
+			otherClaims.Name = "othervmi"
+			otherToken, err := signConsoleToken(key, otherClaims)
+			Expect(err).ToNot(HaveOccurred())
+
+			encodedOtherClaims, _, _ := strings.Cut(otherToken, ".")
+			_, signature, _ := strings.Cut(token, ".")
+			_, err = verifyConsoleToken(key, encodedOtherClaims+"."+signature, time.Now())
+			Expect(err).To(MatchError(errInvalidConsoleToken))
+		})
+
+		It("should be rejected when the serving certificate changed", func() {
+			token, err := signConsoleToken(key, newClaims())
+			Expect(err).ToNot(HaveOccurred())
+
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			cert = &tls.Certificate{PrivateKey: otherKey}
+			key, err = app.consoleTokenKey()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = verifyConsoleToken(key, token, time.Now())
+			Expect(err).To(MatchError(errInvalidConsoleToken))
+		})
+
+		DescribeTable("should be rejected when malformed", func(token string) {
+			_, err := verifyConsoleToken(key, token, time.Now())
+			Expect(err).To(MatchError(errInvalidConsoleToken))
+		},
+			Entry("without signature", "claims"),
+			Entry("with a signature which is not base64", "claims.not-base64!"),
+			Entry("with an empty signature", "claims."),
+		)
+	})
+
+	Context("ConsoleTokenRequestHandler", func() {
+		DescribeTable("should mint a token for the console if the user may access it", func(console v1.ConsoleType, subresource string) {
+			authorizor.EXPECT().Authorize(gomock.Any()).DoAndReturn(func(req *restful.Request) (bool, string, error) {
+				Expect(req.Request.Method).To(Equal(http.MethodGet))
+				Expect(req.Request.URL.Path).To(Equal(strings.TrimSuffix(consoleTokenPath, "consoletoken") + subresource))
+				Expect(req.Request.Header.Get(userHeader)).To(Equal("alice"))
+				return true, "", nil
+			})
+
+			request, response, recorder := newConsoleTokenRequest(&v1.ConsoleTokenOptions{Console: console})
+			app.ConsoleTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			consoleToken := &v1.ConsoleToken{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), consoleToken)).To(Succeed())
+			Expect(consoleToken.Path).To(Equal(ConsoleGatewayPath + "/" + string(console) + "?token=" + url.QueryEscape(consoleToken.Token)))
+			Expect(consoleToken.ExpirationTimestamp.Time).To(BeTemporally("~", time.Now().Add(5*time.Minute), 5*time.Second))
+
+			key, err := app.consoleTokenKey()
+			Expect(err).ToNot(HaveOccurred())
+			claims, err := verifyConsoleToken(key, consoleToken.Token, time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims.Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(claims.Name).To(Equal(testVMIName))
+			Expect(claims.UID).To(Equal(vmi.UID))
+			Expect(claims.Console).To(Equal(console))
+			Expect(claims.User).To(Equal("alice"))
+		},
+			Entry("for VNC", v1.ConsoleTypeVNC, "vnc"),
+			Entry("for the serial console", v1.ConsoleTypeSerial, "console"),
+		)
+
+		It("should fail when the user may not access the console", func() {
+			authorizor.EXPECT().Authorize(gomock.Any()).Return(false, "denied", nil)
+
+			request, response, recorder := newConsoleTokenRequest(&v1.ConsoleTokenOptions{Console: v1.ConsoleTypeVNC})
+			app.ConsoleTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("should fail when the VMI does not exist", func() {
+			authorizor.EXPECT().Authorize(gomock.Any()).Return(true, "", nil)
+			Expect(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Delete(context.TODO(), testVMIName, metav1.DeleteOptions{})).To(Succeed())
+
+			request, response, recorder := newConsoleTokenRequest(&v1.ConsoleTokenOptions{Console: v1.ConsoleTypeVNC})
+			app.ConsoleTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should fail when the feature gate is disabled", func() {
+			app = newApp()
+			request, response, recorder := newConsoleTokenRequest(&v1.ConsoleTokenOptions{Console: v1.ConsoleTypeVNC})
+			app.ConsoleTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		DescribeTable("should fail with invalid options", func(opts *v1.ConsoleTokenOptions) {
+			request, response, recorder := newConsoleTokenRequest(opts)
+			app.ConsoleTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("without console", &v1.ConsoleTokenOptions{}),
+			Entry("with an unknown console", &v1.ConsoleTokenOptions{Console: "spice"}),
+			Entry("with a too short expiration", &v1.ConsoleTokenOptions{Console: v1.ConsoleTypeVNC, ExpirationSeconds: 10}),
+			Entry("with a too long expiration", &v1.ConsoleTokenOptions{Console: v1.ConsoleTypeVNC, ExpirationSeconds: 7200}),
+		)
+	})
+
+	Context("gateway", func() {
+		newGatewayRequest := func(console v1.ConsoleType, token string) (*restful.Request, *restful.Response, *httptest.ResponseRecorder) {
+			request := restful.NewRequest(httptest.NewRequest(http.MethodGet,
+				ConsoleGatewayPath+"/"+string(console)+"?token="+url.QueryEscape(token), nil))
+			recorder := httptest.NewRecorder()
+			response := restful.NewResponse(recorder)
+			response.SetRequestAccepts(restful.MIME_JSON)
+			return request, response, recorder
+		}
+
+		newToken := func(console v1.ConsoleType) string {
+			key, err := app.consoleTokenKey()
+			Expect(err).ToNot(HaveOccurred())
+			token, err := signConsoleToken(key, &consoleTokenClaims{
+				Namespace: metav1.NamespaceDefault,
+				Name:      testVMIName,
+				UID:       vmi.UID,
+				Console:   console,
+				Expires:   time.Now().Add(time.Minute).Unix(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return token
+		}
+
+		DescribeTable("should reject connections", func(handler func(*SubresourceAPIApp) restful.RouteFunction, console v1.ConsoleType, token func() string, expectedCode int) {
+			request, response, recorder := newGatewayRequest(console, token())
+			handler(app)(request, response)
+			Expect(recorder.Code).To(Equal(expectedCode))
+		},
+			Entry("to VNC without token",
+				func(app *SubresourceAPIApp) restful.RouteFunction { return app.ConsoleGatewayVNCHandler },
+				v1.ConsoleTypeVNC, func() string { return "" }, http.StatusUnauthorized),
+			Entry("to VNC with an invalid token",
+				func(app *SubresourceAPIApp) restful.RouteFunction { return app.ConsoleGatewayVNCHandler },
+				v1.ConsoleTypeVNC, func() string { return "claims.signature" }, http.StatusUnauthorized),
+			Entry("to VNC with a token for the serial console",
+				func(app *SubresourceAPIApp) restful.RouteFunction { return app.ConsoleGatewayVNCHandler },
+				v1.ConsoleTypeVNC, func() string { return newToken(v1.ConsoleTypeSerial) }, http.StatusUnauthorized),
+			Entry("to the serial console with a token for VNC",
+				func(app *SubresourceAPIApp) restful.RouteFunction { return app.ConsoleGatewaySerialHandler },
+				v1.ConsoleTypeSerial, func() string { return newToken(v1.ConsoleTypeVNC) }, http.StatusUnauthorized),
+		)
+
+		It("should reject connections to a VMI recreated since the token was minted", func() {
+			token := newToken(v1.ConsoleTypeVNC)
+			Expect(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Delete(context.TODO(), testVMIName, metav1.DeleteOptions{})).To(Succeed())
+			vmi.UID = "other-uid"
+			_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			request, response, recorder := newGatewayRequest(v1.ConsoleTypeVNC, token)
+			app.ConsoleGatewayVNCHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should reject connections when the feature gate is disabled", func() {
+			token := newToken(v1.ConsoleTypeVNC)
+			app = newApp()
+			request, response, recorder := newGatewayRequest(v1.ConsoleTypeVNC, token)
+			app.ConsoleGatewayVNCHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
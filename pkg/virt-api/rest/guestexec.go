@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+)
+
+// GuestExecRequestHandler handles the subresource for executing a command in the guest through the guest agent
+func (app *SubresourceAPIApp) GuestExecRequestHandler(request *restful.Request, response *restful.Response) {
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("request with no body: the guest command is required"), response)
+		return
+	}
+	opts := &v1.GuestExecOptions{}
+	if statusErr := decodeBody(request, opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if statusErr := validateGuestExecOptions(opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestExecURI(vmi)
+	}
+	vmi, url, conn, statusErr := app.prepareConnection(request, validateGuestAgentConnected, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	log.Log.Object(vmi).
+		With("user", request.HeaderParameter(userHeader)).
+		With("command", opts.Command).
+		With("args", fmt.Sprintf("%q", opts.Args)).
+		Info("Executing command in guest")
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	stream, err := conn.PutStream(url, io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to execute command %s in guest", opts.Command)
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	defer stream.Close()
+
+	response.AddHeader("Content-Type", restful.MIME_JSON)
+	response.WriteHeader(http.StatusOK)
+	if err := copyAndFlush(response, stream); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to stream output of command %s", opts.Command)
+		// The status code was already sent, abort the response to let the client notice the missing exit code
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func validateGuestExecOptions(opts *v1.GuestExecOptions) *errors.StatusError {
+	if opts.Command == "" {
+		return errors.NewBadRequest("command is required")
+	}
+	if opts.TimeoutSeconds == 0 {
+		opts.TimeoutSeconds = v1.GuestExecDefaultTimeoutSeconds
+	}
+	if opts.TimeoutSeconds < 0 || opts.TimeoutSeconds > v1.GuestExecMaxTimeoutSeconds {
+		return errors.NewBadRequest(fmt.Sprintf("timeoutSeconds must be between 1 and %d", v1.GuestExecMaxTimeoutSeconds))
+	}
+	return nil
+}
+
+// copyAndFlush forwards every chunk as soon as it arrives so that the client sees the output of a running command
+func copyAndFlush(response *restful.Response, src io.Reader) error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if _, writeErr := response.Write(buf[:n]); writeErr != nil {
+				return writeErr
+			}
+			response.Flush()
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
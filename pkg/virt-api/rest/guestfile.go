@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// GuestFileRead handles the subresource for reading a file from the guest through the guest agent
+func (app *SubresourceAPIApp) GuestFileRead(request *restful.Request, response *restful.Response) {
+	options, statusErr := guestFileOptionsFromRequest(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	_, url, conn, statusErr := app.prepareConnection(request, validateGuestAgentConnected, guestFileURLResolver(options))
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	stream, err := conn.GetStream(url)
+	if err != nil {
+		log.Log.Errorf(getRequestErrFmt, err.Error())
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	defer stream.Close()
+
+	response.AddHeader("Content-Type", "application/octet-stream")
+	response.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(response, stream); err != nil {
+		log.Log.Reason(err).Errorf("Failed to stream guest file %s", options.Path)
+		// The status code was already sent, abort the response to let the client notice the truncated file
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// GuestFileWrite handles the subresource for writing a file to the guest through the guest agent
+func (app *SubresourceAPIApp) GuestFileWrite(request *restful.Request, response *restful.Response) {
+	options, statusErr := guestFileOptionsFromRequest(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	app.putRequestHandler(request, response, validateGuestAgentConnected, guestFileURLResolver(options), false)
+}
+
+func guestFileURLResolver(options *v1.GuestFileOptions) URLResolver {
+	return func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileURI(vmi, options)
+	}
+}
+
+func validateGuestAgentConnected(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi == nil || vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+	}
+	return nil
+}
+
+func guestFileOptionsFromRequest(request *restful.Request) (*v1.GuestFileOptions, *errors.StatusError) {
+	options := &v1.GuestFileOptions{
+		Path:     request.QueryParameter("path"),
+		MaxBytes: v1.GuestFileDefaultMaxBytes,
+	}
+	if options.Path == "" {
+		return nil, errors.NewBadRequest("path parameter is required")
+	}
+	if !path.IsAbs(options.Path) && !isWindowsAbsPath(options.Path) {
+		return nil, errors.NewBadRequest(fmt.Sprintf("path %s must be absolute", options.Path))
+	}
+
+	if maxBytes := request.QueryParameter("maxBytes"); maxBytes != "" {
+		value, err := strconv.ParseInt(maxBytes, 10, 64)
+		if err != nil || value <= 0 {
+			return nil, errors.NewBadRequest(fmt.Sprintf("maxBytes parameter %s must be a positive integer", maxBytes))
+		}
+		options.MaxBytes = value
+	}
+	if options.MaxBytes > v1.GuestFileMaxBytes {
+		return nil, errors.NewBadRequest(fmt.Sprintf("maxBytes must not exceed %d", v1.GuestFileMaxBytes))
+	}
+	return options, nil
+}
+
+// isWindowsAbsPath accepts drive letter paths like C:\Windows or C:/Windows used by Windows guests
+func isWindowsAbsPath(p string) bool {
+	return len(p) >= 3 && p[1] == ':' && (p[2] == '\\' || p[2] == '/') &&
+		((p[0] >= 'a' && p[0] <= 'z') || (p[0] >= 'A' && p[0] <= 'Z'))
+}
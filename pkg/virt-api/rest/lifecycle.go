@@ -67,8 +67,13 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(volumeMigrationManualRecoveryRequiredErr)), response)
 		return
 	}
+	if vm.Status.DiskTaskInProgress != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("disk task %s is in progress", *vm.Status.DiskTaskInProgress)), response)
+		return
+	}
 
 	startPaused := false
+	discardHibernationState := false
 	startChangeRequestData := make(map[string]string)
 	bodyStruct := &v1.StartOptions{}
 	if request.Request.Body != nil {
@@ -77,10 +82,14 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 			return
 		}
 		startPaused = bodyStruct.Paused
+		discardHibernationState = bodyStruct.DiscardHibernationState
 	}
 	if startPaused {
 		startChangeRequestData[v1.StartRequestDataPausedKey] = v1.StartRequestDataPausedTrue
 	}
+	if discardHibernationState {
+		startChangeRequestData[v1.StartRequestDataDiscardHibernationStateKey] = v1.StartRequestDataDiscardHibernationStateTrue
+	}
 
 	var patchErr error
 
@@ -97,9 +106,9 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 	switch runStrategy {
 	case v1.RunStrategyHalted:
 		pausedStartStrategy := v1.StartStrategyPaused
-		// Send start request if VM should start paused. virt-controller will update RunStrategy upon this request.
+		// Send start request if VM should start paused or discard its hibernation state. virt-controller will update RunStrategy upon this request.
 		// No need to send the request if StartStrategy is already set to Paused in VMI Spec.
-		if startPaused && (vm.Spec.Template == nil || vm.Spec.Template.Spec.StartStrategy != &pausedStartStrategy) {
+		if (startPaused && (vm.Spec.Template == nil || vm.Spec.Template.Spec.StartStrategy != &pausedStartStrategy)) || discardHibernationState {
 			patchBytes, err := getChangeRequestJson(vm, v1.VirtualMachineStateChangeRequest{
 				Action: v1.StartRequest,
 				Data:   startChangeRequestData,
@@ -522,6 +531,55 @@ func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Reque
 	app.putRequestHandler(request, response, validate, getURL, false)
 }
 
+func (app *SubresourceAPIApp) HibernateVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	bodyStruct := &v1.HibernateOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Spec.Template == nil || vm.Spec.Template.Spec.Hibernation == nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf("VM %s does not have a hibernation PVC", name)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vmi.Name, fmt.Errorf(vmNotRunning))
+		}
+		if vmi.Spec.Hibernation == nil {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vmi.Name, fmt.Errorf("VMI was started without a hibernation PVC, restart the VM first"))
+		}
+		if vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vmi.Name, fmt.Errorf("VMI is migrating"))
+		}
+		if vmi.Status.Hibernation != nil && vmi.Status.Hibernation.Phase == v1.HibernationSaving {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vmi.Name, fmt.Errorf("VMI is already being hibernated"))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.HibernateURI(vmi)
+	}
+
+	var dryRun bool
+	if len(bodyStruct.DryRun) > 0 && bodyStruct.DryRun[0] == metav1.DryRunAll {
+		dryRun = true
+	}
+	app.putRequestHandler(request, response, validate, getURL, dryRun)
+}
+
 func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
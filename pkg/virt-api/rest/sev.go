@@ -170,6 +170,32 @@ func (app *SubresourceAPIApp) SEVInjectLaunchSecretHandler(request *restful.Requ
 	app.putRequestHandler(request, response, validateVMIForSEVAttestation, getURL, false)
 }
 
+func (app *SubresourceAPIApp) SEVFetchSNPAttestationInfoHandler(request *restful.Request, response *restful.Response) {
+	if !app.ensureSEVEnabled(response) {
+		return
+	}
+
+	// SEV-SNP guests request their attestation reports themselves, so they do not need to be paused
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if !kutil.IsSEVSNPVMI(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not use SEV-SNP"))
+		}
+		if !kutil.IsSEVAttestationRequested(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNoAttestationErr))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.SEVFetchSNPAttestationInfoURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.SEVSNPAttestationInfo{})
+}
+
 // Validate a VMI for SEV attestation: Running, Paused and with Attestation requested.
 func validateVMIForSEVAttestation(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 	if !vmi.IsRunning() {
@@ -253,4 +253,32 @@ var _ = Describe("SEV Subresources", func() {
 		Expect(response.Error()).ToNot(HaveOccurred())
 		Expect(response.StatusCode()).To(Equal(http.StatusOK))
 	})
+
+	It("Should allow to fetch SEV-SNP attestation info when VMI is running", func() {
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/sev/fetchsnpattestationinfo"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, v1.SEVSNPAttestationInfo{}),
+			),
+		)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		createVMI(Running, UnPaused, []libvmi.Option{libvmi.WithSEVSNPAttestation()}, nil)
+		app.SEVFetchSNPAttestationInfoHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+	})
+
+	DescribeTable("Should fail to fetch SEV-SNP attestation info",
+		func(running bool, expectedErr string, option ...libvmi.Option) {
+			createVMI(running, UnPaused, option, nil)
+			app.SEVFetchSNPAttestationInfoHandler(request, response)
+			Expect(response.Error()).To(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+			Expect(response.Error().Error()).To(ContainSubstring(expectedErr))
+		},
+		Entry("when VMI is not running", NotRunning, vmiNotRunning, libvmi.WithSEVSNPAttestation()),
+		Entry("when VMI does not use SEV-SNP", Running, "VMI does not use SEV-SNP", libvmi.WithSEVAttestation()),
+		Entry("when attestation is not requested", Running, vmiNoAttestationErr, libvmi.WithSEVSNP()),
+	)
 })
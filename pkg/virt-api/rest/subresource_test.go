@@ -28,6 +28,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -654,6 +655,20 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
 			Expect(statusErr.Error()).To(ContainSubstring("VM recovery required"))
 		})
+
+		It("should fail when a disk task is in progress", func() {
+			vmi := libvmi.New()
+			vm := libvmi.NewVirtualMachine(vmi)
+			vm.Status.DiskTaskInProgress = pointer.P("sparsify")
+			request.PathParameters()["name"] = vm.Name
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vmi, nil)
+
+			app.StartVMRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			Expect(statusErr.Error()).To(ContainSubstring("disk task sparsify is in progress"))
+		})
 	})
 
 	Context("Subresource api - error handling for StopVMRequestHandler", func() {
@@ -1186,6 +1201,113 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Guest file", func() {
+		withQuery := func(query string) {
+			request.Request.URL = &url.URL{RawQuery: query}
+		}
+
+		It("should stream a guest file from virt-handler", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile", "maxBytes=16777216&path=%2Fetc%2Fhosts"),
+					ghttp.RespondWith(http.StatusOK, "127.0.0.1 localhost"),
+				),
+			)
+			withQuery("path=/etc/hosts")
+			expectVMI(Running, UnPaused, guestAgentConnected)
+
+			app.GuestFileRead(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/octet-stream"))
+			Expect(recorder.Body.String()).To(Equal("127.0.0.1 localhost"))
+		})
+
+		It("should write a guest file through virt-handler", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile", "maxBytes=1024&path=C%3A%5Cconfig.ini"),
+					ghttp.VerifyBody([]byte("content")),
+					ghttp.RespondWith(http.StatusAccepted, ""),
+				),
+			)
+			withQuery(`path=C:\config.ini&maxBytes=1024`)
+			request.Request.Body = io.NopCloser(strings.NewReader("content"))
+			expectVMI(Running, UnPaused, guestAgentConnected)
+
+			app.GuestFileWrite(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		DescribeTable("should fail", func(fn func(*restful.Request, *restful.Response), query string, running bool, expectedCode int) {
+			withQuery(query)
+			if expectedCode != http.StatusBadRequest {
+				expectVMI(running, UnPaused)
+			}
+
+			fn(request, response)
+
+			ExpectStatusErrorWithCode(recorder, expectedCode)
+		},
+			Entry("to read without a path", app.GuestFileRead, "", Running, http.StatusBadRequest),
+			Entry("to read a relative path", app.GuestFileRead, "path=etc/hosts", Running, http.StatusBadRequest),
+			Entry("to read with an invalid maxBytes", app.GuestFileRead, "path=/etc/hosts&maxBytes=-1", Running, http.StatusBadRequest),
+			Entry("to write with a maxBytes above the limit", app.GuestFileWrite, "path=/etc/hosts&maxBytes=67108865", Running, http.StatusBadRequest),
+			Entry("to read from a VMI which is not running", app.GuestFileRead, "path=/etc/hosts", NotRunning, http.StatusConflict),
+			Entry("to write to a VMI which is not running", app.GuestFileWrite, "path=/etc/hosts", NotRunning, http.StatusConflict),
+			Entry("to read from a VMI without guest agent", app.GuestFileRead, "path=/etc/hosts", Running, http.StatusConflict),
+			Entry("to write to a VMI without guest agent", app.GuestFileWrite, "path=/etc/hosts", Running, http.StatusConflict),
+		)
+	})
+
+	Context("Guest exec", func() {
+		withBody := func(opts *v1.GuestExecOptions) {
+			body, err := json.Marshal(opts)
+			Expect(err).ToNot(HaveOccurred())
+			request.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		It("should forward the command to virt-handler and stream its output", func() {
+			output := `{"stdout":"aGVsbG8="}` + "\n" + `{"exited":true,"exitCode":1}` + "\n"
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+					ghttp.VerifyJSONRepresenting(&v1.GuestExecOptions{
+						Command:        "/usr/bin/echo",
+						Args:           []string{"hello"},
+						TimeoutSeconds: v1.GuestExecDefaultTimeoutSeconds,
+					}),
+					ghttp.RespondWith(http.StatusOK, output),
+				),
+			)
+			withBody(&v1.GuestExecOptions{Command: "/usr/bin/echo", Args: []string{"hello"}})
+			expectVMI(Running, UnPaused, guestAgentConnected)
+
+			app.GuestExecRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal(output))
+		})
+
+		DescribeTable("should fail", func(opts *v1.GuestExecOptions, running bool, expectedCode int) {
+			withBody(opts)
+			if expectedCode != http.StatusBadRequest {
+				expectVMI(running, UnPaused)
+			}
+
+			app.GuestExecRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, expectedCode)
+		},
+			Entry("without a command", &v1.GuestExecOptions{}, Running, http.StatusBadRequest),
+			Entry("with a negative timeout", &v1.GuestExecOptions{Command: "/usr/bin/true", TimeoutSeconds: -1}, Running, http.StatusBadRequest),
+			Entry("with a timeout above the limit", &v1.GuestExecOptions{Command: "/usr/bin/true", TimeoutSeconds: 3601}, Running, http.StatusBadRequest),
+			Entry("when the VMI is not running", &v1.GuestExecOptions{Command: "/usr/bin/true"}, NotRunning, http.StatusConflict),
+			Entry("when the VMI has no guest agent", &v1.GuestExecOptions{Command: "/usr/bin/true"}, Running, http.StatusConflict),
+		)
+	})
+
 	Context("Reset", func() {
 		It("Should reset a running VMI", func() {
 			backend.AppendHandlers(
@@ -1256,6 +1378,80 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Hibernation", func() {
+		expectVM := func(withHibernation bool) {
+			vm := &v1.VirtualMachine{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: testVMIName, Namespace: k8smetav1.NamespaceDefault},
+				Spec: v1.VirtualMachineSpec{
+					Template: &v1.VirtualMachineInstanceTemplateSpec{},
+				},
+			}
+			if withHibernation {
+				vm.Spec.Template.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation"}
+			}
+			vmClient.EXPECT().Get(context.Background(), testVMIName, k8smetav1.GetOptions{}).Return(vm, nil)
+		}
+
+		withHibernation := func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation"}
+		}
+
+		DescribeTable("Should hibernate a running VMI according to options", func(hibernateOptions *v1.HibernateOptions, matchExpectation gomegatypes.GomegaMatcher) {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/hibernate"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMI(Running, UnPaused, withHibernation)
+			expectVM(true)
+
+			bytesRepresentation, _ := json.Marshal(hibernateOptions)
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			app.HibernateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			//In case of dry-run the request is not propagated to the handler
+			Expect(backend.ReceivedRequests()).To(matchExpectation)
+		},
+			Entry("with default", &v1.HibernateOptions{}, HaveLen(1)),
+			Entry("with dry-run option", &v1.HibernateOptions{DryRun: withDryRun()}, BeNil()),
+		)
+
+		It("Should fail hibernating a VM without hibernation PVC", func() {
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			expectVM(false)
+
+			app.HibernateVMRequestHandler(request, response)
+
+			status := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(status.Error()).To(ContainSubstring("does not have a hibernation PVC"))
+		})
+
+		DescribeTable("Should fail hibernating", func(running bool, additionalOpts func(vmi *v1.VirtualMachineInstance), expectedError string) {
+			expectVMI(running, UnPaused, additionalOpts)
+			expectVM(true)
+
+			app.HibernateVMRequestHandler(request, response)
+
+			status := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			Expect(status.Error()).To(ContainSubstring(expectedError))
+		},
+			Entry("a not running VMI", NotRunning, withHibernation, "VM is not running"),
+			Entry("a VMI started without hibernation PVC", Running, func(vmi *v1.VirtualMachineInstance) {}, "restart the VM first"),
+			Entry("a migrating VMI", Running, func(vmi *v1.VirtualMachineInstance) {
+				withHibernation(vmi)
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{}
+			}, "VMI is migrating"),
+			Entry("a VMI being hibernated", Running, func(vmi *v1.VirtualMachineInstance) {
+				withHibernation(vmi)
+				vmi.Status.Hibernation = &v1.HibernationStatus{Phase: v1.HibernationSaving}
+			}, "already being hibernated"),
+		)
+	})
+
 	Context("Pausing", func() {
 		DescribeTable("Should pause a running, not paused VMI according to options", func(pauseOptions *v1.PauseOptions, matchExpectation gomegatypes.GomegaMatcher) {
 
@@ -1426,6 +1622,35 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Subresource api - start discarding the hibernation state", func() {
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		})
+
+		DescribeTable("should send a start request to discard the state for VM with RunStrategy", func(runStrategy v1.VirtualMachineRunStrategy) {
+			vm := newVirtualMachineWithRunStrategy(runStrategy)
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+			bytesRepresentation, _ := json.Marshal(&v1.StartOptions{DiscardHibernationState: true})
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vm.Name))
+			vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), k8smetav1.PatchOptions{}).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, body []byte, _ k8smetav1.PatchOptions) (*v1.VirtualMachine, error) {
+					Expect(string(body)).To(ContainSubstring(`"data":{"discardHibernationState":"true"}`))
+					return vm, nil
+				})
+
+			app.StartVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		},
+			Entry("Halted RunStrategy", v1.RunStrategyHalted),
+			Entry("Manual RunStrategy", v1.RunStrategyManual),
+		)
+	})
+
 	AfterEach(func() {
 		backend.Close()
 	})
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+)
+
+func (app *SubresourceAPIApp) ensureTDXEnabled(response *restful.Response) bool {
+	if !app.clusterConfig.WorkloadEncryptionTDXEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.WorkloadEncryptionTDX)), response)
+		return false
+	}
+	return true
+}
+
+func (app *SubresourceAPIApp) TDXFetchQuoteHandler(request *restful.Request, response *restful.Response) {
+	if !app.ensureTDXEnabled(response) {
+		return
+	}
+
+	report := request.QueryParameter("report")
+	if decoded, err := base64.StdEncoding.DecodeString(report); err != nil || len(decoded) != launchsecurity.TDXReportSize {
+		writeError(errors.NewBadRequest(fmt.Sprintf("report must be a base64 encoded TD report of %d bytes", launchsecurity.TDXReportSize)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if !kutil.IsTDXAttestationRequested(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNoAttestationErr))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.TDXFetchQuoteURI(vmi, report)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.TDXQuoteInfo{})
+}
@@ -0,0 +1,166 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+)
+
+var _ = Describe("TDX Subresources", func() {
+	const nodeName = "mynode"
+
+	var (
+		backend    *ghttp.Server
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+		report     string
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+
+		backendAddr := strings.Split(backend.Addr(), ":")
+		backendPort, err := strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "madeup-name",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendAddr[0],
+			},
+		}
+
+		kubeClient := fake.NewSimpleClientset(pod)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		return NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	createVMI := func(phase v1.VirtualMachineInstancePhase, opts ...libvmi.Option) {
+		vmi := libvmi.New(append([]libvmi.Option{
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(phase),
+				libvmistatus.WithNodeName(nodeName),
+			)),
+		}, opts...)...)
+
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		report = base64.StdEncoding.EncodeToString(make([]byte, launchsecurity.TDXReportSize))
+		request = restful.NewRequest(&http.Request{URL: &url.URL{RawQuery: url.Values{"report": {report}}.Encode()}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		response = restful.NewResponse(httptest.NewRecorder())
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		backend = ghttp.NewTLSServer()
+		virtClient = kubevirtfake.NewSimpleClientset()
+		app = newApp(featuregate.WorkloadEncryptionTDX)
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	It("Should fetch a quote for the TD report when VMI is running", func() {
+		createVMI(v1.Running, libvmi.WithTDXAttestation())
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/tdx/fetchquote", url.Values{"report": {report}}.Encode()),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, v1.TDXQuoteInfo{Quote: "AAABBB"}),
+			),
+		)
+
+		app.TDXFetchQuoteHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+	})
+
+	It("Should fail to fetch a quote when the feature gate is disabled", func() {
+		app = newApp()
+		app.TDXFetchQuoteHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	DescribeTable("Should fail to fetch a quote for an invalid report", func(report string) {
+		request.Request.URL.RawQuery = url.Values{"report": {report}}.Encode()
+		app.TDXFetchQuoteHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	},
+		Entry("when it is missing", ""),
+		Entry("when it is not base64", "not-base64!"),
+		Entry("when it has the wrong size", base64.StdEncoding.EncodeToString([]byte("report"))),
+	)
+
+	DescribeTable("Should fail to fetch a quote", func(phase v1.VirtualMachineInstancePhase, opts ...libvmi.Option) {
+		createVMI(phase, opts...)
+		app.TDXFetchQuoteHandler(request, response)
+		Expect(response.Error()).To(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+	},
+		Entry("when VMI is not running", v1.Scheduled, libvmi.WithTDXAttestation()),
+		Entry("when attestation is not requested", v1.Running, libvmi.WithTDX()),
+	)
+})
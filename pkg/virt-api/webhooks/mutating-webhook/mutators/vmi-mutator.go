@@ -26,6 +26,7 @@ import (
 	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
@@ -44,6 +45,7 @@ import (
 type VMIsMutator struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	VMIPresetInformer       cache.SharedIndexInformer
+	NamespaceInformer       cache.SharedIndexInformer
 	KubeVirtServiceAccounts map[string]struct{}
 }
 
@@ -97,6 +99,8 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			}
 		}
 
+		mutator.setKSMMergePolicy(newVMI)
+
 		// Add foreground finalizer
 		newVMI.Finalizers = append(newVMI.Finalizers, v1.VirtualMachineInstanceFinalizer)
 
@@ -158,6 +162,57 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 	return response
 }
 
+// setKSMMergePolicy records on the VMI whether its guest memory may be merged by KSM, unless the VMI
+// opts in or out already. The kubevirt.io/ksm-merge label of the namespace takes precedence over the
+// cluster wide default.
+func (mutator *VMIsMutator) setKSMMergePolicy(vmi *v1.VirtualMachineInstance) {
+	if _, exists := vmi.Annotations[v1.KSMMergeAnnotation]; exists {
+		return
+	}
+
+	policy := mutator.namespaceKSMMergePolicy(vmi.Namespace)
+	if policy == "" {
+		ksmConfig := mutator.ClusterConfig.GetKSMConfiguration()
+		if ksmConfig == nil || ksmConfig.MemoryMergeByDefault == nil || *ksmConfig.MemoryMergeByDefault {
+			return
+		}
+		policy = "false"
+	}
+
+	if vmi.Annotations == nil {
+		vmi.Annotations = map[string]string{}
+	}
+	vmi.Annotations[v1.KSMMergeAnnotation] = policy
+}
+
+func (mutator *VMIsMutator) namespaceKSMMergePolicy(namespace string) string {
+	if mutator.NamespaceInformer == nil {
+		return ""
+	}
+
+	obj, exists, err := mutator.NamespaceInformer.GetStore().GetByKey(namespace)
+	if err != nil {
+		log.Log.Reason(err).Warningf("Error retrieving namespace %s from informer", namespace)
+		return ""
+	} else if !exists {
+		return ""
+	}
+
+	ns, ok := obj.(*k8sv1.Namespace)
+	if !ok {
+		log.Log.Errorf("couldn't cast object to Namespace: %+v", obj)
+		return ""
+	}
+
+	switch policy := ns.Labels[v1.KSMMergeLabel]; policy {
+	case "", "true", "false":
+		return policy
+	default:
+		log.Log.Warningf("%s is an invalid value for %s label in namespace %s, ignoring it", policy, v1.KSMMergeLabel, namespace)
+		return ""
+	}
+}
+
 func markAsNonroot(vmi *v1.VirtualMachineInstance) {
 	vmi.Status.RuntimeUser = 107
 }
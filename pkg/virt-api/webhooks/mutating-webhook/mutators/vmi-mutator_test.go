@@ -192,6 +192,58 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		})
 	})
 
+	Context("KSM merge policy", func() {
+		const testNamespace = "ksm-test"
+
+		BeforeEach(func() {
+			vmi.Namespace = testNamespace
+		})
+
+		setNamespaceKSMMergeLabel := func(value string) {
+			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			ns := &k8sv1.Namespace{ObjectMeta: k8smetav1.ObjectMeta{Name: testNamespace}}
+			if value != "" {
+				ns.Labels = map[string]string{v1.KSMMergeLabel: value}
+			}
+			Expect(namespaceInformer.GetStore().Add(ns)).To(Succeed())
+			mutator.NamespaceInformer = namespaceInformer
+		}
+
+		setMemoryMergeByDefault := func(mergeByDefault *bool) {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						KSMConfiguration: &v1.KSMConfiguration{MemoryMergeByDefault: mergeByDefault},
+					},
+				},
+			})
+		}
+
+		DescribeTable("should set the annotation on VMI create", func(vmiPolicy, namespacePolicy string, mergeByDefault *bool, expectedPolicy string) {
+			if vmiPolicy != "" {
+				vmi.Annotations = map[string]string{v1.KSMMergeAnnotation: vmiPolicy}
+			}
+			setNamespaceKSMMergeLabel(namespacePolicy)
+			setMemoryMergeByDefault(mergeByDefault)
+
+			vmiMeta, _, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			if expectedPolicy == "" {
+				Expect(vmiMeta.Annotations).ToNot(HaveKey(v1.KSMMergeAnnotation))
+			} else {
+				Expect(vmiMeta.Annotations).To(HaveKeyWithValue(v1.KSMMergeAnnotation, expectedPolicy))
+			}
+		},
+			Entry("not when nothing opts in or out", "", "", nil, ""),
+			Entry("not when the cluster merges by default", "", "", pointer.P(true), ""),
+			Entry("to opt out when the cluster does not merge by default", "", "", pointer.P(false), "false"),
+			Entry("from the namespace opting out", "", "false", nil, "false"),
+			Entry("from the namespace opting in", "", "true", pointer.P(false), "true"),
+			Entry("not from a namespace with an invalid label", "", "maybe", nil, ""),
+			Entry("keeping the VMI opting in over the namespace", "true", "false", nil, "true"),
+			Entry("keeping the VMI opting out over the cluster", "false", "", pointer.P(true), "false"),
+		)
+	})
+
 	DescribeTable("should apply defaults on VMI create when arch is known", func(arch string, cpuModel string, machineType string) {
 		// no limits wanted on this test, to not copy the limit to requests
 
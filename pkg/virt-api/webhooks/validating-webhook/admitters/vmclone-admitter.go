@@ -28,13 +28,17 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -112,10 +116,41 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validatePersistentState(vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
+	if newCauses := validateLiveFork(vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
+	if ar.Request.Operation == admissionv1.Update {
+		oldClone := &clone.VirtualMachineClone{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldClone); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if !equality.Semantic.DeepEqual(vmClone.Spec.LiveFork, oldClone.Spec.LiveFork) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "The live fork of a clone cannot be changed",
+				Field:   k8sfield.NewPath("spec").Child("liveFork").String(),
+			})
+		}
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if ar.Request.Operation == admissionv1.Create && vmClone.Spec.LiveFork != nil && len(vmClone.Spec.LiveFork.IdentityResetCommand) > 0 {
+		command := vmClone.Spec.LiveFork.IdentityResetCommand
+		log.Log.Object(vmClone).
+			With("user", ar.Request.UserInfo.Username).
+			With("command", command[0]).
+			With("args", fmt.Sprintf("%q", command[1:])).
+			Info("Admitted live fork identity reset command")
+	}
+
 	reviewResponse := admissionv1.AdmissionResponse{
 		Allowed: true,
 	}
@@ -267,14 +302,68 @@ func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.Status
 func validatePatches(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
-	for i, patch := range vmClone.Spec.Patches {
-		if !json.Valid([]byte(patch)) {
+	for i, patchOp := range vmClone.Spec.Patches {
+		if !json.Valid([]byte(patchOp)) {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: fmt.Sprintf("patch is not valid JSON (%s)", patch),
+				Message: fmt.Sprintf("patch is not valid JSON (%s)", patchOp),
 				Field:   k8sfield.NewPath("spec").Child("patches").Index(i).String(),
 			})
 		}
+		if strings.Contains(patchOp, v1.LiveForkIdentityResetCommandAnnotation) ||
+			strings.Contains(patchOp, patch.EscapeJSONPointer(v1.LiveForkIdentityResetCommandAnnotation)) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "The identity reset command of a live fork can only be set in spec.liveFork",
+				Field:   k8sfield.NewPath("spec").Child("patches").Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validatePersistentState(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
+	policy := vmClone.Spec.PersistentState
+	if policy == nil || *policy == clone.PersistentStateRegenerate || *policy == clone.PersistentStateInclude {
+		return nil
+	}
+
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("persistent state policy %q doesn't exist", *policy),
+		Field:   k8sfield.NewPath("spec").Child("persistentState").String(),
+	}}
+}
+
+func validateLiveFork(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
+	if vmClone.Spec.LiveFork == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	liveForkField := k8sfield.NewPath("spec").Child("liveFork")
+
+	if source := vmClone.Spec.Source; source != nil && source.Kind != virtualMachineKind {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "A live fork requires a VirtualMachine source",
+			Field:   liveForkField.String(),
+		})
+	}
+	if policy := vmClone.Spec.PersistentState; policy != nil && *policy == clone.PersistentStateRegenerate {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "A live fork includes the persistent state of the source",
+			Field:   k8sfield.NewPath("spec").Child("persistentState").String(),
+		})
+	}
+	if command := vmClone.Spec.LiveFork.IdentityResetCommand; len(command) > 0 && command[0] == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "The identity reset command cannot start with an empty path",
+			Field:   liveForkField.Child("identityResetCommand").Index(0).String(),
+		})
 	}
 
 	return causes
@@ -436,6 +436,77 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		})
 	})
 
+	DescribeTable("persistentState", func(policy *clone.PersistentStatePolicy, expectAllowed bool) {
+		vmClone.Spec.PersistentState = policy
+		admitter.admitAndExpect(vmClone, expectAllowed)
+	},
+		Entry("unset", nil, true),
+		Entry("Regenerate", pointer.P(clone.PersistentStateRegenerate), true),
+		Entry("Include", pointer.P(clone.PersistentStateInclude), true),
+		Entry("unknown policy", pointer.P(clone.PersistentStatePolicy("Exclude")), false),
+	)
+
+	Context("liveFork", func() {
+		BeforeEach(func() {
+			vmClone.Spec.LiveFork = &clone.LiveFork{IdentityResetCommand: []string{"/usr/local/bin/reset-identity"}}
+		})
+
+		It("should allow a live fork of a VM", func() {
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		It("should reject a live fork of a snapshot", func() {
+			vmClone.Spec.Source.Kind = virtualMachineSnapshotKind
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("should allow a new SMBIOS serial", func() {
+			vmClone.Spec.NewSMBiosSerial = pointer.P("new-serial")
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		DescribeTable("persistentState", func(policy *clone.PersistentStatePolicy, expectAllowed bool) {
+			vmClone.Spec.PersistentState = policy
+			admitter.admitAndExpect(vmClone, expectAllowed)
+		},
+			Entry("Include", pointer.P(clone.PersistentStateInclude), true),
+			Entry("Regenerate", pointer.P(clone.PersistentStateRegenerate), false),
+		)
+
+		It("should reject an identity reset command with an empty path", func() {
+			vmClone.Spec.LiveFork.IdentityResetCommand = []string{"", "--hostname"}
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("should reject passing the identity reset command in a patch", func() {
+			var err error
+			vmClone.Spec.LiveFork = nil
+			vmClone.Spec.Patches, err = patch.New(patch.WithAdd(
+				"/spec/template/metadata/annotations/"+patch.EscapeJSONPointer(v1.LiveForkIdentityResetCommandAnnotation), `["/bin/true"]`,
+			)).ToSlice()
+			Expect(err).ToNot(HaveOccurred())
+
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		DescribeTable("on update", func(command []string, expectAllowed bool) {
+			oldClone := vmClone.DeepCopy()
+			vmClone.Spec.LiveFork.IdentityResetCommand = command
+
+			ar := createCloneAdmissionReview(vmClone)
+			ar.Request.Operation = admissionv1.Update
+			oldCloneBytes, err := json.Marshal(oldClone)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldCloneBytes}
+
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(expectAllowed))
+		},
+			Entry("should allow keeping the identity reset command", []string{"/usr/local/bin/reset-identity"}, true),
+			Entry("should reject changing the identity reset command", []string{"/bin/sh", "-c", "id"}, false),
+		)
+	})
+
 })
 
 func createCloneAdmissionReview(vmClone *clone.VirtualMachineClone) *admissionv1.AdmissionReview {
@@ -21,6 +21,7 @@ package admitters
 
 import (
 	"context"
+	"crypto/sha512"
 	"encoding/base64"
 	"fmt"
 	"net"
@@ -41,6 +42,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	draadmitter "kubevirt.io/kubevirt/pkg/dra/admitter"
 	"kubevirt.io/kubevirt/pkg/hooks"
@@ -53,6 +55,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
 )
 
 const requiredFieldFmt = "%s is a required field"
@@ -64,6 +67,9 @@ const (
 	minCustomBlockSize = 512
 	maxCustomBlockSize = 2097152 // 2 MB
 
+	// Highest unit libvirt accepts on a virtio-scsi controller
+	maxSCSIUnit = 16383
+
 	// cloudInitNetworkMaxLen and CloudInitUserMaxLen are being limited
 	// to 2K to allow scaling of config as edits will cause entire object
 	// to be distributed to large no of nodes. For larger than 2K, user should
@@ -135,6 +141,8 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 
 	_, isKubeVirtServiceAccount := admitter.KubeVirtServiceAccounts[ar.Request.UserInfo.Username]
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, isKubeVirtServiceAccount)...)
+	causes = append(causes, ValidateLiveForkIdentityResetCommand(k8sfield.NewPath("metadata"), vmi.Annotations, nil,
+		hasRequestOriginatedFromVirtController(ar.Request.UserInfo.Username, admitter.KubeVirtServiceAccounts))...)
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHyperv(k8sfield.NewPath("spec").Child("domain").Child("features").Child("hyperv"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstancePerArch(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	if len(causes) > 0 {
@@ -229,7 +237,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
 	causes = append(causes, validateVolumes(field.Child("volumes"), spec.Volumes, config)...)
-	causes = append(causes, validateContainerDisks(field, spec)...)
+	causes = append(causes, validateContainerDisks(field, spec, config)...)
 
 	causes = append(causes, validateAccessCredentials(field.Child("accessCredentials"), spec.AccessCredentials, spec.Volumes)...)
 
@@ -241,9 +249,12 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMDEVRamFB(field, spec)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validateSerialConsoleRecording(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
+	causes = append(causes, validateGrowFilesystem(field, spec, config)...)
+	causes = append(causes, validateSharedDiskGroup(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 	causes = append(causes, validateVideoConfig(field, spec, config)...)
@@ -295,10 +306,29 @@ func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 			Field:   field.Child("domain", "devices", "downwardMetrics").String(),
 		})
 	}
+	if downwardmetrics.HasDevice(spec) {
+		causes = append(causes, validateDownwardMetricsGroups(
+			field.Child("domain", "devices", "downwardMetrics", "metricGroups"),
+			spec.Domain.Devices.DownwardMetrics.MetricGroups)...)
+	}
 
 	return causes
 }
 
+func validateDownwardMetricsGroups(field *k8sfield.Path, groups []v1.DownwardMetricsGroup) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, group := range groups {
+		if !downwardmetrics.IsSupportedMetricGroup(group) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not a supported downward metrics group, supported groups are %v", group, downwardmetrics.SupportedMetricGroups),
+				Field:   field.Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -565,6 +595,30 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validateSerialConsoleRecording(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	devices := spec.Domain.Devices
+	if devices.SerialConsoleRecording == nil {
+		return causes
+	}
+	recordingField := field.Child("domain", "devices", "serialConsoleRecording")
+	if devices.AutoattachSerialConsole != nil && !*devices.AutoattachSerialConsole {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the serial console to be attached", recordingField.String()),
+			Field:   recordingField.String(),
+		})
+	}
+	if devices.LogSerialConsole != nil && !*devices.LogSerialConsole {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the serial console to be logged", recordingField.String()),
+			Field:   recordingField.String(),
+		})
+	}
+	return causes
+}
+
 func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	launchSecurity := spec.Domain.LaunchSecurity
@@ -600,8 +654,10 @@ func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 			})
 		}
 
+		// SEV-SNP guests request their attestation reports themselves and do not need to wait for a launch secret
+		snp := isSEVSNP(launchSecurity.SEV)
 		startStrategy := spec.StartStrategy
-		if launchSecurity.SEV.Attestation != nil && (startStrategy == nil || *startStrategy != v1.StartStrategyPaused) {
+		if !snp && launchSecurity.SEV.Attestation != nil && (startStrategy == nil || *startStrategy != v1.StartStrategyPaused) {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: fmt.Sprintf("SEV attestation requires VMI StartStrategy '%s'", v1.StartStrategyPaused),
@@ -618,6 +674,154 @@ func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 				})
 			}
 		}
+
+		causes = append(causes, validateSEVSNP(field.Child("launchSecurity", "sev"), launchSecurity.SEV)...)
+	}
+	causes = append(causes, validateTDX(field.Child("launchSecurity"), spec, config)...)
+	return causes
+}
+
+func isSEVSNP(sev *v1.SEV) bool {
+	return sev.Policy != nil && sev.Policy.SecureNestedPaging != nil && *sev.Policy.SecureNestedPaging
+}
+
+func validateSEVSNP(field *k8sfield.Path, sev *v1.SEV) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if !isSEVSNP(sev) {
+		snpFields := []struct {
+			name string
+			set  bool
+		}{
+			{name: "policy.singleSocket", set: sev.Policy != nil && sev.Policy.SingleSocket != nil},
+			{name: "idBlock", set: sev.IDBlock != ""},
+			{name: "idAuth", set: sev.IDAuth != ""},
+			{name: "authorKey", set: sev.AuthorKey != nil},
+			{name: "hostData", set: sev.HostData != ""},
+		}
+		for _, snpField := range snpFields {
+			if snpField.set {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s requires SEV-SNP", field.Child(snpField.name)),
+					Field:   field.Child(snpField.name).String(),
+				})
+			}
+		}
+		return causes
+	}
+
+	if sev.DHCert != "" || sev.Session != "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "SEV-SNP does not use launch sessions, dhCert and session must be empty",
+			Field:   field.String(),
+		})
+	}
+	if sev.IDAuth != "" && sev.IDBlock == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires %s", field.Child("idAuth"), field.Child("idBlock")),
+			Field:   field.Child("idAuth").String(),
+		})
+	}
+	if sev.AuthorKey != nil && *sev.AuthorKey && sev.IDAuth == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires %s", field.Child("authorKey"), field.Child("idAuth")),
+			Field:   field.Child("authorKey").String(),
+		})
+	}
+
+	blobs := []struct {
+		name  string
+		value string
+		size  int
+	}{
+		{name: "idBlock", value: sev.IDBlock, size: launchsecurity.SEVSNPIDBlockSize},
+		{name: "idAuth", value: sev.IDAuth, size: launchsecurity.SEVSNPIDAuthSize},
+		{name: "hostData", value: sev.HostData, size: launchsecurity.SEVSNPHostDataSize},
+	}
+	for _, blob := range blobs {
+		if blob.value == "" {
+			continue
+		}
+		if data, err := base64.StdEncoding.DecodeString(blob.value); err != nil || len(data) != blob.size {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be %d base64 encoded bytes", field.Child(blob.name), blob.size),
+				Field:   field.Child(blob.name).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateTDX(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	tdx := spec.Domain.LaunchSecurity.TDX
+	if tdx == nil {
+		return nil
+	}
+	if !config.WorkloadEncryptionTDXEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.WorkloadEncryptionTDX),
+			Field:   field.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	if spec.Domain.LaunchSecurity.SEV != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "SEV and TDX are mutually exclusive",
+			Field:   field.String(),
+		})
+	}
+
+	firmware := spec.Domain.Firmware
+	if !efiBootEnabled(firmware) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "TDX requires OVMF (UEFI)",
+			Field:   field.String(),
+		})
+	} else if secureBootEnabled(firmware) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "TDX does not work along with SecureBoot",
+			Field:   field.String(),
+		})
+	}
+
+	measurements := []struct {
+		name  string
+		value string
+	}{
+		{name: "mrConfigID", value: tdx.MRConfigID},
+		{name: "mrOwner", value: tdx.MROwner},
+		{name: "mrOwnerConfig", value: tdx.MROwnerConfig},
+	}
+	for _, measurement := range measurements {
+		if measurement.value == "" {
+			continue
+		}
+		if digest, err := base64.StdEncoding.DecodeString(measurement.value); err != nil || len(digest) != sha512.Size384 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a base64 encoded SHA384 digest", field.Child("tdx", measurement.name)),
+				Field:   field.Child("tdx", measurement.name).String(),
+			})
+		}
+	}
+
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		if iface.BootOrder != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("TDX does not work with bootable NICs: %s", iface.Name),
+				Field:   field.String(),
+			})
+		}
 	}
 	return causes
 }
@@ -1207,13 +1411,73 @@ func validateArchitecture(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
-func validateContainerDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+func validateContainerDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for idx, volume := range spec.Volumes {
-		if volume.ContainerDisk == nil || volume.ContainerDisk.Path == "" {
+		if volume.ContainerDisk == nil {
+			continue
+		}
+		containerDiskField := field.Child("volumes").Index(idx).Child("containerDisk")
+		causes = append(causes, validateContainerDiskV2(containerDiskField, volume.ContainerDisk, spec.Volumes, config)...)
+		if volume.ContainerDisk.Path == "" {
 			continue
 		}
-		causes = append(causes, validatePath(field.Child("volumes").Index(idx).Child("containerDisk"), volume.ContainerDisk.Path)...)
+		causes = append(causes, validatePath(containerDiskField, volume.ContainerDisk.Path)...)
+	}
+	return causes
+}
+
+func validateContainerDiskV2(field *k8sfield.Path, containerDisk *v1.ContainerDiskSource, volumes []v1.Volume, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	switch containerDisk.Mode {
+	case "", v1.ContainerDiskModeStandalone, v1.ContainerDiskModeLayered:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s or %s", field.Child("mode").String(), v1.ContainerDiskModeStandalone, v1.ContainerDiskModeLayered),
+			Field:   field.Child("mode").String(),
+		})
+	}
+
+	if (containerDisk.Mode == v1.ContainerDiskModeLayered || containerDisk.Overlay != nil) && !config.ContainerDiskV2Enabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled, layered containerDisks and persistent overlays are not allowed", featuregate.ContainerDiskV2),
+			Field:   field.String(),
+		})
+	}
+
+	if containerDisk.Overlay == nil {
+		return causes
+	}
+	claimName := containerDisk.Overlay.ClaimName
+	if claimName == "" {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s is required", field.Child("overlay", "claimName").String()),
+			Field:   field.Child("overlay", "claimName").String(),
+		})
+	}
+	if _, isDigest := containerdisk.ImageDigest(containerDisk.Image); !isDigest {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must reference the image by digest when an overlay is set, the overlay is only valid on top of the image it was created for", field.Child("image").String()),
+			Field:   field.Child("image").String(),
+		})
+	}
+	claimUsers := 0
+	for _, volume := range volumes {
+		if types.PVCNameFromVirtVolume(&volume) == claimName ||
+			(volume.ContainerDisk != nil && volume.ContainerDisk.Overlay != nil && volume.ContainerDisk.Overlay.ClaimName == claimName) {
+			claimUsers++
+		}
+	}
+	if claimUsers > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: fmt.Sprintf("%s PVC %s must not be used by another volume", field.Child("overlay", "claimName").String(), claimName),
+			Field:   field.Child("overlay", "claimName").String(),
+		})
 	}
 	return causes
 }
@@ -1335,6 +1599,19 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 		})
 	}
 
+	if value, exists := annotations[v1.GuestAgentPollingAnnotation]; exists {
+		annotationField := field.Child("annotations", v1.GuestAgentPollingAnnotation)
+		if polling, err := virtconfig.ParseGuestAgentPollingAnnotation(value); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   annotationField.String(),
+			})
+		} else {
+			causes = append(causes, virtconfig.ValidateGuestAgentPolling(annotationField, polling)...)
+		}
+	}
+
 	// Validate sidecar feature gate if set when the corresponding annotation is found
 	if annotations[hooks.HookSidecarListAnnotationName] != "" && !config.SidecarEnabled() {
 		causes = append(causes, metav1.StatusCause{
@@ -1348,6 +1625,24 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 	return causes
 }
 
+// ValidateLiveForkIdentityResetCommand rejects setting or changing the identity reset command of a live fork by
+// anyone but the clone controller. virt-launcher runs the command as root in the guest, so it has to come from a
+// VirtualMachineClone, whose creation is audited.
+func ValidateLiveForkIdentityResetCommand(field *k8sfield.Path, annotations, oldAnnotations map[string]string, isVirtController bool) []metav1.StatusCause {
+	value, exists := annotations[v1.LiveForkIdentityResetCommandAnnotation]
+	if !exists || isVirtController {
+		return nil
+	}
+	if oldValue, existed := oldAnnotations[v1.LiveForkIdentityResetCommandAnnotation]; existed && oldValue == value {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("the %s annotation can only be set by the clone controller", v1.LiveForkIdentityResetCommandAnnotation),
+		Field:   field.Child("annotations", v1.LiveForkIdentityResetCommandAnnotation).String(),
+	}}
+}
+
 // Copied from kubernetes/pkg/apis/core/validation/validation.go
 func validatePodDNSConfig(dnsConfig *k8sv1.PodDNSConfig, dnsPolicy *k8sv1.DNSPolicy, field *k8sfield.Path) []metav1.StatusCause {
 	var causes []metav1.StatusCause
@@ -1458,6 +1753,39 @@ func validateBootloader(field *k8sfield.Path, bootloader *v1.Bootloader) []metav
 		})
 	}
 
+	if bootloader != nil && bootloader.EFI != nil && bootloader.EFI.SecureBootKeys != nil {
+		causes = append(causes, validateSecureBootKeys(field.Child("efi"), bootloader.EFI)...)
+	}
+
+	return causes
+}
+
+func validateSecureBootKeys(field *k8sfield.Path, efi *v1.EFI) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	keysField := field.Child("secureBootKeys")
+
+	if (efi.SecureBootKeys.Secret == nil) == (efi.SecureBootKeys.ConfigMap == nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must reference either a Secret or a ConfigMap", keysField.String()),
+			Field:   keysField.String(),
+		})
+	}
+	if efi.SecureBoot != nil && !*efi.SecureBoot {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires %s to be enabled", keysField.String(), field.Child("secureBoot").String()),
+			Field:   keysField.String(),
+		})
+	}
+	if efi.Persistent == nil || !*efi.Persistent {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires %s to be enabled", keysField.String(), field.Child("persistent").String()),
+			Field:   keysField.String(),
+		})
+	}
+
 	return causes
 }
 
@@ -1880,6 +2208,10 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				Field:   field.Index(idx).String(),
 			})
 		}
+		if volume.DownwardMetrics != nil {
+			causes = append(causes, validateDownwardMetricsGroups(
+				field.Index(idx).Child("downwardMetrics", "metricGroups"), volume.DownwardMetrics.MetricGroups)...)
+		}
 
 		// validate HostDisk data
 		if hostDisk := volume.HostDisk; hostDisk != nil {
@@ -2041,6 +2373,52 @@ func validatePciAddress(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 	return causes
 }
 
+func getSCSIUnit(disk v1.Disk) *uint {
+	switch {
+	case disk.Disk != nil:
+		return disk.Disk.Unit
+	case disk.LUN != nil:
+		return disk.LUN.Unit
+	default:
+		return nil
+	}
+}
+
+func validateSCSIUnit(field *k8sfield.Path, idx int, disks []v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	disk := disks[idx]
+	unit := getSCSIUnit(disk)
+	if unit == nil {
+		return causes
+	}
+	unitField := field.Index(idx).Child(getDiskType(disk), "unit").String()
+
+	if getDiskBus(disk) != v1.DiskBusSCSI {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s - setting a SCSI unit is only possible with bus type scsi.", field.Index(idx).String()),
+			Field:   unitField,
+		})
+	}
+	if *unit > maxSCSIUnit {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s - SCSI unit %d exceeds the maximum of %d.", field.Index(idx).String(), *unit, maxSCSIUnit),
+			Field:   unitField,
+		})
+	}
+	for otherIdx, other := range disks[:idx] {
+		if otherUnit := getSCSIUnit(other); otherUnit != nil && *otherUnit == *unit {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s and %s must not use the same SCSI unit %d.", field.Index(otherIdx).String(), field.Index(idx).String(), *unit),
+				Field:   unitField,
+			})
+		}
+	}
+	return causes
+}
+
 func validateBootOrderValue(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if disk.BootOrder != nil && *disk.BootOrder < 1 {
@@ -2263,6 +2641,7 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		causes = append(causes, validateDiskName(field, idx, disks)...)
 		causes = append(causes, validateDeviceTarget(field, idx, disk)...)
 		causes = append(causes, validatePciAddress(field, idx, disk)...)
+		causes = append(causes, validateSCSIUnit(field, idx, disks)...)
 		causes = append(causes, validateBootOrderValue(field, idx, disk)...)
 		causes = append(causes, validateBusSupport(field, idx, disk)...)
 		causes = append(causes, validateSerialNumValue(field, idx, disk)...)
@@ -2403,6 +2782,83 @@ func validatePersistentReservation(field *k8sfield.Path, spec *v1.VirtualMachine
 	return causes
 }
 
+func validateGrowFilesystem(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.GrowFilesystem == nil || !*disk.GrowFilesystem {
+			continue
+		}
+		diskField := field.Child("domain", "devices", "disks").Index(idx)
+		if !config.ExpandDisksEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.ExpandDisksGate),
+				Field:   diskField.Child("growFilesystem").String(),
+			})
+		}
+		if disk.Disk == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "growing the filesystem is only supported for disks",
+				Field:   diskField.Child("growFilesystem").String(),
+			})
+		}
+		if disk.Serial == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "a serial is required to identify the disk in the guest when growing the filesystem",
+				Field:   diskField.Child("serial").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateSharedDiskGroup(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.SharedDiskGroup == "" {
+		return causes
+	}
+
+	groupField := field.Child("sharedDiskGroup")
+	if !config.PersistentReservationEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.PersistentReservation),
+			Field:   groupField.String(),
+		})
+	}
+	for _, msg := range validation.IsValidLabelValue(spec.SharedDiskGroup) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is not a valid shared disk group name: %s", spec.SharedDiskGroup, msg),
+			Field:   groupField.String(),
+		})
+	}
+	if !reservation.HasVMISpecPersistentReservation(spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "a shared disk group requires at least one LUN disk with reservation",
+			Field:   groupField.String(),
+		})
+	}
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.LUN == nil || !disk.LUN.Reservation {
+			continue
+		}
+		if disk.Shareable == nil || !*disk.Shareable {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("LUN disk %s with reservation must be shareable when the VMI is part of a shared disk group", disk.Name),
+				Field:   field.Child("domain", "devices", "disks").Index(idx).Child("shareable").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func validateCPUHotplug(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.MaxSockets != 0 {
@@ -21,6 +21,7 @@ package admitters
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"runtime"
@@ -204,6 +205,53 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		Entry("when path is absolute and has trailing slash", "/a/b/c/"),
 	)
 
+	DescribeTable("container disk v2 validation", func(featureGateEnabled bool, mutate func(*v1.VirtualMachineInstance), expectedFields []string) {
+		if featureGateEnabled {
+			enableFeatureGates(featuregate.ContainerDiskV2)
+		}
+		vmi := newBaseVmi(libvmi.WithContainerDisk("testdisk", "testimage"))
+		mutate(vmi)
+
+		causes := validateContainerDisks(k8sfield.NewPath("spec"), &vmi.Spec, config)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, cause := range causes {
+			Expect(cause.Field).To(Equal(expectedFields[i]))
+		}
+	},
+		Entry("should accept a layered containerDisk", true, func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes[0].ContainerDisk.Mode = v1.ContainerDiskModeLayered
+		}, nil),
+		Entry("should accept a persistent overlay", true, func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes[0].ContainerDisk.Image = "testimage@sha256:1234"
+			vmi.Spec.Volumes[0].ContainerDisk.Overlay = &v1.ContainerDiskOverlay{ClaimName: "overlay"}
+		}, nil),
+		Entry("should reject a persistent overlay of an image referenced by tag", true, func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes[0].ContainerDisk.Image = "testimage:latest"
+			vmi.Spec.Volumes[0].ContainerDisk.Overlay = &v1.ContainerDiskOverlay{ClaimName: "overlay"}
+		}, []string{"spec.volumes[0].containerDisk.image"}),
+		Entry("should reject a layered containerDisk without feature gate", false, func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes[0].ContainerDisk.Mode = v1.ContainerDiskModeLayered
+		}, []string{"spec.volumes[0].containerDisk"}),
+		Entry("should reject an unknown mode", true, func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes[0].ContainerDisk.Mode = "Lazy"
+		}, []string{"spec.volumes[0].containerDisk.mode"}),
+		Entry("should reject an overlay without claim", true, func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes[0].ContainerDisk.Overlay = &v1.ContainerDiskOverlay{}
+		}, []string{"spec.volumes[0].containerDisk.overlay.claimName"}),
+		Entry("should reject an overlay claim used by another volume", true, func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes[0].ContainerDisk.Image = "testimage@sha256:1234"
+			vmi.Spec.Volumes[0].ContainerDisk.Overlay = &v1.ContainerDiskOverlay{ClaimName: "overlay"}
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "pvcdisk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "overlay"},
+					},
+				},
+			})
+		}, []string{"spec.volumes[0].containerDisk.overlay.claimName"}),
+	)
+
 	Context("with eviction strategies", func() {
 		DescribeTable("it should allow", func(vmi *v1.VirtualMachineInstance) {
 			ar, err := newAdmissionReviewForVMICreation(vmi)
@@ -421,6 +469,27 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			),
 		)
 
+		DescribeTable("should only allow the clone controller to set the live fork identity reset command", func(userAccount string, expectAllowed bool) {
+			vmi := newBaseVmi(libvmi.WithAnnotation(v1.LiveForkIdentityResetCommandAnnotation, `["/bin/true"]`))
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: userAccount}
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(expectAllowed))
+			if !expectAllowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("can only be set by the clone controller"))
+			}
+		},
+			Entry("allow virt-controller", "system:serviceaccount:kubevirt:"+components.ControllerServiceAccountName, true),
+			Entry("reject virt-api", "system:serviceaccount:kubevirt:"+components.ApiServiceAccountName, false),
+			Entry("reject a user", "fake-account", false),
+			Entry("reject a service account named like virt-controller in another namespace",
+				"system:serviceaccount:fake:"+components.ControllerServiceAccountName, false),
+		)
+
 		It("should reject restricted label by non kubevirt user", func() {
 			vmi := newBaseVmi(libvmi.WithLabel(v1.NodeNameLabel, "someValue"))
 
@@ -480,6 +549,24 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				featuregate.SidecarGate,
 			),
 		)
+
+		DescribeTable("should validate the guest agent polling annotation", func(value string, expectedMsg string) {
+			vmi := newBaseVmi()
+			vmi.Annotations = map[string]string{v1.GuestAgentPollingAnnotation: value}
+
+			causes := ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, config, false)
+			if expectedMsg == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[0].Message).To(ContainSubstring(expectedMsg))
+		},
+			Entry("and accept valid intervals", `{"cpuStatsInterval":"30s","diskStatsInterval":"0s"}`, ""),
+			Entry("and reject invalid JSON", "cpuStatsInterval=30s", "invalid kubevirt.io/guest-agent-polling annotation"),
+			Entry("and reject disabling mandatory intervals", `{"userInterval":"0s"}`, "userInterval must be a positive duration"),
+		)
 	})
 
 	Context("with VirtualMachineInstance spec", func() {
@@ -634,6 +721,24 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.Sound"))
 		})
 
+		It("should allow recording the serial console", func() {
+			vmi.Spec.Domain.Devices.SerialConsoleRecording = &v1.SerialConsoleRecording{ClaimName: "recordings"}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject recording the serial console", func(autoattach, log *bool) {
+			vmi.Spec.Domain.Devices.SerialConsoleRecording = &v1.SerialConsoleRecording{}
+			vmi.Spec.Domain.Devices.AutoattachSerialConsole = autoattach
+			vmi.Spec.Domain.Devices.LogSerialConsole = log
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.serialConsoleRecording"))
+		},
+			Entry("when the serial console is not attached", pointer.P(false), nil),
+			Entry("when the serial console is not logged", nil, pointer.P(false)),
+		)
+
 		It("should reject volume with missing disk / file system", func() {
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
 				Name: "testvolume",
@@ -2099,6 +2204,29 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks.disk[0].pciAddress"))
 		})
 
+		DescribeTable("should validate the SCSI unit of disks", func(disks []v1.Disk, expectedFields ...string) {
+			causes := validateDisks(k8sfield.NewPath("fake"), disks)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("accepting distinct units on disks and LUNs", []v1.Disk{
+				{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(0))}}},
+				{Name: "lun0", DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(3))}}},
+			}),
+			Entry("rejecting a unit on a non-scsi bus", []v1.Disk{
+				{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, Unit: pointer.P(uint(1))}}},
+			}, "fake[0].disk.unit"),
+			Entry("rejecting a unit above the controller limit", []v1.Disk{
+				{Name: "lun0", DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(16384))}}},
+			}, "fake[0].lun.unit"),
+			Entry("rejecting the same unit on two disks", []v1.Disk{
+				{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(2))}}},
+				{Name: "lun0", DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(2))}}},
+			}, "fake[1].lun.unit"),
+		)
+
 		It("should reject disk with multiple targets ", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk",
@@ -2571,6 +2699,25 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				Field:   "fake.domain.devices.downwardMetrics",
 				Message: "downwardMetrics virtio serial is not allowed: DownwardMetrics feature gate is not enabled"}))
 		})
+
+		It("should accept supported metric groups", func() {
+			enableFeatureGates(featuregate.DownwardMetricsFeatureGate)
+			vmi.Spec.Domain.Devices.DownwardMetrics.MetricGroups = []v1.DownwardMetricsGroup{
+				v1.DownwardMetricsGroupDisk, v1.DownwardMetricsGroupNUMA,
+			}
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should reject unknown metric groups", func() {
+			enableFeatureGates(featuregate.DownwardMetricsFeatureGate)
+			vmi.Spec.Domain.Devices.DownwardMetrics.MetricGroups = []v1.DownwardMetricsGroup{
+				v1.DownwardMetricsGroupCPU, "GPU",
+			}
+			causes := validate()
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.downwardMetrics.metricGroups[1]"))
+		})
 	})
 
 	Context("with volume", func() {
@@ -2629,6 +2776,24 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(ContainSubstring("fake must have max one downwardMetric volume set"))
 		})
 
+		It("should reject downwardMetrics volumes with unknown metric groups", func() {
+			enableFeatureGates(featuregate.DownwardMetricsFeatureGate)
+
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testDownwardMetrics",
+				VolumeSource: v1.VolumeSource{
+					DownwardMetrics: &v1.DownwardMetricsVolumeSource{
+						MetricGroups: []v1.DownwardMetricsGroup{"GPU"},
+					},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[0].downwardMetrics.metricGroups[0]"))
+			Expect(causes[0].Message).To(ContainSubstring("GPU is not a supported downward metrics group"))
+		})
+
 		It("should reject hostDisk volumes if the feature gate is not enabled", func() {
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
 				Name: "testHostDisk",
@@ -2868,6 +3033,51 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 		})
 
+		DescribeTable("with custom SecureBoot keys", func(efi *v1.EFI, expectedMessages ...string) {
+			vmi.Spec.Domain.Features = &v1.Features{
+				SMM: &v1.FeatureState{Enabled: pointer.P(true)},
+			}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{EFI: efi},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Field).To(Equal("fake.domain.firmware.bootloader.efi.secureBootKeys"))
+				Expect(causes[i].Message).To(ContainSubstring(message))
+			}
+		},
+			Entry("should accept keys from a Secret", &v1.EFI{
+				Persistent:     pointer.P(true),
+				SecureBootKeys: &v1.SecureBootKeysSource{Secret: &k8sv1.LocalObjectReference{Name: "keys"}},
+			}),
+			Entry("should accept keys from a ConfigMap", &v1.EFI{
+				SecureBoot:     pointer.P(true),
+				Persistent:     pointer.P(true),
+				SecureBootKeys: &v1.SecureBootKeysSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "keys"}},
+			}),
+			Entry("should reject keys without a source", &v1.EFI{
+				Persistent:     pointer.P(true),
+				SecureBootKeys: &v1.SecureBootKeysSource{},
+			}, "must reference either a Secret or a ConfigMap"),
+			Entry("should reject keys from a Secret and a ConfigMap", &v1.EFI{
+				Persistent: pointer.P(true),
+				SecureBootKeys: &v1.SecureBootKeysSource{
+					Secret:    &k8sv1.LocalObjectReference{Name: "keys"},
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "keys"},
+				},
+			}, "must reference either a Secret or a ConfigMap"),
+			Entry("should reject keys without SecureBoot", &v1.EFI{
+				SecureBoot:     pointer.P(false),
+				Persistent:     pointer.P(true),
+				SecureBootKeys: &v1.SecureBootKeysSource{Secret: &k8sv1.LocalObjectReference{Name: "keys"}},
+			}, "requires fake.domain.firmware.bootloader.efi.secureBoot to be enabled"),
+			Entry("should reject keys without persistent EFI", &v1.EFI{
+				SecureBootKeys: &v1.SecureBootKeysSource{Secret: &k8sv1.LocalObjectReference{Name: "keys"}},
+			}, "requires fake.domain.firmware.bootloader.efi.persistent to be enabled"),
+		)
+
 		It("should reject disk without a valid DNS-1123 name", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "TESTDISK2",
@@ -3135,6 +3345,160 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(ContainSubstring("launchSecurity"))
 		})
+
+		Context("with SEV-SNP", func() {
+			encode := func(size int) string {
+				return base64.StdEncoding.EncodeToString(make([]byte, size))
+			}
+
+			BeforeEach(func() {
+				vmi.Spec.Domain.LaunchSecurity.SEV.Policy = &v1.SEVPolicy{
+					SecureNestedPaging: pointer.P(true),
+				}
+			})
+
+			It("should accept SEV-SNP attestation without start strategy 'Paused'", func() {
+				vmi.Spec.Domain.LaunchSecurity.SEV.Attestation = &v1.SEVAttestation{}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should accept the SEV-SNP launch parameters", func() {
+				vmi.Spec.Domain.LaunchSecurity.SEV.Policy.SingleSocket = pointer.P(true)
+				vmi.Spec.Domain.LaunchSecurity.SEV.IDBlock = encode(96)
+				vmi.Spec.Domain.LaunchSecurity.SEV.IDAuth = encode(4096)
+				vmi.Spec.Domain.LaunchSecurity.SEV.AuthorKey = pointer.P(true)
+				vmi.Spec.Domain.LaunchSecurity.SEV.HostData = encode(32)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(mutate func(sev *v1.SEV), expectedField string) {
+				mutate(vmi.Spec.Domain.LaunchSecurity.SEV)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("an ID block of the wrong size", func(sev *v1.SEV) {
+					sev.IDBlock = encode(95)
+				}, "fake.launchSecurity.sev.idBlock"),
+				Entry("an ID block which is not base64", func(sev *v1.SEV) {
+					sev.IDBlock = "not base64!"
+				}, "fake.launchSecurity.sev.idBlock"),
+				Entry("ID authentication without an ID block", func(sev *v1.SEV) {
+					sev.IDAuth = encode(4096)
+				}, "fake.launchSecurity.sev.idAuth"),
+				Entry("an author key without ID authentication", func(sev *v1.SEV) {
+					sev.IDBlock = encode(96)
+					sev.AuthorKey = pointer.P(true)
+				}, "fake.launchSecurity.sev.authorKey"),
+				Entry("host data of the wrong size", func(sev *v1.SEV) {
+					sev.HostData = encode(64)
+				}, "fake.launchSecurity.sev.hostData"),
+				Entry("a launch session", func(sev *v1.SEV) {
+					sev.DHCert = "dhcert"
+					sev.Session = "session"
+				}, "fake.launchSecurity.sev"),
+			)
+
+			DescribeTable("should reject SEV-SNP parameters without SEV-SNP", func(mutate func(sev *v1.SEV), expectedField string) {
+				vmi.Spec.Domain.LaunchSecurity.SEV.Policy.SecureNestedPaging = nil
+				mutate(vmi.Spec.Domain.LaunchSecurity.SEV)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("single socket", func(sev *v1.SEV) {
+					sev.Policy.SingleSocket = pointer.P(true)
+				}, "fake.launchSecurity.sev.policy.singleSocket"),
+				Entry("ID block", func(sev *v1.SEV) {
+					sev.IDBlock = encode(96)
+				}, "fake.launchSecurity.sev.idBlock"),
+				Entry("host data", func(sev *v1.SEV) {
+					sev.HostData = encode(32)
+				}, "fake.launchSecurity.sev.hostData"),
+			)
+		})
+	})
+
+	Context("with Intel TDX LaunchSecurity", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				TDX: &v1.TDX{},
+			}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot: pointer.P(false),
+					},
+				},
+			}
+			enableFeatureGates(featuregate.WorkloadEncryptionTDX)
+		})
+
+		It("should accept when the feature gate is enabled and OVMF is configured", func() {
+			vmi.Spec.Domain.LaunchSecurity.TDX.Attestation = &v1.TDXAttestation{}
+			vmi.Spec.Domain.LaunchSecurity.TDX.MRConfigID = base64.StdEncoding.EncodeToString(make([]byte, 48))
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject when the feature gate is disabled", func() {
+			disableFeatureGates()
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featuregate.WorkloadEncryptionTDX)))
+		})
+
+		It("should reject when combined with SEV", func() {
+			enableFeatureGates(featuregate.WorkloadEncryptionTDX, featuregate.WorkloadEncryptionSEV)
+			vmi.Spec.Domain.LaunchSecurity.SEV = &v1.SEV{}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("SEV and TDX are mutually exclusive"))
+		})
+
+		It("should reject when UEFI is not configured", func() {
+			vmi.Spec.Domain.Firmware = nil
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("TDX requires OVMF"))
+		})
+
+		It("should reject when SecureBoot is enabled", func() {
+			vmi.Spec.Domain.Features = &v1.Features{
+				SMM: &v1.FeatureState{
+					Enabled: pointer.P(true),
+				},
+			}
+			vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot = pointer.P(true)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("TDX does not work along with SecureBoot"))
+		})
+
+		DescribeTable("should reject measurements which are not base64 encoded SHA384 digests", func(value string) {
+			vmi.Spec.Domain.LaunchSecurity.TDX.MROwner = value
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.launchSecurity.tdx.mrOwner"))
+		},
+			Entry("not base64", "not-base64!"),
+			Entry("too short", base64.StdEncoding.EncodeToString(make([]byte, 32))),
+		)
+
+		It("should reject when there are bootable NICs", func() {
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: vmi.Spec.Networks[0].Name, BootOrder: pointer.P(uint(1))},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("TDX does not work with bootable NICs"))
+		})
 	})
 
 	Context("with Secure Execution LaunchSecurity", func() {
@@ -3863,6 +4227,114 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with filesystem grow defined", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			enableFeatureGates(featuregate.ExpandDisksGate)
+		})
+
+		DescribeTable("should validate the disk", func(disk v1.Disk, expectedCauses []string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, disk)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: disk.Name,
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+				},
+			})
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedCauses)))
+			for i, cause := range causes {
+				Expect(cause.Message).To(ContainSubstring(expectedCauses[i]))
+			}
+		},
+			Entry("with a serial", v1.Disk{
+				Name: "testdisk", Serial: "data", GrowFilesystem: pointer.P(true),
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+			}, nil),
+			Entry("without a serial", v1.Disk{
+				Name: "testdisk", GrowFilesystem: pointer.P(true),
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+			}, []string{"a serial is required"}),
+			Entry("with a cdrom", v1.Disk{
+				Name: "testdisk", Serial: "data", GrowFilesystem: pointer.P(true),
+				DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}},
+			}, []string{"only supported for disks"}),
+		)
+
+		It("should reject when the feature gate is disabled", func() {
+			disableFeatureGates()
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", Serial: "data", GrowFilesystem: pointer.P(true),
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+			})
+			causes := validateGrowFilesystem(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featuregate.ExpandDisksGate)))
+		})
+	})
+
+	Context("with a shared disk group", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.SharedDiskGroup = "cluster"
+			enableFeatureGates(featuregate.PersistentReservation)
+		})
+
+		addLUN := func(reservation bool, shareable *bool) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "quorum",
+				Shareable:  shareable,
+				DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Reservation: reservation}},
+			})
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "quorum",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+				},
+			})
+		}
+
+		It("should accept a shareable LUN with reservation", func() {
+			addLUN(true, pointer.P(true))
+			causes := validateSharedDiskGroup(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject a LUN with reservation which is not shareable", func() {
+			addLUN(true, nil)
+			causes := validateSharedDiskGroup(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].shareable"))
+		})
+
+		It("should reject a group without reservation LUNs", func() {
+			addLUN(false, pointer.P(true))
+			causes := validateSharedDiskGroup(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("requires at least one LUN disk with reservation"))
+		})
+
+		It("should reject an invalid group name", func() {
+			addLUN(true, pointer.P(true))
+			vmi.Spec.SharedDiskGroup = "not a label"
+			causes := validateSharedDiskGroup(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.sharedDiskGroup"))
+		})
+
+		It("should reject when the feature gate is disabled", func() {
+			disableFeatureGates()
+			addLUN(true, pointer.P(true))
+			causes := validateSharedDiskGroup(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featuregate.PersistentReservation)))
+		})
+	})
+
 	Context("with CPU hotplug", func() {
 		var vmi *v1.VirtualMachineInstance
 
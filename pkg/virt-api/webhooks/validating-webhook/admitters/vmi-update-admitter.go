@@ -124,6 +124,12 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		}
 	}
 
+	causes := ValidateLiveForkIdentityResetCommand(k8sfield.NewPath("metadata"), newVMI.Annotations, oldVMI.Annotations,
+		hasRequestOriginatedFromVirtController(ar.Request.UserInfo.Username, admitter.kubeVirtServiceAccounts))
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
 		Warnings: warnDeprecatedAPIs(&newVMI.Spec, admitter.clusterConfig),
@@ -251,6 +257,9 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					if len(causes) > 0 {
 						return webhookutils.ToAdmissionResponse(causes)
 					}
+					if causes := validateHotplugSCSIUnit(disk, newDisks); len(causes) > 0 {
+						return webhookutils.ToAdmissionResponse(causes)
+					}
 				}
 			}
 		}
@@ -258,6 +267,23 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 	return nil
 }
 
+// validateHotplugSCSIUnit rejects a hotplugged disk asking for a SCSI unit another disk already claims
+func validateHotplugSCSIUnit(disk v1.Disk, disks map[string]v1.Disk) []metav1.StatusCause {
+	unit := getSCSIUnit(disk)
+	if unit == nil {
+		return nil
+	}
+	for name, other := range disks {
+		if otherUnit := getSCSIUnit(other); name != disk.Name && otherUnit != nil && *otherUnit == *unit {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("hotplug disk %s requests SCSI unit %d which is used by disk %s", disk.Name, *unit, name),
+			}}
+		}
+	}
+	return nil
+}
+
 func isMigratedVolume(newVol, oldVol *v1.Volume, migratedVolumeMap map[string]bool) bool {
 	if newVol.Name != oldVol.Name {
 		return false
@@ -454,6 +480,14 @@ func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionR
 	return nil
 }
 
+func hasRequestOriginatedFromVirtController(requestUsername string, kubeVirtServiceAccounts map[string]struct{}) bool {
+	if _, isKubeVirtServiceAccount := kubeVirtServiceAccounts[requestUsername]; isKubeVirtServiceAccount {
+		return strings.HasSuffix(requestUsername, ":"+components.ControllerServiceAccountName)
+	}
+
+	return false
+}
+
 func hasRequestOriginatedFromVirtHandler(requestUsername string, kubeVirtServiceAccounts map[string]struct{}) bool {
 	if _, isKubeVirtServiceAccount := kubeVirtServiceAccounts[requestUsername]; isKubeVirtServiceAccount {
 		return strings.HasSuffix(requestUsername, components.HandlerServiceAccountName)
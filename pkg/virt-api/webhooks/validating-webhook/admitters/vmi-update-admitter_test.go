@@ -426,6 +426,49 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		),
 	)
 
+	DescribeTable("should only allow the clone controller to change the live fork identity reset command",
+		func(oldCommand, newCommand, serviceAccount string, expectAllowed bool) {
+			vmi := api.NewMinimalVMI("testvmi")
+			updateVmi := vmi.DeepCopy()
+			if oldCommand != "" {
+				vmi.Annotations = map[string]string{v1.LiveForkIdentityResetCommandAnnotation: oldCommand}
+			}
+			if newCommand != "" {
+				updateVmi.Annotations = map[string]string{v1.LiveForkIdentityResetCommandAnnotation: newCommand}
+			}
+			newVMIBytes, _ := json.Marshal(&updateVmi)
+			oldVMIBytes, _ := json.Marshal(&vmi)
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UserInfo: authv1.UserInfo{Username: serviceAccount},
+					Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: newVMIBytes,
+					},
+					OldObject: runtime.RawExtension{
+						Raw: oldVMIBytes,
+					},
+					Operation: admissionv1.Update,
+				},
+			}
+
+			resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(expectAllowed))
+			if !expectAllowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.annotations." + v1.LiveForkIdentityResetCommandAnnotation))
+			}
+		},
+		Entry("reject adding it by a user", "", `["/bin/true"]`, "system:serviceaccount:someNamespace:someUser", false),
+		Entry("reject changing it by a user", `["/bin/true"]`, `["/bin/false"]`, "system:serviceaccount:someNamespace:someUser", false),
+		Entry("reject adding it by virt-handler", "", `["/bin/true"]`,
+			"system:serviceaccount:kubevirt:"+components.HandlerServiceAccountName, false),
+		Entry("allow keeping it by a user", `["/bin/true"]`, `["/bin/true"]`, "system:serviceaccount:someNamespace:someUser", true),
+		Entry("allow removing it by a user", `["/bin/true"]`, "", "system:serviceaccount:someNamespace:someUser", true),
+		Entry("allow adding it by virt-controller", "", `["/bin/true"]`,
+			"system:serviceaccount:kubevirt:"+components.ControllerServiceAccountName, true),
+	)
+
 	emptyResult := func() map[string]v1.Volume {
 		return make(map[string]v1.Volume, 0)
 	}
@@ -605,6 +648,14 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		return res
 	}
 
+	makeDisksWithUnit := func(bus v1.DiskBus, unit uint, indexes ...int) []v1.Disk {
+		res := makeDisksWithBus(bus, indexes...)
+		for i := range res {
+			res[i].Disk.Unit = pointer.P(unit)
+		}
+		return res
+	}
+
 	makeDisksInvalidBootOrder := func(indexes ...int) []v1.Disk {
 		res := makeDisks(indexes...)
 		if len(res) > 0 {
@@ -785,6 +836,30 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			nil),
+		Entry("Should accept if we hotplug a disk to a free SCSI unit",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			append(makeDisks(0), makeDisksWithUnit(v1.DiskBusSCSI, 5, 1)...),
+			makeDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			nil),
+		Entry("Should reject if we hotplug a disk to a SCSI unit in use",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeDisksWithUnit(v1.DiskBusSCSI, 2, 0, 1),
+			makeDisksWithUnit(v1.DiskBusSCSI, 2, 0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			makeExpected("hotplug disk volume-name-1 requests SCSI unit 2 which is used by disk volume-name-0", "")),
+		Entry("Should reject if we hotplug a virtio disk with a SCSI unit",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			append(makeDisks(0), makeDisksWithUnit(v1.DiskBusVirtio, 1, 1)...),
+			makeDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			makeExpected("Hotplug configuration for [volume-name-1] requires scsi bus to set a SCSI unit.", "")),
 		Entry("Should accept if we add LUN disk with valid SCSI bus",
 			makeVolumes(0, 1),
 			makeVolumes(0, 1),
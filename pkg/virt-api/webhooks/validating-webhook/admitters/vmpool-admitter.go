@@ -83,6 +83,11 @@ func (admitter *VMPoolAdmitter) Admit(_ context.Context, ar *admissionv1.Admissi
 
 	_, isKubeVirtServiceAccount := admitter.KubeVirtServiceAccounts[ar.Request.UserInfo.Username]
 	causes := ValidateVMPoolSpec(ar, k8sfield.NewPath("spec"), &pool, admitter.ClusterConfig, isKubeVirtServiceAccount)
+	if template := pool.Spec.VirtualMachineTemplate; template != nil && template.Spec.Template != nil {
+		causes = append(causes, ValidateLiveForkIdentityResetCommand(
+			k8sfield.NewPath("spec", "virtualMachineTemplate", "spec", "template", "metadata"), template.Spec.Template.ObjectMeta.Annotations, nil,
+			hasRequestOriginatedFromVirtController(ar.Request.UserInfo.Username, admitter.KubeVirtServiceAccounts))...)
+	}
 
 	if ar.Request.Operation == admissionv1.Create {
 		clusterCfg := admitter.ClusterConfig.GetConfig()
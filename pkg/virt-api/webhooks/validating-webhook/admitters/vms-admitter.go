@@ -152,6 +152,14 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes, err = admitter.validateLiveForkIdentityResetCommand(ar.Request, vmCopy)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	causes, err = storageAdmitters.Admit(admitter.VirtClient, ctx, ar.Request, &vm, admitter.ClusterConfig)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
@@ -183,6 +191,28 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	}
 }
 
+// validateLiveForkIdentityResetCommand checks the template annotations including those applied by the instance type
+// and preference of the VM
+func (admitter *VMsAdmitter) validateLiveForkIdentityResetCommand(request *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
+	if vm.Spec.Template == nil {
+		return nil, nil
+	}
+
+	var oldAnnotations map[string]string
+	if request.Operation == admissionv1.Update {
+		oldVM := &v1.VirtualMachine{}
+		if err := json.Unmarshal(request.OldObject.Raw, oldVM); err != nil {
+			return nil, err
+		}
+		if oldVM.Spec.Template != nil {
+			oldAnnotations = oldVM.Spec.Template.ObjectMeta.Annotations
+		}
+	}
+
+	return ValidateLiveForkIdentityResetCommand(k8sfield.NewPath("spec", "template", "metadata"), vm.Spec.Template.ObjectMeta.Annotations, oldAnnotations,
+		hasRequestOriginatedFromVirtController(request.UserInfo.Username, admitter.KubeVirtServiceAccounts)), nil
+}
+
 func (admitter *VMsAdmitter) AdmitStatus(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	vm, _, err := webhookutils.GetVMFromAdmissionReview(ar)
 	if err != nil {
@@ -503,5 +533,21 @@ func validateHotplugDiskConfiguration(disk *v1.Disk, name, messagePrefix, field
 		}}
 	}
 
+	if getSCSIUnit(*disk) != nil && bus != v1.DiskBusSCSI {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for [%s] requires scsi bus to set a SCSI unit.", messagePrefix, name),
+			Field:   field,
+		}}
+	}
+
+	if disk.Disk != nil && disk.Disk.PciAddress != "" && bus != v1.DiskBusVirtio {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for [%s] requires virtio bus to set a PCI address.", messagePrefix, name),
+			Field:   field,
+		}}
+	}
+
 	return nil
 }
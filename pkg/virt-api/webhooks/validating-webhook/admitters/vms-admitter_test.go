@@ -32,6 +32,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authentication/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,6 +56,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/tests/framework/checks"
 )
 
@@ -227,6 +229,50 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	DescribeTable("should only allow the clone controller to set the live fork identity reset command",
+		func(operation admissionv1.Operation, oldCommand, username string, expectAllowed bool) {
+			newVM := func(command string) *v1.VirtualMachine {
+				return &v1.VirtualMachine{
+					Spec: v1.VirtualMachineSpec{
+						Running: pointer.P(false),
+						Template: &v1.VirtualMachineInstanceTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Annotations: map[string]string{v1.LiveForkIdentityResetCommandAnnotation: command},
+							},
+							Spec: api.NewMinimalVMI("testvmi").Spec,
+						},
+					},
+				}
+			}
+			vmBytes, err := json.Marshal(newVM(`["/bin/true"]`))
+			Expect(err).ToNot(HaveOccurred())
+			oldVMBytes, err := json.Marshal(newVM(oldCommand))
+			Expect(err).ToNot(HaveOccurred())
+
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UserInfo:  authv1.UserInfo{Username: username},
+					Resource:  webhooks.VirtualMachineGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: vmBytes},
+					OldObject: runtime.RawExtension{Raw: oldVMBytes},
+					Operation: operation,
+				},
+			}
+
+			resp := vmsAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(expectAllowed))
+			if !expectAllowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.metadata.annotations." + v1.LiveForkIdentityResetCommandAnnotation))
+			}
+		},
+		Entry("reject creation by a user", admissionv1.Create, "", "fake-account", false),
+		Entry("allow creation by virt-controller", admissionv1.Create, "",
+			"system:serviceaccount:kubevirt:"+components.ControllerServiceAccountName, true),
+		Entry("reject a change by a user", admissionv1.Update, `["/bin/false"]`, "fake-account", false),
+		Entry("allow an update keeping it by a user", admissionv1.Update, `["/bin/true"]`, "fake-account", true),
+	)
+
 	It("should accept valid vmi spec", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -94,6 +94,10 @@ func ServeVMExports(resp http.ResponseWriter, req *http.Request, clusterConfig *
 	validating_webhooks.Serve(resp, req, storageAdmitters.NewVMExportAdmitter(clusterConfig))
 }
 
+func ServeVMDiskTasks(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageAdmitters.NewVMDiskTaskAdmitter(clusterConfig))
+}
+
 func ServeVmInstancetypes(resp http.ResponseWriter, req *http.Request) {
 	validating_webhooks.Serve(resp, req, &instancetypewebhooks.InstancetypeAdmitter{})
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -783,4 +785,154 @@ var _ = Describe("test configuration", func() {
 		Entry("reference when InstancetypeConfiguration.ReferencePolicy is reference", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Reference)}, v1.Reference),
 		Entry("expand InstancetypeConfiguration.ReferencePolicy is expand", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Expand)}, v1.Expand),
 	)
+
+	Context("guest agent polling", func() {
+		duration := func(d string) *metav1.Duration {
+			parsed, err := time.ParseDuration(d)
+			Expect(err).ToNot(HaveOccurred())
+			return &metav1.Duration{Duration: parsed}
+		}
+
+		vmiWithAnnotation := func(value string) *v1.VirtualMachineInstance {
+			vmi := &v1.VirtualMachineInstance{}
+			if value != "" {
+				vmi.Annotations = map[string]string{v1.GuestAgentPollingAnnotation: value}
+			}
+			return vmi
+		}
+
+		DescribeTable("GetGuestAgentPollingForVMI should return", func(clusterPolling *v1.GuestAgentPollingConfiguration, annotation string, expected *v1.GuestAgentPollingConfiguration) {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				GuestAgentPolling: clusterPolling,
+			})
+			Expect(clusterConfig.GetGuestAgentPollingForVMI(vmiWithAnnotation(annotation))).To(Equal(expected))
+		},
+			Entry("an empty configuration when nothing is set", nil, "", &v1.GuestAgentPollingConfiguration{}),
+			Entry("the cluster configuration without annotation",
+				&v1.GuestAgentPollingConfiguration{CPUStatsInterval: duration("30s")}, "",
+				&v1.GuestAgentPollingConfiguration{CPUStatsInterval: duration("30s")}),
+			Entry("the annotation without cluster configuration", nil, `{"diskStatsInterval":"1m"}`,
+				&v1.GuestAgentPollingConfiguration{DiskStatsInterval: duration("1m")}),
+			Entry("the cluster configuration overridden by the annotation",
+				&v1.GuestAgentPollingConfiguration{CPUStatsInterval: duration("30s"), SysInterval: duration("5m")},
+				`{"cpuStatsInterval":"0s","disksInterval":"2m"}`,
+				&v1.GuestAgentPollingConfiguration{CPUStatsInterval: duration("0s"), SysInterval: duration("5m"), DisksInterval: duration("2m")}),
+		)
+
+		DescribeTable("GetGuestAgentPollingForVMI should fail with an invalid annotation", func(annotation string) {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			_, err := clusterConfig.GetGuestAgentPollingForVMI(vmiWithAnnotation(annotation))
+			Expect(err).To(MatchError(ContainSubstring(v1.GuestAgentPollingAnnotation)))
+		},
+			Entry("which is not JSON", "cpuStatsInterval=30s"),
+			Entry("with an unknown interval", `{"memoryInterval":"30s"}`),
+			Entry("with an invalid duration", `{"cpuStatsInterval":"often"}`),
+		)
+
+		DescribeTable("ValidateGuestAgentPolling", func(polling *v1.GuestAgentPollingConfiguration, expectedFields ...string) {
+			causes := virtconfig.ValidateGuestAgentPolling(k8sfield.NewPath("spec"), polling)
+			fields := []string{}
+			for _, cause := range causes {
+				fields = append(fields, cause.Field)
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("should accept a nil configuration", nil),
+			Entry("should accept positive intervals", &v1.GuestAgentPollingConfiguration{SysInterval: duration("1m"), CPUStatsInterval: duration("10s")}),
+			Entry("should accept disabling optional intervals", &v1.GuestAgentPollingConfiguration{
+				DisksInterval: duration("0s"), CPUStatsInterval: duration("0s"), DiskStatsInterval: duration("0s"),
+			}),
+			Entry("should reject disabling mandatory intervals", &v1.GuestAgentPollingConfiguration{
+				VersionInterval: duration("0s"), FSFreezeStatusInterval: duration("0s"),
+			}, "spec.versionInterval", "spec.fsFreezeStatusInterval"),
+			Entry("should reject negative intervals", &v1.GuestAgentPollingConfiguration{
+				UserInterval: duration("-1s"), DiskStatsInterval: duration("-1s"),
+			}, "spec.userInterval", "spec.diskStatsInterval"),
+		)
+	})
+
+	Context("memory overcommit controller", func() {
+		guestMemory := resource.MustParse("4Gi")
+
+		DescribeTable("GetGuaranteedMemoryForVMI should return", func(config *v1.MemoryOvercommitControllerConfiguration, annotation string, expected string) {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				MemoryOvercommitController: config,
+			})
+			vmi := &v1.VirtualMachineInstance{}
+			if annotation != "" {
+				vmi.Annotations = map[string]string{v1.GuaranteedMemoryAnnotation: annotation}
+			}
+			guaranteed, err := clusterConfig.GetGuaranteedMemoryForVMI(vmi, &guestMemory)
+			Expect(err).ToNot(HaveOccurred())
+			expectedQuantity := resource.MustParse(expected)
+			Expect(guaranteed.Value()).To(Equal(expectedQuantity.Value()))
+		},
+			Entry("the default percentage of the guest memory", nil, "", "2Gi"),
+			Entry("the configured percentage of the guest memory",
+				&v1.MemoryOvercommitControllerConfiguration{GuaranteedMemoryPercent: pointer.P(75)}, "", "3Gi"),
+			Entry("the annotation over the configured percentage",
+				&v1.MemoryOvercommitControllerConfiguration{GuaranteedMemoryPercent: pointer.P(75)}, "1Gi", "1Gi"),
+			Entry("at most the guest memory", nil, "8Gi", "4Gi"),
+		)
+
+		It("GetGuaranteedMemoryForVMI should fail with an invalid annotation", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Annotations = map[string]string{v1.GuaranteedMemoryAnnotation: "plenty"}
+			_, err := clusterConfig.GetGuaranteedMemoryForVMI(vmi, &guestMemory)
+			Expect(err).To(MatchError(ContainSubstring(v1.GuaranteedMemoryAnnotation)))
+		})
+
+		It("should default the interval and the host memory pressure threshold", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			Expect(clusterConfig.GetMemoryOvercommitControllerInterval()).To(Equal(virtconfig.DefaultMemoryOvercommitControllerInterval))
+			Expect(clusterConfig.GetHostMemoryPressureThreshold()).To(Equal(virtconfig.DefaultHostMemoryPressureThreshold))
+		})
+
+		DescribeTable("ValidateMemoryOvercommitController", func(config *v1.MemoryOvercommitControllerConfiguration, expectedFields ...string) {
+			causes := virtconfig.ValidateMemoryOvercommitController(k8sfield.NewPath("spec"), config)
+			fields := []string{}
+			for _, cause := range causes {
+				fields = append(fields, cause.Field)
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("should accept a nil configuration", nil),
+			Entry("should accept valid values", &v1.MemoryOvercommitControllerConfiguration{
+				Interval: &metav1.Duration{Duration: 30 * time.Second}, HostMemoryPressureThreshold: pointer.P(90), GuaranteedMemoryPercent: pointer.P(100),
+			}),
+			Entry("should reject out of range values", &v1.MemoryOvercommitControllerConfiguration{
+				Interval: &metav1.Duration{}, HostMemoryPressureThreshold: pointer.P(0), GuaranteedMemoryPercent: pointer.P(101),
+			}, "spec.interval", "spec.hostMemoryPressureThreshold", "spec.guaranteedMemoryPercent"),
+		)
+	})
+
+	Context("SEV-SNP attestation", func() {
+		DescribeTable("GetAMDKeyDistributionServiceURL should return", func(config *v1.SEVSNPAttestationConfiguration, expected string) {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				SEVSNPAttestation: config,
+			})
+			Expect(clusterConfig.GetAMDKeyDistributionServiceURL()).To(Equal(expected))
+		},
+			Entry("the AMD Key Distribution Service by default", nil, virtconfig.DefaultAMDKeyDistributionServiceURL),
+			Entry("the configured URL",
+				&v1.SEVSNPAttestationConfiguration{KeyDistributionServiceURL: "http://kds-mirror.example.com"}, "http://kds-mirror.example.com"),
+			Entry("nothing when fetching the VCEK chain is disabled",
+				&v1.SEVSNPAttestationConfiguration{KeyDistributionServiceURL: "http://kds-mirror.example.com", DisableVCEKChainFetch: true}, ""),
+		)
+
+		DescribeTable("ValidateSEVSNPAttestation", func(config *v1.SEVSNPAttestationConfiguration, expectedFields ...string) {
+			causes := virtconfig.ValidateSEVSNPAttestation(k8sfield.NewPath("spec"), config)
+			fields := []string{}
+			for _, cause := range causes {
+				fields = append(fields, cause.Field)
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("should accept a nil configuration", nil),
+			Entry("should accept an https URL", &v1.SEVSNPAttestationConfiguration{KeyDistributionServiceURL: "https://kds-mirror.example.com/amd"}),
+			Entry("should reject a relative URL", &v1.SEVSNPAttestationConfiguration{KeyDistributionServiceURL: "kds-mirror"}, "spec.keyDistributionServiceURL"),
+			Entry("should reject another scheme", &v1.SEVSNPAttestationConfiguration{KeyDistributionServiceURL: "ftp://kds-mirror"}, "spec.keyDistributionServiceURL"),
+		)
+	})
 })
@@ -137,6 +137,10 @@ func (config *ClusterConfig) WorkloadEncryptionSEVEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.WorkloadEncryptionSEV)
 }
 
+func (config *ClusterConfig) WorkloadEncryptionTDXEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.WorkloadEncryptionTDX)
+}
+
 func (config *ClusterConfig) DockerSELinuxMCSWorkaroundEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.DockerSELinuxMCSWorkaround)
 }
@@ -173,6 +177,22 @@ func (config *ClusterConfig) ImageVolumeEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.ImageVolume)
 }
 
+func (config *ClusterConfig) ContainerDiskV2Enabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ContainerDiskV2)
+}
+
+func (config *ClusterConfig) VMDiskTasksEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VMDiskTasks)
+}
+
+func (config *ClusterConfig) MemoryOvercommitControllerEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MemoryOvercommitController)
+}
+
+func (config *ClusterConfig) ConsoleGatewayEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ConsoleGateway)
+}
+
 func (config *ClusterConfig) VideoConfigEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.VideoConfig)
 }
@@ -124,6 +124,34 @@ const (
 	//
 	// PasstIPStackMigration enables seamless migration with passt network binding.
 	PasstIPStackMigration = "PasstIPStackMigration"
+
+	// Alpha: v1.7.0
+	//
+	// ContainerDiskV2 allows containerDisks to be served as layered qcow2 backing chains
+	// and to keep their overlay on a PVC.
+	ContainerDiskV2 = "ContainerDiskV2"
+
+	// Alpha: v1.7.0
+	//
+	// VMDiskTasks allows VirtualMachineDiskTasks to run libguestfs operations against the volumes of stopped VMs.
+	VMDiskTasks = "VMDiskTasks"
+
+	// Alpha: v1.7.0
+	//
+	// MemoryOvercommitController lets virt-handler reclaim unused guest memory through the memory balloon
+	// when the node is under memory pressure.
+	MemoryOvercommitController = "MemoryOvercommitController"
+
+	// Alpha: v1.7.0
+	//
+	// WorkloadEncryptionTDX allows to run VMIs as Intel TDX trust domains.
+	WorkloadEncryptionTDX = "WorkloadEncryptionTDX"
+
+	// Alpha: v1.7.0
+	//
+	// ConsoleGateway serves the VNC and serial consoles to browsers on virt-api,
+	// authenticated by short-lived tokens minted by the consoletoken subresource.
+	ConsoleGateway = "ConsoleGateway"
 )
 
 func init() {
@@ -157,4 +185,9 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: VideoConfig, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: PanicDevicesGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: PasstIPStackMigration, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ContainerDiskV2, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VMDiskTasks, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MemoryOvercommitController, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: WorkloadEncryptionTDX, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ConsoleGateway, State: Alpha})
 }
@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+type guestAgentPollingInterval struct {
+	name  string
+	value *metav1.Duration
+	// optional intervals are disabled when set to zero
+	optional bool
+}
+
+func guestAgentPollingIntervals(config *v1.GuestAgentPollingConfiguration) []guestAgentPollingInterval {
+	return []guestAgentPollingInterval{
+		{name: "sysInterval", value: config.SysInterval},
+		{name: "interfacesInterval", value: config.InterfacesInterval},
+		{name: "filesystemInterval", value: config.FilesystemInterval},
+		{name: "userInterval", value: config.UserInterval},
+		{name: "versionInterval", value: config.VersionInterval},
+		{name: "fsFreezeStatusInterval", value: config.FSFreezeStatusInterval},
+		{name: "disksInterval", value: config.DisksInterval, optional: true},
+		{name: "cpuStatsInterval", value: config.CPUStatsInterval, optional: true},
+		{name: "diskStatsInterval", value: config.DiskStatsInterval, optional: true},
+	}
+}
+
+// ValidateGuestAgentPolling checks that all intervals are positive, only the optional intervals may be zero
+func ValidateGuestAgentPolling(field *k8sfield.Path, config *v1.GuestAgentPollingConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for _, interval := range guestAgentPollingIntervals(config) {
+		if interval.value == nil {
+			continue
+		}
+		if interval.value.Duration < 0 || (interval.value.Duration == 0 && !interval.optional) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a positive duration, got %s", field.Child(interval.name), interval.value.Duration),
+				Field:   field.Child(interval.name).String(),
+			})
+		}
+	}
+	return causes
+}
+
+// ParseGuestAgentPollingAnnotation decodes the value of the kubevirt.io/guest-agent-polling annotation
+func ParseGuestAgentPollingAnnotation(value string) (*v1.GuestAgentPollingConfiguration, error) {
+	config := &v1.GuestAgentPollingConfiguration{}
+	decoder := json.NewDecoder(bytes.NewBufferString(value))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(config); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", v1.GuestAgentPollingAnnotation, err)
+	}
+	return config, nil
+}
+
+// GetGuestAgentPollingForVMI returns the cluster wide guest agent polling configuration
+// with the intervals set in the annotation of the VMI taking precedence
+func (c *ClusterConfig) GetGuestAgentPollingForVMI(vmi *v1.VirtualMachineInstance) (*v1.GuestAgentPollingConfiguration, error) {
+	config := &v1.GuestAgentPollingConfiguration{}
+	if clusterConfig := c.GetGuestAgentPolling(); clusterConfig != nil {
+		config = clusterConfig.DeepCopy()
+	}
+
+	value, exists := vmi.Annotations[v1.GuestAgentPollingAnnotation]
+	if !exists {
+		return config, nil
+	}
+	override, err := ParseGuestAgentPollingAnnotation(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if override.SysInterval != nil {
+		config.SysInterval = override.SysInterval
+	}
+	if override.InterfacesInterval != nil {
+		config.InterfacesInterval = override.InterfacesInterval
+	}
+	if override.FilesystemInterval != nil {
+		config.FilesystemInterval = override.FilesystemInterval
+	}
+	if override.UserInterval != nil {
+		config.UserInterval = override.UserInterval
+	}
+	if override.VersionInterval != nil {
+		config.VersionInterval = override.VersionInterval
+	}
+	if override.FSFreezeStatusInterval != nil {
+		config.FSFreezeStatusInterval = override.FSFreezeStatusInterval
+	}
+	if override.DisksInterval != nil {
+		config.DisksInterval = override.DisksInterval
+	}
+	if override.CPUStatsInterval != nil {
+		config.CPUStatsInterval = override.CPUStatsInterval
+	}
+	if override.DiskStatsInterval != nil {
+		config.DiskStatsInterval = override.DiskStatsInterval
+	}
+	return config, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtconfig
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	DefaultMemoryOvercommitControllerInterval = 10 * time.Second
+	DefaultHostMemoryPressureThreshold        = 80
+	DefaultGuaranteedMemoryPercent            = 50
+)
+
+// ValidateMemoryOvercommitController checks that the interval is positive and the percentages are within 1 and 100
+func ValidateMemoryOvercommitController(field *k8sfield.Path, config *v1.MemoryOvercommitControllerConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if config.Interval != nil && config.Interval.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be a positive duration, got %s", field.Child("interval"), config.Interval.Duration),
+			Field:   field.Child("interval").String(),
+		})
+	}
+	percentages := []struct {
+		name  string
+		value *int
+	}{
+		{name: "hostMemoryPressureThreshold", value: config.HostMemoryPressureThreshold},
+		{name: "guaranteedMemoryPercent", value: config.GuaranteedMemoryPercent},
+	}
+	for _, percentage := range percentages {
+		if percentage.value != nil && (*percentage.value < 1 || *percentage.value > 100) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be between 1 and 100, got %d", field.Child(percentage.name), *percentage.value),
+				Field:   field.Child(percentage.name).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func (c *ClusterConfig) GetMemoryOvercommitControllerInterval() time.Duration {
+	config := c.GetConfig().MemoryOvercommitController
+	if config == nil || config.Interval == nil || config.Interval.Duration <= 0 {
+		return DefaultMemoryOvercommitControllerInterval
+	}
+	return config.Interval.Duration
+}
+
+func (c *ClusterConfig) GetHostMemoryPressureThreshold() int {
+	config := c.GetConfig().MemoryOvercommitController
+	if config == nil || config.HostMemoryPressureThreshold == nil {
+		return DefaultHostMemoryPressureThreshold
+	}
+	return *config.HostMemoryPressureThreshold
+}
+
+// GetGuaranteedMemoryForVMI returns the amount of guest memory which must not be reclaimed from the VMI,
+// the kubevirt.io/guaranteed-memory annotation takes precedence over the cluster wide percentage
+func (c *ClusterConfig) GetGuaranteedMemoryForVMI(vmi *v1.VirtualMachineInstance, guestMemory *resource.Quantity) (*resource.Quantity, error) {
+	if value, exists := vmi.Annotations[v1.GuaranteedMemoryAnnotation]; exists {
+		guaranteed, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %v", v1.GuaranteedMemoryAnnotation, err)
+		}
+		if guaranteed.Cmp(*guestMemory) > 0 {
+			return guestMemory, nil
+		}
+		return &guaranteed, nil
+	}
+
+	percent := DefaultGuaranteedMemoryPercent
+	if config := c.GetConfig().MemoryOvercommitController; config != nil && config.GuaranteedMemoryPercent != nil {
+		percent = *config.GuaranteedMemoryPercent
+	}
+	return resource.NewQuantity(guestMemory.Value()*int64(percent)/100, resource.BinarySI), nil
+}
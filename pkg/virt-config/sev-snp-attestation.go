@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virtconfig
+
+import (
+	"fmt"
+	"net/url"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// DefaultAMDKeyDistributionServiceURL serves the VCEK certificates of AMD processors
+const DefaultAMDKeyDistributionServiceURL = "https://kdsintf.amd.com"
+
+// ValidateSEVSNPAttestation checks that the Key Distribution Service URL is an absolute http or https URL
+func ValidateSEVSNPAttestation(field *k8sfield.Path, config *v1.SEVSNPAttestationConfiguration) []metav1.StatusCause {
+	if config == nil || config.KeyDistributionServiceURL == "" {
+		return nil
+	}
+
+	kdsURL, err := url.Parse(config.KeyDistributionServiceURL)
+	if err == nil && (kdsURL.Scheme != "http" && kdsURL.Scheme != "https" || kdsURL.Host == "") {
+		err = fmt.Errorf("it must be an absolute http or https URL")
+	}
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is invalid: %v", field.Child("keyDistributionServiceURL"), err),
+			Field:   field.Child("keyDistributionServiceURL").String(),
+		}}
+	}
+	return nil
+}
+
+// GetAMDKeyDistributionServiceURL returns the base URL virt-handler fetches the VCEK certificates from,
+// or an empty string when it must not fetch them
+func (c *ClusterConfig) GetAMDKeyDistributionServiceURL() string {
+	config := c.GetConfig().SEVSNPAttestation
+	switch {
+	case config == nil:
+		return DefaultAMDKeyDistributionServiceURL
+	case config.DisableVCEKChainFetch:
+		return ""
+	case config.KeyDistributionServiceURL != "":
+		return config.KeyDistributionServiceURL
+	default:
+		return DefaultAMDKeyDistributionServiceURL
+	}
+}
@@ -416,6 +416,10 @@ func (c *ClusterConfig) GetVMStateStorageClass() string {
 	return c.GetConfig().VMStateStorageClass
 }
 
+func (c *ClusterConfig) GetVMStateEncryption() *v1.VMStateEncryption {
+	return c.GetConfig().VMStateEncryption
+}
+
 func (c *ClusterConfig) IsFreePageReportingDisabled() bool {
 	return c.GetConfig().VirtualMachineOptions != nil && c.GetConfig().VirtualMachineOptions.DisableFreePageReporting != nil
 }
@@ -428,6 +432,10 @@ func (c *ClusterConfig) GetKSMConfiguration() *v1.KSMConfiguration {
 	return c.GetConfig().KSMConfiguration
 }
 
+func (c *ClusterConfig) GetGuestAgentPolling() *v1.GuestAgentPollingConfiguration {
+	return c.GetConfig().GuestAgentPolling
+}
+
 func (c *ClusterConfig) GetMaximumCpuSockets() (numOfSockets uint32) {
 	liveConfig := c.GetConfig().LiveUpdateConfiguration
 	if liveConfig != nil && liveConfig.MaxCpuSockets != nil {
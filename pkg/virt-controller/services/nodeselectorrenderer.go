@@ -23,6 +23,8 @@ type NodeSelectorRenderer struct {
 	realtimeEnabled        bool
 	sevEnabled             bool
 	sevESEnabled           bool
+	sevSNPEnabled          bool
+	tdxEnabled             bool
 	SecureExecutionEnabled bool
 }
 
@@ -80,6 +82,12 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 	if nsr.sevESEnabled {
 		nsr.enableSelectorLabel(v1.SEVESLabel)
 	}
+	if nsr.sevSNPEnabled {
+		nsr.enableSelectorLabel(v1.SEVSNPLabel)
+	}
+	if nsr.tdxEnabled {
+		nsr.enableSelectorLabel(v1.TDXLabel)
+	}
 	if nsr.SecureExecutionEnabled {
 		nsr.enableSelectorLabel(v1.SecureExecutionLabel)
 	}
@@ -111,6 +119,18 @@ func WithSEVESSelector() NodeSelectorRendererOption {
 	}
 }
 
+func WithSEVSNPSelector() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.sevSNPEnabled = true
+	}
+}
+
+func WithTDXSelector() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.tdxEnabled = true
+	}
+}
+
 func WithSecureExecutionSelector() NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.SecureExecutionEnabled = true
@@ -472,6 +472,11 @@ func GetMemoryOverhead(vmi *v1.VirtualMachineInstance, cpuArch string, additiona
 		overhead.Add(resource.MustParse("256Mi"))
 	}
 
+	// TDX guests bounce their DMA through shared swiotlb buffers as well, like SEV guests.
+	if util.IsTDXVMI(vmi) {
+		overhead.Add(resource.MustParse("256Mi"))
+	}
+
 	// Having a TPM device will spawn a swtpm process
 	// In `ps`, swtpm has VSZ of 53808 and RSS of 3496, so 53Mi should do
 	if tpm.HasDevice(&vmi.Spec) {
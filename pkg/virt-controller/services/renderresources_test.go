@@ -761,6 +761,25 @@ var _ = Describe("GetMemoryOverhead calculation", func() {
 		})
 	})
 
+	When("the vmi requests Intel TDX", func() {
+		BeforeEach(func() {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				TDX: &v1.TDX{},
+			}
+		})
+
+		It("should add TDX overhead", func() {
+			expected := resource.NewScaledQuantity(0, resource.Kilo)
+			expected.Add(*baseOverhead)
+			expected.Add(*staticOverhead)
+			expected.Add(*videoRAMOverhead)
+			expected.Add(*coresOverhead)
+			expected.Add(*sevOverhead)
+			overhead := GetMemoryOverhead(vmi, "amd64", nil)
+			Expect(overhead.Value()).To(BeEquivalentTo(expected.Value()))
+		})
+	})
+
 	When("the vmi requests TPM device", func() {
 		BeforeEach(func() {
 			vmi.Spec.Domain.Devices = v1.Devices{
@@ -21,6 +21,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
@@ -180,6 +181,12 @@ func withVMIVolumes(pvcStore cache.Store, vmiSpecVolumes []v1.Volume, vmiVolumeS
 			if volume.CloudInitConfigDrive != nil {
 				renderer.handleCloudInitConfigDrive(volume)
 			}
+
+			if containerdisk.HasPersistentOverlay(volume.ContainerDisk) {
+				if err := renderer.handleContainerDiskOverlay(volume, pvcStore); err != nil {
+					return err
+				}
+			}
 		}
 		return nil
 	}
@@ -393,7 +400,20 @@ func PathForNVram(vmi *v1.VirtualMachineInstance) string {
 	return nvramPath
 }
 
-func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName string) VolumeRendererOption {
+// BackendStorageStateDirs returns the directories of the VM state kept on the backend-storage PVC, by name
+func BackendStorageStateDirs(vmi *v1.VirtualMachineInstance) map[string]string {
+	dirs := map[string]string{}
+	if tpm.HasPersistentDevice(&vmi.Spec) {
+		dirs["swtpm"] = PathForSwtpm(vmi)
+		dirs["swtpm-localca"] = PathForSwtpmLocalca(vmi)
+	}
+	if backendstorage.HasPersistentEFI(&vmi.Spec) {
+		dirs["nvram"] = PathForNVram(vmi)
+	}
+	return dirs
+}
+
+func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName string, encryption *v1.VMStateEncryption) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if !backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
 			return nil
@@ -438,6 +458,29 @@ func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName st
 			})
 		}
 
+		if encryption != nil {
+			// The state is encrypted on the PVC, virt-launcher decrypts it into the state directories
+			keysVolumeName := "vm-state-keys"
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: keysVolumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					Secret: &k8sv1.SecretVolumeSource{
+						SecretName: backendstorage.EncryptionKeySecretName(encryption),
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      volumeName,
+				ReadOnly:  false,
+				MountPath: backendstorage.EncryptedStateMountPath,
+			}, k8sv1.VolumeMount{
+				Name:      keysVolumeName,
+				ReadOnly:  true,
+				MountPath: backendstorage.EncryptionKeysMountPath,
+			})
+			return nil
+		}
+
 		if tpm.HasPersistentDevice(&vmi.Spec) {
 			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
 				Name:      volumeName,
@@ -486,6 +529,77 @@ func withVirioFS() VolumeRendererOption {
 	}
 }
 
+// withTDXQuoteGenerationService mounts the socket directory of the quote generation service of the node,
+// which QEMU forwards the quote requests of the guest to
+func withTDXQuoteGenerationService() VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		hostPathType := k8sv1.HostPathDirectory
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: "tdx-qgs",
+			VolumeSource: k8sv1.VolumeSource{
+				HostPath: &k8sv1.HostPathVolumeSource{
+					Path: launchsecurity.TDXQuoteGenerationServiceDir,
+					Type: &hostPathType,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath("tdx-qgs", launchsecurity.TDXQuoteGenerationServiceDir))
+		return nil
+	}
+}
+
+// withSecureBootKeys mounts the Secret or ConfigMap holding the SecureBoot keys virt-launcher enrolls into the EFI NVRAM
+func withSecureBootKeys(source *v1.SecureBootKeysSource) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		const volumeName = "secureboot-keys"
+		var volumeSource k8sv1.VolumeSource
+		switch {
+		case source.Secret != nil:
+			volumeSource.Secret = &k8sv1.SecretVolumeSource{
+				SecretName: source.Secret.Name,
+			}
+		case source.ConfigMap != nil:
+			volumeSource.ConfigMap = &k8sv1.ConfigMapVolumeSource{
+				LocalObjectReference: k8sv1.LocalObjectReference{
+					Name: source.ConfigMap.Name,
+				},
+			}
+		default:
+			return fmt.Errorf("SecureBoot keys must have Secret or ConfigMap reference set")
+		}
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name:         volumeName,
+			VolumeSource: volumeSource,
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      volumeName,
+			MountPath: config.SecureBootKeysSourceDir,
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
+// withHibernation mounts the PVC virt-launcher saves the domain state to, and restores it from
+func withHibernation(hibernation *v1.Hibernation) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		const volumeName = "hibernation"
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: volumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: hibernation.ClaimName,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      volumeName,
+			MountPath: config.HibernationStateDir,
+		})
+		return nil
+	}
+}
+
 func withHugepages() VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		hugepagesBasePath := "/dev/hugepages"
@@ -638,6 +752,33 @@ func (vr *VolumeRenderer) handleDataVolume(volume v1.Volume, pvcStore cache.Stor
 	return nil
 }
 
+func (vr *VolumeRenderer) handleContainerDiskOverlay(volume v1.Volume, pvcStore cache.Store) error {
+	claimName := volume.ContainerDisk.Overlay.ClaimName
+	_, exists, isBlock, err := types.IsPVCBlockFromStore(pvcStore, vr.namespace, claimName)
+	if err != nil {
+		return err
+	} else if !exists {
+		return types.PvcNotFoundError{Reason: fmt.Sprintf("didn't find PVC %v", claimName)}
+	} else if isBlock {
+		return fmt.Errorf("overlay PVC %v of containerDisk %v must have a filesystem volume mode", claimName, volume.Name)
+	}
+
+	volumeName := volume.Name + "-overlay"
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volumeName,
+		VolumeSource: k8sv1.VolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+	vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      volumeName,
+		MountPath: containerdisk.GetOverlayDirFromLauncherView(volume.Name),
+	})
+	return nil
+}
+
 func (vr *VolumeRenderer) handleHostDisk(volume v1.Volume) {
 	var hostPathType k8sv1.HostPathType
 
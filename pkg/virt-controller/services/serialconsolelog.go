@@ -13,6 +13,11 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
+const (
+	serialConsoleRecordingVolumeName = "serial-console-recording"
+	serialConsoleRecordingDir        = "/var/run/kubevirt-serial-console-recording"
+)
+
 func generateSerialConsoleLogContainer(vmi *v1.VirtualMachineInstance, image string, config *virtconfig.ClusterConfig, virtLauncherLogVerbosity uint) *k8sv1.Container {
 	const serialPort = 0
 	if isSerialConsoleLogEnabled(vmi, config) {
@@ -45,6 +50,18 @@ func generateSerialConsoleLogContainer(vmi *v1.VirtualMachineInstance, image str
 			RestartPolicy: pointer.P(k8sv1.ContainerRestartPolicyAlways),
 		}
 
+		if recording := vmi.Spec.Domain.Devices.SerialConsoleRecording; recording != nil {
+			recordingFile := "-"
+			if recording.ClaimName != "" {
+				recordingFile = fmt.Sprintf("%s/%s.cast", serialConsoleRecordingDir, vmi.ObjectMeta.UID)
+				guestConsoleLog.VolumeMounts = append(guestConsoleLog.VolumeMounts, k8sv1.VolumeMount{
+					Name:      serialConsoleRecordingVolumeName,
+					MountPath: serialConsoleRecordingDir,
+				})
+			}
+			guestConsoleLog.Args = append(guestConsoleLog.Args, "--recording", recordingFile)
+		}
+
 		guestConsoleLog.Env = append(guestConsoleLog.Env, k8sv1.EnvVar{Name: ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY, Value: fmt.Sprint(virtLauncherLogVerbosity)})
 
 		return guestConsoleLog
@@ -53,6 +70,22 @@ func generateSerialConsoleLogContainer(vmi *v1.VirtualMachineInstance, image str
 	return nil
 }
 
+// serialConsoleRecordingVolume returns the volume of the PVC the serial console is recorded to, if any
+func serialConsoleRecordingVolume(vmi *v1.VirtualMachineInstance) *k8sv1.Volume {
+	recording := vmi.Spec.Domain.Devices.SerialConsoleRecording
+	if recording == nil || recording.ClaimName == "" {
+		return nil
+	}
+	return &k8sv1.Volume{
+		Name: serialConsoleRecordingVolumeName,
+		VolumeSource: k8sv1.VolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: recording.ClaimName,
+			},
+		},
+	}
+}
+
 func isSerialConsoleLogEnabled(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) bool {
 	if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && *vmi.Spec.Domain.Devices.AutoattachSerialConsole == false {
 		return false
@@ -60,6 +93,9 @@ func isSerialConsoleLogEnabled(vmi *v1.VirtualMachineInstance, config *virtconfi
 	if vmi.Spec.Domain.Devices.LogSerialConsole != nil {
 		return *vmi.Spec.Domain.Devices.LogSerialConsole
 	}
+	if vmi.Spec.Domain.Devices.SerialConsoleRecording != nil {
+		return true
+	}
 	return !config.IsSerialConsoleLogDisabled()
 }
 
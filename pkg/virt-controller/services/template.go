@@ -25,6 +25,7 @@ import (
 	"maps"
 	"math/rand"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -175,6 +176,7 @@ func isFeatureStateEnabled(fs *v1.FeatureState) bool {
 func setNodeAffinityForPod(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
 	setNodeAffinityForHostModelCpuModel(vmi, pod)
 	setNodeAffinityForbiddenFeaturePolicy(vmi, pod)
+	setNodeAffinityForRealtimeTuning(vmi, pod)
 }
 
 func setNodeAffinityForHostModelCpuModel(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
@@ -195,6 +197,32 @@ func setNodeAffinityForbiddenFeaturePolicy(vmi *v1.VirtualMachineInstance, pod *
 	}
 }
 
+// setNodeAffinityForRealtimeTuning prefers the nodes on which all the realtime tuning checks passed,
+// without preventing realtime VMIs from running on nodes which are only realtime capable.
+func setNodeAffinityForRealtimeTuning(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if !vmi.IsRealtimeEnabled() {
+		return
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+		pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+		k8sv1.PreferredSchedulingTerm{
+			Weight: 100,
+			Preference: k8sv1.NodeSelectorTerm{
+				MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+					Key:      v1.RealtimeTunedLabel,
+					Operator: k8sv1.NodeSelectorOpIn,
+					Values:   []string{"true"},
+				}},
+			},
+		})
+}
+
 func modifyNodeAffintyToRejectLabel(origAffinity *k8sv1.Affinity, labelToReject string) *k8sv1.Affinity {
 	affinity := origAffinity.DeepCopy()
 	requirement := k8sv1.NodeSelectorRequirement{
@@ -234,6 +262,29 @@ func modifyNodeAffintyToRejectLabel(origAffinity *k8sv1.Affinity, labelToReject
 	return affinity
 }
 
+// setPodAntiAffinityForSharedDiskGroup spreads the members of a shared disk group across nodes
+func setPodAntiAffinityForSharedDiskGroup(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if vmi.Spec.SharedDiskGroup == "" {
+		return
+	}
+	term := k8sv1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				v1.SharedDiskGroupLabel: vmi.Spec.SharedDiskGroup,
+			},
+		},
+		TopologyKey: k8sv1.LabelHostname,
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if pod.Spec.Affinity.PodAntiAffinity == nil {
+		pod.Spec.Affinity.PodAntiAffinity = &k8sv1.PodAntiAffinity{}
+	}
+	pod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+		pod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+}
+
 func sysprepVolumeSource(sysprepVolume v1.SysprepSource) (k8sv1.VolumeSource, error) {
 	logger := log.DefaultLogger()
 	if sysprepVolume.Secret != nil {
@@ -261,15 +312,14 @@ func (t *templateService) GetLauncherImage() string {
 }
 
 func (t *templateService) RenderLaunchManifestNoVm(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
-	backendStoragePVCName := ""
+	var backendStoragePVC *k8sv1.PersistentVolumeClaim
 	if backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
-		backendStoragePVC := backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
+		backendStoragePVC = backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
 		if backendStoragePVC == nil {
 			return nil, fmt.Errorf("can't generate manifest without backend-storage PVC, waiting for the PVC to be created")
 		}
-		backendStoragePVCName = backendStoragePVC.Name
 	}
-	return t.renderLaunchManifest(vmi, nil, backendStoragePVCName, true)
+	return t.renderLaunchManifest(vmi, nil, backendStoragePVC, true)
 }
 
 func (t *templateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance, migration *v1.VirtualMachineInstanceMigration, sourcePod *k8sv1.Pod) (*k8sv1.Pod, error) {
@@ -277,15 +327,14 @@ func (t *templateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance
 	if err != nil {
 		return nil, fmt.Errorf("can not proceed with the migration when no reproducible image digest can be detected: %v", err)
 	}
-	backendStoragePVCName := ""
+	var backendStoragePVC *k8sv1.PersistentVolumeClaim
 	if backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
-		backendStoragePVC := backendstorage.PVCForMigrationTarget(t.persistentVolumeClaimStore, migration)
+		backendStoragePVC = backendstorage.PVCForMigrationTarget(t.persistentVolumeClaimStore, migration)
 		if backendStoragePVC == nil {
 			return nil, fmt.Errorf("can't generate manifest without backend-storage PVC, waiting for the PVC to be created")
 		}
-		backendStoragePVCName = backendStoragePVC.Name
 	}
-	targetPod, err := t.renderLaunchManifest(vmi, reproducibleImageIDs, backendStoragePVCName, false)
+	targetPod, err := t.renderLaunchManifest(vmi, reproducibleImageIDs, backendStoragePVC, false)
 	if err != nil {
 		return nil, err
 	}
@@ -303,15 +352,14 @@ func (t *templateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance
 }
 
 func (t *templateService) RenderLaunchManifest(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
-	backendStoragePVCName := ""
+	var backendStoragePVC *k8sv1.PersistentVolumeClaim
 	if backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
-		backendStoragePVC := backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
+		backendStoragePVC = backendstorage.PVCForVMI(t.persistentVolumeClaimStore, vmi)
 		if backendStoragePVC == nil {
 			return nil, fmt.Errorf("can't generate manifest without backend-storage PVC, waiting for the PVC to be created")
 		}
-		backendStoragePVCName = backendStoragePVC.Name
 	}
-	return t.renderLaunchManifest(vmi, nil, backendStoragePVCName, false)
+	return t.renderLaunchManifest(vmi, nil, backendStoragePVC, false)
 }
 
 func (t *templateService) IsPPC64() bool {
@@ -345,7 +393,7 @@ func computePodSecurityContext(vmi *v1.VirtualMachineInstance, seccomp *k8sv1.Se
 	return psc
 }
 
-func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, imageIDs map[string]string, backendStoragePVCName string, tempPod bool) (*k8sv1.Pod, error) {
+func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, imageIDs map[string]string, backendStoragePVC *k8sv1.PersistentVolumeClaim, tempPod bool) (*k8sv1.Pod, error) {
 	precond.MustNotBeNil(vmi)
 	domain := precond.MustNotBeEmpty(vmi.GetObjectMeta().GetName())
 	namespace := precond.MustNotBeEmpty(vmi.GetObjectMeta().GetNamespace())
@@ -389,6 +437,17 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 
 	ovmfPath := t.clusterConfig.GetOVMFPath(vmi.Spec.Architecture)
 
+	backendStoragePVCName := ""
+	vmStateEncryption := t.clusterConfig.GetVMStateEncryption()
+	if backendStoragePVC != nil {
+		backendStoragePVCName = backendStoragePVC.Name
+		// The state stays encrypted with the mode and keys the backend-storage PVC was encrypted with
+		vmStateEncryption, err = backendstorage.PVCEncryption(backendStoragePVC, vmStateEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var requestedHookSidecarList hooks.HookSidecarList
 	for _, sidecarCreator := range t.sidecarCreators {
 		sidecars, err := sidecarCreator(vmi, t.clusterConfig.GetConfig())
@@ -429,6 +488,17 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			log.Log.Object(vmi).Infof("Applying custom debug filters for vmi %s: %s", vmi.Name, customDebugFilters)
 			command = append(command, "--libvirt-log-filters", customDebugFilters)
 		}
+		guestAgentPolling, err := t.clusterConfig.GetGuestAgentPollingForVMI(vmi)
+		if err != nil {
+			return nil, err
+		}
+		command = append(command, guestAgentPollingArgs(guestAgentPolling)...)
+		if vmStateEncryption != nil && backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
+			command = append(command,
+				"--vm-state-encryption", backendstorage.EncryptionMode(vmStateEncryption),
+				"--vm-state-dirs", vmStateDirsArg(BackendStorageStateDirs(vmi)),
+			)
+		}
 	}
 
 	if t.clusterConfig.AllowEmulation() {
@@ -444,7 +514,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		command = append(command, "--simulate-crash")
 	}
 
-	volumeRenderer, err := t.newVolumeRenderer(vmi, namespace, requestedHookSidecarList, backendStoragePVCName)
+	volumeRenderer, err := t.newVolumeRenderer(vmi, namespace, requestedHookSidecarList, backendStoragePVCName, vmStateEncryption)
 	if err != nil {
 		return nil, err
 	}
@@ -562,6 +632,9 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	sconsolelogContainer := generateSerialConsoleLogContainer(vmi, t.launcherImage, t.clusterConfig, virtLauncherLogVerbosity)
 	if sconsolelogContainer != nil {
 		initContainers = append(initContainers, *sconsolelogContainer)
+		if recordingVolume := serialConsoleRecordingVolume(vmi); recordingVolume != nil {
+			sidecarVolumes = append(sidecarVolumes, *recordingVolume)
+		}
 	}
 
 	if !t.clusterConfig.ImageVolumeEnabled() && (HaveContainerDiskVolume(vmi.Spec.Volumes) || util.HasKernelBootContainerImage(vmi)) {
@@ -654,6 +727,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	}
 
 	setNodeAffinityForPod(vmi, &pod)
+	setPodAntiAffinityForSharedDiskGroup(vmi, &pod)
 
 	serviceAccountName := serviceAccount(vmi.Spec.Volumes...)
 	if len(serviceAccountName) > 0 {
@@ -716,6 +790,14 @@ func (t *templateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		log.Log.V(4).Info("Add SEV-ES node label selector")
 		opts = append(opts, WithSEVESSelector())
 	}
+	if util.IsSEVSNPVMI(vmi) {
+		log.Log.V(4).Info("Add SEV-SNP node label selector")
+		opts = append(opts, WithSEVSNPSelector())
+	}
+	if util.IsTDXVMI(vmi) {
+		log.Log.V(4).Info("Add TDX node label selector")
+		opts = append(opts, WithTDXSelector())
+	}
 	if util.IsSecureExecutionVMI(vmi) {
 		log.Log.V(4).Info("Add Secure Execution node label selector")
 		opts = append(opts, WithSecureExecutionSelector())
@@ -823,13 +905,13 @@ func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstanc
 	return containerRenderer
 }
 
-func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, namespace string, requestedHookSidecarList hooks.HookSidecarList, backendStoragePVCName string) (*VolumeRenderer, error) {
+func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, namespace string, requestedHookSidecarList hooks.HookSidecarList, backendStoragePVCName string, vmStateEncryption *v1.VMStateEncryption) (*VolumeRenderer, error) {
 	imageVolumeFeatureGateEnabled := t.clusterConfig.ImageVolumeEnabled()
 	volumeOpts := []VolumeRendererOption{
 		withVMIConfigVolumes(vmi.Spec.Domain.Devices.Disks, vmi.Spec.Volumes),
 		withVMIVolumes(t.persistentVolumeClaimStore, vmi.Spec.Volumes, vmi.Status.VolumeStatus),
 		withAccessCredentials(vmi.Spec.AccessCredentials),
-		withBackendStorage(vmi, backendStoragePVCName),
+		withBackendStorage(vmi, backendStoragePVCName, vmStateEncryption),
 	}
 	if imageVolumeFeatureGateEnabled {
 		volumeOpts = append(volumeOpts, withImageVolumes(vmi))
@@ -859,6 +941,18 @@ func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, name
 		volumeOpts = append(volumeOpts, withVirioFS())
 	}
 
+	if util.IsTDXAttestationRequested(vmi) {
+		volumeOpts = append(volumeOpts, withTDXQuoteGenerationService())
+	}
+
+	if keys := util.SecureBootKeysSource(&vmi.Spec); keys != nil {
+		volumeOpts = append(volumeOpts, withSecureBootKeys(keys))
+	}
+
+	if vmi.Spec.Hibernation != nil {
+		volumeOpts = append(volumeOpts, withHibernation(vmi.Spec.Hibernation))
+	}
+
 	volumeRenderer, err := NewVolumeRenderer(
 		imageVolumeFeatureGateEnabled,
 		namespace,
@@ -1570,12 +1664,45 @@ func podLabels(vmi *v1.VirtualMachineInstance, hostName string) map[string]strin
 	labels[v1.AppLabel] = "virt-launcher"
 	labels[v1.CreatedByLabel] = string(vmi.UID)
 	labels[v1.VirtualMachineNameLabel] = hostName
+	if vmi.Spec.SharedDiskGroup != "" {
+		labels[v1.SharedDiskGroupLabel] = vmi.Spec.SharedDiskGroup
+	}
 	if val, exists := vmi.Annotations[istio.InjectSidecarAnnotation]; exists {
 		labels[istio.InjectSidecarLabel] = val
 	}
 	return labels
 }
 
+// guestAgentPollingArgs returns the virt-launcher flags for the configured guest agent polling intervals,
+// unset intervals are left to the virt-launcher defaults
+func guestAgentPollingArgs(config *v1.GuestAgentPollingConfiguration) []string {
+	var args []string
+	addArg := func(flag string, interval *metav1.Duration) {
+		if interval != nil {
+			args = append(args, flag, interval.Duration.String())
+		}
+	}
+	addArg("--qemu-agent-sys-interval", config.SysInterval)
+	addArg("--qemu-agent-interfaces-interval", config.InterfacesInterval)
+	addArg("--qemu-agent-file-interval", config.FilesystemInterval)
+	addArg("--qemu-agent-user-interval", config.UserInterval)
+	addArg("--qemu-agent-version-interval", config.VersionInterval)
+	addArg("--qemu-fsfreeze-status-interval", config.FSFreezeStatusInterval)
+	addArg("--qemu-agent-disks-interval", config.DisksInterval)
+	addArg("--qemu-agent-cpustats-interval", config.CPUStatsInterval)
+	addArg("--qemu-agent-diskstats-interval", config.DiskStatsInterval)
+	return args
+}
+
+func vmStateDirsArg(dirs map[string]string) string {
+	var pairs []string
+	for name, path := range dirs {
+		pairs = append(pairs, name+"="+path)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
+
 func readinessGates() []k8sv1.PodReadinessGate {
 	return []k8sv1.PodReadinessGate{
 		{
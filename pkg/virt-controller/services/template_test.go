@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -56,12 +57,14 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/network/istio"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
 	"kubevirt.io/kubevirt/tools/vms-generator/utils"
 )
 
@@ -1215,6 +1218,18 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+				Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(
+					k8sv1.PreferredSchedulingTerm{
+						Weight: 100,
+						Preference: k8sv1.NodeSelectorTerm{
+							MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+								Key:      v1.RealtimeTunedLabel,
+								Operator: k8sv1.NodeSelectorOpIn,
+								Values:   []string{"true"},
+							}},
+						},
+					},
+				))
 			})
 
 			It("should not add realtime node label selector when no realtime workload", func() {
@@ -1230,6 +1245,9 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(v1.RealtimeLabel))))
+				if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
+					Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(BeEmpty())
+				}
 			})
 
 			Context("When scheduling SEV workloads", func() {
@@ -1285,6 +1303,129 @@ var _ = Describe("Template", func() {
 					Entry("when no SEV-ES policy bit is set", &v1.SEVPolicy{EncryptedState: nil}),
 					Entry("when SEV-ES policy bit is set to false", &v1.SEVPolicy{EncryptedState: pointer.P(false)}),
 				)
+
+				It("should add SEV and SEV-SNP node label selector with SEV-SNP workload", func() {
+					vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+						SEV: &v1.SEV{
+							Policy: &v1.SEVPolicy{
+								SecureNestedPaging: pointer.P(true),
+							},
+						},
+					}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.SEVLabel, "true"))
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.SEVSNPLabel, "true"))
+				})
+
+				It("should not add SEV-SNP node label selector with SEV-ES workload", func() {
+					vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+						SEV: &v1.SEV{
+							Policy: &v1.SEVPolicy{
+								EncryptedState: pointer.P(true),
+							},
+						},
+					}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(Not(HaveKey(v1.SEVSNPLabel)))
+				})
+			})
+
+			Context("When scheduling TDX workloads", func() {
+				var vmi *v1.VirtualMachineInstance
+
+				BeforeEach(func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					vmi = api.NewMinimalVMI("testvmi")
+					vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{TDX: &v1.TDX{}}
+				})
+
+				It("should add TDX node label selector with TDX workload", func() {
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.TDXLabel, "true"))
+					Expect(pod.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "tdx-qgs")))
+				})
+
+				It("should mount the quote generation service of the node when attestation is requested", func() {
+					vmi.Spec.Domain.LaunchSecurity.TDX.Attestation = &v1.TDXAttestation{}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.HostPath.Path", launchsecurity.TDXQuoteGenerationServiceDir)))
+					Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(HaveField("MountPath", launchsecurity.TDXQuoteGenerationServiceDir)))
+				})
+			})
+
+			Context("With custom SecureBoot keys", func() {
+				var vmi *v1.VirtualMachineInstance
+
+				BeforeEach(func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					vmi = api.NewMinimalVMI("testvmi")
+					vmi.Spec.Domain.Firmware = &v1.Firmware{
+						Bootloader: &v1.Bootloader{
+							EFI: &v1.EFI{SecureBoot: pointer.P(true)},
+						},
+					}
+				})
+
+				It("should not mount SecureBoot keys by default", func() {
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "secureboot-keys")))
+				})
+
+				DescribeTable("should mount the SecureBoot keys", func(source *v1.SecureBootKeysSource, expected k8sv1.VolumeSource) {
+					vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBootKeys = source
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{Name: "secureboot-keys", VolumeSource: expected}))
+					Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+						Name:      "secureboot-keys",
+						MountPath: k6tconfig.SecureBootKeysSourceDir,
+						ReadOnly:  true,
+					}))
+				},
+					Entry("from a Secret",
+						&v1.SecureBootKeysSource{Secret: &k8sv1.LocalObjectReference{Name: "keys"}},
+						k8sv1.VolumeSource{Secret: &k8sv1.SecretVolumeSource{SecretName: "keys"}}),
+					Entry("from a ConfigMap",
+						&v1.SecureBootKeysSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "keys"}},
+						k8sv1.VolumeSource{ConfigMap: &k8sv1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "keys"}}}),
+				)
+			})
+
+			Context("With hibernation", func() {
+				It("should not mount a hibernation PVC by default", func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					pod, err := svc.RenderLaunchManifest(api.NewMinimalVMI("testvmi"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "hibernation")))
+				})
+
+				It("should mount the hibernation PVC", func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					vmi := api.NewMinimalVMI("testvmi")
+					vmi.Spec.Hibernation = &v1.Hibernation{ClaimName: "testvmi-hibernation"}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+						Name: "hibernation",
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "testvmi-hibernation"},
+						},
+					}))
+					Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+						Name:      "hibernation",
+						MountPath: k6tconfig.HibernationStateDir,
+					}))
+				})
 			})
 
 			Context("When scheduling Secure Execution workloads", func() {
@@ -1755,6 +1896,39 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.Affinity.PodAntiAffinity).To(BeEquivalentTo(&podAntiAffinity))
 			})
 
+			It("should spread the members of a shared disk group across nodes", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				userTerm := k8sv1.PodAffinityTerm{TopologyKey: "zone"}
+				vm := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "testvm", Namespace: "default", UID: "1234"},
+					Spec: v1.VirtualMachineInstanceSpec{
+						SharedDiskGroup: "cluster",
+						Affinity: &k8sv1.Affinity{PodAntiAffinity: &k8sv1.PodAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []k8sv1.PodAffinityTerm{userTerm},
+						}},
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								DisableHotplug: true,
+							},
+						},
+					},
+				}
+				pod, err := svc.RenderLaunchManifest(&vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Labels).To(HaveKeyWithValue(v1.SharedDiskGroupLabel, "cluster"))
+				Expect(pod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(
+					userTerm,
+					k8sv1.PodAffinityTerm{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{v1.SharedDiskGroupLabel: "cluster"},
+						},
+						TopologyKey: k8sv1.LabelHostname,
+					},
+				))
+				Expect(vm.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(HaveLen(1))
+			})
+
 			It("should add tolerations to pod", func() {
 				config, kvStore, svc = configFactory(defaultArch)
 				podToleration := k8sv1.Toleration{Key: "test"}
@@ -4674,6 +4848,173 @@ var _ = Describe("Template", func() {
 
 				expectStateMounts(pod)
 			})
+
+			It("should mount the whole pvc and the keys Secret when the VM state is encrypted", func() {
+				pvc.Labels = map[string]string{"persistent-state-for": vmiName}
+				err := pvcCache.Add(pvc)
+				Expect(err).NotTo(HaveOccurred())
+
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.VMStateEncryption = &v1.VMStateEncryption{SecretName: "keys"}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := api.NewMinimalVMI(vmiName)
+				vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{
+					Persistent: pointer.P(true),
+				}
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: "vm-state-keys",
+					VolumeSource: k8sv1.VolumeSource{
+						Secret: &k8sv1.SecretVolumeSource{
+							SecretName: "keys",
+						},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElements(
+					k8sv1.VolumeMount{
+						MountPath: backendstorage.EncryptedStateMountPath,
+						Name:      "vm-state",
+					},
+					k8sv1.VolumeMount{
+						MountPath: backendstorage.EncryptionKeysMountPath,
+						Name:      "vm-state-keys",
+						ReadOnly:  true,
+					},
+				))
+				Expect(pod.Spec.Containers[0].VolumeMounts).ToNot(ContainElement(HaveField("SubPath", "swtpm")))
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements(
+					"--vm-state-encryption", "secret",
+					"--vm-state-dirs", "swtpm-localca=/var/lib/swtpm-localca,swtpm=/var/lib/libvirt/swtpm",
+				))
+			})
+
+			It("should keep decrypting the VM state of an encrypted pvc when the encryption is disabled", func() {
+				pvc.Labels = map[string]string{"persistent-state-for": vmiName}
+				pvc.Annotations = backendstorage.EncryptionAnnotations(&v1.VMStateEncryption{
+					KMS: &v1.VMStateKMS{Provider: v1.VMStateKMSProviderLocal, KeySecretName: "kek"},
+				})
+				err := pvcCache.Add(pvc)
+				Expect(err).NotTo(HaveOccurred())
+
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := api.NewMinimalVMI(vmiName)
+				vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{
+					Persistent: pointer.P(true),
+				}
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.Secret.SecretName", "kek")))
+				Expect(pod.Spec.Containers[0].VolumeMounts).ToNot(ContainElement(HaveField("SubPath", "swtpm")))
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements("--vm-state-encryption", "local-kms"))
+			})
+
+			It("should reject switching the encryption mode of an encrypted pvc", func() {
+				pvc.Labels = map[string]string{"persistent-state-for": vmiName}
+				pvc.Annotations = backendstorage.EncryptionAnnotations(&v1.VMStateEncryption{SecretName: "keys"})
+				err := pvcCache.Add(pvc)
+				Expect(err).NotTo(HaveOccurred())
+
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.VMStateEncryption = &v1.VMStateEncryption{
+					KMS: &v1.VMStateKMS{Provider: v1.VMStateKMSProviderLocal, KeySecretName: "kek"},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := api.NewMinimalVMI(vmiName)
+				vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{
+					Persistent: pointer.P(true),
+				}
+				_, err = svc.RenderLaunchManifest(vmi)
+				Expect(err).To(MatchError(ContainSubstring("switching it to local-kms mode is not supported")))
+			})
+		})
+
+		Context("with guest agent polling", func() {
+			It("should not pass any interval to virt-launcher by default", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				pod, err := svc.RenderLaunchManifest(api.NewMinimalVMI("testvmi"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(strings.Join(pod.Spec.Containers[0].Command, " ")).ToNot(ContainSubstring("interval"))
+			})
+
+			It("should pass the cluster intervals overridden by the VMI annotation to virt-launcher", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.GuestAgentPolling = &v1.GuestAgentPollingConfiguration{
+					SysInterval:      &metav1.Duration{Duration: time.Minute},
+					CPUStatsInterval: &metav1.Duration{Duration: 30 * time.Second},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Annotations = map[string]string{v1.GuestAgentPollingAnnotation: `{"cpuStatsInterval":"10s","disksInterval":"5m"}`}
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements(
+					"--qemu-agent-sys-interval", "1m0s",
+					"--qemu-agent-disks-interval", "5m0s",
+					"--qemu-agent-cpustats-interval", "10s",
+				))
+				Expect(pod.Spec.Containers[0].Command).ToNot(ContainElement("--qemu-agent-diskstats-interval"))
+			})
+
+			It("should fail with an invalid annotation", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Annotations = map[string]string{v1.GuestAgentPollingAnnotation: "often"}
+				_, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).To(MatchError(ContainSubstring(v1.GuestAgentPollingAnnotation)))
+			})
+		})
+
+		Context("with a persistent containerDisk overlay", func() {
+			newVMIWithOverlay := func() *v1.VirtualMachineInstance {
+				vmi := libvmi.New(
+					libvmi.WithNamespace(metav1.NamespaceDefault),
+					libvmi.WithContainerDisk("disk0", "registry/image"),
+				)
+				vmi.Spec.Volumes[0].ContainerDisk.Overlay = &v1.ContainerDiskOverlay{ClaimName: "overlay"}
+				return vmi
+			}
+
+			It("should mount the overlay PVC", func() {
+				Expect(pvcCache.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "overlay", Namespace: metav1.NamespaceDefault},
+					Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: pointer.P(k8sv1.PersistentVolumeFilesystem)},
+				})).To(Succeed())
+
+				config, kvStore, svc = configFactory(defaultArch)
+				pod, err := svc.RenderLaunchManifest(newVMIWithOverlay())
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: "disk0-overlay",
+					VolumeSource: k8sv1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "overlay"},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      "disk0-overlay",
+					MountPath: "/var/run/kubevirt-private/container-disk-overlays/disk0",
+				}))
+			})
+
+			It("should reject a block overlay PVC", func() {
+				Expect(pvcCache.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "overlay", Namespace: metav1.NamespaceDefault},
+					Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: pointer.P(k8sv1.PersistentVolumeBlock)},
+				})).To(Succeed())
+
+				config, kvStore, svc = configFactory(defaultArch)
+				_, err := svc.RenderLaunchManifest(newVMIWithOverlay())
+				Expect(err).To(MatchError(ContainSubstring("must have a filesystem volume mode")))
+			})
 		})
 
 		Context("with shared filesystem disks", func() {
@@ -5323,6 +5664,50 @@ var _ = Describe("Template", func() {
 			Entry("without AutoattachSerialConsole but with LogSerialConsole", false, true, false),
 			Entry("without AutoattachSerialConsole and without LogSerialConsole", false, false, false),
 		)
+
+		findGuestConsoleLog := func(pod *k8sv1.Pod) *k8sv1.Container {
+			for i := range pod.Spec.InitContainers {
+				if pod.Spec.InitContainers[i].Name == "guest-console-log" {
+					return &pod.Spec.InitContainers[i]
+				}
+			}
+			return nil
+		}
+
+		It("should record the serial console to the log of guest-console-log", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Domain.Devices.SerialConsoleRecording = &v1.SerialConsoleRecording{}
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			guestConsoleLog := findGuestConsoleLog(pod)
+			Expect(guestConsoleLog).ToNot(BeNil())
+			Expect(guestConsoleLog.Args).To(HaveExactElements("--logfile", ContainSubstring("virt-serial0-log"), "--recording", "-"))
+			Expect(pod.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "serial-console-recording")))
+		})
+
+		It("should record the serial console to a PVC", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.UID = "1234"
+			vmi.Spec.Domain.Devices.SerialConsoleRecording = &v1.SerialConsoleRecording{ClaimName: "recordings"}
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			guestConsoleLog := findGuestConsoleLog(pod)
+			Expect(guestConsoleLog).ToNot(BeNil())
+			Expect(guestConsoleLog.Args).To(ContainElements("--recording", "/var/run/kubevirt-serial-console-recording/1234.cast"))
+			Expect(guestConsoleLog.VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+				Name:      "serial-console-recording",
+				MountPath: "/var/run/kubevirt-serial-console-recording",
+			}))
+			Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+				Name: "serial-console-recording",
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "recordings"},
+				},
+			}))
+			Expect(pod.Spec.Containers[0].VolumeMounts).ToNot(ContainElement(HaveField("Name", "serial-console-recording")))
+		})
 	})
 
 	Context("network-info", func() {
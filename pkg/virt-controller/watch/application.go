@@ -38,6 +38,7 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/disktask"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
@@ -218,6 +219,9 @@ type VirtControllerApp struct {
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clonecontroller.VMCloneController
 
+	vmDiskTaskInformer   cache.SharedIndexInformer
+	vmDiskTaskController *disktask.Controller
+
 	instancetypeInformer        cache.SharedIndexInformer
 	clusterInstancetypeInformer cache.SharedIndexInformer
 	preferenceInformer          cache.SharedIndexInformer
@@ -264,6 +268,7 @@ type VirtControllerApp struct {
 	restoreControllerThreads          int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
+	diskTaskControllerThreads         int
 
 	caConfigMapName          string
 	promCertFilePath         string
@@ -443,6 +448,7 @@ func Execute() {
 	app.migrationPolicyInformer = app.informerFactory.MigrationPolicy()
 
 	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
+	app.vmDiskTaskInformer = app.informerFactory.VirtualMachineDiskTask()
 
 	app.instancetypeInformer = app.informerFactory.VirtualMachineInstancetype()
 	app.clusterInstancetypeInformer = app.informerFactory.VirtualMachineClusterInstancetype()
@@ -487,6 +493,7 @@ func Execute() {
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
+	app.initDiskTaskController()
 	go app.Run()
 
 	<-app.reInitChan
@@ -633,6 +640,11 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the clone controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.vmDiskTaskController.Run(vca.diskTaskControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the disk task controller: %v", err)
+			}
+		}()
 
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
 		close(vca.readyChan)
@@ -955,6 +967,17 @@ func (vca *VirtControllerApp) initCloneController() {
 	}
 }
 
+func (vca *VirtControllerApp) initDiskTaskController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "disktask-controller")
+	vca.vmDiskTaskController, err = disktask.NewController(
+		vca.clientSet, vca.vmDiskTaskInformer, vca.vmInformer, vca.allPodInformer, vca.persistentVolumeClaimInformer, vca.clusterConfig, recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -1063,6 +1086,9 @@ func (vca *VirtControllerApp) AddFlags() {
 
 	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for clone controller")
+
+	flag.IntVar(&vca.diskTaskControllerThreads, "disktask-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for disk task controller")
 }
 
 func (vca *VirtControllerApp) setupLeaderElector() (err error) {
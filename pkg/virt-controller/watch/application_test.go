@@ -60,6 +60,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/disktask"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
@@ -121,6 +122,7 @@ var _ = Describe("Application", func() {
 		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		exportServiceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Service{})
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
+		diskTaskInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineDiskTask{})
 		secretInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Secret{})
 		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
 		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
@@ -256,6 +258,15 @@ var _ = Describe("Application", func() {
 			pvcInformer,
 			recorder,
 		)
+		app.vmDiskTaskController, _ = disktask.NewController(
+			virtClient,
+			diskTaskInformer,
+			vmInformer,
+			podInformer,
+			pvcInformer,
+			config,
+			recorder,
+		)
 
 		app.readyChan = make(chan bool)
 
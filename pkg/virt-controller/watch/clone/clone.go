@@ -38,6 +38,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
 type cloneSourceType string
@@ -140,19 +141,18 @@ func (ctrl *VMCloneController) sync(vmClone *clone.VirtualMachineClone) (syncInf
 				event:          SourceDoesNotExist,
 				reason:         err.Error(),
 			}, nil
-		case ErrSourceWithBackendStorage:
-			return syncInfoType{
-				isCloneFailing: true,
-				event:          SourceWithBackendStorageInvalid,
-				reason:         err.Error(),
-			}, nil
 		default:
 			return syncInfoType{}, err
 		}
 	}
 
 	if ctrl.getTargetType(cloneInfo.vmClone) == targetTypeVM {
-		return ctrl.syncTargetVM(cloneInfo), nil
+		syncInfo := ctrl.syncTargetVM(cloneInfo)
+		// a failed live fork must not leave the source hibernated
+		if syncInfo.isCloneFailing && vmClone.Spec.LiveFork != nil && cloneInfo.sourceVm != nil {
+			syncInfo = ctrl.resumeSourceVM(vmClone, cloneInfo.sourceVm, syncInfo)
+		}
+		return syncInfo, nil
 	}
 	return syncInfoType{err: fmt.Errorf("target type is unknown: %s", ctrl.getTargetType(cloneInfo.vmClone))}, nil
 }
@@ -172,11 +172,7 @@ func (ctrl *VMCloneController) retrieveCloneInfo(vmClone *clone.VirtualMachineCl
 			return nil, err
 		}
 
-		sourceVM := sourceVMObj.(*k6tv1.VirtualMachine)
-		if backendstorage.IsBackendStorageNeededForVM(sourceVM) {
-			return nil, fmt.Errorf("%w: VM %s/%s", ErrSourceWithBackendStorage, vmClone.Namespace, sourceInfo.Name)
-		}
-		cloneInfo.sourceVm = sourceVM
+		cloneInfo.sourceVm = sourceVMObj.(*k6tv1.VirtualMachine)
 
 	case sourceTypeSnapshot:
 		sourceSnapshotObj, err := ctrl.getSource(vmClone, sourceInfo.Name, vmClone.Namespace, string(sourceTypeSnapshot), ctrl.snapshotStore)
@@ -208,6 +204,12 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 
 		if vmCloneInfo.sourceType == sourceTypeVM {
 			if vmClone.Status.SnapshotName == nil {
+				if vmClone.Spec.LiveFork != nil {
+					syncInfo = ctrl.hibernateSourceVM(vmClone, vmCloneInfo.sourceVm, syncInfo)
+					if syncInfo.isFailingOrError() || syncInfo.isClonePending {
+						return syncInfo
+					}
+				}
 				syncInfo = ctrl.createSnapshotFromVm(vmClone, vmCloneInfo.sourceVm, syncInfo)
 				return syncInfo
 			}
@@ -249,7 +251,7 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 
 	case clone.CreatingTargetVM:
 
-		syncInfo = ctrl.verifyVmReady(vmClone, syncInfo)
+		syncInfo = ctrl.verifyVmReady(vmClone, vmCloneInfo.sourceVm, syncInfo)
 		if syncInfo.isFailingOrError() {
 			return syncInfo
 		}
@@ -442,7 +444,7 @@ func (ctrl *VMCloneController) verifySnapshotReady(vmClone *clone.VirtualMachine
 		return snapshot, syncInfo
 	}
 
-	if err := ctrl.verifySnapshotContent(snapshot); err != nil {
+	if err := ctrl.verifySnapshotContent(vmClone, snapshot); err != nil {
 		// At this point the snapshot is already succeded and ready.
 		// If there is an issue with the snapshot content something is not right
 		// and the clone should fail
@@ -472,7 +474,7 @@ func (ctrl *VMCloneController) getSnapshotContent(snapshot *snapshotv1.VirtualMa
 	return contentObj.(*snapshotv1.VirtualMachineSnapshotContent), nil
 }
 
-func (ctrl *VMCloneController) verifySnapshotContent(snapshot *snapshotv1.VirtualMachineSnapshot) error {
+func (ctrl *VMCloneController) verifySnapshotContent(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot) error {
 	content, err := ctrl.getSnapshotContent(snapshot)
 	if err != nil {
 		return err
@@ -489,25 +491,18 @@ func (ctrl *VMCloneController) verifySnapshotContent(snapshot *snapshotv1.Virtua
 		return nil
 	}
 
-	if backendstorage.IsBackendStorageNeededForVMI(&vm.Spec.Template.Spec) {
-		return fmt.Errorf("%w: snapshot %s/%s", ErrSourceWithBackendStorage, snapshot.Namespace, snapshot.Name)
+	var volumesNotBackedUpErr error
+	if includesPersistentState(vmClone) && backendstorage.IsBackendStorageNeededForVMI(&vm.Spec.Template.Spec) &&
+		!hasVolumeBackup(content, storageutils.BackendPVCVolumeName(vm.Name)) {
+		volumesNotBackedUpErr = fmt.Errorf(ErrPersistentStateNotBackedUp, snapshotName)
 	}
 
-	var volumesNotBackedUpErr error
 	for _, volume := range vm.Spec.Template.Spec.Volumes {
 		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
 			continue
 		}
 
-		foundBackup := false
-		for _, volumeBackup := range content.Spec.VolumeBackups {
-			if volume.Name == volumeBackup.VolumeName {
-				foundBackup = true
-				break
-			}
-		}
-
-		if !foundBackup {
+		if !hasVolumeBackup(content, volume.Name) {
 			volumesNotBackedUpErr = errors.Join(volumesNotBackedUpErr, fmt.Errorf(ErrVolumeNotBackedUp, volume.Name, snapshotName))
 		}
 	}
@@ -536,14 +531,14 @@ func (ctrl *VMCloneController) getSnapshot(snapshotName string, sourceNamespace
 }
 
 func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clone.VirtualMachineClone, vm *k6tv1.VirtualMachine, snapshotName string, syncInfo syncInfoType) syncInfoType {
-	patches, err := generatePatches(vm, &vmClone.Spec)
+	patches, err := generatePatches(vm, vmClone)
 	if err != nil {
 		retErr := fmt.Errorf("error generating patches for clone %s: %v", vmClone.Name, err)
 		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(RestoreCreationFailed), retErr.Error())
 		syncInfo.setError(retErr)
 		return syncInfo
 	}
-	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
+	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches, includesPersistentState(vmClone))
 	log.Log.Object(vmClone).Infof("creating restore %s for clone %s", restore.Name, vmClone.Name)
 	createdRestore, err := ctrl.client.VirtualMachineRestore(restore.Namespace).Create(context.Background(), restore, v1.CreateOptions{})
 	if err != nil {
@@ -589,10 +584,10 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineC
 	return syncInfo
 }
 
-func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, syncInfo syncInfoType) syncInfoType {
 	targetVMInfo := vmClone.Spec.Target
 
-	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	obj, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
 		return syncInfo
@@ -601,6 +596,13 @@ func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone,
 		return syncInfo
 	}
 
+	if vmClone.Spec.LiveFork != nil {
+		syncInfo = ctrl.prepareLiveForkTarget(vmClone, sourceVM, obj.(*k6tv1.VirtualMachine), syncInfo)
+		if syncInfo.isFailingOrError() {
+			return syncInfo
+		}
+	}
+
 	ctrl.logAndRecord(vmClone, TargetVMCreated, fmt.Sprintf("created target VM %s for clone %s", targetVMInfo.Name, vmClone.Name))
 	syncInfo.targetVMCreated = true
 
@@ -37,20 +37,25 @@ const (
 	TargetVMCreated       Event = "TargetVMCreated"
 	PVCBound              Event = "PVCBound"
 
-	SnapshotDeleted                 Event = "SnapshotDeleted"
-	SnapshotContentInvalid          Event = "SnapshotContentInvalid"
-	SourceDoesNotExist              Event = "SourceDoesNotExist"
-	SourceWithBackendStorageInvalid Event = "SourceVMWithBackendStorageInvalid"
-	VMVolumeSnapshotsInvalid        Event = "VMVolumeSnapshotsInvalid"
+	SnapshotDeleted          Event = "SnapshotDeleted"
+	SnapshotContentInvalid   Event = "SnapshotContentInvalid"
+	SourceDoesNotExist       Event = "SourceDoesNotExist"
+	VMVolumeSnapshotsInvalid Event = "VMVolumeSnapshotsInvalid"
+
+	SourceVMHibernating          Event = "SourceVMHibernating"
+	SourceVMNotHibernated        Event = "SourceVMNotHibernated"
+	SourceVMResumed              Event = "SourceVMResumed"
+	HibernationDataVolumeCreated Event = "HibernationDataVolumeCreated"
+	LiveForkFailed               Event = "LiveForkFailed"
 )
 
 var (
 	ErrVolumeNotSnapshotable        = "Virtual Machine volume %s does not support snapshots"
 	ErrVolumeSnapshotSupportUnknown = "Virtual Machine volume %s snapshot support unknown"
 	ErrVolumeNotBackedUp            = "volume %s is not backed up in snapshot %s"
+	ErrPersistentStateNotBackedUp   = "persistent state is not backed up in snapshot %s"
 
-	ErrSourceDoesntExist        = errors.New("Source doesnt exist")
-	ErrSourceWithBackendStorage = errors.New("Clone of source with backendstorage is not supported")
+	ErrSourceDoesntExist = errors.New("Source doesnt exist")
 )
 
 type VMCloneController struct {
@@ -312,8 +317,10 @@ func (ctrl *VMCloneController) handleUpdateSourceVM(oldObj, newObj interface{})
 		return
 	}
 
-	// we care only for updates in a vmsource volumeSnapshotStatuses
-	if equality.Semantic.DeepEqual(newVM.Status.VolumeSnapshotStatuses, oldVM.Status.VolumeSnapshotStatuses) {
+	// we care only for updates in a vmsource volumeSnapshotStatuses, and in its hibernation for a live fork
+	if equality.Semantic.DeepEqual(newVM.Status.VolumeSnapshotStatuses, oldVM.Status.VolumeSnapshotStatuses) &&
+		equality.Semantic.DeepEqual(newVM.Status.Hibernation, oldVM.Status.Hibernation) &&
+		newVM.Status.Created == oldVM.Status.Created && newVM.Status.Ready == oldVM.Status.Ready {
 		return
 	}
 
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -40,14 +41,18 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -69,6 +74,7 @@ var _ = Describe("Clone", func() {
 
 		client    *kubevirtfake.Clientset
 		k8sClient *k8sfake.Clientset
+		cdiClient *cdifake.Clientset
 		sourceVM  *virtv1.VirtualMachine
 		vmClone   *clone.VirtualMachineClone
 	)
@@ -211,7 +217,7 @@ var _ = Describe("Clone", func() {
 		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		snapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		restoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
-		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
+		cloneInformer, _ := testutils.NewFakeInformerWithIndexersFor(&clone.VirtualMachineClone{}, kvcontroller.GetVirtualMachineCloneInformerIndexers())
 		snapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 
@@ -238,6 +244,10 @@ var _ = Describe("Clone", func() {
 		virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+
+		cdiClient = cdifake.NewSimpleClientset()
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 
 		k8sClient = k8sfake.NewSimpleClientset()
 		k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -265,18 +275,28 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.PhaseUnset)
 			})
 
-			It("clone should fail if source VM has backendstorage", func() {
+			DescribeTable("should snapshot the persistent state of a source VM with backendstorage", func(policy *clone.PersistentStatePolicy, expectedPolicy snapshotv1.PersistentStatePolicy) {
 				sourceVM.Spec.Template.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{
 					Persistent: pointer.P(true),
 				}
 				addVM(sourceVM)
+				vmClone.Spec.PersistentState = policy
 				vmClone.Status.Phase = clone.PhaseUnset
 				addClone(vmClone)
 
-				controller.Execute()
-				expectEvent(SourceWithBackendStorageInvalid)
-				expectCloneBeInPhase(clone.Failed)
-			})
+				sanityExecute()
+				expectEvent(SnapshotCreated)
+				expectSnapshotExists()
+				expectCloneBeInPhase(clone.SnapshotInProgress)
+
+				vmSnapshot, err := client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.TODO(), testSnapshotName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmSnapshot.Spec.PersistentState).To(HaveValue(Equal(expectedPolicy)))
+			},
+				Entry("only when included", pointer.P(clone.PersistentStateInclude), snapshotv1.PersistentStateInclude),
+				Entry("not when regenerated", pointer.P(clone.PersistentStateRegenerate), snapshotv1.PersistentStateExclude),
+				Entry("not by default", nil, snapshotv1.PersistentStateExclude),
+			)
 
 			It("should report event if VM volumeSnapshots are invalid", func() {
 				sourceVM.Spec.Template.Spec.Volumes = append(sourceVM.Spec.Template.Spec.Volumes, virtv1.Volume{
@@ -617,6 +637,232 @@ var _ = Describe("Clone", func() {
 				})
 			})
 
+			Context("live fork", func() {
+				const sourceClaimName = "source-hibernation"
+
+				BeforeEach(func() {
+					sourceVM.Spec.Template.Spec.Hibernation = &virtv1.Hibernation{ClaimName: sourceClaimName}
+					vmClone.Spec.LiveFork = &clone.LiveFork{IdentityResetCommand: []string{"/usr/local/bin/reset-identity", "--hostname"}}
+				})
+
+				It("should hibernate a running source VM before snapshotting it", func() {
+					sourceVM.Status.Created = true
+					sourceVM.Status.Ready = true
+
+					hibernated := false
+					client.PrependReactor("put", "virtualmachines/hibernate", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+						_, ok := action.(kvtesting.PutAction[*virtv1.HibernateOptions])
+						Expect(ok).To(BeTrue())
+						Expect(action.(kvtesting.PutAction[*virtv1.HibernateOptions]).GetName()).To(Equal(sourceVM.Name))
+						hibernated = true
+						return true, nil, nil
+					})
+
+					_, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), sourceVM, metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					Expect(hibernated).To(BeTrue())
+					updatedVM, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), sourceVM.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(updatedVM.Annotations).To(HaveKey(LiveForkHibernatedAnnotation))
+					expectEvent(SourceVMHibernating)
+					expectSnapshotDoesNotExist()
+					expectCloneBeInPhase(clone.PhaseUnset)
+				})
+
+				It("should wait until the source VM is hibernated", func() {
+					sourceVM.Status.Created = true
+					sourceVM.Status.Hibernation = &virtv1.HibernationStatus{Phase: virtv1.HibernationSaving}
+
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(SourceVMHibernating)
+					expectSnapshotDoesNotExist()
+					expectCloneBeInPhase(clone.PhaseUnset)
+				})
+
+				It("should snapshot a hibernated source VM", func() {
+					sourceVM.Status.Hibernation = &virtv1.HibernationStatus{Phase: virtv1.HibernationSaved}
+
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(SnapshotCreated)
+					expectSnapshotExists()
+					expectCloneBeInPhase(clone.SnapshotInProgress)
+				})
+
+				It("should fail if the source VM does not have a hibernation PVC", func() {
+					sourceVM.Spec.Template.Spec.Hibernation = nil
+
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(LiveForkFailed)
+					expectSnapshotDoesNotExist()
+					expectCloneBeInPhase(clone.Failed)
+				})
+
+				When("the target VM is created", func() {
+					var (
+						snapshot *snapshotv1.VirtualMachineSnapshot
+						restore  *snapshotv1.VirtualMachineRestore
+						targetVM *virtv1.VirtualMachine
+					)
+
+					BeforeEach(func() {
+						sourceVM.Status.Hibernation = &virtv1.HibernationStatus{Phase: virtv1.HibernationSaved}
+						snapshot = createVirtualMachineSnapshot(sourceVM)
+						snapshot.Status.ReadyToUse = pointer.P(true)
+						restore = createVirtualMachineRestore(sourceVM, snapshot.Name)
+						restore.Status.Complete = pointer.P(true)
+						vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+						vmClone.Status.RestoreName = pointer.P(restore.Name)
+						vmClone.Status.Phase = clone.CreatingTargetVM
+
+						targetVM = sourceVM.DeepCopy()
+						targetVM.Name = vmClone.Spec.Target.Name
+						targetVM.UID = "target-vm-uid"
+						targetVM.Spec.Template.Spec.Hibernation = &virtv1.Hibernation{ClaimName: generateHibernationDataVolumeName(vmClone.UID)}
+						var err error
+						targetVM, err = client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), targetVM, metav1.CreateOptions{})
+						Expect(err).ToNot(HaveOccurred())
+
+						addVM(sourceVM)
+						addVM(targetVM)
+						addClone(vmClone)
+						addSnapshot(snapshot)
+						addRestore(restore)
+					})
+
+					It("should copy the saved state of the source and pass on the identity reset command", func() {
+						controller.Execute()
+						expectEvent(HibernationDataVolumeCreated)
+						expectCloneBeInPhase(clone.CreatingTargetVM)
+
+						dv, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.TODO(), generateHibernationDataVolumeName(vmClone.UID), metav1.GetOptions{})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(dv.Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: sourceVM.Namespace, Name: sourceClaimName}))
+						Expect(dv.OwnerReferences).To(HaveLen(1))
+						Expect(dv.OwnerReferences[0].UID).To(Equal(targetVM.UID))
+
+						updatedVM, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), targetVM.Name, metav1.GetOptions{})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(updatedVM.Spec.Template.ObjectMeta.Annotations).To(HaveKeyWithValue(
+							virtv1.LiveForkIdentityResetCommandAnnotation, `["/usr/local/bin/reset-identity","--hostname"]`))
+					})
+
+					It("should succeed once the saved state is copied", func() {
+						dv := generateHibernationDataVolume(generateHibernationDataVolumeName(vmClone.UID), sourceVM, targetVM)
+						dv.Status.Phase = cdiv1.Succeeded
+						_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.TODO(), dv, metav1.CreateOptions{})
+						Expect(err).ToNot(HaveOccurred())
+
+						sanityExecute()
+						expectEvent(TargetVMCreated)
+						expectCloneBeInPhase(clone.Succeeded)
+					})
+
+					It("should fail if copying the saved state failed", func() {
+						dv := generateHibernationDataVolume(generateHibernationDataVolumeName(vmClone.UID), sourceVM, targetVM)
+						dv.Status.Phase = cdiv1.Failed
+						_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.TODO(), dv, metav1.CreateOptions{})
+						Expect(err).ToNot(HaveOccurred())
+
+						sanityExecute()
+						expectEvent(LiveForkFailed)
+						expectCloneBeInPhase(clone.Failed)
+					})
+
+					Context("with a source VM hibernated by a live fork", func() {
+						var started bool
+
+						addHibernationDataVolume := func(cloneUID types.UID, phase cdiv1.DataVolumePhase) {
+							dv := generateHibernationDataVolume(generateHibernationDataVolumeName(cloneUID), sourceVM, targetVM)
+							dv.Status.Phase = phase
+							_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.TODO(), dv, metav1.CreateOptions{})
+							Expect(err).ToNot(HaveOccurred())
+						}
+
+						expectSourceVMHibernatedByLiveFork := func(hibernated bool) {
+							updatedVM, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), sourceVM.Name, metav1.GetOptions{})
+							Expect(err).ToNot(HaveOccurred())
+							if hibernated {
+								Expect(updatedVM.Annotations).To(HaveKey(LiveForkHibernatedAnnotation))
+							} else {
+								Expect(updatedVM.Annotations).ToNot(HaveKey(LiveForkHibernatedAnnotation))
+							}
+						}
+
+						BeforeEach(func() {
+							sourceVM.Annotations = map[string]string{LiveForkHibernatedAnnotation: "true"}
+							Expect(controller.vmStore.Update(sourceVM)).To(Succeed())
+							_, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), sourceVM, metav1.CreateOptions{})
+							Expect(err).ToNot(HaveOccurred())
+
+							started = false
+							client.PrependReactor("put", "virtualmachines/start", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+								Expect(action.(kvtesting.PutAction[*virtv1.StartOptions]).GetName()).To(Equal(sourceVM.Name))
+								started = true
+								return true, nil, nil
+							})
+						})
+
+						It("should resume the source VM once the saved state is copied", func() {
+							addHibernationDataVolume(vmClone.UID, cdiv1.Succeeded)
+
+							sanityExecute()
+							Expect(started).To(BeTrue())
+							expectSourceVMHibernatedByLiveFork(false)
+							expectEvent(SourceVMResumed)
+							expectEvent(TargetVMCreated)
+							expectCloneBeInPhase(clone.Succeeded)
+						})
+
+						It("should resume the source VM if copying the saved state failed", func() {
+							addHibernationDataVolume(vmClone.UID, cdiv1.Failed)
+
+							sanityExecute()
+							Expect(started).To(BeTrue())
+							expectSourceVMHibernatedByLiveFork(false)
+							expectEvent(SourceVMResumed)
+							expectEvent(LiveForkFailed)
+							expectCloneBeInPhase(clone.Failed)
+						})
+
+						DescribeTable("should keep the source VM hibernated while another live fork needs its saved state", func(phase clone.VirtualMachineClonePhase, dvPhase cdiv1.DataVolumePhase) {
+							otherClone := vmClone.DeepCopy()
+							otherClone.Name = "other-clone"
+							otherClone.UID = "other-clone-uid"
+							otherClone.Spec.Target.Name = "other-target-vm"
+							otherClone.Status.Phase = phase
+							Expect(controller.vmCloneIndexer.Add(otherClone)).To(Succeed())
+							if dvPhase != "" {
+								addHibernationDataVolume(otherClone.UID, dvPhase)
+							}
+							addHibernationDataVolume(vmClone.UID, cdiv1.Succeeded)
+
+							sanityExecute()
+							Expect(started).To(BeFalse())
+							expectSourceVMHibernatedByLiveFork(true)
+							expectEvent(TargetVMCreated)
+							expectCloneBeInPhase(clone.Succeeded)
+						},
+							Entry("before its snapshot is taken", clone.SnapshotInProgress, cdiv1.DataVolumePhase("")),
+							Entry("before its copy is created", clone.CreatingTargetVM, cdiv1.DataVolumePhase("")),
+							Entry("while it is copied", clone.CreatingTargetVM, cdiv1.CloneInProgress),
+						)
+					})
+				})
+			})
+
 		})
 
 		Context("with source snapshot", func() {
@@ -665,7 +911,7 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.Failed)
 			})
 
-			It("clone should fail if source VMSnapshot has backendstorage", func() {
+			It("should fail clone including the persistent state if source VMSnapshot does not hold it", func() {
 				snapshot := createVirtualMachineSnapshot(sourceVM)
 				snapshot.Status.ReadyToUse = pointer.P(true)
 				snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
@@ -674,6 +920,7 @@ var _ = Describe("Clone", func() {
 				}
 
 				setSnapshotSource(vmClone, snapshot.Name)
+				vmClone.Spec.PersistentState = pointer.P(clone.PersistentStateInclude)
 
 				addClone(vmClone)
 				addSnapshot(snapshot)
@@ -684,6 +931,38 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.Failed)
 			})
 
+			DescribeTable("should create restore of source VMSnapshot with backendstorage", func(policy *clone.PersistentStatePolicy, expectedPolicy snapshotv1.PersistentStatePolicy) {
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+				snapshotContent.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{
+					Persistent: pointer.P(true),
+				}
+				snapshotContent.Spec.VolumeBackups = []snapshotv1.VolumeBackup{{
+					VolumeName: storageutils.BackendPVCVolumeName(sourceVM.Name),
+				}}
+
+				setSnapshotSource(vmClone, snapshot.Name)
+				vmClone.Spec.PersistentState = policy
+
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectEvent(RestoreCreated)
+				expectCloneBeInPhase(clone.RestoreInProgress)
+				expectRestoreExists()
+
+				vmRestore, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmRestore.Spec.PersistentState).To(HaveValue(Equal(expectedPolicy)))
+			},
+				Entry("restoring the persistent state when included", pointer.P(clone.PersistentStateInclude), snapshotv1.PersistentStateInclude),
+				Entry("regenerating the persistent state by default", nil, snapshotv1.PersistentStateExclude),
+			)
+
 			It("should fail clone if snaphshot ready - but not all volumes were snapshoted", func() {
 				snapshot := createVirtualMachineSnapshot(sourceVM)
 				snapshot.Status.ReadyToUse = pointer.P(true)
@@ -1123,6 +1402,32 @@ var _ = Describe("Clone", func() {
 			})
 		})
 
+		Context("Hibernation", func() {
+			It("should not share the hibernation PVC of the source", func() {
+				sourceVM.Spec.Template.Spec.Hibernation = &virtv1.Hibernation{ClaimName: "source-hibernation"}
+				addClone(vmClone)
+
+				expectedVM := sourceVM.DeepCopy()
+				expectedVM.Spec.Template.Spec.Hibernation = nil
+				sanityExecute()
+				expectVMCreationFromPatches(expectedVM)
+			})
+
+			It("should give a live fork its own identity and resume it from its own hibernation PVC", func() {
+				sourceVM.Spec.Template.Spec.Hibernation = &virtv1.Hibernation{ClaimName: "source-hibernation"}
+				sourceVM.Spec.Template.Spec.Domain.Firmware = &virtv1.Firmware{UUID: "source-uuid", Serial: "source-serial"}
+				vmClone.Spec.LiveFork = &clone.LiveFork{}
+				vmClone.Spec.NewSMBiosSerial = pointer.P("fork-serial")
+				addClone(vmClone)
+
+				expectedVM := sourceVM.DeepCopy()
+				expectedVM.Spec.Template.Spec.Domain.Firmware = &virtv1.Firmware{Serial: "fork-serial"}
+				expectedVM.Spec.Template.Spec.Hibernation.ClaimName = generateHibernationDataVolumeName(vmClone.UID)
+				sanityExecute()
+				expectVMCreationFromPatches(expectedVM)
+			})
+		})
+
 		Context("Target VM name", func() {
 			expectTargetVMNameExist := func() {
 				restore, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
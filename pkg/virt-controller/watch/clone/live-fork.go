@@ -0,0 +1,279 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clone "kubevirt.io/api/clone/v1beta1"
+	k6tv1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+// LiveForkHibernatedAnnotation marks a source VM which was hibernated by a live fork. It is started again and the
+// annotation is removed once no live fork of it needs its saved state anymore.
+const LiveForkHibernatedAnnotation = "clone.kubevirt.io/live-fork-hibernated"
+
+// hibernateSourceVM makes sure the memory of the source is saved and the source is stopped before it is
+// snapshotted, so that the disks of the target match the state it resumes from
+func (ctrl *VMCloneController) hibernateSourceVM(vmClone *clone.VirtualMachineClone, vm *k6tv1.VirtualMachine, syncInfo syncInfoType) syncInfoType {
+	if vm.Spec.Template == nil || vm.Spec.Template.Spec.Hibernation == nil {
+		return syncInfoType{
+			isCloneFailing: true,
+			event:          LiveForkFailed,
+			reason:         fmt.Sprintf("source VM %s does not have a hibernation PVC", vm.Name),
+		}
+	}
+
+	hibernation := vm.Status.Hibernation
+	switch {
+	case hibernation != nil && hibernation.Phase == k6tv1.HibernationSaved && !vm.Status.Created:
+		return syncInfo
+	case hibernation != nil && (hibernation.Phase == k6tv1.HibernationSaving || hibernation.Phase == k6tv1.HibernationSaved):
+		return syncInfoType{
+			isClonePending: true,
+			event:          SourceVMHibernating,
+			reason:         fmt.Sprintf("waiting for source VM %s to be hibernated", vm.Name),
+		}
+	case hibernation != nil && hibernation.Phase == k6tv1.HibernationFailed &&
+		hibernation.StartTimestamp != nil && !hibernation.StartTimestamp.Before(&vmClone.CreationTimestamp):
+		return syncInfoType{
+			isCloneFailing: true,
+			event:          LiveForkFailed,
+			reason:         fmt.Sprintf("failed hibernating source VM %s: %s", vm.Name, hibernation.Message),
+		}
+	case vm.Status.Ready:
+		if err := ctrl.markSourceVMHibernated(vm); err != nil {
+			syncInfo.setError(fmt.Errorf("failed marking source VM %s as hibernated by clone %s: %v", vm.Name, vmClone.Name, err))
+			return syncInfo
+		}
+		err := ctrl.client.VirtualMachine(vm.Namespace).Hibernate(context.Background(), vm.Name, &k6tv1.HibernateOptions{})
+		// a conflict means the hibernation is already in progress
+		if err != nil && !k8serrors.IsConflict(err) {
+			syncInfo.setError(fmt.Errorf("failed hibernating source VM %s for clone %s: %v", vm.Name, vmClone.Name, err))
+			return syncInfo
+		}
+		return syncInfoType{
+			isClonePending: true,
+			event:          SourceVMHibernating,
+			reason:         fmt.Sprintf("hibernating source VM %s", vm.Name),
+		}
+	default:
+		return syncInfoType{
+			isClonePending: true,
+			event:          SourceVMNotHibernated,
+			reason:         fmt.Sprintf("source VM %s has to be running or hibernated", vm.Name),
+		}
+	}
+}
+
+// prepareLiveForkTarget copies the state saved by the source to the hibernation PVC of the target and passes the
+// identity reset command on to the target. The clone only succeeds once the state is copied.
+func (ctrl *VMCloneController) prepareLiveForkTarget(vmClone *clone.VirtualMachineClone, sourceVM, targetVM *k6tv1.VirtualMachine, syncInfo syncInfoType) syncInfoType {
+	if sourceVM.Spec.Template == nil || sourceVM.Spec.Template.Spec.Hibernation == nil {
+		syncInfo.isCloneFailing = true
+		syncInfo.event = LiveForkFailed
+		syncInfo.reason = fmt.Sprintf("source VM %s does not have a hibernation PVC anymore", sourceVM.Name)
+		return syncInfo
+	}
+
+	if err := ctrl.setIdentityResetCommand(vmClone, targetVM); err != nil {
+		syncInfo.setError(fmt.Errorf("failed setting the identity reset command of target VM %s for clone %s: %v", targetVM.Name, vmClone.Name, err))
+		return syncInfo
+	}
+
+	dataVolumes := ctrl.client.CdiClient().CdiV1beta1().DataVolumes(vmClone.Namespace)
+	dvName := generateHibernationDataVolumeName(vmClone.UID)
+	dv, err := dataVolumes.Get(context.Background(), dvName, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		dv, err = dataVolumes.Create(context.Background(), generateHibernationDataVolume(dvName, sourceVM, targetVM), v1.CreateOptions{})
+		if err == nil {
+			ctrl.logAndRecord(vmClone, HibernationDataVolumeCreated, fmt.Sprintf("created hibernation DataVolume %s for clone %s", dvName, vmClone.Name))
+		}
+	}
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("failed creating hibernation DataVolume %s for clone %s: %v", dvName, vmClone.Name, err))
+		return syncInfo
+	}
+
+	switch dv.Status.Phase {
+	case cdiv1.Succeeded:
+		return ctrl.resumeSourceVM(vmClone, sourceVM, syncInfo)
+	case cdiv1.Failed:
+		syncInfo.isCloneFailing = true
+		syncInfo.event = LiveForkFailed
+		syncInfo.reason = fmt.Sprintf("failed copying the hibernation state of source VM %s to DataVolume %s", sourceVM.Name, dvName)
+		return syncInfo
+	default:
+		syncInfo.setError(fmt.Errorf("hibernation DataVolume %s is not ready yet for clone %s", dvName, vmClone.Name))
+		return syncInfo
+	}
+}
+
+func (ctrl *VMCloneController) markSourceVMHibernated(vm *k6tv1.VirtualMachine) error {
+	if _, exists := vm.Annotations[LiveForkHibernatedAnnotation]; exists {
+		return nil
+	}
+
+	patchSet := patch.New()
+	if vm.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{LiveForkHibernatedAnnotation: "true"}))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(LiveForkHibernatedAnnotation), "true"))
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, payload, v1.PatchOptions{})
+	return err
+}
+
+// resumeSourceVM starts the source again once no other live fork of it still needs its saved state. A source which
+// was not hibernated by a live fork is left alone.
+func (ctrl *VMCloneController) resumeSourceVM(vmClone *clone.VirtualMachineClone, vm *k6tv1.VirtualMachine, syncInfo syncInfoType) syncInfoType {
+	if _, exists := vm.Annotations[LiveForkHibernatedAnnotation]; !exists {
+		return syncInfo
+	}
+
+	pending, err := ctrl.hasPendingLiveFork(vmClone, vm)
+	if err != nil {
+		return syncInfoType{err: fmt.Errorf("failed looking up the live forks of source VM %s: %v", vm.Name, err)}
+	}
+	if pending {
+		return syncInfo
+	}
+
+	err = ctrl.client.VirtualMachine(vm.Namespace).Start(context.Background(), vm.Name, &k6tv1.StartOptions{})
+	// a conflict means the source is already running
+	if err != nil && !k8serrors.IsConflict(err) {
+		return syncInfoType{err: fmt.Errorf("failed resuming source VM %s for clone %s: %v", vm.Name, vmClone.Name, err)}
+	}
+
+	payload, err := patch.New(
+		patch.WithTest("/metadata/annotations/"+patch.EscapeJSONPointer(LiveForkHibernatedAnnotation), vm.Annotations[LiveForkHibernatedAnnotation]),
+		patch.WithRemove("/metadata/annotations/"+patch.EscapeJSONPointer(LiveForkHibernatedAnnotation)),
+	).GeneratePayload()
+	if err != nil {
+		return syncInfoType{err: err}
+	}
+	_, err = ctrl.client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, payload, v1.PatchOptions{})
+	if err != nil {
+		return syncInfoType{err: fmt.Errorf("failed unmarking source VM %s as hibernated by clone %s: %v", vm.Name, vmClone.Name, err)}
+	}
+
+	ctrl.logAndRecord(vmClone, SourceVMResumed, fmt.Sprintf("resumed source VM %s", vm.Name))
+	return syncInfo
+}
+
+// hasPendingLiveFork returns whether another live fork of the source has not copied its saved state yet
+func (ctrl *VMCloneController) hasPendingLiveFork(vmClone *clone.VirtualMachineClone, vm *k6tv1.VirtualMachine) (bool, error) {
+	objs, err := ctrl.vmCloneIndexer.ByIndex("vmSource", getKey(vm.Name, vm.Namespace))
+	if err != nil {
+		return false, err
+	}
+
+	dataVolumes := ctrl.client.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace)
+	for _, obj := range objs {
+		otherClone := obj.(*clone.VirtualMachineClone)
+		if otherClone.UID == vmClone.UID || otherClone.Spec.LiveFork == nil ||
+			otherClone.Status.Phase == clone.Succeeded || otherClone.Status.Phase == clone.Failed {
+			continue
+		}
+		if otherClone.Status.Phase != clone.CreatingTargetVM {
+			return true, nil
+		}
+		dv, err := dataVolumes.Get(context.Background(), generateHibernationDataVolumeName(otherClone.UID), v1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if dv.Status.Phase != cdiv1.Succeeded && dv.Status.Phase != cdiv1.Failed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setIdentityResetCommand sets the command on the target directly, the patches of a restore are limited to plain values
+func (ctrl *VMCloneController) setIdentityResetCommand(vmClone *clone.VirtualMachineClone, targetVM *k6tv1.VirtualMachine) error {
+	command := vmClone.Spec.LiveFork.IdentityResetCommand
+	if len(command) == 0 {
+		return nil
+	}
+	value, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+	annotations := targetVM.Spec.Template.ObjectMeta.Annotations
+	if annotations[k6tv1.LiveForkIdentityResetCommandAnnotation] == string(value) {
+		return nil
+	}
+
+	patchSet := patch.New()
+	if annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/spec/template/metadata/annotations",
+			map[string]string{k6tv1.LiveForkIdentityResetCommandAnnotation: string(value)}))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/spec/template/metadata/annotations/"+patch.EscapeJSONPointer(k6tv1.LiveForkIdentityResetCommandAnnotation),
+			string(value)))
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.client.VirtualMachine(targetVM.Namespace).Patch(context.Background(), targetVM.Name, types.JSONPatchType, payload, v1.PatchOptions{})
+	return err
+}
+
+func generateHibernationDataVolume(name string, sourceVM, targetVM *k6tv1.VirtualMachine) *cdiv1.DataVolume {
+	return &cdiv1.DataVolume{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: targetVM.Namespace,
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: k6tv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+				Kind:       k6tv1.VirtualMachineGroupVersionKind.Kind,
+				Name:       targetVM.Name,
+				UID:        targetVM.UID,
+			}},
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				PVC: &cdiv1.DataVolumeSourcePVC{
+					Namespace: sourceVM.Namespace,
+					Name:      sourceVM.Spec.Template.Spec.Hibernation.ClaimName,
+				},
+			},
+			// the size is taken from the source PVC
+			Storage: &cdiv1.StorageSpec{},
+		},
+	}
+}
@@ -64,6 +64,10 @@ func generateRestoreName(vmCloneUID types.UID) string {
 	return fmt.Sprintf("tmp-restore-%s", string(vmCloneUID))
 }
 
+func generateHibernationDataVolumeName(vmCloneUID types.UID) string {
+	return fmt.Sprintf("hibernation-%s", string(vmCloneUID))
+}
+
 func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
@@ -72,6 +76,31 @@ func isInPhase(vmClone *clone.VirtualMachineClone, phase clone.VirtualMachineClo
 	return vmClone.Status.Phase == phase
 }
 
+// includesPersistentState returns whether the vTPM and EFI NVRAM state of the source is copied to the target. A live
+// fork always includes it, the guest resumes with the keys it had sealed.
+func includesPersistentState(vmClone *clone.VirtualMachineClone) bool {
+	if vmClone.Spec.LiveFork != nil {
+		return true
+	}
+	return vmClone.Spec.PersistentState != nil && *vmClone.Spec.PersistentState == clone.PersistentStateInclude
+}
+
+func persistentStatePolicy(include bool) *snapshotv1.PersistentStatePolicy {
+	if include {
+		return pointer.P(snapshotv1.PersistentStateInclude)
+	}
+	return pointer.P(snapshotv1.PersistentStateExclude)
+}
+
+func hasVolumeBackup(content *snapshotv1.VirtualMachineSnapshotContent, volumeName string) bool {
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		if volumeBackup.VolumeName == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
 func generateSnapshot(vmClone *clone.VirtualMachineClone, sourceVM *v1.VirtualMachine) *snapshotv1.VirtualMachineSnapshot {
 	return &snapshotv1.VirtualMachineSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
@@ -87,11 +116,12 @@ func generateSnapshot(vmClone *clone.VirtualMachineClone, sourceVM *v1.VirtualMa
 				Name:     sourceVM.Name,
 				APIGroup: pointer.P(kubevirtApiGroup),
 			},
+			PersistentState: persistentStatePolicy(includesPersistentState(vmClone)),
 		},
 	}
 }
 
-func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName, namespace, cloneName, snapshotName string, cloneUID types.UID, patches []string) *snapshotv1.VirtualMachineRestore {
+func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName, namespace, cloneName, snapshotName string, cloneUID types.UID, patches []string, includePersistentState bool) *snapshotv1.VirtualMachineRestore {
 	targetInfo = targetInfo.DeepCopy()
 	if targetInfo.Name == "" {
 		targetInfo.Name = generateVMName(sourceVMName)
@@ -109,6 +139,7 @@ func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName,
 			Target:                     *targetInfo,
 			VirtualMachineSnapshotName: snapshotName,
 			Patches:                    patches,
+			PersistentState:            persistentStatePolicy(includePersistentState),
 		},
 	}
 }
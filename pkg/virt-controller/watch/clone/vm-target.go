@@ -33,7 +33,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 )
 
-func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clone.VirtualMachineCloneSpec) ([]string, error) {
+func generatePatches(source *k6tv1.VirtualMachine, vmClone *clone.VirtualMachineClone) ([]string, error) {
+	cloneSpec := &vmClone.Spec
 	patchSet := patch.New()
 	addMacAddressPatches(patchSet, source.Spec.Template.Spec.Domain.Devices.Interfaces, cloneSpec.NewMacAddresses)
 	addSmbiosSerialPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware, cloneSpec.NewSMBiosSerial)
@@ -42,6 +43,11 @@ func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clone.VirtualMachi
 	addRemovePatchesFromFilter(patchSet, source.Spec.Template.ObjectMeta.Labels, cloneSpec.Template.LabelFilters, "/spec/template/metadata/labels")
 	addRemovePatchesFromFilter(patchSet, source.Spec.Template.ObjectMeta.Annotations, cloneSpec.Template.AnnotationFilters, "/spec/template/metadata/annotations")
 	addFirmwareUUIDPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware)
+	if cloneSpec.LiveFork == nil {
+		addHibernationPatches(patchSet, source.Spec.Template.Spec.Hibernation)
+	} else {
+		addLiveForkPatches(patchSet, generateHibernationDataVolumeName(vmClone.UID))
+	}
 
 	patches, err := generateStringPatchOperations(patchSet)
 	if err != nil {
@@ -159,3 +165,19 @@ func addFirmwareUUIDPatches(patchSet *patch.PatchSet, firmware *k6tv1.Firmware)
 
 	patchSet.AddOption(patch.WithReplace("/spec/template/spec/domain/firmware/uuid", ""))
 }
+
+// addHibernationPatches makes sure the target does not share the hibernation PVC of the source. Only a live fork
+// resumes from the state saved by the source.
+func addHibernationPatches(patchSet *patch.PatchSet, hibernation *k6tv1.Hibernation) {
+	if hibernation == nil {
+		return
+	}
+
+	patchSet.AddOption(patch.WithRemove("/spec/template/spec/hibernation"))
+}
+
+// addLiveForkPatches lets the target resume from the state saved by the source. The state is copied to the
+// hibernation PVC of the target once it is created.
+func addLiveForkPatches(patchSet *patch.PatchSet, hibernationClaimName string) {
+	patchSet.AddOption(patch.WithReplace("/spec/template/spec/hibernation/claimName", hibernationClaimName))
+}
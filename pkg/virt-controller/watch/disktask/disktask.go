@@ -0,0 +1,660 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package disktask
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	virtoperatorutils "kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+const (
+	// Finalizer keeps the task around until the VirtualMachine it locked is released
+	Finalizer = "kubevirt.io/virtualMachineDiskTaskFinalizer"
+
+	// DiskTaskPodLabel is the value of the app label of libguestfs pods started for disk tasks
+	DiskTaskPodLabel = "virt-disk-task"
+
+	defaultVerbosityLevel = 2
+	defaultImageName      = "libguestfs-tools"
+	diskTaskScript        = "/disk-task.sh"
+	diskImageName         = "disk.img"
+	pendingRequeueDelay   = 5 * time.Second
+
+	logTailLines = 50
+	logMaxBytes  = 4096
+)
+
+const (
+	DiskTaskStartedReason   = "DiskTaskStarted"
+	DiskTaskSucceededReason = "DiskTaskSucceeded"
+	DiskTaskFailedReason    = "DiskTaskFailed"
+)
+
+type Controller struct {
+	client        kubecli.KubevirtClient
+	taskIndexer   cache.Indexer
+	vmStore       cache.Store
+	podStore      cache.Store
+	pvcStore      cache.Store
+	clusterConfig *virtconfig.ClusterConfig
+	recorder      record.EventRecorder
+
+	queue     workqueue.TypedRateLimitingInterface[string]
+	hasSynced func() bool
+}
+
+// taskVolume is a PVC backed volume of the VirtualMachine handed to libguestfs
+type taskVolume struct {
+	name      string
+	claimName string
+	block     bool
+}
+
+func NewController(client kubecli.KubevirtClient, taskInformer, vmInformer, podInformer, pvcInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig, recorder record.EventRecorder) (*Controller, error) {
+	c := &Controller{
+		client:        client,
+		taskIndexer:   taskInformer.GetIndexer(),
+		vmStore:       vmInformer.GetStore(),
+		podStore:      podInformer.GetStore(),
+		pvcStore:      pvcInformer.GetStore(),
+		clusterConfig: clusterConfig,
+		recorder:      recorder,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmdisktask"},
+		),
+	}
+
+	c.hasSynced = func() bool {
+		return taskInformer.HasSynced() && vmInformer.HasSynced() && podInformer.HasSynced() && pvcInformer.HasSynced()
+	}
+
+	_, err := taskInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleTask,
+			UpdateFunc: func(_, newObj interface{}) { c.handleTask(newObj) },
+			DeleteFunc: c.handleTask,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleVM,
+			UpdateFunc: func(_, newObj interface{}) { c.handleVM(newObj) },
+			DeleteFunc: c.handleVM,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = podInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handlePod,
+			UpdateFunc: func(_, newObj interface{}) { c.handlePod(newObj) },
+			DeleteFunc: c.handlePod,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) handleTask(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	task, ok := obj.(*v1.VirtualMachineDiskTask)
+	if !ok {
+		log.Log.Errorf("disk task controller expected object of type VirtualMachineDiskTask but found %T", obj)
+		return
+	}
+
+	key, err := controller.KeyFunc(task)
+	if err != nil {
+		log.Log.Object(task).Reason(err).Error("cannot get disk task key")
+		return
+	}
+
+	log.Log.V(defaultVerbosityLevel).Infof("enqueued %q for sync", key)
+	c.queue.Add(key)
+}
+
+func (c *Controller) handleVM(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	vm, ok := obj.(*v1.VirtualMachine)
+	if !ok {
+		log.Log.Errorf("disk task controller expected object of type VirtualMachine but found %T", obj)
+		return
+	}
+
+	vmKey, err := controller.KeyFunc(vm)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("cannot get vm key")
+		return
+	}
+
+	keys, err := c.taskIndexer.IndexKeys("vm", vmKey)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("cannot get disk tasks from vm indexer")
+		return
+	}
+	if vm.Status.DiskTaskInProgress != nil {
+		keys = append(keys, controller.NamespacedKey(vm.Namespace, *vm.Status.DiskTaskInProgress))
+	}
+
+	for _, key := range keys {
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) handlePod(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	pod, ok := obj.(*k8sv1.Pod)
+	if !ok {
+		return
+	}
+
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != v1.VirtualMachineDiskTaskGroupVersionKind.Kind {
+		return
+	}
+
+	c.queue.Add(controller.NamespacedKey(pod.Namespace, owner.Name))
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting disk task controller")
+	defer log.Log.Info("Shutting down disk task controller")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing disk task %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(defaultVerbosityLevel).Infof("processed disk task %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.taskIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	task := obj.(*v1.VirtualMachineDiskTask).DeepCopy()
+
+	if task.DeletionTimestamp != nil {
+		if err := c.releaseVM(task); err != nil {
+			return err
+		}
+		if !controller.HasFinalizer(task, Finalizer) {
+			return nil
+		}
+		controller.RemoveFinalizer(task, Finalizer)
+		_, err := c.client.GeneratedKubeVirtClient().KubevirtV1().VirtualMachineDiskTasks(task.Namespace).Update(context.Background(), task, metav1.UpdateOptions{})
+		return err
+	}
+
+	if task.IsFinal() {
+		return c.releaseVM(task)
+	}
+
+	if !controller.HasFinalizer(task, Finalizer) {
+		controller.AddFinalizer(task, Finalizer)
+		_, err := c.client.GeneratedKubeVirtClient().KubevirtV1().VirtualMachineDiskTasks(task.Namespace).Update(context.Background(), task, metav1.UpdateOptions{})
+		return err
+	}
+
+	status := task.Status.DeepCopy()
+	syncErr := c.sync(key, task, status)
+
+	if !equality.Semantic.DeepEqual(&task.Status, status) {
+		task.Status = *status
+		if _, err := c.client.GeneratedKubeVirtClient().KubevirtV1().VirtualMachineDiskTasks(task.Namespace).UpdateStatus(context.Background(), task, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return syncErr
+}
+
+func (c *Controller) sync(key string, task *v1.VirtualMachineDiskTask, status *v1.VirtualMachineDiskTaskStatus) error {
+	pod, err := c.getPod(task)
+	if err != nil {
+		return err
+	}
+	if pod != nil {
+		return c.syncPod(task, pod, status)
+	}
+	if status.Phase == v1.DiskTaskRunning {
+		c.fail(task, status, fmt.Sprintf("libguestfs pod %s disappeared", status.PodName))
+		return nil
+	}
+
+	obj, exists, err := c.vmStore.GetByKey(controller.NamespacedKey(task.Namespace, task.Spec.VMName))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		setPending(status, fmt.Sprintf("VirtualMachine %s does not exist", task.Spec.VMName))
+		return nil
+	}
+	vm := obj.(*v1.VirtualMachine)
+
+	if reason := vmNotReady(vm, task); reason != "" {
+		setPending(status, reason)
+		return nil
+	}
+
+	volumes, pendingReason, err := c.taskVolumes(task, vm)
+	if err != nil {
+		c.fail(task, status, err.Error())
+		return nil
+	}
+	if pendingReason != "" {
+		setPending(status, pendingReason)
+		c.queue.AddAfter(key, pendingRequeueDelay)
+		return nil
+	}
+
+	if vm.Status.DiskTaskInProgress == nil {
+		// Lock the VirtualMachine first, the pod is created once the update is observed
+		vmCopy := vm.DeepCopy()
+		vmCopy.Status.DiskTaskInProgress = pointer.P(task.Name)
+		_, err := c.client.VirtualMachine(vm.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
+		return err
+	}
+
+	image, err := c.libguestfsImage()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.CoreV1().Pods(task.Namespace).Create(context.Background(), c.renderPod(task, vm, volumes, image), metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	c.recorder.Eventf(task, k8sv1.EventTypeNormal, DiskTaskStartedReason, "Started %s on VirtualMachine %s", task.Spec.Operation, vm.Name)
+	status.Phase = v1.DiskTaskRunning
+	status.PodName = podName(task)
+	status.StartTimestamp = pointer.P(metav1.Now())
+	status.Message = fmt.Sprintf("Running %s", task.Spec.Operation)
+	return nil
+}
+
+func (c *Controller) syncPod(task *v1.VirtualMachineDiskTask, pod *k8sv1.Pod, status *v1.VirtualMachineDiskTaskStatus) error {
+	switch pod.Status.Phase {
+	case k8sv1.PodSucceeded:
+		status.Logs = c.podLogs(pod)
+		status.Phase = v1.DiskTaskSucceeded
+		status.CompletionTimestamp = pointer.P(metav1.Now())
+		status.Message = fmt.Sprintf("%s completed successfully", task.Spec.Operation)
+		c.recorder.Event(task, k8sv1.EventTypeNormal, DiskTaskSucceededReason, status.Message)
+	case k8sv1.PodFailed:
+		status.Logs = c.podLogs(pod)
+		c.fail(task, status, fmt.Sprintf("%s failed, see the logs of the task", task.Spec.Operation))
+	default:
+		status.Phase = v1.DiskTaskRunning
+		status.PodName = pod.Name
+		if status.StartTimestamp == nil {
+			status.StartTimestamp = pointer.P(metav1.Now())
+		}
+	}
+	return nil
+}
+
+func (c *Controller) fail(task *v1.VirtualMachineDiskTask, status *v1.VirtualMachineDiskTaskStatus, message string) {
+	status.Phase = v1.DiskTaskFailed
+	status.CompletionTimestamp = pointer.P(metav1.Now())
+	status.Message = message
+	c.recorder.Event(task, k8sv1.EventTypeWarning, DiskTaskFailedReason, message)
+}
+
+func setPending(status *v1.VirtualMachineDiskTaskStatus, message string) {
+	status.Phase = v1.DiskTaskPending
+	status.Message = message
+}
+
+// vmNotReady returns why the volumes of the VirtualMachine can't be handed to libguestfs yet
+func vmNotReady(vm *v1.VirtualMachine, task *v1.VirtualMachineDiskTask) string {
+	if vm.Status.DiskTaskInProgress != nil && *vm.Status.DiskTaskInProgress != task.Name {
+		return fmt.Sprintf("VirtualMachine %s is locked by disk task %s", vm.Name, *vm.Status.DiskTaskInProgress)
+	}
+	if vm.Status.RestoreInProgress != nil {
+		return fmt.Sprintf("VirtualMachine %s is being restored", vm.Name)
+	}
+	if vm.Status.SnapshotInProgress != nil {
+		return fmt.Sprintf("VirtualMachine %s is being snapshotted", vm.Name)
+	}
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return err.Error()
+	}
+	if runStrategy != v1.RunStrategyHalted && runStrategy != v1.RunStrategyManual {
+		return fmt.Sprintf("VirtualMachine %s must have runStrategy %s or %s", vm.Name, v1.RunStrategyHalted, v1.RunStrategyManual)
+	}
+	if vm.Status.Created {
+		return fmt.Sprintf("VirtualMachine %s must be stopped", vm.Name)
+	}
+	return ""
+}
+
+// taskVolumes resolves the volumes of the task to their PVCs. An error means the task can never run.
+func (c *Controller) taskVolumes(task *v1.VirtualMachineDiskTask, vm *v1.VirtualMachine) ([]taskVolume, string, error) {
+	requested := map[string]bool{}
+	for _, name := range task.Spec.Volumes {
+		requested[name] = true
+	}
+
+	var volumes []taskVolume
+	if vm.Spec.Template != nil {
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if len(task.Spec.Volumes) > 0 && !requested[volume.Name] {
+				continue
+			}
+			delete(requested, volume.Name)
+
+			var claimName string
+			switch {
+			case volume.PersistentVolumeClaim != nil:
+				claimName = volume.PersistentVolumeClaim.ClaimName
+			case volume.DataVolume != nil:
+				claimName = volume.DataVolume.Name
+			default:
+				if len(task.Spec.Volumes) > 0 {
+					return nil, "", fmt.Errorf("volume %s is not backed by a PersistentVolumeClaim", volume.Name)
+				}
+				continue
+			}
+
+			pvc, exists, isBlock, err := storagetypes.IsPVCBlockFromStore(c.pvcStore, vm.Namespace, claimName)
+			if err != nil {
+				return nil, "", err
+			}
+			if !exists || pvc.Status.Phase != k8sv1.ClaimBound {
+				return nil, fmt.Sprintf("Waiting for PersistentVolumeClaim %s to be bound", claimName), nil
+			}
+			volumes = append(volumes, taskVolume{name: volume.Name, claimName: claimName, block: isBlock})
+		}
+	}
+
+	for name := range requested {
+		return nil, "", fmt.Errorf("volume %s does not exist in VirtualMachine %s", name, vm.Name)
+	}
+	if len(volumes) == 0 {
+		return nil, "", fmt.Errorf("VirtualMachine %s has no volumes backed by a PersistentVolumeClaim", vm.Name)
+	}
+	return volumes, "", nil
+}
+
+// releaseVM removes the lock the task holds on its VirtualMachine
+func (c *Controller) releaseVM(task *v1.VirtualMachineDiskTask) error {
+	obj, exists, err := c.vmStore.GetByKey(controller.NamespacedKey(task.Namespace, task.Spec.VMName))
+	if err != nil || !exists {
+		return err
+	}
+	vm := obj.(*v1.VirtualMachine)
+	if vm.Status.DiskTaskInProgress == nil || *vm.Status.DiskTaskInProgress != task.Name {
+		return nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmCopy.Status.DiskTaskInProgress = nil
+	_, err = c.client.VirtualMachine(vm.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
+	return err
+}
+
+func (c *Controller) getPod(task *v1.VirtualMachineDiskTask) (*k8sv1.Pod, error) {
+	obj, exists, err := c.podStore.GetByKey(controller.NamespacedKey(task.Namespace, podName(task)))
+	if err != nil || !exists {
+		return nil, err
+	}
+	pod := obj.(*k8sv1.Pod)
+	if !metav1.IsControlledBy(pod, task) {
+		return nil, fmt.Errorf("pod %s is not owned by disk task %s", pod.Name, task.Name)
+	}
+	return pod, nil
+}
+
+// podLogs returns the tail of the output of the libguestfs pod, errors are reported in place of the logs
+func (c *Controller) podLogs(pod *k8sv1.Pod) string {
+	logs, err := c.client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &k8sv1.PodLogOptions{
+		TailLines:  pointer.P(int64(logTailLines)),
+		LimitBytes: pointer.P(int64(logMaxBytes)),
+	}).DoRaw(context.Background())
+	if err != nil {
+		log.Log.Object(pod).Reason(err).Warning("failed to fetch the logs of the disk task pod")
+		return fmt.Sprintf("failed to fetch the logs of pod %s: %v", pod.Name, err)
+	}
+	return strings.TrimSpace(string(logs))
+}
+
+// libguestfsImage returns the libguestfs-tools image deployed along the current KubeVirt version
+func (c *Controller) libguestfsImage() (string, error) {
+	kv := c.clusterConfig.GetConfigFromKubeVirtCR()
+	if kv == nil {
+		return "", fmt.Errorf("failed getting KubeVirt config")
+	}
+	var config virtoperatorutils.KubeVirtDeploymentConfig
+	if err := json.Unmarshal([]byte(kv.Status.ObservedDeploymentConfig), &config); err != nil {
+		return "", err
+	}
+	if config.GsImage != "" {
+		return config.GsImage, nil
+	}
+
+	image := config.GetImagePrefix() + defaultImageName
+	switch {
+	case config.GsSha != "":
+		image = fmt.Sprintf("%s@%s", image, config.GsSha)
+	case kv.Status.ObservedKubeVirtVersion != "":
+		image = fmt.Sprintf("%s:%s", image, kv.Status.ObservedKubeVirtVersion)
+	default:
+		return "", fmt.Errorf("neither the digest nor the tag of the libguestfs image are known")
+	}
+	if kv.Status.ObservedKubeVirtRegistry != "" {
+		image = fmt.Sprintf("%s/%s", kv.Status.ObservedKubeVirtRegistry, image)
+	}
+	return image, nil
+}
+
+func podName(task *v1.VirtualMachineDiskTask) string {
+	return fmt.Sprintf("virt-disk-task-%s", task.UID)
+}
+
+func (c *Controller) renderPod(task *v1.VirtualMachineDiskTask, vm *v1.VirtualMachine, volumes []taskVolume, image string) *k8sv1.Pod {
+	var (
+		disks        []string
+		podVolumes   []k8sv1.Volume
+		volumeMounts []k8sv1.VolumeMount
+		devices      []k8sv1.VolumeDevice
+	)
+	for i, volume := range volumes {
+		name := fmt.Sprintf("disk-%d", i)
+		podVolumes = append(podVolumes, k8sv1.Volume{
+			Name: name,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: volume.claimName},
+			},
+		})
+		if volume.block {
+			path := fmt.Sprintf("/dev/disk-task/%s", name)
+			devices = append(devices, k8sv1.VolumeDevice{Name: name, DevicePath: path})
+			disks = append(disks, path)
+			continue
+		}
+		path := fmt.Sprintf("/disks/%s", name)
+		volumeMounts = append(volumeMounts, k8sv1.VolumeMount{Name: name, MountPath: path})
+		disks = append(disks, fmt.Sprintf("%s/%s", path, diskImageName))
+	}
+
+	for _, dir := range []struct{ name, path string }{
+		{"libguestfs-tmp", "/tmp/guestfs"},
+		{"home", "/home/guestfs"},
+	} {
+		podVolumes = append(podVolumes, k8sv1.Volume{
+			Name:         dir.name,
+			VolumeSource: k8sv1.VolumeSource{EmptyDir: &k8sv1.EmptyDirVolumeSource{}},
+		})
+		volumeMounts = append(volumeMounts, k8sv1.VolumeMount{Name: dir.name, MountPath: dir.path})
+	}
+
+	env := []k8sv1.EnvVar{
+		{Name: "LIBGUESTFS_BACKEND", Value: "direct"},
+		{Name: "LIBGUESTFS_PATH", Value: "/usr/local/lib/guestfs/appliance"},
+		{Name: "LIBGUESTFS_TMPDIR", Value: "/tmp/guestfs"},
+		{Name: "HOME", Value: "/home/guestfs"},
+		{Name: "DISK_TASK_OPERATION", Value: string(task.Spec.Operation)},
+		{Name: "DISK_TASK_DISKS", Value: strings.Join(disks, " ")},
+	}
+	if task.Spec.Sysprep != nil {
+		env = append(env, k8sv1.EnvVar{Name: "SYSPREP_OPERATIONS", Value: strings.Join(task.Spec.Sysprep.Operations, ",")})
+	}
+	if task.Spec.Customize != nil {
+		env = append(env, k8sv1.EnvVar{Name: "CUSTOMIZE_COMMANDS", Value: strings.Join(task.Spec.Customize.Commands, "\n")})
+	}
+	if task.Spec.ResizeFilesystem != nil {
+		env = append(env, k8sv1.EnvVar{Name: "RESIZE_DEVICE", Value: task.Spec.ResizeFilesystem.Device})
+	}
+
+	resources := k8sv1.ResourceRequirements{}
+	if c.clusterConfig.AllowEmulation() {
+		env = append(env, k8sv1.EnvVar{Name: "LIBGUESTFS_BACKEND_SETTINGS", Value: "force_tcg"})
+	} else {
+		resources.Limits = k8sv1.ResourceList{services.KvmDevice: resource.MustParse("1")}
+	}
+
+	pod := &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName(task),
+			Namespace: task.Namespace,
+			Labels: map[string]string{
+				v1.AppLabel: DiskTaskPodLabel,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(task, v1.VirtualMachineDiskTaskGroupVersionKind),
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			RestartPolicy: k8sv1.RestartPolicyNever,
+			SecurityContext: &k8sv1.PodSecurityContext{
+				RunAsNonRoot: pointer.P(true),
+				SeccompProfile: &k8sv1.SeccompProfile{
+					Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			Containers: []k8sv1.Container{{
+				Name:            "libguestfs",
+				Image:           image,
+				ImagePullPolicy: c.clusterConfig.GetImagePullPolicy(),
+				Command:         []string{diskTaskScript},
+				Env:             env,
+				Resources:       resources,
+				VolumeMounts:    volumeMounts,
+				VolumeDevices:   devices,
+				SecurityContext: &k8sv1.SecurityContext{
+					AllowPrivilegeEscalation: pointer.P(false),
+					Capabilities: &k8sv1.Capabilities{
+						Drop: []k8sv1.Capability{"ALL"},
+					},
+				},
+			}},
+			Volumes: podVolumes,
+		},
+	}
+
+	// Land on the nodes the VirtualMachine can run on, its volumes are reachable from there
+	if vm.Spec.Template != nil {
+		pod.Spec.NodeSelector = vm.Spec.Template.Spec.NodeSelector
+		pod.Spec.Affinity = vm.Spec.Template.Spec.Affinity
+		pod.Spec.Tolerations = vm.Spec.Template.Spec.Tolerations
+	}
+
+	return pod
+}
@@ -0,0 +1,312 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package disktask
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("VirtualMachineDiskTask controller", func() {
+	const (
+		taskName = "sparsify"
+		taskUID  = "task-uid"
+		pvcName  = "root-pvc"
+	)
+
+	var (
+		ctrl      *Controller
+		recorder  *record.FakeRecorder
+		mockQueue *testutils.MockWorkQueue[string]
+		client    *kubevirtfake.Clientset
+		k8sClient *k8sfake.Clientset
+		vm        *v1.VirtualMachine
+		task      *v1.VirtualMachineDiskTask
+	)
+
+	newPVC := func(name string, volumeMode k8sv1.PersistentVolumeMode) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: pointer.P(volumeMode)},
+			Status:     k8sv1.PersistentVolumeClaimStatus{Phase: k8sv1.ClaimBound},
+		}
+	}
+
+	addVM := func(vm *v1.VirtualMachine) {
+		_, err := client.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctrl.vmStore.Add(vm)).To(Succeed())
+	}
+
+	addTask := func(task *v1.VirtualMachineDiskTask) {
+		_, err := client.KubevirtV1().VirtualMachineDiskTasks(task.Namespace).Create(context.Background(), task, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctrl.taskIndexer.Add(task)).To(Succeed())
+		key, err := controller.KeyFunc(task)
+		Expect(err).ToNot(HaveOccurred())
+		mockQueue.Add(key)
+	}
+
+	addPod := func(phase k8sv1.PodPhase) {
+		pod := ctrl.renderPod(task, vm, []taskVolume{{name: "disk0", claimName: pvcName}}, "image")
+		pod.Status.Phase = phase
+		Expect(ctrl.podStore.Add(pod)).To(Succeed())
+	}
+
+	getTask := func() *v1.VirtualMachineDiskTask {
+		task, err := client.KubevirtV1().VirtualMachineDiskTasks(metav1.NamespaceDefault).Get(context.Background(), taskName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return task
+	}
+
+	getVM := func() *v1.VirtualMachine {
+		vm, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	getPod := func() *k8sv1.Pod {
+		pod, err := k8sClient.CoreV1().Pods(metav1.NamespaceDefault).Get(context.Background(), "virt-disk-task-"+taskUID, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pod
+	}
+
+	BeforeEach(func() {
+		taskInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineDiskTask{}, cache.Indexers{
+			"vm": func(obj interface{}) ([]string, error) {
+				task := obj.(*v1.VirtualMachineDiskTask)
+				return []string{controller.NamespacedKey(task.Namespace, task.Spec.VMName)}, nil
+			},
+		})
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				ObservedKubeVirtRegistry: "quay.io/kubevirt",
+				ObservedKubeVirtVersion:  "v1.7.0",
+				ObservedDeploymentConfig: "{}",
+			},
+		})
+
+		client = kubevirtfake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(client).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		var err error
+		ctrl, err = NewController(virtClient, taskInformer, vmInformer, podInformer, pvcInformer, config, recorder)
+		Expect(err).ToNot(HaveOccurred())
+		mockQueue = testutils.NewMockWorkQueue(ctrl.queue)
+		ctrl.queue = mockQueue
+
+		vm = libvmi.NewVirtualMachine(
+			libvmi.New(
+				libvmi.WithNamespace(metav1.NamespaceDefault),
+				libvmi.WithPersistentVolumeClaim("disk0", pvcName),
+				libvmi.WithContainerDisk("disk1", "registry/image"),
+			),
+			libvmi.WithRunStrategy(v1.RunStrategyHalted),
+		)
+		Expect(ctrl.pvcStore.Add(newPVC(pvcName, k8sv1.PersistentVolumeFilesystem))).To(Succeed())
+
+		task = &v1.VirtualMachineDiskTask{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       taskName,
+				Namespace:  metav1.NamespaceDefault,
+				UID:        taskUID,
+				Finalizers: []string{Finalizer},
+			},
+			Spec: v1.VirtualMachineDiskTaskSpec{
+				VMName:    vm.Name,
+				Operation: v1.DiskTaskOperationSparsify,
+			},
+		}
+	})
+
+	It("should add the finalizer to new tasks", func() {
+		task.Finalizers = nil
+		addTask(task)
+
+		ctrl.Execute()
+		Expect(getTask().Finalizers).To(ConsistOf(Finalizer))
+	})
+
+	It("should wait for the VirtualMachine to exist", func() {
+		addTask(task)
+
+		ctrl.Execute()
+		task := getTask()
+		Expect(task.Status.Phase).To(Equal(v1.DiskTaskPending))
+		Expect(task.Status.Message).To(Equal(fmt.Sprintf("VirtualMachine %s does not exist", vm.Name)))
+	})
+
+	DescribeTable("should keep the task pending while the VirtualMachine", func(modify func(vm *v1.VirtualMachine), message string) {
+		modify(vm)
+		addVM(vm)
+		addTask(task)
+
+		ctrl.Execute()
+		task := getTask()
+		Expect(task.Status.Phase).To(Equal(v1.DiskTaskPending))
+		Expect(task.Status.Message).To(ContainSubstring(message))
+		Expect(getVM().Status.DiskTaskInProgress).To(Equal(vm.Status.DiskTaskInProgress))
+	},
+		Entry("is running", func(vm *v1.VirtualMachine) {
+			vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+		}, "must have runStrategy"),
+		Entry("still has a VMI", func(vm *v1.VirtualMachine) {
+			vm.Status.Created = true
+		}, "must be stopped"),
+		Entry("is locked by another task", func(vm *v1.VirtualMachine) {
+			vm.Status.DiskTaskInProgress = pointer.P("other")
+		}, "is locked by disk task other"),
+		Entry("is being restored", func(vm *v1.VirtualMachine) {
+			vm.Status.RestoreInProgress = pointer.P("restore")
+		}, "is being restored"),
+	)
+
+	It("should fail when a requested volume is not backed by a PVC", func() {
+		task.Spec.Volumes = []string{"disk1"}
+		addVM(vm)
+		addTask(task)
+
+		ctrl.Execute()
+		task := getTask()
+		Expect(task.Status.Phase).To(Equal(v1.DiskTaskFailed))
+		Expect(task.Status.Message).To(Equal("volume disk1 is not backed by a PersistentVolumeClaim"))
+		testutils.ExpectEvent(recorder, DiskTaskFailedReason)
+	})
+
+	It("should lock the VirtualMachine before creating the pod", func() {
+		addVM(vm)
+		addTask(task)
+
+		ctrl.Execute()
+		Expect(getVM().Status.DiskTaskInProgress).To(HaveValue(Equal(taskName)))
+		_, err := k8sClient.CoreV1().Pods(metav1.NamespaceDefault).Get(context.Background(), "virt-disk-task-"+taskUID, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should create the libguestfs pod once the VirtualMachine is locked", func() {
+		vm.Status.DiskTaskInProgress = pointer.P(taskName)
+		addVM(vm)
+		addTask(task)
+
+		ctrl.Execute()
+		task := getTask()
+		Expect(task.Status.Phase).To(Equal(v1.DiskTaskRunning))
+		Expect(task.Status.PodName).To(Equal("virt-disk-task-" + taskUID))
+		Expect(task.Status.StartTimestamp).ToNot(BeNil())
+		testutils.ExpectEvent(recorder, DiskTaskStartedReason)
+
+		pod := getPod()
+		Expect(metav1.IsControlledBy(pod, task)).To(BeTrue())
+		Expect(pod.Spec.Volumes).To(ContainElement(HaveField("PersistentVolumeClaim.ClaimName", pvcName)))
+		container := pod.Spec.Containers[0]
+		Expect(container.Image).To(Equal("quay.io/kubevirt/libguestfs-tools:v1.7.0"))
+		Expect(container.Env).To(ContainElements(
+			k8sv1.EnvVar{Name: "DISK_TASK_OPERATION", Value: "Sparsify"},
+			k8sv1.EnvVar{Name: "DISK_TASK_DISKS", Value: "/disks/disk-0/disk.img"},
+		))
+	})
+
+	It("should hand block volumes to the pod as devices", func() {
+		Expect(ctrl.pvcStore.Update(newPVC(pvcName, k8sv1.PersistentVolumeBlock))).To(Succeed())
+		vm.Status.DiskTaskInProgress = pointer.P(taskName)
+		addVM(vm)
+		addTask(task)
+
+		ctrl.Execute()
+		container := getPod().Spec.Containers[0]
+		Expect(container.VolumeDevices).To(ConsistOf(k8sv1.VolumeDevice{Name: "disk-0", DevicePath: "/dev/disk-task/disk-0"}))
+		Expect(container.Env).To(ContainElement(k8sv1.EnvVar{Name: "DISK_TASK_DISKS", Value: "/dev/disk-task/disk-0"}))
+	})
+
+	DescribeTable("should record the result of the pod", func(podPhase k8sv1.PodPhase, phase v1.VirtualMachineDiskTaskPhase, reason string) {
+		vm.Status.DiskTaskInProgress = pointer.P(taskName)
+		addVM(vm)
+		task.Status.Phase = v1.DiskTaskRunning
+		addTask(task)
+		addPod(podPhase)
+
+		ctrl.Execute()
+		task := getTask()
+		Expect(task.Status.Phase).To(Equal(phase))
+		Expect(task.Status.CompletionTimestamp).ToNot(BeNil())
+		Expect(task.Status.Logs).To(Equal("fake logs"))
+		testutils.ExpectEvent(recorder, reason)
+	},
+		Entry("when it succeeded", k8sv1.PodSucceeded, v1.DiskTaskSucceeded, DiskTaskSucceededReason),
+		Entry("when it failed", k8sv1.PodFailed, v1.DiskTaskFailed, DiskTaskFailedReason),
+	)
+
+	It("should release the VirtualMachine once the task finished", func() {
+		vm.Status.DiskTaskInProgress = pointer.P(taskName)
+		addVM(vm)
+		task.Status.Phase = v1.DiskTaskSucceeded
+		addTask(task)
+
+		ctrl.Execute()
+		Expect(getVM().Status.DiskTaskInProgress).To(BeNil())
+	})
+
+	It("should release the VirtualMachine and remove the finalizer on deletion", func() {
+		vm.Status.DiskTaskInProgress = pointer.P(taskName)
+		addVM(vm)
+		task.DeletionTimestamp = pointer.P(metav1.Now())
+		addTask(task)
+
+		ctrl.Execute()
+		Expect(getVM().Status.DiskTaskInProgress).To(BeNil())
+		Expect(getTask().Finalizers).To(BeEmpty())
+	})
+})
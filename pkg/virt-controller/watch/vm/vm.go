@@ -108,6 +108,12 @@ const (
 	// SuccessfulDataVolumeCreateReason is added in an event when a dynamically generated
 	// dataVolume is successfully created
 	SuccessfulDataVolumeCreateReason = "SuccessfulDataVolumeCreate"
+	// FailedDataVolumeDeleteReason is added in an event when deleting a dataVolume
+	// created for a cancelled volume migration fails.
+	FailedDataVolumeDeleteReason = "FailedDataVolumeDelete"
+	// SuccessfulDataVolumeDeleteReason is added in an event when a dataVolume created
+	// for a cancelled volume migration is successfully deleted
+	SuccessfulDataVolumeDeleteReason = "SuccessfulDataVolumeDelete"
 	// SourcePVCNotAvailabe is added in an event when the source PVC of a valid
 	// clone Datavolume doesn't exist
 	SourcePVCNotAvailabe = "SourcePVCNotAvailabe"
@@ -608,15 +614,24 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUUnplug, k8score.ConditionFalse) {
+		setRestartRequired(vm, "CPU sockets updated in template spec. The guest did not release the hot-unplugged vCPUs")
+		return nil
+	}
+
 	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets == vmi.Spec.Domain.CPU.Sockets {
 		return nil
 	}
 
-	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8score.ConditionTrue) {
 		return fmt.Errorf("another CPU hotplug is in progress")
 	}
 
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUUnplug, k8score.ConditionTrue) {
+		return fmt.Errorf("another CPU hot-unplug is in progress")
+	}
+
 	if migrations.IsMigrating(vmi) {
 		return fmt.Errorf("CPU hotplug is not allowed while VMI is migrating")
 	}
@@ -629,11 +644,6 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
-	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets {
-		setRestartRequired(vm, "Reduction of CPU socket count requires a restart")
-		return nil
-	}
-
 	if virtconfig.IsARM64(vm.Spec.Template.Spec.Architecture) {
 		setRestartRequired(vm, "ARM doesn't support CPU hotplug")
 		return nil
@@ -852,7 +862,7 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 		if !ok {
 			continue
 		}
-		if vmiVol.ContainerDisk != nil {
+		if vmiVol.ContainerDisk != nil && volume.ContainerDisk != nil {
 			vmCopy.Spec.Template.Spec.Volumes[i].ContainerDisk.ImagePullPolicy = vmiVol.ContainerDisk.ImagePullPolicy
 		}
 	}
@@ -879,6 +889,7 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 	if volMigAbort, err := volumemig.VolumeMigrationCancel(c.clientset, vmi, vm); volMigAbort {
 		if err == nil {
 			log.Log.Object(vm).Infof("Cancel volume migration")
+			err = c.deleteUnusedDestinationDataVolumes(vm, vmi)
 		}
 		return err
 	}
@@ -891,6 +902,10 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 			setRestartRequired(vm, "the volumes replacement is effective only after restart")
 		}
 	case *vm.Spec.UpdateVolumesStrategy == virtv1.UpdateVolumesStrategyMigration:
+		// Create the destination DataVolumes of the volumes which aren't backed by a PVC
+		if created, err := c.createDestinationDataVolumes(vm, vmi); err != nil || created {
+			return err
+		}
 		// Validate if the update volumes can be migrated
 		if err := volumemig.ValidateVolumes(vmi, vm, c.dataVolumeStore, c.pvcStore); err != nil {
 			return c.handleValidationErrors(err, vmi, vm)
@@ -934,6 +949,53 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 	return nil
 }
 
+// createDestinationDataVolumes creates the blank DataVolumes which the hostDisks and containerDisks are copied to
+// during the volume migration. The DataVolumes are owned by the VM in order to be garbage collected together with it.
+func (c *Controller) createDestinationDataVolumes(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (bool, error) {
+	templates, err := volumemig.GenerateDestinationDataVolumeTemplates(c.dataVolumeStore, c.pvcStore, vmi, vm)
+	if err != nil {
+		return false, err
+	}
+	vmKey, err := controller.KeyFunc(vm)
+	if err != nil {
+		return false, err
+	}
+	for _, template := range templates {
+		newDataVolume, err := watchutil.CreateDataVolumeManifest(c.clientset, template, vm)
+		if err != nil {
+			return false, fmt.Errorf("unable to create DataVolume manifest: %v", err)
+		}
+		c.dataVolumeExpectations.ExpectCreations(vmKey, 1)
+		curDataVolume, err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Create(context.Background(), newDataVolume, metav1.CreateOptions{})
+		if err != nil {
+			c.dataVolumeExpectations.CreationObserved(vmKey)
+			c.recorder.Eventf(vm, k8score.EventTypeWarning, FailedDataVolumeCreateReason, "Error creating DataVolume %s: %v", newDataVolume.Name, err)
+			return false, fmt.Errorf("failed to create DataVolume: %v", err)
+		}
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, SuccessfulDataVolumeCreateReason, "Created DataVolume %s", curDataVolume.Name)
+	}
+
+	return len(templates) > 0, nil
+}
+
+// deleteUnusedDestinationDataVolumes deletes the DataVolumes created for a volume migration which has been cancelled
+func (c *Controller) deleteUnusedDestinationDataVolumes(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	dvs, err := volumemig.UnusedDestinationDataVolumes(c.dataVolumeStore, vmi, vm)
+	if err != nil {
+		return err
+	}
+	for _, dv := range dvs {
+		err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(dv.Namespace).Delete(context.Background(), dv.Name, metav1.DeleteOptions{})
+		if err != nil && !apiErrors.IsNotFound(err) {
+			c.recorder.Eventf(vm, k8score.EventTypeWarning, FailedDataVolumeDeleteReason, "Error deleting DataVolume %s: %v", dv.Name, err)
+			return fmt.Errorf("failed to delete DataVolume: %v", err)
+		}
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, SuccessfulDataVolumeDeleteReason, "Deleted DataVolume %s", dv.Name)
+	}
+
+	return nil
+}
+
 func (c *Controller) addStartRequest(vm *virtv1.VirtualMachine) error {
 	desiredStateChangeRequests := append(vm.Status.StateChangeRequests, virtv1.VirtualMachineStateChangeRequest{Action: virtv1.StartRequest})
 	patchSet := patch.New()
@@ -968,6 +1030,14 @@ func (c *Controller) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Virt
 				log.Log.Object(vm).Infof("processing forced restart request for VMI with phase %s and VM runStrategy: %s", vmi.Status.Phase, runStrategy)
 			}
 			if forceRestart || vmi.IsFinal() {
+				if !forceRestart && isVMIHibernated(vmi) {
+					log.Log.Object(vm).Infof("VMI was hibernated, setting runStrategy to halted")
+					// the VM is resumed from the saved state on its next start
+					vm.Spec.Running = nil
+					vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyHalted)
+					// return here and let the halted runstrategy stop the VMI.
+					return vm, nil
+				}
 				if vmi.IsDecentralizedMigration() {
 					if vmi.IsMigrationCompleted() {
 						log.Log.Object(vm).Infof("decentralized migration completed, setting runStrategy to halted")
@@ -1248,6 +1318,11 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 		return vm, nil
 	}
 
+	if vm.Status.DiskTaskInProgress != nil {
+		log.Log.Object(vm).V(4).Infof("Disk task %s is processing the volumes of the VM, delaying start", *vm.Status.DiskTaskInProgress)
+		return vm, nil
+	}
+
 	// TODO add check for existence
 	vmKey, err := controller.KeyFunc(vm)
 	if err != nil {
@@ -1555,6 +1630,53 @@ func startFailureBackoffTimeLeft(vm *virtv1.VirtualMachine) int64 {
 	return 0
 }
 
+// syncSecureBootKeys keeps the SecureBoot keys last reported by a VMI, they stay enrolled in the persistent EFI NVRAM
+// of the VM while it is stopped
+func syncSecureBootKeys(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vm.Spec.Template == nil || util.SecureBootKeysSource(&vm.Spec.Template.Spec) == nil {
+		vm.Status.SecureBootKeys = nil
+	} else if vmi != nil && vmi.Status.SecureBootKeys != nil {
+		vm.Status.SecureBootKeys = vmi.Status.SecureBootKeys.DeepCopy()
+	}
+}
+
+// syncPersistentReservations reports the persistent reservations of the LUNs of the running VMI
+func syncPersistentReservations(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	vm.Status.PersistentReservations = nil
+	if vmi == nil || vmi.IsFinal() {
+		return
+	}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.PersistentReservation == nil {
+			continue
+		}
+		vm.Status.PersistentReservations = append(vm.Status.PersistentReservations, virtv1.VolumePersistentReservation{
+			Name:        volumeStatus.Name,
+			Reservation: *volumeStatus.PersistentReservation.DeepCopy(),
+		})
+	}
+}
+
+// syncHibernation keeps the hibernation status last reported by a VMI, a saved state is restored on the next start
+func syncHibernation(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vm.Spec.Template == nil || vm.Spec.Template.Spec.Hibernation == nil {
+		vm.Status.Hibernation = nil
+	} else if vmi != nil && vmi.Status.Hibernation != nil {
+		vm.Status.Hibernation = vmi.Status.Hibernation.DeepCopy()
+	} else if vmi != nil && metav1.HasAnnotation(vmi.ObjectMeta, virtv1.HibernationDiscardStateAnnotation) {
+		// the saved state was discarded when the VMI started
+		vm.Status.Hibernation = nil
+	}
+}
+
+func isHibernated(vm *virtv1.VirtualMachine) bool {
+	return vm.Status.Hibernation != nil && vm.Status.Hibernation.Phase == virtv1.HibernationSaved
+}
+
+func isVMIHibernated(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.Status.Phase == virtv1.Succeeded && vmi.Status.Hibernation != nil && vmi.Status.Hibernation.Phase == virtv1.HibernationSaved
+}
+
 func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
 	if shouldClearStartFailure(vm, vmi) {
 		// if a vmi associated with the vm hits a running phase, then reset the start failure counter
@@ -1593,20 +1715,19 @@ func syncVolumeMigration(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineIn
 	vmiCond := controller.NewVirtualMachineInstanceConditionManager()
 
 	// Check if the volumes have been recovered and point to the original ones
-	srcMigVols := make(map[string]string)
-	for _, v := range vm.Status.VolumeUpdateState.VolumeMigrationState.MigratedVolumes {
-		if v.SourcePVCInfo != nil {
-			srcMigVols[v.VolumeName] = v.SourcePVCInfo.ClaimName
+	srcMigVols := make(map[string]*virtv1.StorageMigratedVolumeInfo)
+	for i, v := range vm.Status.VolumeUpdateState.VolumeMigrationState.MigratedVolumes {
+		if v.SourcePVCInfo != nil || v.SourceVolume != nil {
+			srcMigVols[v.VolumeName] = &vm.Status.VolumeUpdateState.VolumeMigrationState.MigratedVolumes[i]
 		}
 	}
 	recoveredOldVMVolumes := true
 	for _, v := range vm.Spec.Template.Spec.Volumes {
-		name := storagetypes.PVCNameFromVirtVolume(&v)
-		origName, ok := srcMigVols[v.Name]
+		migVol, ok := srcMigVols[v.Name]
 		if !ok {
 			continue
 		}
-		if origName != name {
+		if !volumemig.IsSourceVolume(migVol, &v) {
 			recoveredOldVMVolumes = false
 		}
 	}
@@ -1901,6 +2022,21 @@ func (c *Controller) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMa
 
 	setupStableFirmwareUUID(vm, vmi)
 
+	// resume from the domain state saved by the last hibernation, unless the start requested to discard it
+	if vmi.Spec.Hibernation != nil {
+		if hasStartDiscardHibernationStateRequest(vm) {
+			if vmi.ObjectMeta.Annotations == nil {
+				vmi.ObjectMeta.Annotations = map[string]string{}
+			}
+			vmi.ObjectMeta.Annotations[virtv1.HibernationDiscardStateAnnotation] = ""
+		} else if isHibernated(vm) {
+			if vmi.ObjectMeta.Annotations == nil {
+				vmi.ObjectMeta.Annotations = map[string]string{}
+			}
+			vmi.ObjectMeta.Annotations[virtv1.HibernationRestoreAnnotation] = ""
+		}
+	}
+
 	// TODO check if vmi labels exist, and when make sure that they match. For now just override them
 	vmi.ObjectMeta.Labels = vm.Spec.Template.ObjectMeta.Labels
 	vmi.ObjectMeta.OwnerReferences = []metav1.OwnerReference{
@@ -1922,6 +2058,18 @@ func hasStartPausedRequest(vm *virtv1.VirtualMachine) bool {
 		pausedValue == virtv1.StartRequestDataPausedTrue
 }
 
+func hasStartDiscardHibernationStateRequest(vm *virtv1.VirtualMachine) bool {
+	if len(vm.Status.StateChangeRequests) == 0 {
+		return false
+	}
+
+	stateChange := vm.Status.StateChangeRequests[0]
+	discardValue, hasDiscard := stateChange.Data[virtv1.StartRequestDataDiscardHibernationStateKey]
+	return stateChange.Action == virtv1.StartRequest &&
+		hasDiscard &&
+		discardValue == virtv1.StartRequestDataDiscardHibernationStateTrue
+}
+
 func hasStartRequest(vm *virtv1.VirtualMachine) bool {
 	if len(vm.Status.StateChangeRequests) == 0 {
 		return false
@@ -2501,6 +2649,9 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 	}
 
 	syncStartFailureStatus(vm, vmi)
+	syncSecureBootKeys(vm, vmi)
+	syncHibernation(vm, vmi)
+	syncPersistentReservations(vm, vmi)
 	// On a successful migration, the volume change condition is removed and we need to detect the removal before the synchronization of the VMI
 	// condition to the VM
 	syncVolumeMigration(vm, vmi)
@@ -3377,11 +3528,18 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 		return nil
 	}
 
+	if conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMemoryUnplug, k8score.ConditionFalse) {
+		setRestartRequired(vm, "memory updated in template spec. The guest did not release the hot-unplugged memory")
+		return nil
+	}
+
 	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Equal(*vmi.Spec.Domain.Memory.Guest) {
 		return nil
 	}
 
-	if !vmi.IsMigratable() {
+	// memory is hot-unplugged in place, only plugging memory requires a migration
+	isUnplug := vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Cmp(*vmi.Spec.Domain.Memory.Guest) < 0
+	if !isUnplug && !vmi.IsMigratable() {
 		setRestartRequired(vm, "memory updated in template spec. Memory-hotplug is only available for migratable VMs")
 		return nil
 	}
@@ -3396,6 +3554,11 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 		return fmt.Errorf("another memory hotplug is in progress")
 	}
 
+	if conditionManager.HasConditionWithStatus(vmi,
+		virtv1.VirtualMachineInstanceMemoryUnplug, k8score.ConditionTrue) {
+		return fmt.Errorf("another memory hot-unplug is in progress")
+	}
+
 	if migrations.IsMigrating(vmi) {
 		return fmt.Errorf("memory hotplug is not allowed while VMI is migrating")
 	}
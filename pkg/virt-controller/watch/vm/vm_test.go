@@ -51,6 +51,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
 	"kubevirt.io/kubevirt/tests/framework/matcher"
 
 	gomegatypes "github.com/onsi/gomega/types"
@@ -2613,6 +2614,43 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(string(vmi1.Spec.Domain.Firmware.UUID)).To(Equal(uid))
 		})
 
+		DescribeTable("should request the restore of the hibernated state", func(phase v1.HibernationPhase, withHibernation, expectRestore bool) {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			if withHibernation {
+				vm.Spec.Template.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation"}
+			}
+			vm.Status.Hibernation = &v1.HibernationStatus{Phase: phase}
+
+			vmi := controller.setupVMIFromVM(vm)
+			if expectRestore {
+				Expect(vmi.Annotations).To(HaveKey(v1.HibernationRestoreAnnotation))
+			} else {
+				Expect(vmi.Annotations).ToNot(HaveKey(v1.HibernationRestoreAnnotation))
+			}
+		},
+			Entry("when the state was saved", v1.HibernationSaved, true, true),
+			Entry("not when the state was restored", v1.HibernationRestored, true, false),
+			Entry("not when the hibernation failed", v1.HibernationFailed, true, false),
+			Entry("not when the VM has no hibernation PVC anymore", v1.HibernationSaved, false, false),
+		)
+
+		DescribeTable("should request to discard the hibernated state when the start requested it", func(phase v1.HibernationPhase) {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			vm.Spec.Template.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation"}
+			vm.Status.Hibernation = &v1.HibernationStatus{Phase: phase}
+			vm.Status.StateChangeRequests = []v1.VirtualMachineStateChangeRequest{{
+				Action: v1.StartRequest,
+				Data:   map[string]string{v1.StartRequestDataDiscardHibernationStateKey: v1.StartRequestDataDiscardHibernationStateTrue},
+			}}
+
+			vmi := controller.setupVMIFromVM(vm)
+			Expect(vmi.Annotations).To(HaveKey(v1.HibernationDiscardStateAnnotation))
+			Expect(vmi.Annotations).ToNot(HaveKey(v1.HibernationRestoreAnnotation))
+		},
+			Entry("when the state was saved", v1.HibernationSaved),
+			Entry("when the restore failed", v1.HibernationFailed),
+		)
+
 		It("should delete VirtualMachineInstance when stopped", func() {
 			vm, vmi := watchtesting.DefaultVirtualMachine(false)
 
@@ -4887,6 +4925,70 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
 				})
+
+				It("should patch VMI when CPU hot-unplug is requested", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    2,
+						MaxSockets: 4,
+					}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(uint32(1)))
+					Expect(vm).ToNot(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+				})
+
+				It("should refuse a CPU change while the guest releases hot-unplugged vCPUs", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    2,
+						MaxSockets: 4,
+					}
+					virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceVCPUUnplug,
+						Status: k8sv1.ConditionTrue,
+					})
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(MatchError("another CPU hot-unplug is in progress"))
+				})
+
+				It("should raise RestartRequired condition when the guest did not release the hot-unplugged vCPUs", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					// the sockets of the VMI are already updated once the hot-unplug started
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    1,
+						MaxSockets: 4,
+					}
+					virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceVCPUUnplug,
+						Status: k8sv1.ConditionFalse,
+						Reason: "HotUnplugTimedOut",
+					})
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+				})
 			})
 
 			Context("Memory", func() {
@@ -5271,6 +5373,61 @@ var _ = Describe("VirtualMachine", func() {
 						"Status":  Equal(k8sv1.ConditionTrue),
 					}))
 				})
+
+				It("should hot-unplug memory in place even if VM is not migratable", func() {
+					guestAtBoot := resource.MustParse("1Gi")
+					guestMemory := resource.MustParse("2Gi")
+					newMemory := resource.MustParse("1Gi")
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newMemory}
+					vm.Spec.Template.Spec.Architecture = "amd64"
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestFromSpec}
+					vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory] = guestMemory
+					vmi.Status.Memory = &v1.MemoryStatus{
+						GuestAtBoot:  &guestAtBoot,
+						GuestCurrent: &guestMemory,
+					}
+					virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionFalse,
+					})
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleMemoryHotplugRequest(vm, vmi)).To(Succeed())
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.Memory.Guest.Value()).To(Equal(newMemory.Value()))
+					Expect(vm).ToNot(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+				})
+
+				It("should set a restartRequired condition if the guest did not release the hot-unplugged memory", func() {
+					guestMemory := resource.MustParse("2Gi")
+					newMemory := resource.MustParse("1Gi")
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newMemory}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestFromSpec}
+					vmi.Status.Memory = &v1.MemoryStatus{
+						GuestAtBoot:  &newMemory,
+						GuestCurrent: &guestMemory,
+					}
+					virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceMemoryUnplug,
+						Status: k8sv1.ConditionFalse,
+					})
+
+					Expect(controller.handleMemoryHotplugRequest(vm, vmi)).To(Succeed())
+
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Message).To(ContainSubstring("The guest did not release the hot-unplugged memory"))
+				})
 			})
 
 			Context("Tolerations", func() {
@@ -5538,6 +5695,68 @@ var _ = Describe("VirtualMachine", func() {
 					Entry("when the destination DV doesn't exist", false, storagetypes.NewDVNotFoundError(newDVName)),
 					Entry("when the destination DV exist but the PVC", true, storagetypes.NewPVCNotFoundError(newDVName)),
 				)
+
+				It("should create the destination DataVolume of a containerDisk", func() {
+					var created int
+					cdiClient.Fake.PrependReactor("create", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						created++
+						dv := action.(testing.CreateAction).GetObject().(*cdiv1.DataVolume)
+						Expect(dv.Name).To(Equal(newDVName))
+						Expect(dv.OwnerReferences).To(HaveLen(1))
+						Expect(dv.OwnerReferences[0].UID).To(Equal(vmUID))
+						Expect(dv.Annotations).To(HaveKeyWithValue(volumemig.DestinationDataVolumeAnnotation, diskName))
+						Expect(dv.Spec.Source.Blank).ToNot(BeNil())
+						return true, dv, nil
+					})
+					vmi := libvmi.New(libvmi.WithNamespace(ns), libvmi.WithContainerDisk(diskName, "image"))
+					vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: diskName, Size: 1073741824}}
+					vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns),
+						libvmi.WithDataVolume(diskName, newDVName)),
+						libvmi.WithUpdateVolumeStrategy(v1.UpdateVolumesStrategyMigration))
+					vm.UID = vmUID
+
+					Expect(controller.handleVolumeUpdateRequest(vm, vmi)).To(Succeed())
+					Expect(created).To(Equal(1))
+					testutils.ExpectEvent(recorder, SuccessfulDataVolumeCreateReason)
+				})
+
+				It("should delete the destination DataVolume when the volume migration is cancelled", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+					var deleted int
+					shouldExpectDataVolumeDeletion(&deleted)
+					dv := libdv.NewDataVolume(libdv.WithName(newDVName), libdv.WithNamespace(ns))
+					dv.Annotations = map[string]string{volumemig.DestinationDataVolumeAnnotation: diskName}
+					dv.OwnerReferences = []metav1.OwnerReference{{UID: vmUID, Controller: pointer.P(true)}}
+					Expect(dataVolumeInformer.GetStore().Add(dv)).To(Succeed())
+
+					vmi := libvmi.New(libvmi.WithNamespace(ns), libvmi.WithDataVolume(diskName, newDVName))
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+						Type:   v1.VirtualMachineInstanceVolumesChange,
+						Status: k8sv1.ConditionTrue,
+					}}
+					vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{{
+						VolumeName:         diskName,
+						SourceVolume:       &v1.MigratedSourceVolume{ContainerDisk: &v1.ContainerDiskSource{Image: "image"}},
+						DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: newDVName},
+					}}
+					vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns),
+						libvmi.WithContainerDisk(diskName, "image")),
+						libvmi.WithUpdateVolumeStrategy(v1.UpdateVolumesStrategyMigration))
+					vm.UID = vmUID
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.TODO(),
+						vmi, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					Expect(controller.handleVolumeUpdateRequest(vm, vmi)).To(Succeed())
+					Expect(deleted).To(Equal(1))
+					testutils.ExpectEvent(recorder, SuccessfulDataVolumeDeleteReason)
+				})
 			})
 
 			Context("Instance Types and Preferences", func() {
@@ -6259,6 +6478,53 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(vmiList.Items).To(BeEmpty())
 			})
+
+			It("should not start the VMI while a disk task is in progress", func() {
+				vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)))
+				vm.Status.DiskTaskInProgress = pointer.P("sparsify")
+				vmCopy, err := controller.startVMI(vm)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm).To(Equal(vmCopy))
+				vmiList, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmiList.Items).To(BeEmpty())
+			})
+		})
+
+		Context("hibernation", func() {
+			DescribeTable("should halt the VM once the VMI is hibernated", func(phase v1.HibernationPhase, expectedRunStrategy v1.VirtualMachineRunStrategy) {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Template.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation"}
+				key, err := virtcontroller.KeyFunc(vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				addVirtualMachine(vm)
+				sanityExecute(vm)
+				clearExpectations(vm)
+
+				vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				By("marking the VMI as hibernated")
+				vmi.Status.Phase = v1.Succeeded
+				vmi.Status.Hibernation = &v1.HibernationStatus{Phase: phase}
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Update(context.TODO(), vmi, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				controller.vmiIndexer.Add(vmi)
+
+				controller.Queue.Add(key)
+				sanityExecute(vm)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Spec.RunStrategy).To(Equal(pointer.P(expectedRunStrategy)))
+				Expect(vm.Status.Hibernation).To(Equal(vmi.Status.Hibernation))
+			},
+				Entry("when the state was saved", v1.HibernationSaved, v1.RunStrategyHalted),
+				Entry("not when the hibernation failed", v1.HibernationFailed, v1.RunStrategyAlways),
+			)
 		})
 
 		Context("decentralized migration", func() {
@@ -6441,6 +6707,89 @@ var _ = Describe("VirtualMachine", func() {
 		)
 	})
 
+	Context("syncSecureBootKeys", func() {
+		keys := &v1.SecureBootKeysStatus{PK: []string{"aa"}, KEK: []string{"bb"}, DB: []string{"cc"}}
+		otherKeys := &v1.SecureBootKeysStatus{PK: []string{"dd"}, KEK: []string{"bb"}, DB: []string{"cc"}}
+
+		withVMIKeys := func(keys *v1.SecureBootKeysStatus) *v1.VirtualMachineInstance {
+			vmi := libvmi.New(libvmi.WithSecureBootKeysSecret("keys"))
+			vmi.Status.SecureBootKeys = keys
+			return vmi
+		}
+
+		DescribeTable("should report the SecureBoot keys", func(vmi *v1.VirtualMachineInstance, withKeys bool, previous, expected *v1.SecureBootKeysStatus) {
+			vm := libvmi.NewVirtualMachine(libvmi.New())
+			if withKeys {
+				vm = libvmi.NewVirtualMachine(libvmi.New(libvmi.WithSecureBootKeysSecret("keys")))
+			}
+			vm.Status.SecureBootKeys = previous
+			syncSecureBootKeys(vm, vmi)
+			Expect(vm.Status.SecureBootKeys).To(Equal(expected))
+		},
+			Entry("reported by the VMI", withVMIKeys(keys), true, nil, keys),
+			Entry("newly reported by the VMI", withVMIKeys(keys), true, otherKeys, keys),
+			Entry("until reported by the VMI", withVMIKeys(nil), true, otherKeys, otherKeys),
+			Entry("while the VM is stopped", nil, true, keys, keys),
+			Entry("only when requested by the VM", nil, false, keys, nil),
+		)
+	})
+
+	Context("syncHibernation", func() {
+		saved := &v1.HibernationStatus{Phase: v1.HibernationSaved, QEMUVersion: "9.1.0", MachineType: "pc-q35-rhel9.6.0"}
+		restoring := &v1.HibernationStatus{Phase: v1.HibernationRestoring}
+
+		withVMIHibernation := func(status *v1.HibernationStatus) *v1.VirtualMachineInstance {
+			vmi := libvmi.New()
+			vmi.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation"}
+			vmi.Status.Hibernation = status
+			return vmi
+		}
+
+		DescribeTable("should report the hibernation", func(vmi *v1.VirtualMachineInstance, withHibernation bool, previous, expected *v1.HibernationStatus) {
+			vm := libvmi.NewVirtualMachine(libvmi.New())
+			if withHibernation {
+				vm.Spec.Template.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation"}
+			}
+			vm.Status.Hibernation = previous
+			syncHibernation(vm, vmi)
+			Expect(vm.Status.Hibernation).To(Equal(expected))
+		},
+			Entry("reported by the VMI", withVMIHibernation(saved), true, nil, saved),
+			Entry("newly reported by the VMI", withVMIHibernation(restoring), true, saved, restoring),
+			Entry("until reported by the VMI", withVMIHibernation(nil), true, saved, saved),
+			Entry("while the VM is stopped", nil, true, saved, saved),
+			Entry("only when requested by the VM", nil, false, saved, nil),
+			Entry("not once the VMI discarded the state", libvmi.New(libvmi.WithAnnotation(v1.HibernationDiscardStateAnnotation, "")), true, saved, nil),
+		)
+	})
+
+	Context("syncPersistentReservations", func() {
+		reservation := &v1.PersistentReservationStatus{Keys: []string{"0x1", "0x2"}, Holder: "0x1", Type: "WriteExclusiveRegistrantsOnly"}
+		reported := []v1.VolumePersistentReservation{{Name: "quorum", Reservation: *reservation}}
+
+		withVMIReservation := func(phase v1.VirtualMachineInstancePhase, reservation *v1.PersistentReservationStatus) *v1.VirtualMachineInstance {
+			vmi := libvmi.New(
+				libvmi.WithPersistentVolumeClaimLun("quorum", "quorum-pvc", true),
+				libvmi.WithPersistentVolumeClaim("data", "data-pvc"),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+			)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "quorum", PersistentReservation: reservation}, {Name: "data"}}
+			return vmi
+		}
+
+		DescribeTable("should report the persistent reservations", func(vmi *v1.VirtualMachineInstance, previous, expected []v1.VolumePersistentReservation) {
+			vm := libvmi.NewVirtualMachine(libvmi.New())
+			vm.Status.PersistentReservations = previous
+			syncPersistentReservations(vm, vmi)
+			Expect(vm.Status.PersistentReservations).To(Equal(expected))
+		},
+			Entry("reported by the running VMI", withVMIReservation(v1.Running, reservation), nil, reported),
+			Entry("only once reported by the VMI", withVMIReservation(v1.Running, nil), nil, nil),
+			Entry("not once the VMI is stopped", withVMIReservation(v1.Succeeded, reservation), reported, nil),
+			Entry("not without a VMI", nil, reported, nil),
+		)
+	})
+
 	Context("syncVolumeMigration", func() {
 		const (
 			volName = "disk0"
@@ -45,6 +45,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
@@ -115,6 +116,10 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return common.NewSyncError(fmt.Errorf("active migration exists"), controller.FailedCreatePodReason), pod
 		}
 
+		if syncErr := c.validateSharedDiskGroup(vmi); syncErr != nil {
+			return syncErr, pod
+		}
+
 		backendStoragePVCName, syncErr := c.handleBackendStorage(vmi)
 		if syncErr != nil {
 			return syncErr, pod
@@ -869,6 +874,25 @@ func (c *Controller) listPodsFromNamespace(namespace string) ([]*k8sv1.Pod, erro
 	return pods, nil
 }
 
+func (c *Controller) validateSharedDiskGroup(vmi *virtv1.VirtualMachineInstance) common.SyncError {
+	if vmi.Spec.SharedDiskGroup == "" {
+		return nil
+	}
+	objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return common.NewSyncError(err, controller.FailedCreatePodReason)
+	}
+	members := []*virtv1.VirtualMachineInstance{}
+	for _, obj := range objs {
+		members = append(members, obj.(*virtv1.VirtualMachineInstance))
+	}
+	if err := reservation.ValidateSharedDiskGroupMembers(vmi, members); err != nil {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.SharedDiskGroupMismatchReason, err.Error())
+		return common.NewSyncError(err, controller.SharedDiskGroupMismatchReason)
+	}
+	return nil
+}
+
 func (c *Controller) setActivePods(vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachineInstance, error) {
 	pods, err := c.listPodsFromNamespace(vmi.Namespace)
 	if err != nil {
@@ -994,14 +1018,16 @@ func (c *Controller) requireCPUHotplug(vmi *virtv1.VirtualMachineInstance) bool
 		Sockets: vmi.Status.CurrentCPUTopology.Sockets,
 		Threads: vmi.Status.CurrentCPUTopology.Threads,
 	}
-	return hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU) != hardware.GetNumberOfVCPUs(cpuTopoLogyFromStatus)
+	// vCPUs are hot-unplugged in place by virt-handler, only plugging vCPUs requires a migration
+	return hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU) > hardware.GetNumberOfVCPUs(cpuTopoLogyFromStatus)
 }
 
 func (c *Controller) requireMemoryHotplug(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi.Status.Memory == nil || vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Guest == nil || vmi.Spec.Domain.Memory.MaxGuest == nil {
 		return false
 	}
-	return vmi.Spec.Domain.Memory.Guest.Value() != vmi.Status.Memory.GuestRequested.Value()
+	// memory is hot-unplugged in place by virt-handler, only plugging memory requires a migration
+	return vmi.Spec.Domain.Memory.Guest.Value() > vmi.Status.Memory.GuestRequested.Value()
 }
 
 func (c *Controller) syncMemoryHotplug(vmi *virtv1.VirtualMachineInstance) {
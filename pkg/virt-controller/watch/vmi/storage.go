@@ -332,6 +332,10 @@ func (c *Controller) requireVolumesUpdate(vmi *virtv1.VirtualMachineInstance) bo
 	}
 	migVolsMap := make(map[string]string)
 	for _, v := range vmi.Status.MigratedVolumes {
+		// Volumes which aren't backed by a PVC, like hostDisks, don't have a source claim
+		if v.SourcePVCInfo == nil || v.DestinationPVCInfo == nil {
+			continue
+		}
 		migVolsMap[v.SourcePVCInfo.ClaimName] = v.DestinationPVCInfo.ClaimName
 	}
 	for _, v := range vmi.Spec.Volumes {
@@ -3803,6 +3803,58 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		})
 	})
 
+	Context("with a shared disk group", func() {
+		withSharedLUN := func(claimName string) libvmi.Option {
+			return func(vmi *virtv1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, virtv1.Disk{
+					Name:       "quorum",
+					Shareable:  pointer.P(true),
+					DiskDevice: virtv1.DiskDevice{LUN: &virtv1.LunTarget{Bus: virtv1.DiskBusSCSI, Reservation: true}},
+				})
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+					Name: "quorum",
+					VolumeSource: virtv1.VolumeSource{
+						PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+						},
+					},
+				})
+			}
+		}
+
+		newMember := func(name, group string, opts ...libvmi.Option) *virtv1.VirtualMachineInstance {
+			vmi := newPendingVirtualMachine(name)
+			vmi.Spec.SharedDiskGroup = group
+			for _, opt := range opts {
+				opt(vmi)
+			}
+			return vmi
+		}
+
+		It("should delay pod creation if the members use different reservation LUNs", func() {
+			Expect(controller.vmiIndexer.Add(newMember("member", "cluster", withSharedLUN("quorum")))).To(Succeed())
+			vmi := newMember("testvmi", "cluster", withSharedLUN("other-quorum"))
+			addVirtualMachine(vmi)
+			addDataVolumePVC(newPvc(vmi.Namespace, "other-quorum"))
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, kvcontroller.SharedDiskGroupMismatchReason)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", virtv1.CreatedByLabel, string(vmi.UID))})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(BeEmpty())
+		})
+
+		It("should ignore VMIs of other shared disk groups", func() {
+			Expect(controller.vmiIndexer.Add(newMember("member", "other-cluster", withSharedLUN("quorum")))).To(Succeed())
+			vmi := newMember("testvmi", "cluster")
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			expectMatchingPodCreation(vmi, HaveField("ObjectMeta.Labels", HaveKeyWithValue(virtv1.SharedDiskGroupLabel, "cluster")))
+		})
+	})
+
 	Context("Event handling", func() {
 		indexVMI := func(vmi *virtv1.VirtualMachineInstance) {
 			Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
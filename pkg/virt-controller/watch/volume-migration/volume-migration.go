@@ -25,6 +25,7 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
@@ -44,19 +45,24 @@ import (
 
 const InvalidUpdateErrMsg = "The volume can only be reverted to the previous version during the update"
 
+// DestinationDataVolumeAnnotation marks the DataVolumes created by the controller as destination of the migration
+// of a volume which isn't backed by a PVC. The value is the name of the migrated volume.
+const DestinationDataVolumeAnnotation = "kubevirt.io/volume-migration-destination"
+
 // invalidVols includes the invalid volumes for the volume migration
 type invalidVols struct {
 	hotplugged []string
 	fs         []string
 	shareable  []string
 	luns       []string
+	cdroms     []string
 	noCSIDVs   []string
 }
 
 func (vols *invalidVols) errorMessage() error {
 	var s strings.Builder
 	if len(vols.hotplugged) < 1 && len(vols.fs) < 1 &&
-		len(vols.shareable) < 1 && len(vols.luns) < 1 && len(vols.noCSIDVs) < 1 {
+		len(vols.shareable) < 1 && len(vols.luns) < 1 && len(vols.cdroms) < 1 && len(vols.noCSIDVs) < 1 {
 		return nil
 	}
 	s.WriteString("invalid volumes to update with migration:")
@@ -72,6 +78,9 @@ func (vols *invalidVols) errorMessage() error {
 	if len(vols.luns) > 0 {
 		s.WriteString(fmt.Sprintf(" luns: %v", vols.luns))
 	}
+	if len(vols.cdroms) > 0 {
+		s.WriteString(fmt.Sprintf(" cdroms: %v", vols.cdroms))
+	}
 	if len(vols.noCSIDVs) > 0 {
 		s.WriteString(fmt.Sprintf(" DV storage class isn't a CSI or not using volume populators: %v", vols.noCSIDVs))
 	}
@@ -79,7 +88,7 @@ func (vols *invalidVols) errorMessage() error {
 	return fmt.Errorf(s.String())
 }
 
-// updatedVolumesMapping returns a mapping with the volume names and the old claims that have been updated in the VM
+// updatedVolumesMapping returns a mapping with the volume names and the new claims that have been updated in the VM
 func updatedVolumesMapping(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) map[string]string {
 	updateVols := make(map[string]string)
 	vmVols := make(map[string]string)
@@ -91,17 +100,45 @@ func updatedVolumesMapping(vmi *virtv1.VirtualMachineInstance, vm *virtv1.Virtua
 	}
 	// Old volumes
 	for _, v := range vmi.Spec.Volumes {
-		name := storagetypes.PVCNameFromVirtVolume(&v)
-		if name == "" {
+		claim, ok := vmVols[v.Name]
+		if !ok {
 			continue
 		}
-		if claim, ok := vmVols[v.Name]; ok && name != claim {
+		name := storagetypes.PVCNameFromVirtVolume(&v)
+		switch {
+		case name != "" && name != claim:
+			updateVols[v.Name] = claim
+		// Volumes which aren't backed by a PVC, like hostDisks and containerDisks, can be copied to a PVC
+		case name == "" && MigratedSourceVolumeFromVolume(&v) != nil:
 			updateVols[v.Name] = claim
 		}
 	}
 	return updateVols
 }
 
+// MigratedSourceVolumeFromVolume returns the source of the volume migration for the volumes which aren't backed by a PVC,
+// and nil otherwise
+func MigratedSourceVolumeFromVolume(volume *virtv1.Volume) *virtv1.MigratedSourceVolume {
+	switch {
+	case volume.HostDisk != nil:
+		return &virtv1.MigratedSourceVolume{HostDisk: volume.HostDisk.DeepCopy()}
+	case volume.ContainerDisk != nil:
+		return &virtv1.MigratedSourceVolume{ContainerDisk: volume.ContainerDisk.DeepCopy()}
+	}
+	return nil
+}
+
+// IsSourceVolume checks if the volume corresponds to the source volume of the migrated volume
+func IsSourceVolume(migVol *virtv1.StorageMigratedVolumeInfo, volume *virtv1.Volume) bool {
+	switch {
+	case migVol.SourcePVCInfo != nil:
+		return storagetypes.PVCNameFromVirtVolume(volume) == migVol.SourcePVCInfo.ClaimName
+	case migVol.SourceVolume != nil:
+		return equality.Semantic.DeepEqual(MigratedSourceVolumeFromVolume(volume), migVol.SourceVolume)
+	}
+	return false
+}
+
 // ValidateVolumes checks that the volumes can be updated with the migration
 func ValidateVolumes(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine, dvStore, pvcStore cache.Store) error {
 	var invalidVols invalidVols
@@ -114,6 +151,7 @@ func ValidateVolumes(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachi
 	updatedVols := updatedVolumesMapping(vmi, vm)
 	valid := true
 	disks := storagetypes.GetDisksByName(&vmi.Spec)
+	vmiVols := storagetypes.GetVolumesByName(&vmi.Spec)
 	filesystems := storagetypes.GetFilesystemsFromVolumes(vmi)
 	for _, v := range vm.Spec.Template.Spec.Volumes {
 		_, ok := updatedVols[v.Name]
@@ -147,6 +185,14 @@ func ValidateVolumes(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachi
 			continue
 		}
 
+		// CD-ROMs aren't copied during the migration, hence the medium cannot be moved to a PVC
+		if srcVol, ok := vmiVols[v.Name]; ok && d.DiskDevice.CDRom != nil &&
+			MigratedSourceVolumeFromVolume(srcVol) != nil {
+			invalidVols.cdroms = append(invalidVols.cdroms, v.Name)
+			valid = false
+			continue
+		}
+
 		// DataVolumes with a no-csi storage class
 		if v.VolumeSource.DataVolume != nil {
 			dv, err := storagetypes.GetDataVolumeFromCache(vm.Namespace, v.VolumeSource.DataVolume.Name, dvStore)
@@ -201,10 +247,15 @@ func VolumeMigrationCancel(clientset kubecli.KubevirtClient, vmi *virtv1.Virtual
 
 func changeMigratedVolumes(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) bool {
 	updatedVols := updatedVolumesMapping(vmi, vm)
+	vmVols := storagetypes.GetVolumesByName(&vm.Spec.Template.Spec)
 	for _, migVol := range vmi.Status.MigratedVolumes {
 		if _, ok := updatedVols[migVol.VolumeName]; ok {
 			return true
 		}
+		// The volume has been replaced by a volume which isn't backed by a PVC
+		if v, ok := vmVols[migVol.VolumeName]; ok && storagetypes.PVCNameFromVirtVolume(v) == "" {
+			return true
+		}
 	}
 	return false
 }
@@ -212,16 +263,24 @@ func changeMigratedVolumes(vmi *virtv1.VirtualMachineInstance, vm *virtv1.Virtua
 // revertedToOldVolumes checks that all migrated volumes have been reverted from destination to the source volume
 func revertedToOldVolumes(vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) bool {
 	updatedVols := updatedVolumesMapping(vmi, vm)
+	vmVols := storagetypes.GetVolumesByName(&vm.Spec.Template.Spec)
 	for _, migVol := range vmi.Status.MigratedVolumes {
-		if migVol.SourcePVCInfo == nil {
+		switch {
+		case migVol.SourcePVCInfo != nil:
+			claim, ok := updatedVols[migVol.VolumeName]
+			if !ok || migVol.SourcePVCInfo.ClaimName != claim {
+				return false
+			}
+			delete(updatedVols, migVol.VolumeName)
+		case migVol.SourceVolume != nil:
+			v, ok := vmVols[migVol.VolumeName]
+			if !ok || !IsSourceVolume(&migVol, v) {
+				return false
+			}
+		default:
 			// something wrong with the source volume
 			return false
 		}
-		claim, ok := updatedVols[migVol.VolumeName]
-		if !ok || migVol.SourcePVCInfo.ClaimName != claim {
-			return false
-		}
-		delete(updatedVols, migVol.VolumeName)
 	}
 	// updatedVols should only include the source volumes and not additional volumes.
 	return len(updatedVols) == 0
@@ -270,9 +329,12 @@ func IsVolumeMigrating(vmi *virtv1.VirtualMachineInstance) bool {
 func GenerateMigratedVolumes(pvcStore cache.Store, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) ([]virtv1.StorageMigratedVolumeInfo, error) {
 	var migVolsInfo []virtv1.StorageMigratedVolumeInfo
 	oldVols := make(map[string]string)
+	oldSrcVols := make(map[string]*virtv1.MigratedSourceVolume)
 	for _, v := range vmi.Spec.Volumes {
 		if pvcName := storagetypes.PVCNameFromVirtVolume(&v); pvcName != "" {
 			oldVols[v.Name] = pvcName
+		} else if srcVol := MigratedSourceVolumeFromVolume(&v); srcVol != nil {
+			oldSrcVols[v.Name] = srcVol
 		}
 	}
 	for _, v := range vm.Spec.Template.Spec.Volumes {
@@ -280,43 +342,159 @@ func GenerateMigratedVolumes(pvcStore cache.Store, vmi *virtv1.VirtualMachineIns
 		if claim == "" {
 			continue
 		}
+		srcVol, isSrcVol := oldSrcVols[v.Name]
 		oldClaim, ok := oldVols[v.Name]
-		if !ok {
+		if !isSrcVol && (!ok || oldClaim == claim) {
 			continue
 		}
-		if oldClaim == claim {
-			continue
-		}
-		oldPvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, oldClaim, pvcStore)
-		if err != nil {
-			return nil, err
-		}
 		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, claim, pvcStore)
 		if err != nil {
 			return nil, err
 		}
-		var oldVolMode *k8sv1.PersistentVolumeMode
 		var volMode *k8sv1.PersistentVolumeMode
-		if oldPvc != nil && oldPvc.Spec.VolumeMode != nil {
-			oldVolMode = oldPvc.Spec.VolumeMode
-		}
 		if pvc != nil && pvc.Spec.VolumeMode != nil {
 			volMode = pvc.Spec.VolumeMode
 		}
-		migVolsInfo = append(migVolsInfo, virtv1.StorageMigratedVolumeInfo{
+		migVol := virtv1.StorageMigratedVolumeInfo{
 			VolumeName: v.Name,
 			DestinationPVCInfo: &virtv1.PersistentVolumeClaimInfo{
 				ClaimName:  claim,
 				VolumeMode: volMode,
 			},
-			SourcePVCInfo: &virtv1.PersistentVolumeClaimInfo{
-				ClaimName:  oldClaim,
-				VolumeMode: oldVolMode,
+		}
+		if isSrcVol {
+			migVol.SourceVolume = srcVol
+			migVolsInfo = append(migVolsInfo, migVol)
+			continue
+		}
+		oldPvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, oldClaim, pvcStore)
+		if err != nil {
+			return nil, err
+		}
+		var oldVolMode *k8sv1.PersistentVolumeMode
+		if oldPvc != nil && oldPvc.Spec.VolumeMode != nil {
+			oldVolMode = oldPvc.Spec.VolumeMode
+		}
+		migVol.SourcePVCInfo = &virtv1.PersistentVolumeClaimInfo{
+			ClaimName:  oldClaim,
+			VolumeMode: oldVolMode,
+		}
+		migVolsInfo = append(migVolsInfo, migVol)
+	}
+
+	return migVolsInfo, nil
+}
+
+// GenerateDestinationDataVolumeTemplates generates the blank DataVolumes which the volumes not backed by a PVC, like
+// hostDisks and containerDisks, are copied to. A DataVolume is only generated if the VM references a DataVolume which
+// neither exists nor is created from one of the DataVolumeTemplates of the VM.
+func GenerateDestinationDataVolumeTemplates(dvStore, pvcStore cache.Store, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) ([]virtv1.DataVolumeTemplateSpec, error) {
+	var templates []virtv1.DataVolumeTemplateSpec
+	vmiVols := storagetypes.GetVolumesByName(&vmi.Spec)
+	for _, v := range vm.Spec.Template.Spec.Volumes {
+		if v.DataVolume == nil {
+			continue
+		}
+		srcVol, ok := vmiVols[v.Name]
+		if !ok || MigratedSourceVolumeFromVolume(srcVol) == nil {
+			continue
+		}
+		if hasDataVolumeTemplate(vm, v.DataVolume.Name) {
+			continue
+		}
+		dv, err := storagetypes.GetDataVolumeFromCache(vm.Namespace, v.DataVolume.Name, dvStore)
+		if err != nil {
+			return nil, err
+		}
+		if dv != nil {
+			continue
+		}
+		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, v.DataVolume.Name, pvcStore)
+		if err != nil {
+			return nil, err
+		}
+		if pvc != nil {
+			continue
+		}
+		size, err := sourceVolumeSize(vmi, srcVol)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, virtv1.DataVolumeTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        v.DataVolume.Name,
+				Annotations: map[string]string{DestinationDataVolumeAnnotation: v.Name},
+			},
+			Spec: cdiv1.DataVolumeSpec{
+				Source: &cdiv1.DataVolumeSource{Blank: &cdiv1.DataVolumeBlankImage{}},
+				Storage: &cdiv1.StorageSpec{
+					Resources: k8sv1.VolumeResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: *size},
+					},
+				},
 			},
 		})
 	}
 
-	return migVolsInfo, nil
+	return templates, nil
+}
+
+// sourceVolumeSize returns the size of the disk of a volume not backed by a PVC. The size reported by virt-handler
+// is preferred over the capacity of the hostDisk since an existing disk can be larger than the requested capacity.
+func sourceVolumeSize(vmi *virtv1.VirtualMachineInstance, volume *virtv1.Volume) (*resource.Quantity, error) {
+	for _, status := range vmi.Status.VolumeStatus {
+		if status.Name == volume.Name && status.Size > 0 {
+			return resource.NewQuantity(status.Size, resource.BinarySI), nil
+		}
+	}
+	if volume.HostDisk != nil && volume.HostDisk.Capacity.Value() > 0 {
+		capacity := volume.HostDisk.Capacity.DeepCopy()
+		return &capacity, nil
+	}
+	return nil, fmt.Errorf("the size of the volume %s isn't known yet", volume.Name)
+}
+
+func hasDataVolumeTemplate(vm *virtv1.VirtualMachine, name string) bool {
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		if template.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// UnusedDestinationDataVolumes returns the DataVolumes created as destination of the migrated volumes of the VMI which
+// the VM doesn't reference anymore, like after the cancellation of the volume migration
+func UnusedDestinationDataVolumes(dvStore cache.Store, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) ([]*cdiv1.DataVolume, error) {
+	var dvs []*cdiv1.DataVolume
+	referenced := make(map[string]bool)
+	for _, v := range vm.Spec.Template.Spec.Volumes {
+		if name := storagetypes.PVCNameFromVirtVolume(&v); name != "" {
+			referenced[name] = true
+		}
+	}
+	for _, migVol := range vmi.Status.MigratedVolumes {
+		if migVol.SourceVolume == nil || migVol.DestinationPVCInfo == nil ||
+			referenced[migVol.DestinationPVCInfo.ClaimName] || hasDataVolumeTemplate(vm, migVol.DestinationPVCInfo.ClaimName) {
+			continue
+		}
+		dv, err := storagetypes.GetDataVolumeFromCache(vm.Namespace, migVol.DestinationPVCInfo.ClaimName, dvStore)
+		if err != nil {
+			return nil, err
+		}
+		if dv == nil || dv.DeletionTimestamp != nil {
+			continue
+		}
+		if _, ok := dv.Annotations[DestinationDataVolumeAnnotation]; !ok {
+			continue
+		}
+		if ref := metav1.GetControllerOf(dv); ref == nil || ref.UID != vm.UID {
+			continue
+		}
+		dvs = append(dvs, dv)
+	}
+
+	return dvs, nil
 }
 
 // PatchVMIStatusWithMigratedVolumes patches the VMI status with the source and destination volume information during the volume migration
@@ -29,7 +29,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
@@ -137,6 +139,24 @@ var _ = Describe("Volume Migration", func() {
 			Entry("with a DV with a no-csi storageclass", libvmi.New(libvmi.WithNamespace(ns), libvmi.WithDataVolume("disk0", "vol0")),
 				libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns), libvmi.WithDataVolume("disk0", noCSIDVName))),
 				fmt.Errorf("invalid volumes to update with migration: DV storage class isn't a CSI or not using volume populators: [disk0]")),
+			Entry("with a hostDisk volume", libvmi.New(
+				libvmi.WithHostDisk("disk0", "/disks/disk0.img", v1.HostDiskExists),
+			), libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithPersistentVolumeClaim("disk0", "vol2"),
+			)), nil),
+			Entry("with a containerDisk volume", libvmi.New(
+				libvmi.WithContainerDisk("disk0", "image"),
+			), libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithPersistentVolumeClaim("disk0", "vol2"),
+			)), nil),
+			Entry("with an invalid containerDisk cdrom", libvmi.New(
+				libvmi.WithCDRomAndVolume(v1.DiskBusSATA, v1.Volume{
+					Name:         "disk0",
+					VolumeSource: v1.VolumeSource{ContainerDisk: &v1.ContainerDiskSource{Image: "image"}},
+				}),
+			), libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithCDRom("disk0", v1.DiskBusSATA, "vol2"),
+			)), fmt.Errorf("invalid volumes to update with migration: cdroms: [disk0]")),
 		)
 
 		It("should return an error if the DV doesn't exist", func() {
@@ -257,6 +277,30 @@ var _ = Describe("Volume Migration", func() {
 				{generateDiskNameFromIndex(0), "src0", "dst0"}, {generateDiskNameFromIndex(1), "src1", "dst1"}, {generateDiskNameFromIndex(2), "src2", "dst2"}},
 				true, fmt.Errorf(volumemigration.InvalidUpdateErrMsg), false),
 		)
+
+		It("should cancel the volume migration when a hostDisk volume is reverted", func() {
+			const path = "/disks/disk0.img"
+			vmi := libvmi.New(libvmi.WithNamespace(ns), libvmi.WithPersistentVolumeClaim("disk0", "dst0"))
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type: v1.VirtualMachineInstanceVolumesChange, Status: k8sv1.ConditionTrue})
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{{
+				VolumeName: "disk0",
+				SourceVolume: &v1.MigratedSourceVolume{
+					HostDisk: &v1.HostDisk{Path: path, Type: v1.HostDiskExists},
+				},
+				DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: "dst0"},
+			}}
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns), libvmi.WithHostDisk("disk0", path, v1.HostDiskExists)))
+			_, err := fakeClientset.KubevirtV1().VirtualMachineInstances(ns).Create(context.TODO(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := volumemigration.VolumeMigrationCancel(virtClient, vmi, vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeTrue())
+			updatedVMI, err := fakeClientset.KubevirtV1().VirtualMachineInstances(ns).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.MigratedVolumes).To(BeEmpty())
+		})
 	})
 
 	Context("IsVolumeMigrating", func() {
@@ -356,6 +400,28 @@ var _ = Describe("Volume Migration", func() {
 			Entry("without any update", []string{"vol0"}, []string{"vol0"}, map[string]migVolumes{}),
 		)
 
+		DescribeTable("should generate the migrated volumes for a source not backed by a PVC", func(vmiVolume libvmi.Option, expectedSrc *v1.MigratedSourceVolume) {
+			shouldAddPVCsIntoTheStore(nil, []string{"dst0"})
+			vmi := libvmi.New(libvmi.WithNamespace(ns), vmiVolume)
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns), libvmi.WithPersistentVolumeClaim("disk0", "dst0")))
+
+			volMig, err := volumemigration.GenerateMigratedVolumes(pvcStore, vmi, vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volMig).To(ConsistOf(v1.StorageMigratedVolumeInfo{
+				VolumeName:   "disk0",
+				SourceVolume: expectedSrc,
+				DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{
+					ClaimName:  "dst0",
+					VolumeMode: virtpointer.P(k8sv1.PersistentVolumeFilesystem),
+				},
+			}))
+		},
+			Entry("with a hostDisk", libvmi.WithHostDisk("disk0", "/disks/disk0.img", v1.HostDiskExists),
+				&v1.MigratedSourceVolume{HostDisk: &v1.HostDisk{Path: "/disks/disk0.img", Type: v1.HostDiskExists}}),
+			Entry("with a containerDisk", libvmi.WithContainerDisk("disk0", "image"),
+				&v1.MigratedSourceVolume{ContainerDisk: &v1.ContainerDiskSource{Image: "image"}}),
+		)
+
 	})
 
 	Context("ValidateVolumesUpdateMigration", func() {
@@ -478,6 +544,123 @@ var _ = Describe("Volume Migration", func() {
 		)
 	})
 
+	Context("GenerateDestinationDataVolumeTemplates", func() {
+		var (
+			dataVolumeStore cache.Store
+			pvcStore        cache.Store
+		)
+		const (
+			ns     = "test"
+			volume = "disk0"
+			dvName = "dst0"
+		)
+		BeforeEach(func() {
+			dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+			dataVolumeStore = dataVolumeInformer.GetStore()
+			pvcStore = pvcInformer.GetStore()
+		})
+
+		newVM := func(opts ...libvmi.VMOption) *v1.VirtualMachine {
+			return libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns), libvmi.WithDataVolume(volume, dvName)), opts...)
+		}
+
+		DescribeTable("should generate a blank DataVolume sized as the source volume", func(vmiVolume libvmi.Option, size int64, expectedSize string) {
+			vmi := libvmi.New(libvmi.WithNamespace(ns), vmiVolume)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: volume, Size: size}}
+
+			templates, err := volumemigration.GenerateDestinationDataVolumeTemplates(dataVolumeStore, pvcStore, vmi, newVM())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(templates).To(HaveLen(1))
+			Expect(templates[0].Name).To(Equal(dvName))
+			Expect(templates[0].Annotations).To(HaveKeyWithValue(volumemigration.DestinationDataVolumeAnnotation, volume))
+			Expect(templates[0].Spec.Source.Blank).ToNot(BeNil())
+			storage := templates[0].Spec.Storage.Resources.Requests[k8sv1.ResourceStorage]
+			Expect(storage.Equal(resource.MustParse(expectedSize))).To(BeTrue())
+		},
+			Entry("with a containerDisk", libvmi.WithContainerDisk(volume, "image"), int64(1073741824), "1Gi"),
+			Entry("with a hostDisk", libvmi.WithHostDisk(volume, "/disks/disk0.img", v1.HostDiskExists), int64(2147483648), "2Gi"),
+			Entry("with the capacity of a hostDisk", libvmi.WithHostDiskAndCapacity(volume, "/disks/disk0.img", v1.HostDiskExistsOrCreate, "3Gi"), int64(0), "3Gi"),
+		)
+
+		It("should fail if the size of the source volume isn't known", func() {
+			vmi := libvmi.New(libvmi.WithNamespace(ns), libvmi.WithContainerDisk(volume, "image"))
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: volume}}
+
+			_, err := volumemigration.GenerateDestinationDataVolumeTemplates(dataVolumeStore, pvcStore, vmi, newVM())
+			Expect(err).To(MatchError(ContainSubstring("the size of the volume disk0 isn't known")))
+		})
+
+		DescribeTable("should not generate a DataVolume", func(existing func(), vm *v1.VirtualMachine) {
+			existing()
+			vmi := libvmi.New(libvmi.WithNamespace(ns), libvmi.WithContainerDisk(volume, "image"))
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: volume, Size: 1024}}
+
+			Expect(volumemigration.GenerateDestinationDataVolumeTemplates(dataVolumeStore, pvcStore, vmi, vm)).To(BeEmpty())
+		},
+			Entry("if the DataVolume exists", func() {
+				Expect(dataVolumeStore.Add(libdv.NewDataVolume(libdv.WithNamespace(ns), libdv.WithName(dvName)))).To(Succeed())
+			}, newVM()),
+			Entry("if the PVC exists", func() {
+				Expect(pvcStore.Add(&k8sv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: dvName, Namespace: ns}})).To(Succeed())
+			}, newVM()),
+			Entry("if the DataVolume is created from a template", func() {},
+				newVM(libvmi.WithDataVolumeTemplate(libdv.NewDataVolume(libdv.WithName(dvName))))),
+		)
+	})
+
+	Context("UnusedDestinationDataVolumes", func() {
+		var dataVolumeStore cache.Store
+		const (
+			ns     = "test"
+			volume = "disk0"
+			dvName = "dst0"
+			vmUID  = "vm-uid"
+		)
+		BeforeEach(func() {
+			dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			dataVolumeStore = dataVolumeInformer.GetStore()
+		})
+
+		newVMI := func() *v1.VirtualMachineInstance {
+			vmi := libvmi.New(libvmi.WithNamespace(ns), libvmi.WithContainerDisk(volume, "image"))
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{{
+				VolumeName:         volume,
+				SourceVolume:       &v1.MigratedSourceVolume{ContainerDisk: &v1.ContainerDiskSource{Image: "image"}},
+				DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: dvName},
+			}}
+			return vmi
+		}
+
+		addDataVolume := func(owner types.UID, annotated bool) {
+			dv := libdv.NewDataVolume(libdv.WithNamespace(ns), libdv.WithName(dvName))
+			dv.OwnerReferences = []metav1.OwnerReference{{UID: owner, Controller: pointer.P(true)}}
+			if annotated {
+				dv.Annotations = map[string]string{volumemigration.DestinationDataVolumeAnnotation: volume}
+			}
+			Expect(dataVolumeStore.Add(dv)).To(Succeed())
+		}
+
+		DescribeTable("should return the DataVolumes created for the migration", func(owner types.UID, annotated bool, vmVolume libvmi.Option, expectedLen int) {
+			addDataVolume(owner, annotated)
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns), vmVolume))
+			vm.UID = vmUID
+
+			dvs, err := volumemigration.UnusedDestinationDataVolumes(dataVolumeStore, newVMI(), vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dvs).To(HaveLen(expectedLen))
+		},
+			Entry("when the VM has been reverted to the source volume", types.UID(vmUID), true,
+				libvmi.WithContainerDisk(volume, "image"), 1),
+			Entry("but not when the VM still references the DataVolume", types.UID(vmUID), true,
+				libvmi.WithDataVolume(volume, dvName), 0),
+			Entry("but not when the DataVolume isn't owned by the VM", types.UID("other"), true,
+				libvmi.WithContainerDisk(volume, "image"), 0),
+			Entry("but not when the DataVolume wasn't created by the controller", types.UID(vmUID), false,
+				libvmi.WithContainerDisk(volume, "image"), 0),
+		)
+	})
+
 	Context("PatchVMIVolumes", func() {
 		var (
 			ctrl          *gomock.Controller
@@ -96,6 +96,7 @@ type LauncherClient interface {
 	CancelVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error
 	FinalizeVirtualMachineMigration(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	HotplugHostDevices(vmi *v1.VirtualMachineInstance) error
+	HibernateVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	DeleteDomain(vmi *v1.VirtualMachineInstance) error
 	GetDomain() (*api.Domain, bool, error)
 	GetDomainStats() (*stats.DomainStats, bool, error)
@@ -105,14 +106,20 @@ type LauncherClient interface {
 	Exec(string, string, []string, int32) (int, string, error)
 	Ping() error
 	GuestPing(string, int32) error
+	GuestFileRead(domainName, path string, maxBytes int64, w io.Writer) error
+	GuestFileWrite(domainName, path string, maxBytes int64, r io.Reader) error
+	GuestExec(domainName, command string, args []string, timeout time.Duration, output func(stdout, stderr []byte) error) (int, error)
 	Close()
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
 	SyncVirtualMachineCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
+	GetTDXQuote(*v1.VirtualMachineInstance, []byte) (*v1.TDXQuoteInfo, error)
+	GetSEVSNPAttestationInfo(*v1.VirtualMachineInstance) (*v1.SEVSNPAttestationInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
 	SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
+	SetGuestMemoryTarget(domainName string, targetKiB uint64) error
 	GetDomainDirtyRateStats() (dirtyRateMbps int64, err error)
 }
 
@@ -126,6 +133,19 @@ const (
 	longTimeout  time.Duration = 20 * time.Second
 )
 
+// guestFileChunkSize is the amount of data moved per launcher call when copying guest files
+const guestFileChunkSize = 32 * 1024
+
+// ErrGuestFileTooLarge is returned when a guest file copy exceeds the allowed size
+var ErrGuestFileTooLarge = errors.New("guest file exceeds the maximum allowed size")
+
+// guestExecPollInterval is the interval in which the status of a guest command is polled
+const guestExecPollInterval = 250 * time.Millisecond
+
+// ErrGuestExecTimeout is returned when a guest command does not exit within its timeout, the command keeps running
+// in the guest
+var ErrGuestExecTimeout = errors.New("timed out waiting for the guest command to exit")
+
 func SetBaseDir(dir string) {
 	baseDir = dir
 }
@@ -397,6 +417,10 @@ func (c *VirtLauncherClient) PauseVirtualMachine(vmi *v1.VirtualMachineInstance)
 	return c.genericSendVMICmd("Pause", c.v1client.PauseVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
 
+func (c *VirtLauncherClient) HibernateVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("Hibernate", c.v1client.HibernateVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
 func (c *VirtLauncherClient) UnpauseVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("Unpause", c.v1client.UnpauseVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -763,6 +787,149 @@ func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32)
 	return err
 }
 
+// GuestFileRead copies the guest file at path to w, failing with ErrGuestFileTooLarge after maxBytes
+func (c *VirtLauncherClient) GuestFileRead(domainName, path string, maxBytes int64, w io.Writer) error {
+	handle, err := c.guestFileOpen(domainName, path, "r")
+	if err != nil {
+		return err
+	}
+	defer c.guestFileClose(domainName, handle)
+
+	var total int64
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+		resp, err := c.v1client.GuestFileRead(ctx, &cmdv1.GuestFileReadRequest{
+			DomainName: domainName,
+			Handle:     handle,
+			Count:      guestFileChunkSize,
+		})
+		cancel()
+		if err = handleError(err, "GuestFileRead", resp.GetResponse()); err != nil {
+			return err
+		}
+
+		total += int64(len(resp.Data))
+		if total > maxBytes {
+			return ErrGuestFileTooLarge
+		}
+		if _, err := w.Write(resp.Data); err != nil {
+			return err
+		}
+		if resp.Eof || len(resp.Data) == 0 {
+			return nil
+		}
+	}
+}
+
+// GuestFileWrite copies r to the guest file at path, failing with ErrGuestFileTooLarge after maxBytes
+func (c *VirtLauncherClient) GuestFileWrite(domainName, path string, maxBytes int64, r io.Reader) error {
+	handle, err := c.guestFileOpen(domainName, path, "w")
+	if err != nil {
+		return err
+	}
+	defer c.guestFileClose(domainName, handle)
+
+	var total int64
+	buf := make([]byte, guestFileChunkSize)
+	for {
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 {
+			total += int64(n)
+			if total > maxBytes {
+				return ErrGuestFileTooLarge
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+			response, err := c.v1client.GuestFileWrite(ctx, &cmdv1.GuestFileWriteRequest{
+				DomainName: domainName,
+				Handle:     handle,
+				Data:       buf[:n],
+			})
+			cancel()
+			if err = handleError(err, "GuestFileWrite", response); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			return nil
+		} else if readErr != nil {
+			return readErr
+		}
+	}
+}
+
+func (c *VirtLauncherClient) guestFileOpen(domainName, path, mode string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileOpen(ctx, &cmdv1.GuestFileOpenRequest{
+		DomainName: domainName,
+		Path:       path,
+		Mode:       mode,
+	})
+	if err = handleError(err, "GuestFileOpen", resp.GetResponse()); err != nil {
+		return 0, err
+	}
+	return resp.Handle, nil
+}
+
+func (c *VirtLauncherClient) guestFileClose(domainName string, handle int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	response, err := c.v1client.GuestFileClose(ctx, &cmdv1.GuestFileCloseRequest{
+		DomainName: domainName,
+		Handle:     handle,
+	})
+	if err = handleError(err, "GuestFileClose", response); err != nil {
+		log.Log.Reason(err).Warningf("failed to close guest file handle %d", handle)
+	}
+}
+
+// GuestExec runs the command in the guest and passes its output to output. The guest agent only reports the output
+// of a command once it exited, so output is called with the whole output after the command exited.
+// It returns the exit code of the command or ErrGuestExecTimeout if it did not exit within timeout, in which
+// case the command is still running in the guest and its output is lost.
+func (c *VirtLauncherClient) GuestExec(domainName, command string, args []string, timeout time.Duration, output func(stdout, stderr []byte) error) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	startResponse, err := c.v1client.GuestExecStart(ctx, &cmdv1.GuestExecStartRequest{
+		DomainName: domainName,
+		Command:    command,
+		Args:       args,
+	})
+	cancel()
+	if err = handleError(err, "GuestExecStart", startResponse.GetResponse()); err != nil {
+		return -1, err
+	}
+
+	ticker := time.NewTicker(guestExecPollInterval)
+	defer ticker.Stop()
+	deadline := time.Now().Add(timeout)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+		resp, err := c.v1client.GuestExecStatus(ctx, &cmdv1.GuestExecStatusRequest{
+			DomainName: domainName,
+			Pid:        startResponse.Pid,
+		})
+		cancel()
+		if err = handleError(err, "GuestExecStatus", resp.GetResponse()); err != nil {
+			return -1, err
+		}
+
+		if len(resp.Stdout) > 0 || len(resp.Stderr) > 0 {
+			if err := output(resp.Stdout, resp.Stderr); err != nil {
+				return -1, err
+			}
+		}
+		if resp.Exited {
+			return int(resp.ExitCode), nil
+		}
+
+		if deadline.Before(<-ticker.C) {
+			return -1, fmt.Errorf("%w: the command with PID %d is still running in the guest", ErrGuestExecTimeout, startResponse.Pid)
+		}
+	}
+}
+
 func (c *VirtLauncherClient) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	request := &cmdv1.EmptyRequest{}
 	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
@@ -811,6 +978,66 @@ func (c *VirtLauncherClient) GetLaunchMeasurement(vmi *v1.VirtualMachineInstance
 	return sevMeasurementInfo, nil
 }
 
+func (c *VirtLauncherClient) GetTDXQuote(vmi *v1.VirtualMachineInstance, report []byte) (*v1.TDXQuoteInfo, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.TDXQuoteRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Report: report,
+	}
+
+	// The quote generation service may need to reach the provisioning certification service
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	tdxQuoteResponse, err := c.v1client.GetTDXQuote(ctx, request)
+	if err = handleError(err, "GetTDXQuote", tdxQuoteResponse.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	tdxQuoteInfo := &v1.TDXQuoteInfo{}
+	if err := json.Unmarshal(tdxQuoteResponse.GetTdxQuote(), tdxQuoteInfo); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling TDX quote response")
+		return nil, err
+	}
+
+	return tdxQuoteInfo, nil
+}
+
+func (c *VirtLauncherClient) GetSEVSNPAttestationInfo(vmi *v1.VirtualMachineInstance) (*v1.SEVSNPAttestationInfo, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.VMIRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	sevSNPAttestationInfoResponse, err := c.v1client.GetSEVSNPAttestationInfo(ctx, request)
+	if err = handleError(err, "GetSEVSNPAttestationInfo", sevSNPAttestationInfoResponse.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	sevSNPAttestationInfo := &v1.SEVSNPAttestationInfo{}
+	if err := json.Unmarshal(sevSNPAttestationInfoResponse.GetSevSNPAttestationInfo(), sevSNPAttestationInfo); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling SEV-SNP attestation info response")
+		return nil, err
+	}
+
+	return sevSNPAttestationInfo, nil
+}
+
 func (c *VirtLauncherClient) InjectLaunchSecret(vmi *v1.VirtualMachineInstance, sevSecretOptions *v1.SEVSecretOptions) error {
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
@@ -840,3 +1067,14 @@ func (c *VirtLauncherClient) InjectLaunchSecret(vmi *v1.VirtualMachineInstance,
 func (c *VirtLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error {
 	return c.genericSendVMICmd("SyncVirtualMachineMemory", c.v1client.SyncVirtualMachineMemory, vmi, options)
 }
+
+func (c *VirtLauncherClient) SetGuestMemoryTarget(domainName string, targetKiB uint64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	response, err := c.v1client.SetGuestMemoryTarget(ctx, &cmdv1.GuestMemoryTargetRequest{
+		DomainName: domainName,
+		TargetKiB:  targetKiB,
+	})
+	return handleError(err, "SetGuestMemoryTarget", response)
+}
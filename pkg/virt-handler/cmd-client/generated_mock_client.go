@@ -10,11 +10,12 @@
 package cmdclient
 
 import (
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 	v1 "kubevirt.io/api/core/v1"
-
 	v10 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
@@ -250,6 +251,36 @@ func (mr *MockLauncherClientMockRecorder) GetSEVInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockLauncherClient)(nil).GetSEVInfo))
 }
 
+// GetSEVSNPAttestationInfo mocks base method.
+func (m *MockLauncherClient) GetSEVSNPAttestationInfo(arg0 *v1.VirtualMachineInstance) (*v1.SEVSNPAttestationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationInfo", arg0)
+	ret0, _ := ret[0].(*v1.SEVSNPAttestationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationInfo indicates an expected call of GetSEVSNPAttestationInfo.
+func (mr *MockLauncherClientMockRecorder) GetSEVSNPAttestationInfo(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationInfo", reflect.TypeOf((*MockLauncherClient)(nil).GetSEVSNPAttestationInfo), arg0)
+}
+
+// GetTDXQuote mocks base method.
+func (m *MockLauncherClient) GetTDXQuote(arg0 *v1.VirtualMachineInstance, arg1 []byte) (*v1.TDXQuoteInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTDXQuote", arg0, arg1)
+	ret0, _ := ret[0].(*v1.TDXQuoteInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTDXQuote indicates an expected call of GetTDXQuote.
+func (mr *MockLauncherClientMockRecorder) GetTDXQuote(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTDXQuote", reflect.TypeOf((*MockLauncherClient)(nil).GetTDXQuote), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockLauncherClient) GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +296,49 @@ func (mr *MockLauncherClientMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockLauncherClient)(nil).GetUsers))
 }
 
+// GuestExec mocks base method.
+func (m *MockLauncherClient) GuestExec(domainName, command string, args []string, timeout time.Duration, output func([]byte, []byte) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExec", domainName, command, args, timeout, output)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExec indicates an expected call of GuestExec.
+func (mr *MockLauncherClientMockRecorder) GuestExec(domainName, command, args, timeout, output any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExec", reflect.TypeOf((*MockLauncherClient)(nil).GuestExec), domainName, command, args, timeout, output)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockLauncherClient) GuestFileRead(domainName, path string, maxBytes int64, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", domainName, path, maxBytes, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockLauncherClientMockRecorder) GuestFileRead(domainName, path, maxBytes, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileRead), domainName, path, maxBytes, w)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockLauncherClient) GuestFileWrite(domainName, path string, maxBytes int64, r io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", domainName, path, maxBytes, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockLauncherClientMockRecorder) GuestFileWrite(domainName, path, maxBytes, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileWrite), domainName, path, maxBytes, r)
+}
+
 // GuestPing mocks base method.
 func (m *MockLauncherClient) GuestPing(arg0 string, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -279,6 +353,20 @@ func (mr *MockLauncherClientMockRecorder) GuestPing(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestPing", reflect.TypeOf((*MockLauncherClient)(nil).GuestPing), arg0, arg1)
 }
 
+// HibernateVirtualMachine mocks base method.
+func (m *MockLauncherClient) HibernateVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HibernateVirtualMachine", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HibernateVirtualMachine indicates an expected call of HibernateVirtualMachine.
+func (mr *MockLauncherClientMockRecorder) HibernateVirtualMachine(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HibernateVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).HibernateVirtualMachine), vmi)
+}
+
 // HotplugHostDevices mocks base method.
 func (m *MockLauncherClient) HotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -377,6 +465,20 @@ func (mr *MockLauncherClientMockRecorder) ResetVirtualMachine(vmi any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).ResetVirtualMachine), vmi)
 }
 
+// SetGuestMemoryTarget mocks base method.
+func (m *MockLauncherClient) SetGuestMemoryTarget(domainName string, targetKiB uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuestMemoryTarget", domainName, targetKiB)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuestMemoryTarget indicates an expected call of SetGuestMemoryTarget.
+func (mr *MockLauncherClientMockRecorder) SetGuestMemoryTarget(domainName, targetKiB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuestMemoryTarget", reflect.TypeOf((*MockLauncherClient)(nil).SetGuestMemoryTarget), domainName, targetKiB)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockLauncherClient) ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
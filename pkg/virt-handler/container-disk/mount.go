@@ -242,12 +242,9 @@ func (m *mounter) MountAndVerify(vmi *v1.VirtualMachineInstance) error {
 			if err != nil {
 				return err
 			}
-			diskName := containerdisk.GetDiskTargetName(i)
-			// If diskName is a symlink it will fail if the target exists.
-			if err := safepath.TouchAtNoFollow(diskTargetDir, diskName, os.ModePerm); err != nil {
-				if !os.IsExist(err) {
-					return fmt.Errorf("failed to create mount point target: %v", err)
-				}
+			diskName := getDiskTargetName(&volume, i)
+			if err := createMountTarget(diskTargetDir, diskName, containerdisk.IsLayered(volume.ContainerDisk)); err != nil {
+				return err
 			}
 			targetFile, err := safepath.JoinNoFollow(diskTargetDir, diskName)
 			if err != nil {
@@ -279,7 +276,7 @@ func (m *mounter) MountAndVerify(vmi *v1.VirtualMachineInstance) error {
 			if err != nil {
 				return err
 			}
-			diskName := containerdisk.GetDiskTargetName(i)
+			diskName := getDiskTargetName(&volume, i)
 			targetFile, err := safepath.JoinNoFollow(diskTargetDir, diskName)
 			if err != nil {
 				return err
@@ -608,6 +605,29 @@ func (m *mounter) unmountKernelArtifacts(vmi *v1.VirtualMachineInstance) error {
 	return fmt.Errorf("kernel artifacts record wasn't found")
 }
 
+// getDiskTargetName returns the mount point name of a containerDisk.
+// Layered containerDisks mount the directory holding their backing chain instead of a single file.
+func getDiskTargetName(volume *v1.Volume, volumeIndex int) string {
+	if containerdisk.IsLayered(volume.ContainerDisk) {
+		return containerdisk.GetLayeredDiskTargetName(volumeIndex)
+	}
+	return containerdisk.GetDiskTargetName(volumeIndex)
+}
+
+func createMountTarget(diskTargetDir *safepath.Path, diskName string, isDir bool) error {
+	var err error
+	// If diskName is a symlink it will fail if the target exists.
+	if isDir {
+		err = safepath.MkdirAtNoFollow(diskTargetDir, diskName, 0755)
+	} else {
+		err = safepath.TouchAtNoFollow(diskTargetDir, diskName, os.ModePerm)
+	}
+	if err != nil && !os.IsExist(err) {
+		return fmt.Errorf("failed to create mount point target: %v", err)
+	}
+	return nil
+}
+
 func (m *mounter) getContainerDiskPath(vmi *v1.VirtualMachineInstance, volume *v1.Volume, volumeIndex int) (*safepath.Path, error) {
 	sock, err := m.socketPathGetter(vmi, volumeIndex)
 	if err != nil {
@@ -624,6 +644,9 @@ func (m *mounter) getContainerDiskPath(vmi *v1.VirtualMachineInstance, volume *v
 		return nil, fmt.Errorf("failed to detect root mount point of containerDisk %v on the node: %v", volume.Name, err)
 	}
 
+	if containerdisk.IsLayered(volume.ContainerDisk) {
+		return containerdisk.GetImageDir(mountPoint, volume.ContainerDisk.Path)
+	}
 	return containerdisk.GetImage(mountPoint, volume.ContainerDisk.Path)
 }
 
@@ -692,7 +715,7 @@ func (m *mounter) ComputeChecksums(vmi *v1.VirtualMachineInstance) (*DiskChecksu
 
 	// compute for containerdisks
 	for i, volume := range vmi.Spec.Volumes {
-		if volume.VolumeSource.ContainerDisk == nil {
+		if volume.VolumeSource.ContainerDisk == nil || containerdisk.IsLayered(volume.VolumeSource.ContainerDisk) {
 			continue
 		}
 
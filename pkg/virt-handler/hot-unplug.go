@@ -0,0 +1,231 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// hotUnplugTimeout is how long the guest is given to release unplugged
+	// vCPUs or memory before the unplug is reported as failed.
+	hotUnplugTimeout = 5 * time.Minute
+
+	hotUnplugInProgressReason = "HotUnplugInProgress"
+	hotUnplugTimedOutReason   = "HotUnplugTimedOut"
+)
+
+// hotUnplug shrinks the vCPUs and the guest memory of a running VMI in place,
+// without migrating it, when the spec requests less than what is plugged.
+// Growing still goes through a live migration driven by virt-controller.
+func (c *VirtualMachineController) hotUnplug(vmi *v1.VirtualMachineInstance) error {
+	if migrations.IsMigrating(vmi) {
+		return nil
+	}
+
+	unplugCPUs := needsVCPUUnplug(vmi)
+	unplugMemory := needsMemoryUnplug(vmi)
+	if !unplugCPUs && !unplugMemory {
+		return nil
+	}
+
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to hot-unplug: %v", err)
+	}
+	options := virtualMachineOptions(nil, 0, nil, c.capabilities, c.clusterConfig)
+	key := controller.VirtualMachineInstanceKey(vmi)
+
+	if unplugCPUs {
+		log.Log.Object(vmi).V(3).Info("hot-unplugging vCPUs")
+		if err := client.SyncVirtualMachineCPUs(vmi, options); err != nil {
+			return fmt.Errorf("failed to hot-unplug vCPUs: %v", err)
+		}
+		vmi.Status.CurrentCPUTopology = &v1.CPUTopology{
+			Sockets: vmi.Spec.Domain.CPU.Sockets,
+			Cores:   vmi.Spec.Domain.CPU.Cores,
+			Threads: vmi.Spec.Domain.CPU.Threads,
+		}
+		setHotUnplugInProgress(vmi, v1.VirtualMachineInstanceVCPUUnplug, "waiting for the guest to release the hot-unplugged vCPUs")
+		c.queue.AddAfter(key, hotUnplugTimeout)
+	}
+
+	if unplugMemory {
+		log.Log.Object(vmi).V(3).Info("hot-unplugging guest memory")
+		if err := client.SyncVirtualMachineMemory(vmi, options); err != nil {
+			return fmt.Errorf("failed to hot-unplug guest memory: %v", err)
+		}
+		vmi.Status.Memory.GuestRequested = vmi.Spec.Domain.Memory.Guest
+		setHotUnplugInProgress(vmi, v1.VirtualMachineInstanceMemoryUnplug, "waiting for the guest to release the hot-unplugged memory")
+		c.queue.AddAfter(key, hotUnplugTimeout)
+	}
+
+	return nil
+}
+
+func needsVCPUUnplug(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.CPU == nil || vmi.Status.CurrentCPUTopology == nil {
+		return false
+	}
+	if controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceVCPUUnplug, k8sv1.ConditionTrue) {
+		return false
+	}
+	return hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU) < currentVCPUs(vmi)
+}
+
+func currentVCPUs(vmi *v1.VirtualMachineInstance) int64 {
+	return hardware.GetNumberOfVCPUs(&v1.CPU{
+		Sockets: vmi.Status.CurrentCPUTopology.Sockets,
+		Cores:   vmi.Status.CurrentCPUTopology.Cores,
+		Threads: vmi.Status.CurrentCPUTopology.Threads,
+	})
+}
+
+func needsMemoryUnplug(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Guest == nil || vmi.Spec.Domain.Memory.MaxGuest == nil {
+		return false
+	}
+	if vmi.Status.Memory == nil || vmi.Status.Memory.GuestRequested == nil {
+		return false
+	}
+	if controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceMemoryUnplug, k8sv1.ConditionTrue) {
+		return false
+	}
+	return vmi.Spec.Domain.Memory.Guest.Cmp(*vmi.Status.Memory.GuestRequested) < 0
+}
+
+func setHotUnplugInProgress(vmi *v1.VirtualMachineInstance, condType v1.VirtualMachineInstanceConditionType, message string) {
+	now := metav1.Now()
+	controller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               condType,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             hotUnplugInProgressReason,
+		Message:            message,
+	})
+}
+
+func (c *VirtualMachineController) updateVCPUStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.VCPU == nil {
+		return
+	}
+	plugged := int64(domain.Spec.VCPU.CPUs)
+	if domain.Spec.VCPUs != nil {
+		plugged = 0
+		for _, vcpu := range domain.Spec.VCPUs.VCPU {
+			if vcpu.Enabled == "yes" {
+				plugged++
+			}
+		}
+	}
+	requested := plugged
+	if vmi.Spec.Domain.CPU != nil {
+		requested = hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
+	}
+	vmi.Status.Vcpus = &v1.VCPUStatus{
+		Requested: requested,
+		Plugged:   plugged,
+	}
+}
+
+// updateHotUnplugConditions removes the unplug conditions once the guest
+// released the resources, and marks them as failed once the guest did not
+// do so within hotUnplugTimeout. A failed unplug is cleared as soon as the
+// guest released the resources after all, or the spec requests a different
+// amount, so that the next unplug is not blocked by it.
+func updateHotUnplugConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceVCPUUnplug); cond != nil {
+		switch {
+		case vcpusReleased(vmi):
+			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUUnplug)
+		case cond.Status == k8sv1.ConditionTrue:
+			timeOutHotUnplug(vmi, condManager, v1.VirtualMachineInstanceVCPUUnplug, "the guest did not release the hot-unplugged vCPUs")
+		case cond.Reason == hotUnplugTimedOutReason && vcpuRequestChanged(vmi):
+			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUUnplug)
+		}
+	}
+
+	if cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug); cond != nil {
+		switch {
+		case memoryReleased(vmi):
+			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+		case cond.Status == k8sv1.ConditionTrue:
+			timeOutHotUnplug(vmi, condManager, v1.VirtualMachineInstanceMemoryUnplug, "the guest did not release the hot-unplugged memory")
+		case cond.Reason == hotUnplugTimedOutReason && memoryRequestChanged(vmi):
+			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+		}
+	}
+}
+
+func vcpusReleased(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Status.Vcpus != nil && vmi.Status.Vcpus.Plugged <= vmi.Status.Vcpus.Requested
+}
+
+// vcpuRequestChanged tells whether the spec requests a different number of
+// vCPUs than the last unplug did.
+func vcpuRequestChanged(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.CPU == nil || vmi.Status.CurrentCPUTopology == nil {
+		return true
+	}
+	return hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU) != currentVCPUs(vmi)
+}
+
+func memoryReleased(vmi *v1.VirtualMachineInstance) bool {
+	memory := vmi.Status.Memory
+	return memory != nil && memory.GuestCurrent != nil && memory.GuestRequested != nil && memory.GuestCurrent.Cmp(*memory.GuestRequested) <= 0
+}
+
+// memoryRequestChanged tells whether the spec requests a different amount of
+// guest memory than the last unplug did.
+func memoryRequestChanged(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Guest == nil || vmi.Status.Memory == nil || vmi.Status.Memory.GuestRequested == nil {
+		return true
+	}
+	return vmi.Spec.Domain.Memory.Guest.Cmp(*vmi.Status.Memory.GuestRequested) != 0
+}
+
+func timeOutHotUnplug(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager, condType v1.VirtualMachineInstanceConditionType, message string) {
+	cond := condManager.GetCondition(vmi, condType)
+	if time.Since(cond.LastTransitionTime.Time) < hotUnplugTimeout {
+		return
+	}
+	now := metav1.Now()
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               condType,
+		Status:             k8sv1.ConditionFalse,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             hotUnplugTimedOutReason,
+		Message:            message,
+	})
+}
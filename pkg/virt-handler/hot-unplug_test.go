@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Hot-unplug", func() {
+	setHotUnplugTimedOut := func(vmi *v1.VirtualMachineInstance, condType v1.VirtualMachineInstanceConditionType) {
+		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+			Type:   condType,
+			Status: k8sv1.ConditionFalse,
+			Reason: hotUnplugTimedOutReason,
+		})
+	}
+
+	newRunningVMI := func(specSockets, currentSockets uint32) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithCPUCount(1, 1, specSockets))
+		vmi.Status.CurrentCPUTopology = &v1.CPUTopology{Sockets: currentSockets, Cores: 1, Threads: 1}
+		return vmi
+	}
+
+	withMemory := func(vmi *v1.VirtualMachineInstance, guest, requested string) *v1.VirtualMachineInstance {
+		guestQuantity := resource.MustParse(guest)
+		maxGuest := resource.MustParse("2Gi")
+		requestedQuantity := resource.MustParse(requested)
+		vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestQuantity, MaxGuest: &maxGuest}
+		vmi.Status.Memory = &v1.MemoryStatus{GuestRequested: &requestedQuantity, GuestCurrent: &requestedQuantity}
+		return vmi
+	}
+
+	DescribeTable("should detect a vCPU unplug", func(specSockets, currentSockets uint32, expected bool) {
+		Expect(needsVCPUUnplug(newRunningVMI(specSockets, currentSockets))).To(Equal(expected))
+	},
+		Entry("when sockets are reduced", uint32(2), uint32(4), true),
+		Entry("not when sockets are unchanged", uint32(4), uint32(4), false),
+		Entry("not when sockets are increased", uint32(4), uint32(2), false),
+	)
+
+	It("should not start a vCPU unplug while another one is in progress", func() {
+		vmi := newRunningVMI(2, 4)
+		setHotUnplugInProgress(vmi, v1.VirtualMachineInstanceVCPUUnplug, "")
+		Expect(needsVCPUUnplug(vmi)).To(BeFalse())
+	})
+
+	It("should start a vCPU unplug after a previous one timed out", func() {
+		vmi := newRunningVMI(2, 4)
+		setHotUnplugTimedOut(vmi, v1.VirtualMachineInstanceVCPUUnplug)
+		Expect(needsVCPUUnplug(vmi)).To(BeTrue())
+	})
+
+	It("should start a memory unplug after a previous one timed out", func() {
+		vmi := withMemory(newRunningVMI(1, 1), "1Gi", "2Gi")
+		setHotUnplugTimedOut(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+		Expect(needsMemoryUnplug(vmi)).To(BeTrue())
+	})
+
+	DescribeTable("should detect a memory unplug", func(guest, requested string, expected bool) {
+		Expect(needsMemoryUnplug(withMemory(newRunningVMI(1, 1), guest, requested))).To(Equal(expected))
+	},
+		Entry("when guest memory is reduced", "1Gi", "2Gi", true),
+		Entry("not when guest memory is unchanged", "1Gi", "1Gi", false),
+		Entry("not when guest memory is increased", "2Gi", "1Gi", false),
+	)
+
+	It("should report requested and plugged vCPUs from the domain", func() {
+		vmi := newRunningVMI(2, 4)
+		domain := api.NewMinimalDomain("test")
+		domain.Spec.VCPU = &api.VCPU{CPUs: 4}
+		domain.Spec.VCPUs = &api.VCPUs{VCPU: []api.VCPUsVCPU{
+			{ID: 0, Enabled: "yes"},
+			{ID: 1, Enabled: "yes"},
+			{ID: 2, Enabled: "yes"},
+			{ID: 3, Enabled: "no"},
+		}}
+
+		(&VirtualMachineController{}).updateVCPUStatus(vmi, domain)
+		Expect(vmi.Status.Vcpus).To(Equal(&v1.VCPUStatus{Requested: 2, Plugged: 3}))
+	})
+
+	Context("conditions", func() {
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+
+		It("should remove the vCPU unplug condition once the guest released the vCPUs", func() {
+			vmi := newRunningVMI(2, 2)
+			setHotUnplugInProgress(vmi, v1.VirtualMachineInstanceVCPUUnplug, "")
+			vmi.Status.Vcpus = &v1.VCPUStatus{Requested: 2, Plugged: 2}
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceVCPUUnplug)).To(BeFalse())
+		})
+
+		It("should keep the vCPU unplug condition while the guest has not released the vCPUs", func() {
+			vmi := newRunningVMI(2, 2)
+			setHotUnplugInProgress(vmi, v1.VirtualMachineInstanceVCPUUnplug, "")
+			vmi.Status.Vcpus = &v1.VCPUStatus{Requested: 2, Plugged: 4}
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceVCPUUnplug, k8sv1.ConditionTrue)).To(BeTrue())
+		})
+
+		It("should fail the memory unplug when the guest did not release the memory in time", func() {
+			vmi := withMemory(newRunningVMI(1, 1), "1Gi", "1Gi")
+			current := resource.MustParse("2Gi")
+			vmi.Status.Memory.GuestCurrent = &current
+			setHotUnplugInProgress(vmi, v1.VirtualMachineInstanceMemoryUnplug, "")
+			vmi.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-hotUnplugTimeout))
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceMemoryUnplug, k8sv1.ConditionFalse, hotUnplugTimedOutReason)).To(BeTrue())
+		})
+
+		It("should remove the memory unplug condition once the guest released the memory", func() {
+			vmi := withMemory(newRunningVMI(1, 1), "1Gi", "1Gi")
+			setHotUnplugInProgress(vmi, v1.VirtualMachineInstanceMemoryUnplug, "")
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug)).To(BeFalse())
+		})
+
+		It("should keep a timed out vCPU unplug condition while the guest has not released the vCPUs", func() {
+			vmi := newRunningVMI(2, 2)
+			setHotUnplugTimedOut(vmi, v1.VirtualMachineInstanceVCPUUnplug)
+			vmi.Status.Vcpus = &v1.VCPUStatus{Requested: 2, Plugged: 4}
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceVCPUUnplug, k8sv1.ConditionFalse, hotUnplugTimedOutReason)).To(BeTrue())
+		})
+
+		It("should remove a timed out vCPU unplug condition once the guest released the vCPUs", func() {
+			vmi := newRunningVMI(2, 2)
+			setHotUnplugTimedOut(vmi, v1.VirtualMachineInstanceVCPUUnplug)
+			vmi.Status.Vcpus = &v1.VCPUStatus{Requested: 2, Plugged: 2}
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceVCPUUnplug)).To(BeFalse())
+		})
+
+		It("should remove a timed out vCPU unplug condition when the spec requests a different number of vCPUs", func() {
+			vmi := newRunningVMI(3, 2)
+			setHotUnplugTimedOut(vmi, v1.VirtualMachineInstanceVCPUUnplug)
+			vmi.Status.Vcpus = &v1.VCPUStatus{Requested: 3, Plugged: 4}
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceVCPUUnplug)).To(BeFalse())
+		})
+
+		It("should keep a timed out memory unplug condition while the guest has not released the memory", func() {
+			vmi := withMemory(newRunningVMI(1, 1), "1Gi", "1Gi")
+			current := resource.MustParse("2Gi")
+			vmi.Status.Memory.GuestCurrent = &current
+			setHotUnplugTimedOut(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceMemoryUnplug, k8sv1.ConditionFalse, hotUnplugTimedOutReason)).To(BeTrue())
+		})
+
+		It("should remove a timed out memory unplug condition when the spec requests a different amount of memory", func() {
+			vmi := withMemory(newRunningVMI(1, 1), "1536Mi", "1Gi")
+			current := resource.MustParse("2Gi")
+			vmi.Status.Memory.GuestCurrent = &current
+			setHotUnplugTimedOut(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+
+			updateHotUnplugConditions(vmi, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,267 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	virthandlermetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// guestFreeMemoryReservePercent is the share of the guest memory which is left free
+	// in the guest when reclaiming, to absorb allocations until the next adjustment
+	guestFreeMemoryReservePercent = 10
+	// hostMemoryPressureHysteresis is how many percent the node memory usage has to drop
+	// below the threshold before reclaimed memory is given back to the guests
+	hostMemoryPressureHysteresis = 10
+	// minimumTargetChangePercent avoids resizing the balloon for changes smaller than
+	// this share of the guest memory
+	minimumTargetChangePercent = 1
+)
+
+// memoryOvercommitController reclaims unused guest memory through the memory balloon
+// of the VMIs running on the node when the node is under memory pressure, and gives it
+// back once the pressure is gone.
+type memoryOvercommitController struct {
+	host            string
+	vmiStore        cache.Store
+	clusterConfig   *virtconfig.ClusterConfig
+	launcherClients launcherclients.LauncherClientsManager
+	getMemInfo      func() (total uint64, available uint64, err error)
+	// targets holds the balloon targets set by the controller, by VMI UID
+	targets map[types.UID]guestMemoryTarget
+}
+
+type guestMemoryTarget struct {
+	namespace string
+	name      string
+	targetKiB uint64
+}
+
+func newMemoryOvercommitController(host string, vmiStore cache.Store, clusterConfig *virtconfig.ClusterConfig, launcherClients launcherclients.LauncherClientsManager) *memoryOvercommitController {
+	return &memoryOvercommitController{
+		host:            host,
+		vmiStore:        vmiStore,
+		clusterConfig:   clusterConfig,
+		launcherClients: launcherClients,
+		getMemInfo:      getTotalAndAvailableMem,
+		targets:         map[types.UID]guestMemoryTarget{},
+	}
+}
+
+func (c *memoryOvercommitController) Run(stopCh chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-time.After(c.clusterConfig.GetMemoryOvercommitControllerInterval()):
+			c.reconcile()
+		}
+	}
+}
+
+func (c *memoryOvercommitController) reconcile() {
+	vmis := map[types.UID]*v1.VirtualMachineInstance{}
+	for _, obj := range c.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		vmis[vmi.UID] = vmi
+	}
+
+	for uid := range c.targets {
+		if vmi, exists := vmis[uid]; !exists || !vmi.IsRunning() {
+			c.forget(uid)
+		}
+	}
+
+	if !c.clusterConfig.MemoryOvercommitControllerEnabled() {
+		c.releaseAll(vmis)
+		return
+	}
+
+	total, available, err := c.getMemInfo()
+	if err != nil {
+		log.Log.Reason(err).Error("failed to read the node memory usage")
+		return
+	}
+	if total == 0 {
+		return
+	}
+	usedPercent := int((total - available) * 100 / total)
+	threshold := c.clusterConfig.GetHostMemoryPressureThreshold()
+
+	for _, vmi := range vmis {
+		if !isMemoryOvercommitCandidate(vmi) {
+			continue
+		}
+		if err := c.adjust(vmi, usedPercent, threshold); err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("failed to adjust the guest memory target")
+		}
+	}
+}
+
+func (c *memoryOvercommitController) adjust(vmi *v1.VirtualMachineInstance, usedPercent, threshold int) error {
+	guestMemory := guestMemoryOf(vmi)
+	guaranteed, err := c.clusterConfig.GetGuaranteedMemoryForVMI(vmi, guestMemory)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err != nil {
+		return err
+	}
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil || !exists || domainStats.Memory == nil || !domainStats.Memory.ActualBalloonSet {
+		return err
+	}
+
+	var free uint64
+	switch {
+	case domainStats.Memory.UsableSet:
+		free = domainStats.Memory.Usable
+	case domainStats.Memory.UnusedSet:
+		free = domainStats.Memory.Unused
+	default:
+		// without reports of the guest there is nothing to base the target on
+		return nil
+	}
+
+	guestKiB := uint64(guestMemory.Value() / 1024)
+	target := calculateGuestMemoryTarget(guestKiB, uint64(guaranteed.Value()/1024), domainStats.Memory.ActualBalloon, free, usedPercent, threshold)
+
+	// small changes are skipped, unless they give back all the reclaimed memory
+	_, tracked := c.targets[vmi.UID]
+	givesBackAll := tracked && target == guestKiB
+	if absDiff(target, domainStats.Memory.ActualBalloon) < guestKiB*minimumTargetChangePercent/100 && !givesBackAll {
+		return nil
+	}
+
+	log.Log.Object(vmi).V(3).Infof("setting the guest memory target to %d KiB", target)
+	if err := client.SetGuestMemoryTarget(api.VMINamespaceKeyFunc(vmi), target); err != nil {
+		return err
+	}
+
+	if target >= guestKiB {
+		c.forget(vmi.UID)
+		return nil
+	}
+	c.targets[vmi.UID] = guestMemoryTarget{namespace: vmi.Namespace, name: vmi.Name, targetKiB: target}
+	virthandlermetrics.SetReclaimedGuestMemory(vmi.Namespace, vmi.Name, c.host, float64((guestKiB-target)*1024))
+	return nil
+}
+
+// calculateGuestMemoryTarget returns the new balloon target in KiB. Under pressure, half of the memory the
+// guest does not need beyond a reserve is reclaimed on every round, down to the guaranteed memory. Memory
+// is given back when the guest runs short of it, or all at once when the pressure is gone.
+func calculateGuestMemoryTarget(guestKiB, guaranteedKiB, currentKiB, freeKiB uint64, usedPercent, threshold int) uint64 {
+	reserve := guestKiB * guestFreeMemoryReservePercent / 100
+
+	target := currentKiB
+	switch {
+	case usedPercent < threshold-hostMemoryPressureHysteresis:
+		target = guestKiB
+	case freeKiB < reserve/2:
+		target = currentKiB + reserve
+	case usedPercent >= threshold && freeKiB > reserve:
+		target = currentKiB - (freeKiB-reserve)/2
+	}
+
+	if target < guaranteedKiB {
+		target = guaranteedKiB
+	}
+	if target > guestKiB {
+		target = guestKiB
+	}
+	return target
+}
+
+// releaseAll gives the reclaimed memory back to all VMIs, when the controller gets disabled
+func (c *memoryOvercommitController) releaseAll(vmis map[types.UID]*v1.VirtualMachineInstance) {
+	for uid := range c.targets {
+		vmi := vmis[uid]
+		client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("failed to give back the reclaimed guest memory")
+			continue
+		}
+		if err := client.SetGuestMemoryTarget(api.VMINamespaceKeyFunc(vmi), uint64(guestMemoryOf(vmi).Value()/1024)); err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("failed to give back the reclaimed guest memory")
+			continue
+		}
+		c.forget(uid)
+	}
+}
+
+func (c *memoryOvercommitController) forget(uid types.UID) {
+	if target, exists := c.targets[uid]; exists {
+		virthandlermetrics.DeleteReclaimedGuestMemory(target.namespace, target.name, c.host)
+		delete(c.targets, uid)
+	}
+}
+
+func isMemoryOvercommitCandidate(vmi *v1.VirtualMachineInstance) bool {
+	if !vmi.IsRunning() || migrations.IsMigrating(vmi) {
+		return false
+	}
+	devices := vmi.Spec.Domain.Devices
+	if devices.AutoattachMemBalloon != nil && !*devices.AutoattachMemBalloon {
+		return false
+	}
+	// the balloon cannot give back hugepages to the node
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil {
+		return false
+	}
+	if vmi.IsRealtimeEnabled() {
+		return false
+	}
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	return !conditionManager.HasCondition(vmi, v1.VirtualMachineInstanceMemoryChange) &&
+		!conditionManager.HasCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+}
+
+func guestMemoryOf(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+	if vmi.Status.Memory != nil && vmi.Status.Memory.GuestRequested != nil {
+		return vmi.Status.Memory.GuestRequested
+	}
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		return vmi.Spec.Domain.Memory.Guest
+	}
+	return vmi.Spec.Domain.Resources.Requests.Memory()
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Memory overcommit controller", func() {
+	const (
+		gib        = 1024 * 1024
+		guestKiB   = 4 * gib
+		guaranteed = 2 * gib
+		threshold  = 80
+	)
+
+	DescribeTable("calculateGuestMemoryTarget", func(currentKiB, freeKiB uint64, usedPercent int, expected uint64) {
+		Expect(calculateGuestMemoryTarget(guestKiB, guaranteed, currentKiB, freeKiB, usedPercent, threshold)).To(Equal(expected))
+	},
+		Entry("should reclaim half of the free memory beyond the reserve under pressure",
+			uint64(guestKiB), uint64(2*gib), 90, uint64(guestKiB-(2*gib-guestKiB/10)/2)),
+		Entry("should not reclaim below the guaranteed memory",
+			uint64(3*gib), uint64(3*gib), 90, uint64(guaranteed)),
+		Entry("should keep the target when the guest has no memory to spare under pressure",
+			uint64(3*gib), uint64(guestKiB/10), 90, uint64(3*gib)),
+		Entry("should give memory back when the guest runs short of it",
+			uint64(3*gib), uint64(guestKiB/100), 90, uint64(3*gib+guestKiB/10)),
+		Entry("should keep the target between the threshold and the hysteresis",
+			uint64(3*gib), uint64(gib), 75, uint64(3*gib)),
+		Entry("should give all memory back when the pressure is gone",
+			uint64(3*gib), uint64(gib), 50, uint64(guestKiB)),
+	)
+
+	Context("reconcile", func() {
+		var (
+			client     *cmdclient.MockLauncherClient
+			vmiStore   cache.Store
+			vmi        *v1.VirtualMachineInstance
+			controller *memoryOvercommitController
+		)
+
+		newController := func(featureGates ...string) *memoryOvercommitController {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			})
+			c := newMemoryOvercommitController("node01", vmiStore, clusterConfig, &launcherclients.MockLauncherClientManager{Client: client})
+			c.getMemInfo = func() (uint64, uint64, error) { return 100 * gib, 5 * gib, nil }
+			return c
+		}
+
+		BeforeEach(func() {
+			client = cmdclient.NewMockLauncherClient(gomock.NewController(GinkgoT()))
+			vmiStore = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+			vmi = libvmi.New(libvmi.WithName("testvmi"), libvmi.WithNamespace("default"), libvmi.WithGuestMemory("4Gi"))
+			vmi.UID = "1234"
+			vmi.Status.Phase = v1.Running
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+			controller = newController(featuregate.MemoryOvercommitController)
+		})
+
+		It("should reclaim free guest memory under node memory pressure", func() {
+			client.EXPECT().GetDomainStats().Return(&stats.DomainStats{Memory: &stats.DomainStatsMemory{
+				ActualBalloonSet: true, ActualBalloon: guestKiB,
+				UsableSet: true, Usable: 2 * gib,
+			}}, true, nil)
+			client.EXPECT().SetGuestMemoryTarget("default_testvmi", uint64(guestKiB-(2*gib-guestKiB/10)/2)).Return(nil)
+
+			controller.reconcile()
+			Expect(controller.targets).To(HaveKey(vmi.UID))
+		})
+
+		It("should leave VMIs with a guaranteed memory annotation above their free memory alone", func() {
+			vmi.Annotations = map[string]string{v1.GuaranteedMemoryAnnotation: "4Gi"}
+			client.EXPECT().GetDomainStats().Return(&stats.DomainStats{Memory: &stats.DomainStatsMemory{
+				ActualBalloonSet: true, ActualBalloon: guestKiB,
+				UsableSet: true, Usable: 2 * gib,
+			}}, true, nil)
+
+			controller.reconcile()
+			Expect(controller.targets).To(BeEmpty())
+		})
+
+		It("should skip VMIs without a memory balloon", func() {
+			vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointerBool(false)
+
+			controller.reconcile()
+			Expect(controller.targets).To(BeEmpty())
+		})
+
+		It("should give the reclaimed memory back when the feature gate gets disabled", func() {
+			controller.targets[vmi.UID] = guestMemoryTarget{namespace: vmi.Namespace, name: vmi.Name, targetKiB: 3 * gib}
+			disabled := newController()
+			disabled.targets = controller.targets
+			client.EXPECT().SetGuestMemoryTarget("default_testvmi", uint64(guestKiB)).Return(nil)
+
+			disabled.reconcile()
+			Expect(disabled.targets).To(BeEmpty())
+		})
+
+		It("should forget VMIs which are gone", func() {
+			controller.targets["gone"] = guestMemoryTarget{namespace: "default", name: "gone", targetKiB: 3 * gib}
+			vmi.Status.Phase = v1.Succeeded
+
+			controller.reconcile()
+			Expect(controller.targets).To(BeEmpty())
+		})
+	})
+
+	It("should use the requested guest memory", func() {
+		requested := resource.MustParse("8Gi")
+		vmi := libvmi.New(libvmi.WithGuestMemory("4Gi"))
+		vmi.Status.Memory = &v1.MemoryStatus{GuestRequested: &requested}
+		Expect(guestMemoryOf(vmi).Value()).To(Equal(requested.Value()))
+	})
+})
+
+func pointerBool(b bool) *bool {
+	return &b
+}
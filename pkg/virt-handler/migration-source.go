@@ -211,6 +211,29 @@ func (c *MigrationSourceController) setMigrationProgressStatus(vmi *v1.VirtualMa
 	}
 
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+
+	setMigratedVolumesProgress(vmi, domain.Spec.Metadata.KubeVirt.VolumeMigration)
+}
+
+func setMigratedVolumesProgress(vmi *v1.VirtualMachineInstance, volumeMigrationMetadata *api.VolumeMigrationMetadata) {
+	if volumeMigrationMetadata == nil || volumeMigrationMetadata.UID != vmi.Status.MigrationState.MigrationUID {
+		return
+	}
+	progress := make(map[string]api.VolumeMigrationProgress)
+	for _, v := range volumeMigrationMetadata.Volumes {
+		progress[v.Name] = v
+	}
+	for i, v := range vmi.Status.MigratedVolumes {
+		p, ok := progress[v.VolumeName]
+		if !ok {
+			continue
+		}
+		vmi.Status.MigratedVolumes[i].Progress = &v1.StorageMigratedVolumeProgress{
+			TotalBytes:     p.TotalBytes,
+			ProcessedBytes: p.ProcessedBytes,
+			Completed:      p.Completed,
+		}
+	}
 }
 
 func (c *MigrationSourceController) updateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
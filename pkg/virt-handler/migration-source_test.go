@@ -328,6 +328,35 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 			Expect(vmi.Status.MigrationState.FailureReason).To(Equal(d.Spec.Metadata.KubeVirt.Migration.FailureReason))
 			testutils.ExpectEvent(recorder, v1.Migrated.String())
 		})
+
+		It("should set the progress of the migrated volumes", func() {
+			d := newDomainMigrationKubevirtMetadata("1234", nil, false, false, v1.MigrationPreCopy)
+			d.Spec.Metadata.KubeVirt.VolumeMigration = &api.VolumeMigrationMetadata{
+				UID: "1234",
+				Volumes: []api.VolumeMigrationProgress{
+					{Name: "disk0", TotalBytes: 1024, ProcessedBytes: 512},
+				},
+			}
+			vmi := libvmi.New(libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      "1234",
+					SourceNode:        host,
+					TargetNodeAddress: "othernode",
+					Completed:         false,
+				}), libvmistatus.WithNodeName(host)),
+			))
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{
+				{VolumeName: "disk0"},
+				{VolumeName: "disk1"},
+			}
+
+			controller.setMigrationProgressStatus(vmi, d)
+			Expect(vmi.Status.MigratedVolumes[0].Progress).To(Equal(&v1.StorageMigratedVolumeProgress{
+				TotalBytes:     1024,
+				ProcessedBytes: 512,
+			}))
+			Expect(vmi.Status.MigratedVolumes[1].Progress).To(BeNil())
+		})
 	})
 
 	Context("handleMigrationAbort", func() {
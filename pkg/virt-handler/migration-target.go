@@ -591,6 +591,10 @@ func (c *MigrationTargetController) handleTargetMigrationProxy(vmi *v1.VirtualMa
 func replaceMigratedVolumesStatus(vmi *v1.VirtualMachineInstance) {
 	replaceVolsStatus := make(map[string]*v1.PersistentVolumeClaimInfo)
 	for _, v := range vmi.Status.MigratedVolumes {
+		// Volumes which aren't backed by a PVC already report the destination claim in their status
+		if v.SourcePVCInfo == nil {
+			continue
+		}
 		replaceVolsStatus[v.SourcePVCInfo.ClaimName] = v.DestinationPVCInfo
 	}
 	for i, v := range vmi.Status.VolumeStatus {
@@ -119,6 +119,7 @@ func (n *NodeLabeller) loadDomCapabilities() error {
 
 	n.hostCapabilities.items = usableModels
 	n.SEV = hostDomCapabilities.SEV
+	n.TDX = hostDomCapabilities.TDX
 	n.SecureExecution = hostDomCapabilities.SecureExecution
 
 	return nil
@@ -159,6 +160,12 @@ func (n *NodeLabeller) getDomCapabilities() (HostDomCapabilities, error) {
 		hostDomCapabilities.SEV.SupportedES = "no"
 	}
 
+	if hostDomCapabilities.SEV.Supported == "yes" && hostDomCapabilities.LaunchSecurity.SupportsType("sev-snp") {
+		hostDomCapabilities.SEV.SupportedSNP = "yes"
+	} else {
+		hostDomCapabilities.SEV.SupportedSNP = "no"
+	}
+
 	return hostDomCapabilities, err
 }
 
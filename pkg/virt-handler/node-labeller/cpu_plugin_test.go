@@ -202,6 +202,31 @@ var _ = Describe("Node-labeller config", func() {
 		)
 	})
 
+	DescribeTable("return correct TDX capabilities",
+		func(domCapabilitiesFileName string, expected string) {
+			nlController.domCapabilitiesFileName = domCapabilitiesFileName
+			err := nlController.loadDomCapabilities()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(nlController.TDX.Supported).To(Equal(expected))
+		},
+		Entry("when TDX is supported", "domcapabilities_tdx.xml", "yes"),
+		Entry("when TDX is not reported", "domcapabilities_nosev.xml", ""),
+	)
+
+	DescribeTable("return correct SEV-SNP capabilities",
+		func(domCapabilitiesFileName string, expected string) {
+			nlController.domCapabilitiesFileName = domCapabilitiesFileName
+			err := nlController.loadDomCapabilities()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(nlController.SEV.SupportedSNP).To(Equal(expected))
+		},
+		Entry("when SEV-SNP is supported", "domcapabilities_sevsnp.xml", "yes"),
+		Entry("when only SEV-ES is supported", "domcapabilities_sev.xml", "no"),
+		Entry("when SEV is not supported", "domcapabilities_nosev.xml", "no"),
+	)
+
 	DescribeTable("return correct SecureExecution capabilities",
 		func(isSupported bool) {
 			if isSupported {
@@ -34,6 +34,8 @@ type hostCPUModel struct {
 type HostDomCapabilities struct {
 	CPU             CPU                          `xml:"cpu"`
 	SEV             SEVConfiguration             `xml:"features>sev"`
+	TDX             TDXConfiguration             `xml:"features>tdx"`
+	LaunchSecurity  LaunchSecurityConfiguration  `xml:"features>launchSecurity"`
 	SecureExecution SecureExecutionConfiguration `xml:"features>s390-pv"`
 }
 
@@ -92,6 +94,39 @@ type SEVConfiguration struct {
 	MaxGuests       uint   `xml:"maxGuests"`
 	MaxESGuests     uint   `xml:"maxESGuests"`
 	SupportedES     string `xml:"-"`
+	SupportedSNP    string `xml:"-"`
+}
+
+type TDXConfiguration struct {
+	Supported string `xml:"supported,attr"`
+}
+
+type LaunchSecurityConfiguration struct {
+	Supported string `xml:"supported,attr"`
+	Enums     []Enum `xml:"enum"`
+}
+
+type Enum struct {
+	Name   string   `xml:"name,attr"`
+	Values []string `xml:"value"`
+}
+
+// SupportsType returns true if the given launch security type is listed in the sectype enum
+func (l LaunchSecurityConfiguration) SupportsType(secType string) bool {
+	if l.Supported != "yes" {
+		return false
+	}
+	for _, enum := range l.Enums {
+		if enum.Name != "sectype" {
+			continue
+		}
+		for _, value := range enum.Values {
+			if value == secType {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 type SecureExecutionConfiguration struct {
@@ -22,8 +22,10 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os/exec"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +45,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	realtimetuning "kubevirt.io/kubevirt/pkg/virt-handler/realtime-tuning"
 )
 
 var nodeLabellerLabels = []string{
@@ -52,8 +55,11 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.CPUTimerLabel,
 	kubevirtv1.HypervLabel,
 	kubevirtv1.RealtimeLabel,
+	kubevirtv1.RealtimeTuningLabel,
 	kubevirtv1.SEVLabel,
 	kubevirtv1.SEVESLabel,
+	kubevirtv1.SEVSNPLabel,
+	kubevirtv1.TDXLabel,
 	kubevirtv1.HostModelCPULabel,
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
@@ -78,6 +84,7 @@ type NodeLabeller struct {
 	supportedMachines       []libvirtxml.CapsGuestMachine
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
+	TDX                     TDXConfiguration
 	SecureExecution         SecureExecutionConfiguration
 	arch                    archLabeller
 }
@@ -282,12 +289,13 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[kubevirtv1.HostModelCPULabel+hostCpuModel.Name] = "true"
 	}
 
-	capable, err := isNodeRealtimeCapable()
+	capable, err := nodeRealtimeCapable()
 	if err != nil {
 		n.logger.Reason(err).Error("failed to identify if a node is capable of running realtime workloads")
 	}
 	if capable {
 		newLabels[kubevirtv1.RealtimeLabel] = "true"
+		maps.Copy(newLabels, n.realtimeTuningLabels())
 	}
 
 	if n.SEV.Supported == "yes" {
@@ -297,6 +305,12 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 	if n.SEV.SupportedES == "yes" {
 		newLabels[kubevirtv1.SEVESLabel] = "true"
 	}
+	if n.SEV.SupportedSNP == "yes" {
+		newLabels[kubevirtv1.SEVSNPLabel] = "true"
+	}
+	if n.TDX.Supported == "yes" {
+		newLabels[kubevirtv1.TDXLabel] = "true"
+	}
 	if n.SecureExecution.Supported == "yes" {
 		newLabels[kubevirtv1.SecureExecutionLabel] = "true"
 	}
@@ -320,8 +334,34 @@ func (n *NodeLabeller) removeLabellerLabels(node *v1.Node) {
 	}
 }
 
+// realtimeTuningLabels reports the result of each realtime tuning check of the node, and marks the node as tuned
+// when all of them passed.
+func (n *NodeLabeller) realtimeTuningLabels() map[string]string {
+	results, err := checkRealtimeTuning()
+	if err != nil {
+		n.logger.Reason(err).Error("failed to validate the realtime tuning of the node")
+		return nil
+	}
+	labels := make(map[string]string)
+	for _, result := range results {
+		labels[kubevirtv1.RealtimeTuningLabel+string(result.Check)] = strconv.FormatBool(result.Passed)
+	}
+	if results.Passed() {
+		labels[kubevirtv1.RealtimeTunedLabel] = "true"
+	} else {
+		n.logger.V(2).Infof("node is not tuned for realtime workloads: %s", results.Failures())
+	}
+	return labels
+}
+
 const kernelSchedRealtimeRuntimeInMicrosecods = "kernel.sched_rt_runtime_us"
 
+// These are vars so they can be changed by the unit tests
+var (
+	nodeRealtimeCapable = isNodeRealtimeCapable
+	checkRealtimeTuning = realtimetuning.CheckHost
+)
+
 // isNodeRealtimeCapable Checks if a node is capable of running realtime workloads. Currently by validating if the kernel system setting value
 // for `kernel.sched_rt_runtime_us` is set to allow running realtime scheduling with unlimited time (==-1)
 // TODO: This part should be improved to validate against key attributes that determine best if a host is able to run realtime
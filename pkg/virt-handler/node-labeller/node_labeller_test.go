@@ -40,6 +40,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 	util "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller/util"
+	realtimetuning "kubevirt.io/kubevirt/pkg/virt-handler/realtime-tuning"
 )
 
 const nodeName = "testNode"
@@ -155,6 +156,44 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(node.Labels).To(HaveKey(v1.SEVESLabel))
 	})
 
+	It("should not add SEV-SNP label", func() {
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.SEVSNPLabel)))
+	})
+
+	It("should add SEV-SNP label", func() {
+		nlController.domCapabilitiesFileName = "domcapabilities_sevsnp.xml"
+		Expect(nlController.loadAll()).Should(Succeed())
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.SEVSNPLabel, "true"))
+	})
+
+	It("should not add TDX label", func() {
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.TDXLabel)))
+	})
+
+	It("should add TDX label", func() {
+		nlController.domCapabilitiesFileName = "domcapabilities_tdx.xml"
+		Expect(nlController.loadAll()).Should(Succeed())
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.TDXLabel, "true"))
+	})
+
 	It("should not add SecureExecution label", func() {
 		nlController.volumePath = "testdata/s390x"
 		Expect(nlController.loadAll()).Should(Succeed())
@@ -302,6 +341,69 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(node.Labels).To(HaveKey("INeedToBeHere"))
 	})
 
+	Context("realtime tuning", func() {
+		var origNodeRealtimeCapable func() (bool, error)
+		var origCheckRealtimeTuning func() (realtimetuning.Results, error)
+
+		BeforeEach(func() {
+			origNodeRealtimeCapable, origCheckRealtimeTuning = nodeRealtimeCapable, checkRealtimeTuning
+			nodeRealtimeCapable = func() (bool, error) { return true, nil }
+		})
+
+		AfterEach(func() {
+			nodeRealtimeCapable, checkRealtimeTuning = origNodeRealtimeCapable, origCheckRealtimeTuning
+		})
+
+		It("should mark the node as tuned when all the checks pass", func() {
+			checkRealtimeTuning = func() (realtimetuning.Results, error) {
+				return realtimetuning.Results{
+					{Check: realtimetuning.KernelCmdline, Passed: true},
+					{Check: realtimetuning.IsolatedCPUs, Passed: true},
+				}, nil
+			}
+
+			Expect(nlController.execute()).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeTunedLabel, "true"))
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeTuningLabel+"KernelCmdline", "true"))
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeTuningLabel+"IsolatedCPUs", "true"))
+		})
+
+		It("should report the failed checks and not mark the node as tuned", func() {
+			checkRealtimeTuning = func() (realtimetuning.Results, error) {
+				return realtimetuning.Results{
+					{Check: realtimetuning.KernelCmdline, Passed: true},
+					{Check: realtimetuning.IRQAffinity, Message: "IRQs 30 are handled on the CPUs"},
+				}, nil
+			}
+
+			Expect(nlController.execute()).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+			Expect(node.Labels).ToNot(HaveKey(v1.RealtimeTunedLabel))
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeTuningLabel+"IRQAffinity", "false"))
+		})
+
+		It("should remove the tuning labels when the node is no longer realtime capable", func() {
+			checkRealtimeTuning = func() (realtimetuning.Results, error) {
+				return realtimetuning.Results{{Check: realtimetuning.KernelCmdline, Passed: true}}, nil
+			}
+			Expect(nlController.execute()).To(BeTrue())
+			Expect(retrieveNode(kubeClient).Labels).To(HaveKey(v1.RealtimeTunedLabel))
+
+			nodeRealtimeCapable = func() (bool, error) { return false, nil }
+			nlController.queue.Add(nodeName)
+			Expect(nlController.execute()).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).ToNot(HaveKey(v1.RealtimeTunedLabel))
+			Expect(node.Labels).ToNot(HaveKey(v1.RealtimeTuningLabel + "KernelCmdline"))
+		})
+	})
+
 	DescribeTable("should add machine type labels", func(machines []libvirtxml.CapsGuestMachine, arch string) {
 		supportedMachines = machines
 
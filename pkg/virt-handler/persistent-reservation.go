@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// persistentReservationPollInterval is the interval between two reads of the persistent reservations of a VMI
+	persistentReservationPollInterval = 30 * time.Second
+	// persistentReservationMaxConcurrentReads limits the number of VMIs whose persistent reservations are read at
+	// the same time
+	persistentReservationMaxConcurrentReads = 4
+)
+
+type persistentReservationReadFunc func(vmi *v1.VirtualMachineInstance) map[string]*v1.PersistentReservationStatus
+
+// persistentReservationPoller reads the SCSI persistent reservations of the LUNs in the background and caches them
+// for the status updates of the VMIs, since the SG_IO commands can block for a long time and must not run in the
+// reconcile loop. The VMI is enqueued again when its reservations change.
+type persistentReservationPoller struct {
+	interval time.Duration
+	read     persistentReservationReadFunc
+	notify   func(vmi *v1.VirtualMachineInstance)
+	readers  chan struct{}
+
+	lock    sync.Mutex
+	entries map[types.UID]*persistentReservationEntry
+}
+
+type persistentReservationEntry struct {
+	vmi      *v1.VirtualMachineInstance
+	statuses map[string]*v1.PersistentReservationStatus
+	lastRead time.Time
+	reading  bool
+}
+
+func newPersistentReservationPoller(interval time.Duration, read persistentReservationReadFunc, notify func(vmi *v1.VirtualMachineInstance)) *persistentReservationPoller {
+	return &persistentReservationPoller{
+		interval: interval,
+		read:     read,
+		notify:   notify,
+		readers:  make(chan struct{}, persistentReservationMaxConcurrentReads),
+		entries:  make(map[types.UID]*persistentReservationEntry),
+	}
+}
+
+// Run reads again the persistent reservations of the polled VMIs whose cache is older than the poll interval
+func (p *persistentReservationPoller) Run(stopCh <-chan struct{}) {
+	wait.Until(p.pollAll, p.interval, stopCh)
+}
+
+// Get returns the cached persistent reservations of the LUNs of the VMI by volume name, and polls the VMI from now on.
+// The reservations of a VMI polled for the first time are read in the background.
+func (p *persistentReservationPoller) Get(vmi *v1.VirtualMachineInstance) map[string]*v1.PersistentReservationStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	entry, exists := p.entries[vmi.UID]
+	if !exists {
+		entry = &persistentReservationEntry{}
+		p.entries[vmi.UID] = entry
+	}
+	entry.vmi = vmi.DeepCopy()
+	if !exists {
+		p.startRead(entry)
+	}
+	return entry.statuses
+}
+
+// Forget stops polling the VMI and drops its cached persistent reservations
+func (p *persistentReservationPoller) Forget(uid types.UID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.entries, uid)
+}
+
+func (p *persistentReservationPoller) pollAll() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, entry := range p.entries {
+		if time.Since(entry.lastRead) >= p.interval {
+			p.startRead(entry)
+		}
+	}
+}
+
+// startRead reads the persistent reservations of the entry in the background, unless they are already being read.
+// It must be called with the lock held.
+func (p *persistentReservationPoller) startRead(entry *persistentReservationEntry) {
+	if entry.reading {
+		return
+	}
+	entry.reading = true
+	vmi := entry.vmi
+	go func() {
+		p.readers <- struct{}{}
+		statuses := p.read(vmi)
+		<-p.readers
+
+		p.lock.Lock()
+		changed := !equality.Semantic.DeepEqual(entry.statuses, statuses)
+		entry.statuses = statuses
+		entry.lastRead = time.Now()
+		entry.reading = false
+		_, polled := p.entries[vmi.UID]
+		p.lock.Unlock()
+
+		if changed && polled {
+			p.notify(vmi)
+		}
+	}()
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("virt-handler persistent reservation poller", func() {
+	const interval = 100 * time.Millisecond
+
+	var (
+		poller   *persistentReservationPoller
+		vmi      *v1.VirtualMachineInstance
+		reads    atomic.Int32
+		holder   atomic.Value
+		notified chan types.UID
+		stopCh   chan struct{}
+	)
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{}
+		vmi.UID = "c4ab4ae0-db63-45d8-aa0f-fc53dc84bdab"
+		reads.Store(0)
+		holder.Store("0x1")
+		notified = make(chan types.UID, 10)
+		stopCh = make(chan struct{})
+		poller = newPersistentReservationPoller(interval,
+			func(*v1.VirtualMachineInstance) map[string]*v1.PersistentReservationStatus {
+				reads.Add(1)
+				return map[string]*v1.PersistentReservationStatus{"quorum": {Holder: holder.Load().(string)}}
+			},
+			func(vmi *v1.VirtualMachineInstance) {
+				notified <- vmi.UID
+			})
+	})
+
+	AfterEach(func() {
+		close(stopCh)
+	})
+
+	It("should read the reservations in the background and notify when they are known", func() {
+		Expect(poller.Get(vmi)).To(BeEmpty())
+		Eventually(notified).Should(Receive(Equal(vmi.UID)))
+		Expect(poller.Get(vmi)).To(HaveKeyWithValue("quorum", &v1.PersistentReservationStatus{Holder: "0x1"}))
+		Expect(reads.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("should not read the reservations again before the interval", func() {
+		poller.Get(vmi)
+		Eventually(notified).Should(Receive())
+		go poller.Run(stopCh)
+		Consistently(reads.Load, interval/2, interval/10).Should(BeEquivalentTo(1))
+	})
+
+	It("should only notify when the reservations changed", func() {
+		poller.Get(vmi)
+		Eventually(notified).Should(Receive())
+		go poller.Run(stopCh)
+
+		Eventually(reads.Load).Should(BeNumerically(">", 2))
+		Expect(notified).ToNot(Receive())
+
+		holder.Store("0x2")
+		Eventually(notified).Should(Receive(Equal(vmi.UID)))
+		Expect(poller.Get(vmi)).To(HaveKeyWithValue("quorum", &v1.PersistentReservationStatus{Holder: "0x2"}))
+	})
+
+	It("should stop polling forgotten VMIs", func() {
+		poller.Get(vmi)
+		Eventually(notified).Should(Receive())
+		poller.Forget(vmi.UID)
+		go poller.Run(stopCh)
+		Consistently(reads.Load, 3*interval, interval/10).Should(BeEquivalentTo(1))
+	})
+})
@@ -0,0 +1,280 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package realtimetuning validates that a host is tuned for running realtime workloads:
+// isolated CPUs, a disabled timer tick and interrupts kept away from the isolated CPUs.
+package realtimetuning
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+// Check names a single realtime tuning check.
+type Check string
+
+const (
+	// KernelCmdline checks that the kernel was booted with the arguments isolating CPUs and disabling the timer tick.
+	KernelCmdline Check = "KernelCmdline"
+	// IsolatedCPUs checks that the CPUs are isolated from the general scheduler.
+	IsolatedCPUs Check = "IsolatedCPUs"
+	// TimerTick checks that the scheduling-clock interrupt is disabled on the CPUs.
+	TimerTick Check = "TimerTick"
+	// IRQAffinity checks that no interrupt is handled on the CPUs.
+	IRQAffinity Check = "IRQAffinity"
+	// CgroupCPUSet checks that the vCPUs are pinned to CPUs of the cpuset cgroup of the VMI.
+	CgroupCPUSet Check = "CgroupCPUSet"
+)
+
+const cpuListLimit = 50000
+
+var (
+	// These are vars so they can be changed by the unit tests
+	cmdlinePath = "/proc/cmdline"
+	irqPath     = "/proc/irq"
+	// Use the path from the host filesystem, like for KSM.
+	cpuSysfsPath = "/proc/1/root/sys/devices/system/cpu"
+
+	requiredKernelArgs = []string{"isolcpus", "nohz_full"}
+)
+
+// Result is the outcome of a single check.
+type Result struct {
+	Check   Check
+	Passed  bool
+	Message string
+}
+
+// Results are the outcomes of all the checks of a host or VMI.
+type Results []Result
+
+// Passed returns true when all the checks passed.
+func (r Results) Passed() bool {
+	for _, result := range r {
+		if !result.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the result of the given check.
+func (r Results) Get(check Check) (Result, bool) {
+	for _, result := range r {
+		if result.Check == check {
+			return result, true
+		}
+	}
+	return Result{}, false
+}
+
+// Failures describes the failed checks in a single line.
+func (r Results) Failures() string {
+	var failures []string
+	for _, result := range r {
+		if !result.Passed {
+			failures = append(failures, fmt.Sprintf("%s: %s", result.Check, result.Message))
+		}
+	}
+	return strings.Join(failures, "; ")
+}
+
+type hostTuning struct {
+	kernelArgs   map[string]string
+	isolatedCPUs []int
+	noHzFullCPUs []int
+	// irqs maps the IRQ number to the CPUs handling it
+	irqs map[string][]int
+}
+
+// CheckHost validates the tuning of the host, independently of any VMI.
+func CheckHost() (Results, error) {
+	host, err := readHostTuning()
+	if err != nil {
+		return nil, err
+	}
+	if len(host.isolatedCPUs) == 0 {
+		return Results{
+			host.checkKernelCmdline(),
+			{Check: IsolatedCPUs, Message: "no CPUs are isolated"},
+			{Check: TimerTick, Message: "no CPUs are isolated"},
+			{Check: IRQAffinity, Message: "no CPUs are isolated"},
+		}, nil
+	}
+	return Results{
+		host.checkKernelCmdline(),
+		{Check: IsolatedCPUs, Passed: true},
+		checkSubset(TimerTick, host.isolatedCPUs, host.noHzFullCPUs, "running the timer tick"),
+		host.checkIRQAffinity(host.isolatedCPUs),
+	}, nil
+}
+
+// CheckCPUs validates the tuning of the host for the CPUs the realtime vCPUs are pinned to.
+// cgroupCPUs are the CPUs of the cpuset cgroup of the VMI.
+func CheckCPUs(vcpuCPUs, cgroupCPUs []int) (Results, error) {
+	host, err := readHostTuning()
+	if err != nil {
+		return nil, err
+	}
+	return Results{
+		host.checkKernelCmdline(),
+		checkSubset(CgroupCPUSet, vcpuCPUs, cgroupCPUs, "outside of the cpuset cgroup"),
+		checkSubset(IsolatedCPUs, vcpuCPUs, host.isolatedCPUs, "not isolated"),
+		checkSubset(TimerTick, vcpuCPUs, host.noHzFullCPUs, "running the timer tick"),
+		host.checkIRQAffinity(vcpuCPUs),
+	}, nil
+}
+
+func (h *hostTuning) checkKernelCmdline() Result {
+	var missing []string
+	for _, arg := range requiredKernelArgs {
+		if _, exists := h.kernelArgs[arg]; !exists {
+			missing = append(missing, arg)
+		}
+	}
+	if len(missing) > 0 {
+		return Result{Check: KernelCmdline, Message: fmt.Sprintf("missing kernel arguments %s", strings.Join(missing, ","))}
+	}
+	return Result{Check: KernelCmdline, Passed: true}
+}
+
+func (h *hostTuning) checkIRQAffinity(cpus []int) Result {
+	if len(cpus) == 0 {
+		return Result{Check: IRQAffinity, Message: "no CPUs to validate"}
+	}
+	var irqs []string
+	for irq, irqCPUs := range h.irqs {
+		if slices.ContainsFunc(irqCPUs, func(cpu int) bool { return slices.Contains(cpus, cpu) }) {
+			irqs = append(irqs, irq)
+		}
+	}
+	if len(irqs) > 0 {
+		slices.Sort(irqs)
+		return Result{Check: IRQAffinity, Message: fmt.Sprintf("IRQs %s are handled on the CPUs", strings.Join(irqs, ","))}
+	}
+	return Result{Check: IRQAffinity, Passed: true}
+}
+
+// checkSubset passes when all the cpus are in the allowed ones.
+func checkSubset(check Check, cpus, allowed []int, reason string) Result {
+	if len(cpus) == 0 {
+		return Result{Check: check, Message: "no CPUs to validate"}
+	}
+	var outside []int
+	for _, cpu := range cpus {
+		if !slices.Contains(allowed, cpu) {
+			outside = append(outside, cpu)
+		}
+	}
+	if len(outside) > 0 {
+		return Result{Check: check, Message: fmt.Sprintf("CPUs %s are %s", formatCPUs(outside), reason)}
+	}
+	return Result{Check: check, Passed: true}
+}
+
+func formatCPUs(cpus []int) string {
+	formatted := make([]string, 0, len(cpus))
+	for _, cpu := range cpus {
+		formatted = append(formatted, fmt.Sprintf("%d", cpu))
+	}
+	return strings.Join(formatted, ",")
+}
+
+func readHostTuning() (*hostTuning, error) {
+	cmdline, err := os.ReadFile(cmdlinePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the kernel command line: %v", err)
+	}
+	isolatedCPUs, err := readCPUList(filepath.Join(cpuSysfsPath, "isolated"))
+	if err != nil {
+		return nil, err
+	}
+	noHzFullCPUs, err := readCPUList(filepath.Join(cpuSysfsPath, "nohz_full"))
+	if err != nil {
+		return nil, err
+	}
+	irqs, err := readIRQAffinities()
+	if err != nil {
+		return nil, err
+	}
+	return &hostTuning{
+		kernelArgs:   parseKernelArgs(string(cmdline)),
+		isolatedCPUs: isolatedCPUs,
+		noHzFullCPUs: noHzFullCPUs,
+		irqs:         irqs,
+	}, nil
+}
+
+func parseKernelArgs(cmdline string) map[string]string {
+	args := map[string]string{}
+	for _, field := range strings.Fields(cmdline) {
+		key, value, _ := strings.Cut(field, "=")
+		args[key] = value
+	}
+	return args
+}
+
+// readCPUList reads a CPU list file, which is empty or contains "(null)" when no CPU is set.
+func readCPUList(path string) ([]int, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	line := strings.TrimSpace(string(content))
+	if line == "" || line == "(null)" {
+		return nil, nil
+	}
+	cpus, err := hardware.ParseCPUSetLine(line, cpuListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return cpus, nil
+}
+
+// readIRQAffinities returns the CPUs actually handling each IRQ. The effective affinity is preferred,
+// the requested affinity is used on kernels which do not report it. Inactive IRQs have no CPUs.
+func readIRQAffinities() (map[string][]int, error) {
+	entries, err := os.ReadDir(irqPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IRQs: %v", err)
+	}
+	irqs := map[string][]int{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		affinityPath := filepath.Join(irqPath, entry.Name(), "effective_affinity_list")
+		if _, err := os.Stat(affinityPath); os.IsNotExist(err) {
+			affinityPath = filepath.Join(irqPath, entry.Name(), "smp_affinity_list")
+		}
+		cpus, err := readCPUList(affinityPath)
+		if err != nil {
+			return nil, err
+		}
+		irqs[entry.Name()] = cpus
+	}
+	return irqs, nil
+}
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package realtimetuning
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Realtime tuning", func() {
+	var (
+		origCmdlinePath  string
+		origIRQPath      string
+		origCPUSysfsPath string
+	)
+
+	writeFile := func(path, content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+	}
+
+	setIRQ := func(irq, effectiveAffinity string) {
+		writeFile(filepath.Join(irqPath, irq, "effective_affinity_list"), effectiveAffinity+"\n")
+		writeFile(filepath.Join(irqPath, irq, "smp_affinity_list"), "0-7\n")
+	}
+
+	BeforeEach(func() {
+		origCmdlinePath, origIRQPath, origCPUSysfsPath = cmdlinePath, irqPath, cpuSysfsPath
+		dir := GinkgoT().TempDir()
+		cmdlinePath = filepath.Join(dir, "cmdline")
+		irqPath = filepath.Join(dir, "irq")
+		cpuSysfsPath = filepath.Join(dir, "cpu")
+
+		writeFile(cmdlinePath, "BOOT_IMAGE=/vmlinuz root=/dev/sda1 isolcpus=managed_irq,domain,2-5 nohz_full=2-5 irqaffinity=0-1\n")
+		writeFile(filepath.Join(cpuSysfsPath, "isolated"), "2-5\n")
+		writeFile(filepath.Join(cpuSysfsPath, "nohz_full"), "2-5\n")
+		setIRQ("0", "0")
+		setIRQ("24", "1")
+	})
+
+	AfterEach(func() {
+		cmdlinePath, irqPath, cpuSysfsPath = origCmdlinePath, origIRQPath, origCPUSysfsPath
+	})
+
+	Context("host", func() {
+		It("should pass on a tuned host", func() {
+			results, err := CheckHost()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Passed()).To(BeTrue(), results.Failures())
+			Expect(results).To(HaveLen(4))
+		})
+
+		It("should fail every check when no CPU is isolated", func() {
+			writeFile(cmdlinePath, "BOOT_IMAGE=/vmlinuz root=/dev/sda1\n")
+			writeFile(filepath.Join(cpuSysfsPath, "isolated"), "\n")
+			writeFile(filepath.Join(cpuSysfsPath, "nohz_full"), "(null)\n")
+
+			results, err := CheckHost()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Passed()).To(BeFalse())
+			for _, result := range results {
+				Expect(result.Passed).To(BeFalse(), string(result.Check))
+			}
+			Expect(results.Failures()).To(ContainSubstring("KernelCmdline: missing kernel arguments isolcpus,nohz_full"))
+		})
+
+		It("should report IRQs handled on the isolated CPUs", func() {
+			setIRQ("30", "3")
+			setIRQ("31", "0-7")
+
+			results, err := CheckHost()
+			Expect(err).ToNot(HaveOccurred())
+			result, exists := results.Get(IRQAffinity)
+			Expect(exists).To(BeTrue())
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(Equal("IRQs 30,31 are handled on the CPUs"))
+		})
+
+		It("should ignore inactive IRQs", func() {
+			setIRQ("40", "")
+
+			results, err := CheckHost()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Passed()).To(BeTrue(), results.Failures())
+		})
+
+		It("should fall back to the requested affinity without effective affinity", func() {
+			writeFile(filepath.Join(irqPath, "50", "smp_affinity_list"), "4\n")
+
+			results, err := CheckHost()
+			Expect(err).ToNot(HaveOccurred())
+			result, _ := results.Get(IRQAffinity)
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(ContainSubstring("IRQs 50"))
+		})
+	})
+
+	Context("CPUs", func() {
+		It("should pass when the vCPUs are pinned to tuned CPUs of the cgroup", func() {
+			results, err := CheckCPUs([]int{2, 3}, []int{1, 2, 3})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Passed()).To(BeTrue(), results.Failures())
+			Expect(results).To(HaveLen(5))
+		})
+
+		DescribeTable("should fail", func(vcpuCPUs, cgroupCPUs []int, check Check, message string) {
+			writeFile(filepath.Join(cpuSysfsPath, "nohz_full"), "2-4\n")
+			setIRQ("30", "4")
+
+			results, err := CheckCPUs(vcpuCPUs, cgroupCPUs)
+			Expect(err).ToNot(HaveOccurred())
+			result, exists := results.Get(check)
+			Expect(exists).To(BeTrue())
+			Expect(result.Passed).To(BeFalse())
+			Expect(result.Message).To(Equal(message))
+		},
+			Entry("when the vCPUs are pinned outside of the cgroup", []int{2, 3}, []int{2}, CgroupCPUSet, "CPUs 3 are outside of the cpuset cgroup"),
+			Entry("when the CPUs are not isolated", []int{1, 2}, []int{1, 2}, IsolatedCPUs, "CPUs 1 are not isolated"),
+			Entry("when the CPUs run the timer tick", []int{5}, []int{5}, TimerTick, "CPUs 5 are running the timer tick"),
+			Entry("when the CPUs handle IRQs", []int{4}, []int{4}, IRQAffinity, "IRQs 30 are handled on the CPUs"),
+			Entry("when there are no pinned vCPUs", nil, []int{2, 3}, CgroupCPUSet, "no CPUs to validate"),
+		)
+	})
+})
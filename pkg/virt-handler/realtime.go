@@ -5,10 +5,21 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	realtimetuning "kubevirt.io/kubevirt/pkg/virt-handler/realtime-tuning"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 type maskType bool
@@ -32,8 +43,12 @@ var (
 	vcpuRegex = regexp.MustCompile(`^CPU (\d+)/KVM\n$`) // These threads follow this naming pattern as their command value (/proc/{pid}/task/{taskid}/comm)
 	// QEMU uses threads to represent vCPUs.
 
+	// This is a var so it can be changed by the unit tests
+	checkRealtimeTuning = realtimetuning.CheckCPUs
 )
 
+const cpuListLimit = 50000
+
 // configureRealTimeVCPUs parses the realtime mask value and configured the selected vcpus
 // for real time workloads by setting the scheduler to FIFO and process priority equal to 1.
 func (c *VirtualMachineController) configureVCPUScheduler(vmi *v1.VirtualMachineInstance) error {
@@ -187,3 +202,94 @@ func (c *cpuMask) has(vcpuID string) bool {
 func (c *cpuMask) set(vcpuID string, mtype maskType) {
 	c.mask[vcpuID] = mtype
 }
+
+// updateRealtimeConditions validates the host tuning for the realtime vCPUs of a running VMI and reports
+// the result in the RealtimeReady condition. A warning event is recorded whenever the tuning becomes insufficient.
+func (c *VirtualMachineController) updateRealtimeConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if !vmi.IsRealtimeEnabled() || !vmi.IsRunning() || domain == nil {
+		return
+	}
+	cgroupManager, err := getCgroupManager(vmi, c.host)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to get the cgroup manager to validate the realtime tuning")
+		return
+	}
+	results, err := validateRealtimeTuning(vmi, domain, cgroupManager)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to validate the realtime tuning of the host")
+		return
+	}
+	if updateRealtimeReadyCondition(vmi, results, condManager) && !results.Passed() {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonRealtimeNotTuned, results.Failures())
+	}
+}
+
+// validateRealtimeTuning runs the realtime tuning checks for the CPUs the realtime vCPUs are pinned to.
+func validateRealtimeTuning(vmi *v1.VirtualMachineInstance, domain *api.Domain, cgroupManager cgroup.Manager) (realtimetuning.Results, error) {
+	cpuset, err := cgroupManager.GetCpuSet()
+	if err != nil {
+		return nil, err
+	}
+	cgroupCPUs, err := hardware.ParseCPUSetLine(cpuset, cpuListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the cpuset of the VMI: %v", err)
+	}
+	vcpuCPUs, err := realtimeVCPUPinnedCPUs(vmi, domain)
+	if err != nil {
+		return nil, err
+	}
+	return checkRealtimeTuning(vcpuCPUs, cgroupCPUs)
+}
+
+// realtimeVCPUPinnedCPUs returns the CPUs the vCPUs selected by the realtime mask are pinned to.
+func realtimeVCPUPinnedCPUs(vmi *v1.VirtualMachineInstance, domain *api.Domain) ([]int, error) {
+	if domain.Spec.CPUTune == nil {
+		return nil, nil
+	}
+	mask, err := parseCPUMask(vmi.Spec.Domain.CPU.Realtime.Mask)
+	if err != nil {
+		return nil, err
+	}
+	var cpus []int
+	for _, pin := range domain.Spec.CPUTune.VCPUPin {
+		if !mask.isEnabled(strconv.FormatUint(uint64(pin.VCPU), 10)) {
+			continue
+		}
+		pinned, err := hardware.ParseCPUSetLine(pin.CPUSet, cpuListLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the pinning of vCPU %d: %v", pin.VCPU, err)
+		}
+		for _, cpu := range pinned {
+			if !slices.Contains(cpus, cpu) {
+				cpus = append(cpus, cpu)
+			}
+		}
+	}
+	return cpus, nil
+}
+
+// updateRealtimeReadyCondition sets the RealtimeReady condition from the results and returns true when it changed.
+func updateRealtimeReadyCondition(vmi *v1.VirtualMachineInstance, results realtimetuning.Results, condManager *controller.VirtualMachineInstanceConditionManager) bool {
+	status := k8sv1.ConditionTrue
+	reason := v1.VirtualMachineInstanceReasonRealtimeTuned
+	message := ""
+	if !results.Passed() {
+		status = k8sv1.ConditionFalse
+		reason = v1.VirtualMachineInstanceReasonRealtimeNotTuned
+		message = results.Failures()
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceRealtimeReady)
+	if condition != nil && condition.Status == status && condition.Message == message {
+		return false
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceRealtimeReady)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceRealtimeReady,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+	})
+	return true
+}
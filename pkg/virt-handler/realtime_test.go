@@ -5,6 +5,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	realtimetuning "kubevirt.io/kubevirt/pkg/virt-handler/realtime-tuning"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 var _ = Describe("Running real time workloads", func() {
@@ -100,6 +109,77 @@ var _ = Describe("Running real time workloads", func() {
 		)
 	})
 
+	Context("validates the realtime tuning", func() {
+		var (
+			vmi                     *v1.VirtualMachineInstance
+			domain                  *api.Domain
+			mockCgroupManager       *cgroup.MockManager
+			origCheckRealtimeTuning func([]int, []int) (realtimetuning.Results, error)
+		)
+
+		BeforeEach(func() {
+			vmi = &v1.VirtualMachineInstance{}
+			vmi.Spec.Domain.CPU = &v1.CPU{Realtime: &v1.Realtime{Mask: "1-2"}}
+			domain = api.NewMinimalDomain("test")
+			domain.Spec.CPUTune = &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{
+				{VCPU: 0, CPUSet: "2"},
+				{VCPU: 1, CPUSet: "3"},
+				{VCPU: 2, CPUSet: "4-5"},
+			}}
+			mockCgroupManager = cgroup.NewMockManager(gomock.NewController(GinkgoT()))
+			origCheckRealtimeTuning = checkRealtimeTuning
+		})
+
+		AfterEach(func() {
+			checkRealtimeTuning = origCheckRealtimeTuning
+		})
+
+		It("of the CPUs the realtime vCPUs are pinned to", func() {
+			mockCgroupManager.EXPECT().GetCpuSet().Return("2-5", nil)
+			checkRealtimeTuning = func(vcpuCPUs, cgroupCPUs []int) (realtimetuning.Results, error) {
+				Expect(vcpuCPUs).To(Equal([]int{3, 4, 5}))
+				Expect(cgroupCPUs).To(Equal([]int{2, 3, 4, 5}))
+				return realtimetuning.Results{{Check: realtimetuning.IsolatedCPUs, Passed: true}}, nil
+			}
+
+			results, err := validateRealtimeTuning(vmi, domain, mockCgroupManager)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Passed()).To(BeTrue())
+		})
+
+		It("and fails when the cpuset can't be read", func() {
+			mockCgroupManager.EXPECT().GetCpuSet().Return("", fmt.Errorf("no cpuset"))
+
+			_, err := validateRealtimeTuning(vmi, domain, mockCgroupManager)
+			Expect(err).To(MatchError("no cpuset"))
+		})
+
+		It("and reports the result in the RealtimeReady condition", func() {
+			condManager := controller.NewVirtualMachineInstanceConditionManager()
+			failed := realtimetuning.Results{
+				{Check: realtimetuning.KernelCmdline, Passed: true},
+				{Check: realtimetuning.TimerTick, Message: "CPUs 3 are running the timer tick"},
+			}
+
+			Expect(updateRealtimeReadyCondition(vmi, failed, condManager)).To(BeTrue())
+			condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceRealtimeReady)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonRealtimeNotTuned))
+			Expect(condition.Message).To(Equal("TimerTick: CPUs 3 are running the timer tick"))
+
+			Expect(updateRealtimeReadyCondition(vmi, failed, condManager)).To(BeFalse())
+
+			passed := realtimetuning.Results{{Check: realtimetuning.TimerTick, Passed: true}}
+			Expect(updateRealtimeReadyCondition(vmi, passed, condManager)).To(BeTrue())
+			Expect(vmi.Status.Conditions).To(HaveLen(1))
+			condition = condManager.GetCondition(vmi, v1.VirtualMachineInstanceRealtimeReady)
+			Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonRealtimeTuned))
+			Expect(condition.Message).To(BeEmpty())
+		})
+	})
+
 })
 
 func newMask(cpuEnabled, cpuDisabled []string) cpuMask {
@@ -47,7 +47,7 @@ import (
 
 type ConsoleHandler struct {
 	podIsolationDetector isolation.PodIsolationDetector
-	serialStopChans      map[types.UID]chan struct{}
+	serialSessions       map[types.UID]*serialSession
 	vncStopChans         map[types.UID]chan struct{}
 	serialLock           *sync.Mutex
 	vncLock              *sync.Mutex
@@ -64,7 +64,7 @@ type UsbredirHandlerVMI struct {
 func NewConsoleHandler(podIsolationDetector isolation.PodIsolationDetector, vmiStore cache.Store, certManager certificate.Manager) *ConsoleHandler {
 	return &ConsoleHandler{
 		podIsolationDetector: podIsolationDetector,
-		serialStopChans:      make(map[types.UID]chan struct{}),
+		serialSessions:       make(map[types.UID]*serialSession),
 		vncStopChans:         make(map[types.UID]chan struct{}),
 		serialLock:           &sync.Mutex{},
 		vncLock:              &sync.Mutex{},
@@ -164,16 +164,117 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 		response.WriteError(code, err)
 		return
 	}
+	readOnly := false
+	if readOnlyParam := request.QueryParameter("readOnly"); readOnlyParam != "" {
+		readOnly, err = strconv.ParseBool(readOnlyParam)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Failed parsing the query parameter readOnly %s", readOnlyParam)
+			response.WriteError(http.StatusBadRequest, err)
+			return
+		}
+	}
 	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-serial0")
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for serial console")
 		response.WriteError(http.StatusBadRequest, err)
 		return
 	}
+	t.streamSerial(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), readOnly)
+}
+
+// streamSerial attaches the client to the shared session of the serial console of the VMI.
+// A read-write client takes the console over from the previous one, read-only clients
+// only receive the output of the guest.
+func (t *ConsoleHandler) streamSerial(vmi *v1.VirtualMachineInstance, request *restful.Request, response *restful.Response, dial func() (net.Conn, error), readOnly bool) {
+	var upgrader = kvcorev1.NewUpgrader()
+	clientSocket, err := upgrader.Upgrade(response.ResponseWriter, request.Request, nil)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to upgrade client websocket connection")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	defer clientSocket.Close()
+
+	log.Log.Object(vmi).Infof("Websocket connection upgraded")
+
+	client := newSerialClient()
+	session, err := t.attachSerialClient(vmi, dial, client, readOnly)
+	if err != nil {
+		response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	defer t.detachSerialClient(vmi.GetUID(), session, client)
+
+	errCh := make(chan error, 2)
+	go func() {
+		_, err := kvcorev1.CopyTo(clientSocket, client)
+		log.Log.Object(vmi).Reason(err).Error("error encountered reading from unix socket")
+		errCh <- err
+	}()
+
+	go func() {
+		input := io.Discard
+		if !readOnly {
+			input = session.input(client)
+		}
+		_, err := kvcorev1.CopyFrom(input, clientSocket)
+		log.Log.Object(vmi).Reason(err).Error("error encountered reading from client (virt-api) websocket")
+		errCh <- err
+	}()
+
+	select {
+	case <-client.stop:
+		break
+	case err := <-errCh:
+		if err != nil && err != io.EOF {
+			log.Log.Object(vmi).Reason(err).Error("Error in proxing websocket and unix socket")
+			response.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
+
+// attachSerialClient attaches the client to the serial console session of the VMI,
+// and connects to the serial console if the VMI has no session yet.
+func (t *ConsoleHandler) attachSerialClient(vmi *v1.VirtualMachineInstance, dial func() (net.Conn, error), client *serialClient, readOnly bool) (*serialSession, error) {
+	t.serialLock.Lock()
+	defer t.serialLock.Unlock()
+
 	uid := vmi.GetUID()
-	stopCh := newStopChan(uid, t.serialLock, t.serialStopChans)
-	defer deleteStopChan(uid, stopCh, t.serialLock, t.serialStopChans)
-	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh)
+	session, exists := t.serialSessions[uid]
+	if !exists {
+		conn, err := dial()
+		if err != nil {
+			return nil, err
+		}
+		session = newSerialSession(conn)
+		t.serialSessions[uid] = session
+		go func() {
+			err := session.run()
+			log.Log.Object(vmi).Reason(err).Info("serial console session ended")
+			t.serialLock.Lock()
+			defer t.serialLock.Unlock()
+			if t.serialSessions[uid] == session {
+				delete(t.serialSessions, uid)
+			}
+			session.detachAll()
+		}()
+	}
+	session.attach(client, readOnly)
+	return session, nil
+}
+
+// detachSerialClient detaches the client from the session, and closes the connection to
+// the serial console when it was the last one.
+func (t *ConsoleHandler) detachSerialClient(uid types.UID, session *serialSession, client *serialClient) {
+	t.serialLock.Lock()
+	defer t.serialLock.Unlock()
+	if session.detach(client) {
+		return
+	}
+	if t.serialSessions[uid] == session {
+		delete(t.serialSessions, uid)
+	}
+	session.conn.Close()
 }
 
 func (t *ConsoleHandler) VSOCKHandler(request *restful.Request, response *restful.Response) {
@@ -19,9 +19,14 @@
 package rest
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 
@@ -33,7 +38,10 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
 )
 
 const (
@@ -44,16 +52,20 @@ const (
 )
 
 type LifecycleHandler struct {
-	recorder     record.EventRecorder
-	vmiStore     cache.Store
-	virtShareDir string
+	recorder      record.EventRecorder
+	vmiStore      cache.Store
+	virtShareDir  string
+	clusterConfig *virtconfig.ClusterConfig
+	vcekFetcher   *launchsecurity.VCEKFetcher
 }
 
-func NewLifecycleHandler(recorder record.EventRecorder, vmiStore cache.Store, virtShareDir string) *LifecycleHandler {
+func NewLifecycleHandler(recorder record.EventRecorder, vmiStore cache.Store, virtShareDir string, clusterConfig *virtconfig.ClusterConfig) *LifecycleHandler {
 	return &LifecycleHandler{
-		recorder:     recorder,
-		vmiStore:     vmiStore,
-		virtShareDir: virtShareDir,
+		recorder:      recorder,
+		vmiStore:      vmiStore,
+		virtShareDir:  virtShareDir,
+		clusterConfig: clusterConfig,
+		vcekFetcher:   launchsecurity.NewVCEKFetcher(nil),
 	}
 }
 
@@ -181,6 +193,23 @@ func (lh *LifecycleHandler) SoftRebootHandler(request *restful.Request, response
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) HibernateHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	err = client.HibernateVirtualMachine(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to hibernate VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Hibernating", "VirtualMachineInstance hibernation started")
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (lh *LifecycleHandler) GetGuestInfo(request *restful.Request, response *restful.Response) {
 	log.Log.Info("Retreiving guestinfo")
 	vmi, client, err := lh.getVMILauncherClient(request, response)
@@ -237,6 +266,159 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+// GetGuestFile streams a file from the guest through the guest agent
+func (lh *LifecycleHandler) GetGuestFile(request *restful.Request, response *restful.Response) {
+	path, maxBytes, err := guestFileParams(request)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Copying guest file %s from %s", path, vmi.Name)
+
+	w := &guestFileWriter{response: response}
+	if err := client.GuestFileRead(api.VMINamespaceKeyFunc(vmi), path, maxBytes, w); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to copy guest file %s", path)
+		if w.started {
+			// The status is already sent, abort the connection so that the copy is not mistaken as complete
+			panic(http.ErrAbortHandler)
+		}
+		response.WriteError(guestFileErrorCode(err), err)
+		return
+	}
+	if !w.started {
+		response.AddHeader("Content-Type", "application/octet-stream")
+		response.WriteHeader(http.StatusOK)
+	}
+}
+
+// PutGuestFile streams the request body into a file in the guest through the guest agent
+func (lh *LifecycleHandler) PutGuestFile(request *restful.Request, response *restful.Response) {
+	path, maxBytes, err := guestFileParams(request)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("request with no body: file content is required"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Copying guest file %s to %s", path, vmi.Name)
+
+	if err := client.GuestFileWrite(api.VMINamespaceKeyFunc(vmi), path, maxBytes, request.Request.Body); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to copy guest file %s", path)
+		response.WriteError(guestFileErrorCode(err), err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func guestFileParams(request *restful.Request) (string, int64, error) {
+	path := request.QueryParameter("path")
+	if path == "" {
+		return "", 0, fmt.Errorf("the path of the guest file is required")
+	}
+	maxBytes, err := strconv.ParseInt(request.QueryParameter("maxBytes"), 10, 64)
+	if err != nil || maxBytes <= 0 {
+		return "", 0, fmt.Errorf("a positive maxBytes is required")
+	}
+	return path, maxBytes, nil
+}
+
+func guestFileErrorCode(err error) int {
+	if errors.Is(err, cmdclient.ErrGuestFileTooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusInternalServerError
+}
+
+// guestFileWriter sends the response status with the first chunk of a guest file
+type guestFileWriter struct {
+	response *restful.Response
+	started  bool
+}
+
+func (w *guestFileWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.response.AddHeader("Content-Type", "application/octet-stream")
+		w.response.WriteHeader(http.StatusOK)
+		w.started = true
+	}
+	return w.response.Write(p)
+}
+
+// PutGuestExec runs a command in the guest through the guest agent and writes its result as JSON encoded
+// GuestExecOutput messages. The guest agent only reports the output once the command exited.
+func (lh *LifecycleHandler) PutGuestExec(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("request with no body: the guest command is required"))
+		return
+	}
+	opts := &v1.GuestExecOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode guest command")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	if opts.Command == "" || opts.TimeoutSeconds <= 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("a command and a positive timeout are required"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Executing guest command %s with args %v", opts.Command, opts.Args)
+
+	w := &guestExecWriter{response: response}
+	exitCode, err := client.GuestExec(api.VMINamespaceKeyFunc(vmi), opts.Command, opts.Args,
+		time.Duration(opts.TimeoutSeconds)*time.Second, func(stdout, stderr []byte) error {
+			return w.write(&v1.GuestExecOutput{Stdout: stdout, Stderr: stderr})
+		})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to execute guest command %s", opts.Command)
+		if !w.started {
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		w.write(&v1.GuestExecOutput{Error: err.Error()})
+		return
+	}
+	log.Log.Object(vmi).Infof("Guest command %s exited with code %d", opts.Command, exitCode)
+	w.write(&v1.GuestExecOutput{Exited: true, ExitCode: int32(exitCode)})
+}
+
+// guestExecWriter sends the response status with the first message and flushes every message to the client
+type guestExecWriter struct {
+	response *restful.Response
+	started  bool
+}
+
+func (w *guestExecWriter) write(output *v1.GuestExecOutput) error {
+	if !w.started {
+		w.response.AddHeader("Content-Type", restful.MIME_JSON)
+		w.response.WriteHeader(http.StatusOK)
+		w.started = true
+	}
+	if err := json.NewEncoder(w.response).Encode(output); err != nil {
+		return err
+	}
+	w.response.Flush()
+	return nil
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
@@ -297,6 +479,58 @@ func (lh *LifecycleHandler) SEVQueryLaunchMeasurementHandler(request *restful.Re
 	response.WriteEntity(sevMeasurementInfo)
 }
 
+func (lh *LifecycleHandler) TDXFetchQuoteHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	report, err := base64.StdEncoding.DecodeString(request.QueryParameter("report"))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode TD report")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to decode TD report: %v", err))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Retrieving TDX quote")
+
+	tdxQuoteInfo, err := client.GetTDXQuote(vmi, report)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get TDX quote")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(tdxQuoteInfo)
+}
+
+func (lh *LifecycleHandler) SEVFetchSNPAttestationInfoHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Retrieving SEV-SNP attestation info")
+
+	sevSNPAttestationInfo, err := client.GetSEVSNPAttestationInfo(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get SEV-SNP attestation info")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	// The reports can still be verified with certificates fetched by the guest owner, by the chip ID and TCB version
+	if kdsURL := lh.clusterConfig.GetAMDKeyDistributionServiceURL(); kdsURL != "" {
+		chain, err := lh.vcekFetcher.FetchChain(kdsURL, sevSNPAttestationInfo.Product, sevSNPAttestationInfo.ChipID, sevSNPAttestationInfo.ReportedTCB)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("Failed to fetch the VCEK chain from %s", kdsURL)
+		}
+		sevSNPAttestationInfo.VCEKChain = chain
+	}
+
+	response.WriteEntity(sevSNPAttestationInfo)
+}
+
 func (lh *LifecycleHandler) SEVInjectLaunchSecretHandler(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+)
+
+// serialClientBufferSize is the number of reads from the serial console which are
+// buffered for a client. A client falling further behind is disconnected, so that
+// it does not hold up the console for the other clients.
+const serialClientBufferSize = 64
+
+var errSerialConsoleTaken = errors.New("the serial console was taken over by another connection")
+
+// serialSession shares the connection to the serial console of a VMI between one
+// read-write owner and any number of read-only viewers. The output of the guest is
+// sent to all of them, only the input of the owner is sent to the guest.
+type serialSession struct {
+	conn    net.Conn
+	lock    sync.Mutex
+	owner   *serialClient
+	clients map[*serialClient]struct{}
+}
+
+// serialClient is a websocket connection attached to a serialSession.
+// It is read from to get the output of the guest.
+type serialClient struct {
+	out     chan []byte
+	stop    chan struct{}
+	pending []byte
+}
+
+func newSerialSession(conn net.Conn) *serialSession {
+	return &serialSession{
+		conn:    conn,
+		clients: make(map[*serialClient]struct{}),
+	}
+}
+
+func newSerialClient() *serialClient {
+	return &serialClient{
+		out:  make(chan []byte, serialClientBufferSize),
+		stop: make(chan struct{}),
+	}
+}
+
+func (c *serialClient) Read(p []byte) (int, error) {
+	if len(c.pending) == 0 {
+		b, ok := <-c.out
+		if !ok {
+			return 0, io.EOF
+		}
+		c.pending = b
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+// attach adds a client to the session. A read-write client becomes the owner,
+// the previous owner is disconnected.
+func (s *serialSession) attach(c *serialClient, readOnly bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !readOnly {
+		if s.owner != nil {
+			s.detachLocked(s.owner)
+		}
+		s.owner = c
+	}
+	s.clients[c] = struct{}{}
+}
+
+// detach removes a client from the session and returns whether it has clients left
+func (s *serialSession) detach(c *serialClient) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.detachLocked(c)
+	return len(s.clients) > 0
+}
+
+func (s *serialSession) detachLocked(c *serialClient) {
+	if _, exists := s.clients[c]; !exists {
+		return
+	}
+	delete(s.clients, c)
+	if s.owner == c {
+		s.owner = nil
+	}
+	close(c.out)
+	close(c.stop)
+}
+
+func (s *serialSession) detachAll() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for c := range s.clients {
+		s.detachLocked(c)
+	}
+}
+
+func (s *serialSession) broadcast(b []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for c := range s.clients {
+		select {
+		case c.out <- b:
+		default:
+			s.detachLocked(c)
+		}
+	}
+}
+
+// run sends the output of the guest to the clients until the connection to the
+// serial console is closed.
+func (s *serialSession) run() error {
+	buf := make([]byte, kvcorev1.WebsocketMessageBufferSize)
+	for {
+		n, err := s.conn.Read(buf)
+		if n > 0 {
+			b := make([]byte, n)
+			copy(b, buf[:n])
+			s.broadcast(b)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// input returns the writer for the input of a client. Writing fails once the
+// client is no longer the owner of the session.
+func (s *serialSession) input(c *serialClient) io.Writer {
+	return &serialInput{session: s, client: c}
+}
+
+type serialInput struct {
+	session *serialSession
+	client  *serialClient
+}
+
+func (i *serialInput) Write(p []byte) (int, error) {
+	i.session.lock.Lock()
+	defer i.session.lock.Unlock()
+	if i.session.owner != i.client {
+		return 0, errSerialConsoleTaken
+	}
+	return i.session.conn.Write(p)
+}
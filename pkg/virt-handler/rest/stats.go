@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// libvirt reports the memory stats in KiB
+const kibibyte = 1024
+
+// GetStats returns the resource usage of the domain, the dirty rate is only measured when requested
+func (lh *LifecycleHandler) GetStats(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists || domainStats == nil {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("domain of VMI %s/%s does not exist", vmi.Namespace, vmi.Name))
+		return
+	}
+
+	vmiStats := convertDomainStats(domainStats)
+	vmiStats.Timestamp = metav1.NowMicro()
+
+	if request.QueryParameter("dirtyRate") == "true" {
+		dirtyRate, err := client.GetDomainDirtyRateStats()
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("Failed to get the dirty rate of the domain")
+		} else {
+			vmiStats.DirtyRateMegabytesPerSecond = &dirtyRate
+		}
+	}
+
+	response.WriteEntity(vmiStats)
+}
+
+// convertDomainStats converts the stats libvirt reports for a domain to their API representation
+func convertDomainStats(domainStats *stats.DomainStats) *v1.VirtualMachineInstanceStats {
+	vmiStats := &v1.VirtualMachineInstanceStats{}
+
+	if cpu := domainStats.Cpu; cpu != nil {
+		vmiStats.CPU = &v1.VirtualMachineInstanceCPUStats{
+			TimeNanoseconds:   cpu.Time,
+			UserNanoseconds:   cpu.User,
+			SystemNanoseconds: cpu.System,
+		}
+	}
+
+	for i, vcpu := range domainStats.Vcpu {
+		vcpuStats := v1.VirtualMachineInstanceVCPUStats{
+			ID:               int32(i),
+			TimeNanoseconds:  vcpu.Time,
+			WaitNanoseconds:  vcpu.Wait,
+			DelayNanoseconds: vcpu.Delay,
+		}
+		if vcpu.StateSet {
+			vcpuStats.State = vcpuState(vcpu.State)
+		}
+		vmiStats.VCPUs = append(vmiStats.VCPUs, vcpuStats)
+	}
+
+	if mem := domainStats.Memory; mem != nil {
+		vmiStats.Memory = &v1.VirtualMachineInstanceMemoryStats{
+			ActualBalloonBytes: mem.ActualBalloon * kibibyte,
+			AvailableBytes:     mem.Available * kibibyte,
+			UsableBytes:        mem.Usable * kibibyte,
+			UnusedBytes:        mem.Unused * kibibyte,
+			RSSBytes:           mem.RSS * kibibyte,
+			SwapInBytes:        mem.SwapIn * kibibyte,
+			SwapOutBytes:       mem.SwapOut * kibibyte,
+			MajorFaults:        mem.MajorFault,
+			MinorFaults:        mem.MinorFault,
+		}
+	}
+
+	for _, block := range domainStats.Block {
+		if !block.NameSet {
+			continue
+		}
+		name := block.Name
+		if block.Alias != "" {
+			name = block.Alias
+		}
+		vmiStats.Disks = append(vmiStats.Disks, v1.VirtualMachineInstanceDiskStats{
+			Name:                 name,
+			ReadRequests:         block.RdReqs,
+			ReadBytes:            block.RdBytes,
+			ReadTimeNanoseconds:  block.RdTimes,
+			WriteRequests:        block.WrReqs,
+			WriteBytes:           block.WrBytes,
+			WriteTimeNanoseconds: block.WrTimes,
+			FlushRequests:        block.FlReqs,
+			FlushTimeNanoseconds: block.FlTimes,
+		})
+	}
+
+	for _, net := range domainStats.Net {
+		if !net.NameSet {
+			continue
+		}
+		name := net.Name
+		if net.AliasSet {
+			name = net.Alias
+		}
+		vmiStats.Interfaces = append(vmiStats.Interfaces, v1.VirtualMachineInstanceInterfaceStats{
+			Name:      name,
+			RxBytes:   net.RxBytes,
+			RxPackets: net.RxPkts,
+			RxErrors:  net.RxErrs,
+			RxDropped: net.RxDrop,
+			TxBytes:   net.TxBytes,
+			TxPackets: net.TxPkts,
+			TxErrors:  net.TxErrs,
+			TxDropped: net.TxDrop,
+		})
+	}
+
+	return vmiStats
+}
+
+func vcpuState(state int) string {
+	switch state {
+	case stats.VCPURunning:
+		return "Running"
+	case stats.VCPUBlocked:
+		return "Blocked"
+	case stats.VCPUOffline:
+		return "Offline"
+	default:
+		return ""
+	}
+}
@@ -52,6 +52,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/config"
+	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
 	drautil "kubevirt.io/kubevirt/pkg/dra"
 	"kubevirt.io/kubevirt/pkg/executor"
@@ -61,6 +62,7 @@ import (
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	pvctypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -98,28 +100,32 @@ type downwardMetricsManager interface {
 
 type VirtualMachineController struct {
 	*BaseController
-	launcherClients          launcher_clients.LauncherClientsManager
-	capabilities             *libvirtxml.Caps
-	clientset                kubecli.KubevirtClient
-	containerDiskMounter     container_disk.Mounter
-	downwardMetricsManager   downwardMetricsManager
-	hotplugVolumeMounter     hotplug_volume.VolumeMounter
-	hostCpuModel             string
-	ioErrorRetryManager      *FailRetryManager
-	queue                    workqueue.TypedRateLimitingInterface[string]
-	deviceManagerController  *device_manager.DeviceController
-	heartBeat                *heartbeat.HeartBeat
-	heartBeatInterval        time.Duration
-	migrationProxy           migrationproxy.ProxyManager
-	netConf                  netconf
-	netStat                  netstat
-	podIsolationDetector     isolation.PodIsolationDetector
-	recorder                 record.EventRecorder
-	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
-	vmiExpectations          *controller.UIDTrackingControllerExpectations
-	vmiGlobalStore           cache.Store
-	multipathSocketMonitor   *multipath_monitor.MultipathSocketMonitor
-}
+	launcherClients             launcher_clients.LauncherClientsManager
+	capabilities                *libvirtxml.Caps
+	clientset                   kubecli.KubevirtClient
+	containerDiskMounter        container_disk.Mounter
+	downwardMetricsManager      downwardMetricsManager
+	hotplugVolumeMounter        hotplug_volume.VolumeMounter
+	hostCpuModel                string
+	ioErrorRetryManager         *FailRetryManager
+	persistentReservationPoller *persistentReservationPoller
+	queue                       workqueue.TypedRateLimitingInterface[string]
+	deviceManagerController     *device_manager.DeviceController
+	heartBeat                   *heartbeat.HeartBeat
+	heartBeatInterval           time.Duration
+	migrationProxy              migrationproxy.ProxyManager
+	netConf                     netconf
+	netStat                     netstat
+	podIsolationDetector        isolation.PodIsolationDetector
+	recorder                    record.EventRecorder
+	sriovHotplugExecutorPool    *executor.RateLimitedExecutorPool
+	vmiExpectations             *controller.UIDTrackingControllerExpectations
+	vmiGlobalStore              cache.Store
+	multipathSocketMonitor      *multipath_monitor.MultipathSocketMonitor
+	memoryOvercommit            *memoryOvercommitController
+}
+
+var readPersistentReservation = reservation.ReadPersistentReservation
 
 var getCgroupManager = func(vmi *v1.VirtualMachineInstance, host string) (cgroup.Manager, error) {
 	return cgroup.NewManagerFromVM(vmi, host)
@@ -232,7 +238,13 @@ func NewVirtualMachineController(
 		device_manager.PermanentHostDevicePlugins(maxDevices, permissions),
 		clusterConfig,
 		clientset.CoreV1())
+	c.persistentReservationPoller = newPersistentReservationPoller(persistentReservationPollInterval,
+		c.readPersistentReservations,
+		func(vmi *v1.VirtualMachineInstance) {
+			c.queue.Add(controller.VirtualMachineInstanceKey(vmi))
+		})
 	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
+	c.memoryOvercommit = newMemoryOvercommitController(host, c.vmiStore, clusterConfig, launcherClients)
 
 	return c, nil
 }
@@ -267,8 +279,12 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 
 	heartBeatDone := c.heartBeat.Run(c.heartBeatInterval, stopCh)
 
+	go c.memoryOvercommit.Run(stopCh)
+
 	go c.ioErrorRetryManager.Run(stopCh)
 
+	go c.persistentReservationPoller.Run(stopCh)
+
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
@@ -511,7 +527,8 @@ func (c *VirtualMachineController) updateHotplugVolumeStatus(vmi *v1.VirtualMach
 func needToComputeChecksums(vmi *v1.VirtualMachineInstance) bool {
 	containerDisks := map[string]*v1.Volume{}
 	for _, volume := range vmi.Spec.Volumes {
-		if volume.VolumeSource.ContainerDisk != nil {
+		// Layered containerDisks are new enough to not need checksums for older virt-handlers
+		if volume.VolumeSource.ContainerDisk != nil && !containerdisk.IsLayered(volume.VolumeSource.ContainerDisk) {
 			containerDisks[volume.Name] = &volume
 		}
 	}
@@ -719,6 +736,30 @@ func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 	}
 }
 
+func updateFilesystemGrowConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.FilesystemGrow == nil {
+		return
+	}
+
+	message := domain.Spec.Metadata.KubeVirt.FilesystemGrow.Message
+	status := k8sv1.ConditionFalse
+	if domain.Spec.Metadata.KubeVirt.FilesystemGrow.Succeeded {
+		status = k8sv1.ConditionTrue
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceFilesystemGrown)
+	if condition != nil && condition.Status == status && condition.Message == message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceFilesystemGrown)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceFilesystemGrown,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Message:            message,
+	})
+}
+
 func (c *VirtualMachineController) updateLiveMigrationConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
 	// Calculate whether the VM is migratable
 	liveMigrationCondition, isBlockMigration := c.calculateLiveMigrationCondition(vmi)
@@ -895,6 +936,66 @@ func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineIn
 
 }
 
+func (c *VirtualMachineController) updateGuestDisks(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil {
+		return
+	}
+
+	var guestDisks []v1.VirtualMachineInstanceGuestDisk
+	for _, disk := range domain.Status.GuestDisks {
+		guestDisk := v1.VirtualMachineInstanceGuestDisk{
+			Name:         disk.Name,
+			Partition:    disk.Partition,
+			Serial:       disk.Serial,
+			Bus:          disk.Bus,
+			Alias:        disk.GuestAlias,
+			Dependencies: disk.Dependencies,
+		}
+		// Disks of VMI volumes carry the user defined alias of the volume
+		if strings.HasPrefix(disk.Alias, api.UserAliasPrefix) {
+			guestDisk.VolumeName = strings.TrimPrefix(disk.Alias, api.UserAliasPrefix)
+		}
+		guestDisks = append(guestDisks, guestDisk)
+	}
+	vmi.Status.GuestDisks = guestDisks
+}
+
+func updateSecureBootKeysStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.SecureBootKeys == nil {
+		return
+	}
+
+	keys := domain.Spec.Metadata.KubeVirt.SecureBootKeys
+	vmi.Status.SecureBootKeys = &v1.SecureBootKeysStatus{
+		PK:  keys.PK,
+		KEK: keys.KEK,
+		DB:  keys.DB,
+		DBX: keys.DBX,
+	}
+}
+
+func updateHibernationStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Hibernation == nil {
+		return
+	}
+
+	hibernation := domain.Spec.Metadata.KubeVirt.Hibernation
+	vmi.Status.Hibernation = &v1.HibernationStatus{
+		Phase:          hibernation.Phase,
+		QEMUVersion:    hibernation.QEMUVersion,
+		MachineType:    hibernation.MachineType,
+		StartTimestamp: hibernation.StartTimestamp.DeepCopy(),
+		EndTimestamp:   hibernation.EndTimestamp.DeepCopy(),
+		Message:        hibernation.Message,
+	}
+	// libvirt stops the domain as soon as its state is saved, the metadata may not report it yet
+	if hibernation.Phase == v1.HibernationSaving && domain.Status.Status == api.Shutoff && domain.Status.Reason == api.ReasonSaved {
+		now := metav1.Now()
+		vmi.Status.Hibernation.Phase = v1.HibernationSaved
+		vmi.Status.Hibernation.EndTimestamp = &now
+	}
+}
+
 func IsoGuestVolumePath(namespace, name string, volume *v1.Volume) string {
 	const basepath = "/var/run"
 	switch {
@@ -983,6 +1084,127 @@ func (c *VirtualMachineController) updateIsoSizeStatus(vmi *v1.VirtualMachineIns
 	}
 }
 
+// sourceVolumeDiskPath returns the path, as seen from virt-launcher, of the disk of the volumes which aren't backed by
+// a PVC but can be migrated to one
+func sourceVolumeDiskPath(volumeIndex int, volume *v1.Volume) string {
+	switch {
+	case volume.HostDisk != nil:
+		return hostdisk.GetMountedHostDiskPath(volume.Name, volume.HostDisk.Path)
+	case volume.ContainerDisk != nil && !containerdisk.IsLayered(volume.ContainerDisk):
+		return containerdisk.GetDiskTargetPathFromLauncherView(volumeIndex)
+	default:
+		return ""
+	}
+}
+
+// updateSourceVolumeSizeStatus reports the virtual size of the hostDisks and containerDisks, which virt-controller
+// needs to create the destination DataVolumes of their migration
+func (c *VirtualMachineController) updateSourceVolumeSizeStatus(vmi *v1.VirtualMachineInstance) {
+	if vmi.Status.Phase != v1.Running {
+		return
+	}
+
+	var rootPath *safepath.Path
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		volPath := sourceVolumeDiskPath(i, volume)
+		if volPath == "" {
+			continue
+		}
+		var status *v1.VolumeStatus
+		for j := range vmi.Status.VolumeStatus {
+			if vmi.Status.VolumeStatus[j].Name == volume.Name {
+				status = &vmi.Status.VolumeStatus[j]
+				break
+			}
+		}
+		if status == nil || status.Size > 0 {
+			continue
+		}
+
+		if rootPath == nil {
+			res, err := c.podIsolationDetector.Detect(vmi)
+			if err != nil {
+				log.DefaultLogger().Reason(err).Warningf("failed to detect VMI %s", vmi.Name)
+				return
+			}
+			rootPath, err = res.MountRoot()
+			if err != nil {
+				log.DefaultLogger().Reason(err).Warningf("failed to detect VMI %s", vmi.Name)
+				return
+			}
+		}
+
+		safeVolPath, err := rootPath.AppendAndResolveWithRelativeRoot(volPath)
+		if err != nil {
+			log.DefaultLogger().Warningf("failed to determine the virtual size for volume %s", volPath)
+			continue
+		}
+		err = safeVolPath.ExecuteNoFollow(func(safePath string) error {
+			size, err := osdisk.GetVirtualSize(safePath)
+			if err != nil {
+				return err
+			}
+			status.Size = size
+			return nil
+		})
+		if err != nil {
+			log.DefaultLogger().Reason(err).Warningf("failed to determine the virtual size for volume %s", volPath)
+		}
+	}
+}
+
+func (c *VirtualMachineController) updatePersistentReservationStatus(vmi *v1.VirtualMachineInstance) {
+	if vmi.Status.Phase != v1.Running || !reservation.HasVMIPersistentReservation(vmi) {
+		return
+	}
+
+	statuses := c.persistentReservationPoller.Get(vmi)
+	for i := range vmi.Status.VolumeStatus {
+		if status, ok := statuses[vmi.Status.VolumeStatus[i].Name]; ok {
+			vmi.Status.VolumeStatus[i].PersistentReservation = status.DeepCopy()
+		}
+	}
+}
+
+// readPersistentReservations reads the persistent reservations of the LUNs with reservation of the VMI by volume name.
+// It is called by the persistent reservation poller, outside of the reconcile loop.
+func (c *VirtualMachineController) readPersistentReservations(vmi *v1.VirtualMachineInstance) map[string]*v1.PersistentReservationStatus {
+	res, err := c.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to detect VMI to read the persistent reservations")
+		return nil
+	}
+	rootPath, err := res.MountRoot()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to detect VMI to read the persistent reservations")
+		return nil
+	}
+
+	statuses := make(map[string]*v1.PersistentReservationStatus)
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.LUN == nil || !disk.LUN.Reservation {
+			continue
+		}
+		devPath, err := rootPath.AppendAndResolveWithRelativeRoot("dev", disk.Name)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to find the device of LUN %s", disk.Name)
+			continue
+		}
+		var status *v1.PersistentReservationStatus
+		err = devPath.ExecuteNoFollow(func(safePath string) (err error) {
+			status, err = readPersistentReservation(safePath)
+			return err
+		})
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to read the persistent reservation of LUN %s", disk.Name)
+			continue
+		}
+		statuses[disk.Name] = status
+	}
+	return statuses
+}
+
 func (c *VirtualMachineController) updateSELinuxContext(vmi *v1.VirtualMachineInstance) error {
 	_, present, err := selinux.NewSELinux()
 	if err != nil {
@@ -1015,14 +1237,20 @@ func (c *VirtualMachineController) updateMemoryInfo(vmi *v1.VirtualMachineInstan
 
 func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	c.updateIsoSizeStatus(vmi)
+	c.updateSourceVolumeSizeStatus(vmi)
 	err := c.updateSELinuxContext(vmi)
 	if err != nil {
 		log.Log.Reason(err).Errorf("couldn't find the SELinux context for %s", vmi.Name)
 	}
 	c.updateGuestInfoFromDomain(vmi, domain)
 	c.updateVolumeStatusesFromDomain(vmi, domain)
+	c.updatePersistentReservationStatus(vmi)
 	c.updateFSFreezeStatus(vmi, domain)
+	c.updateGuestDisks(vmi, domain)
+	updateSecureBootKeysStatus(vmi, domain)
+	updateHibernationStatus(vmi, domain)
 	c.updateMachineType(vmi, domain)
+	c.updateVCPUStatus(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
@@ -1032,12 +1260,15 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 
 func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
 	c.updateAccessCredentialConditions(vmi, domain, condManager)
+	updateFilesystemGrowConditions(vmi, domain, condManager)
 	c.updateLiveMigrationConditions(vmi, condManager)
 	err := c.updateGuestAgentConditions(vmi, domain, condManager)
 	if err != nil {
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	updateHotUnplugConditions(vmi, condManager)
+	c.updateRealtimeConditions(vmi, domain, condManager)
 
 	return nil
 }
@@ -1203,6 +1434,10 @@ func (c *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 		return newNonMigratableCondition("VMI uses SEV", v1.VirtualMachineInstanceReasonSEVNotMigratable), isBlockMigration
 	}
 
+	if util.IsTDXVMI(vmi) {
+		return newNonMigratableCondition("VMI uses TDX", v1.VirtualMachineInstanceReasonTDXNotMigratable), isBlockMigration
+	}
+
 	if util.IsSecureExecutionVMI(vmi) {
 		return newNonMigratableCondition("VMI uses Secure Execution", v1.VirtualMachineInstanceReasonSecureExecutionNotMigratable), isBlockMigration
 	}
@@ -1294,6 +1529,10 @@ func (c *VirtualMachineController) calculateLiveStorageMigrationCondition(vmi *v
 		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonSEVNotMigratable, "VMI uses SEV")
 	}
 
+	if util.IsTDXVMI(vmi) {
+		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonTDXNotMigratable, "VMI uses TDX")
+	}
+
 	if reservation.HasVMIPersistentReservation(vmi) {
 		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonPRNotMigratable, "VMI uses SCSI persitent reservation")
 	}
@@ -1523,6 +1762,8 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 
 	c.sriovHotplugExecutorPool.Delete(vmi.UID)
 
+	c.persistentReservationPoller.Forget(vmi.UID)
+
 	// Watch dog file and command client must be the last things removed here
 	c.launcherClients.CloseLauncherClient(vmi)
 
@@ -1766,6 +2007,8 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 			if !shared {
 				return true, fmt.Errorf("cannot migrate VMI with non-shared HostDisk")
 			}
+		} else if containerdisk.HasPersistentOverlay(volSrc.ContainerDisk) {
+			return true, fmt.Errorf("cannot migrate VMI: containerDisk %v keeps its overlay on PVC %v", volume.Name, volSrc.ContainerDisk.Overlay.ClaimName)
 		} else {
 			if _, ok := filesystems[volume.Name]; ok {
 				log.Log.Object(vmi).Infof("Volume %s is shared with virtiofs, allow live migration", volume.Name)
@@ -1984,6 +2227,11 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.hotUnplug(vmi); err != nil {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "HotUnplugFailed", err.Error())
+		return err
+	}
+
 	isolationRes, err := c.podIsolationDetector.Detect(vmi)
 	if err != nil {
 		return fmt.Errorf(failedDetectIsolationFmt, err)
@@ -2081,6 +2329,10 @@ func (c *VirtualMachineController) shouldWaitForSEVAttestation(vmi *v1.VirtualMa
 func (c *VirtualMachineController) syncVirtualMachine(client cmdclient.LauncherClient, vmi *v1.VirtualMachineInstance, preallocatedVolumes []string) error {
 	smbios := c.clusterConfig.GetSMBIOS()
 	period := c.clusterConfig.GetMemBalloonStatsPeriod()
+	if period == 0 && c.clusterConfig.MemoryOvercommitControllerEnabled() {
+		// the memory overcommit controller relies on the memory statistics reported by the guest
+		period = virtconfig.DefaultMemBalloonStatsPeriod
+	}
 
 	options := virtualMachineOptions(smbios, period, preallocatedVolumes, c.capabilities, c.clusterConfig)
 	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
@@ -49,10 +49,12 @@ import (
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/certificates"
+	launcherdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
@@ -87,6 +89,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 	var recorder *record.FakeRecorder
 
 	var sockFile string
+	var vmiShareDir string
 	var vmiTestUUID types.UID
 	var podTestUUID types.UID
 	var stop chan struct{}
@@ -113,7 +116,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		DeferCleanup(os.RemoveAll, podsDir)
 		certDir := GinkgoT().TempDir()
 
-		vmiShareDir := GinkgoT().TempDir()
+		vmiShareDir = GinkgoT().TempDir()
 		ghostCacheDir := GinkgoT().TempDir()
 
 		_ = virtcache.InitializeGhostRecordCache(virtcache.NewIterableCheckpointManager(ghostCacheDir))
@@ -2090,6 +2093,15 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI with non-shared HostDisk")))
 		})
+
+		It("should not be allowed to live-migrate containerDisks with a persistent overlay", func() {
+			vmi := libvmi.New(libvmi.WithContainerDisk("mydisk", "registry/image"))
+			vmi.Spec.Volumes[0].ContainerDisk.Overlay = &v1.ContainerDiskOverlay{ClaimName: "overlay"}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).To(MatchError("cannot migrate VMI: containerDisk mydisk keeps its overlay on PVC overlay"))
+		})
 		DescribeTable("with host model", func(hostCpuModel string) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
@@ -2257,6 +2269,19 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonSEVNotMigratable))
 		})
 
+		It("should not be allowed to live-migrate if the VMI uses TDX", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				TDX: &v1.TDX{},
+			}
+
+			condition, isBlockMigration := controller.calculateLiveMigrationCondition(vmi)
+			Expect(isBlockMigration).To(BeFalse())
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonTDXNotMigratable))
+		})
+
 		It("should not be allowed to live-migrate if the VMI uses Secure Execution", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{}
@@ -2557,6 +2582,126 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.FSFreezeStatus).To(BeEmpty())
 		})
 
+		It("should update the guest disks in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Status.GuestDisks = []api.GuestDisk{
+				{Name: "/dev/vda", Serial: "serial0", Bus: "virtio", Alias: "ua-rootdisk"},
+				{Name: "/dev/dm-0", GuestAlias: "vg-data", Dependencies: []string{"/dev/vdb"}},
+			}
+
+			addVMI(vmi, domain)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.GuestDisks).To(Equal([]v1.VirtualMachineInstanceGuestDisk{
+				{Name: "/dev/vda", Serial: "serial0", Bus: "virtio", VolumeName: "rootdisk"},
+				{Name: "/dev/dm-0", Alias: "vg-data", Dependencies: []string{"/dev/vdb"}},
+			}))
+		})
+
+		It("should report the enrolled SecureBoot keys in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.SecureBootKeys = &api.SecureBootKeysMetadata{
+				PK:  []string{"aa"},
+				KEK: []string{"bb"},
+				DB:  []string{"cc", "dd"},
+			}
+
+			addVMI(vmi, domain)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.SecureBootKeys).To(Equal(&v1.SecureBootKeysStatus{
+				PK:  []string{"aa"},
+				KEK: []string{"bb"},
+				DB:  []string{"cc", "dd"},
+			}))
+		})
+
+		It("should report the hibernation progress in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+
+			startTimestamp := metav1.Now()
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Hibernation = &api.HibernationMetadata{
+				Phase:          v1.HibernationSaving,
+				QEMUVersion:    "9.1.0",
+				MachineType:    "pc-q35-rhel9.6.0",
+				StartTimestamp: &startTimestamp,
+			}
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Running))
+			Expect(updatedVMI.Status.Hibernation).ToNot(BeNil())
+			Expect(updatedVMI.Status.Hibernation.Phase).To(Equal(v1.HibernationSaving))
+			Expect(updatedVMI.Status.Hibernation.QEMUVersion).To(Equal("9.1.0"))
+			Expect(updatedVMI.Status.Hibernation.MachineType).To(Equal("pc-q35-rhel9.6.0"))
+			Expect(updatedVMI.Status.Hibernation.EndTimestamp).To(BeNil())
+		})
+
+		It("should report the hibernation as saved when the domain state was saved", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+
+			startTimestamp := metav1.Now()
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Shutoff
+			domain.Status.Reason = api.ReasonSaved
+			domain.Spec.Metadata.KubeVirt.Hibernation = &api.HibernationMetadata{
+				Phase:          v1.HibernationSaving,
+				StartTimestamp: &startTimestamp,
+			}
+
+			addVMI(vmi, domain)
+
+			client.EXPECT().DeleteDomain(gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().UnmountAll(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecuteNoDomain()
+
+			testutils.ExpectEvent(recorder, VMISignalDeletion)
+			testutils.ExpectEvent(recorder, VMIShutdown)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Succeeded))
+			Expect(updatedVMI.Status.Hibernation).ToNot(BeNil())
+			Expect(updatedVMI.Status.Hibernation.Phase).To(Equal(v1.HibernationSaved))
+			Expect(updatedVMI.Status.Hibernation.EndTimestamp).ToNot(BeNil())
+		})
+
 		It("should update Memory information in VMI status", func() {
 			initialMemory := resource.MustParse("128Ki")
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -2681,6 +2826,103 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 	})
 
+	Context("VirtualMachineInstance controller gets informed about persistent reservations", func() {
+		var origReadPersistentReservation func(string) (*v1.PersistentReservationStatus, error)
+
+		BeforeEach(func() {
+			origReadPersistentReservation = readPersistentReservation
+		})
+
+		AfterEach(func() {
+			readPersistentReservation = origReadPersistentReservation
+		})
+
+		It("should report the reservation keys and holder of LUNs with reservation", func() {
+			vmi := libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithPersistentVolumeClaimLun("quorum", "quorum-pvc", true),
+				libvmi.WithPersistentVolumeClaim("data", "data-pvc"),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+			)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "quorum"}, {Name: "data"}}
+			f, err := os.OpenFile(filepath.Join(vmiShareDir, "dev", "quorum"), os.O_CREATE, 0644)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(f.Close()).To(Succeed())
+
+			prStatus := &v1.PersistentReservationStatus{
+				Keys:   []string{"0x1", "0x2"},
+				Holder: "0x1",
+				Type:   "WriteExclusiveRegistrantsOnly",
+			}
+			reads := make(chan string, 10)
+			readPersistentReservation = func(path string) (*v1.PersistentReservationStatus, error) {
+				reads <- path
+				return prStatus, nil
+			}
+
+			By("reading the reservations in the background on the first status update")
+			controller.updatePersistentReservationStatus(vmi)
+			Expect(vmi.Status.VolumeStatus[0].PersistentReservation).To(BeNil())
+			Eventually(reads).Should(Receive())
+
+			By("reporting the cached reservations on the next status updates")
+			Eventually(func() *v1.PersistentReservationStatus {
+				controller.updatePersistentReservationStatus(vmi)
+				return vmi.Status.VolumeStatus[0].PersistentReservation
+			}).Should(Equal(prStatus))
+			Expect(vmi.Status.VolumeStatus[1].PersistentReservation).To(BeNil())
+			Consistently(reads).ShouldNot(Receive())
+		})
+
+		It("should only read LUNs with reservation", func() {
+			vmi := libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithPersistentVolumeClaimLun("quorum", "quorum-pvc", true),
+				libvmi.WithPersistentVolumeClaimLun("lun", "lun-pvc", false),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+			)
+			f, err := os.OpenFile(filepath.Join(vmiShareDir, "dev", "quorum"), os.O_CREATE, 0644)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(f.Close()).To(Succeed())
+
+			var readPaths []string
+			readPersistentReservation = func(path string) (*v1.PersistentReservationStatus, error) {
+				readPaths = append(readPaths, path)
+				return &v1.PersistentReservationStatus{}, nil
+			}
+
+			statuses := controller.readPersistentReservations(vmi)
+			Expect(readPaths).To(HaveLen(1))
+			Expect(statuses).To(HaveKey("quorum"))
+			Expect(statuses).ToNot(HaveKey("lun"))
+		})
+	})
+
+	Context("VirtualMachineInstance controller gets informed about the size of the volumes not backed by a PVC", func() {
+		It("should report the virtual size of hostDisks and containerDisks", func() {
+			vmi := libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithContainerDisk("cdisk", "image"),
+				libvmi.WithHostDisk("hdisk", "/disks/disk.img", v1.HostDiskExists),
+				libvmi.WithPersistentVolumeClaim("data", "data-pvc"),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+			)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "cdisk"}, {Name: "hdisk"}, {Name: "data"}}
+			for path, size := range map[string]int{
+				launcherdisk.GetDiskTargetPathFromLauncherView(0):           1024,
+				hostdisk.GetMountedHostDiskPath("hdisk", "/disks/disk.img"): 2048,
+			} {
+				Expect(os.MkdirAll(filepath.Join(vmiShareDir, filepath.Dir(path)), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(vmiShareDir, path), make([]byte, size), 0644)).To(Succeed())
+			}
+
+			controller.updateSourceVolumeSizeStatus(vmi)
+			Expect(vmi.Status.VolumeStatus[0].Size).To(BeEquivalentTo(1024))
+			Expect(vmi.Status.VolumeStatus[1].Size).To(BeEquivalentTo(2048))
+			Expect(vmi.Status.VolumeStatus[2].Size).To(BeZero())
+		})
+	})
+
 	Context("Guest Agent Compatibility", func() {
 		var vmi *v1.VirtualMachineInstance
 		var vmiWithPassword *v1.VirtualMachineInstance
@@ -2932,6 +3174,41 @@ var _ = Describe("CurrentMemory in Libvirt Domain", func() {
 	)
 })
 
+var _ = Describe("updateFilesystemGrowConditions", func() {
+	newDomainWithFilesystemGrow := func(succeeded bool, message string) *api.Domain {
+		domain := api.NewMinimalDomain("test")
+		domain.Spec.Metadata.KubeVirt.FilesystemGrow = &api.FilesystemGrowMetadata{
+			Succeeded: succeeded,
+			Message:   message,
+		}
+		return domain
+	}
+
+	DescribeTable("should set the filesystem grown condition", func(domain *api.Domain, expectedStatus k8sv1.ConditionStatus) {
+		vmi := libvmi.New()
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:    v1.VirtualMachineInstanceFilesystemGrown,
+			Status:  k8sv1.ConditionFalse,
+			Message: "previous failure",
+		}}
+		updateFilesystemGrowConditions(vmi, domain, virtcontroller.NewVirtualMachineInstanceConditionManager())
+		Expect(vmi.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(v1.VirtualMachineInstanceFilesystemGrown),
+			"Status":  Equal(expectedStatus),
+			"Message": Equal(domain.Spec.Metadata.KubeVirt.FilesystemGrow.Message),
+		})))
+	},
+		Entry("when the filesystem grew", newDomainWithFilesystemGrow(true, "grew the filesystem on disk data"), k8sv1.ConditionTrue),
+		Entry("when the filesystem failed to grow", newDomainWithFilesystemGrow(false, "failed to grow the filesystem on disk data"), k8sv1.ConditionFalse),
+	)
+
+	It("should leave the conditions untouched without any filesystem grow metadata", func() {
+		vmi := libvmi.New()
+		updateFilesystemGrowConditions(vmi, api.NewMinimalDomain("test"), virtcontroller.NewVirtualMachineInstanceConditionManager())
+		Expect(vmi.Status.Conditions).To(BeEmpty())
+	})
+})
+
 func addActivePods(vmi *v1.VirtualMachineInstance, podUID types.UID, hostName string) *v1.VirtualMachineInstance {
 
 	if vmi.Status.ActivePods != nil {
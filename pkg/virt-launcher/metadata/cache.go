@@ -31,6 +31,10 @@ type Cache struct {
 	GracePeriod      SafeData[api.GracePeriodMetadata]
 	AccessCredential SafeData[api.AccessCredentialMetadata]
 	MemoryDump       SafeData[api.MemoryDumpMetadata]
+	VolumeMigration  SafeData[*api.VolumeMigrationMetadata]
+	FilesystemGrow   SafeData[api.FilesystemGrowMetadata]
+	SecureBootKeys   SafeData[*api.SecureBootKeysMetadata]
+	Hibernation      SafeData[api.HibernationMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -44,6 +48,10 @@ func NewCache() *Cache {
 	cache.GracePeriod.dirtyChanel = cache.notificationSignal
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
+	cache.VolumeMigration.dirtyChanel = cache.notificationSignal
+	cache.FilesystemGrow.dirtyChanel = cache.notificationSignal
+	cache.SecureBootKeys.dirtyChanel = cache.notificationSignal
+	cache.Hibernation.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
@@ -44,5 +44,17 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.VolumeMigration.Load(); exists && value != nil {
+		kubevirtMetadata.VolumeMigration = value
+	}
+	if value, exists := metadataCache.FilesystemGrow.Load(); exists {
+		kubevirtMetadata.FilesystemGrow = &value
+	}
+	if value, exists := metadataCache.SecureBootKeys.Load(); exists && value != nil {
+		kubevirtMetadata.SecureBootKeys = value
+	}
+	if value, exists := metadataCache.Hibernation.Load(); exists {
+		kubevirtMetadata.Hibernation = &value
+	}
 	return kubevirtMetadata
 }
@@ -58,6 +58,8 @@ type Notifier struct {
 	intervalTimeout time.Duration
 	sendTimeout     time.Duration
 	totalTimeout    time.Duration
+
+	migrationCompletedHook func()
 }
 
 type libvirtEvent struct {
@@ -122,6 +124,12 @@ func (n *Notifier) SetCustomTimeouts(interval, send, total time.Duration) {
 
 }
 
+// SetMigrationCompletedHook sets a function which is called on the migration target when the migration completed,
+// before virt-handler is notified about it
+func (n *Notifier) SetMigrationCompletedHook(hook func()) {
+	n.migrationCompletedHook = hook
+}
+
 func (n *Notifier) detectSocketPath() string {
 
 	// use the legacy domain socket if it exists. This would
@@ -260,7 +268,7 @@ func (e *eventCaller) updateStatus(status *api.DomainStatus) {
 
 func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEvent, client *Notifier, events chan watch.Event,
 	interfaceStatus []api.InterfaceStatus, osInfo *api.GuestOSInfo, vmi *v1.VirtualMachineInstance, fsFreezeStatus *api.FSFreeze,
-	metadataCache *metadata.Cache) {
+	guestDisks []api.GuestDisk, metadataCache *metadata.Cache) {
 
 	d, err := c.LookupDomainByName(util.DomainFromNamespaceName(domain.ObjectMeta.Namespace, domain.ObjectMeta.Name))
 	if err != nil {
@@ -366,6 +374,9 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 					}
 					metadataCache.Migration.Store(migrationMetadata)
 				}
+				if client.migrationCompletedHook != nil {
+					client.migrationCompletedHook()
+				}
 
 				event := watch.Event{Type: watch.Modified, Object: domain}
 				client.SendDomainEvent(event)
@@ -383,6 +394,10 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 			domain.Status.FSFreezeStatus = *fsFreezeStatus
 		}
 
+		if guestDisks != nil {
+			domain.Status.GuestDisks = guestDisks
+		}
+
 		err := client.SendDomainEvent(watch.Event{Type: watch.Modified, Object: domain})
 		if err != nil {
 			log.Log.Reason(err).Error("Could not send domain notify event.")
@@ -413,11 +428,7 @@ func (n *Notifier) StartDomainNotifier(
 	vmi *v1.VirtualMachineInstance,
 	domainName string,
 	agentStore *agentpoller.AsyncAgentStore,
-	qemuAgentSysInterval time.Duration,
-	qemuAgentFileInterval time.Duration,
-	qemuAgentUserInterval time.Duration,
-	qemuAgentVersionInterval time.Duration,
-	qemuAgentFSFreezeStatusInterval time.Duration,
+	agentPollerIntervals agentpoller.PollerIntervals,
 	metadataCache *metadata.Cache,
 ) error {
 
@@ -434,11 +445,7 @@ func (n *Notifier) StartDomainNotifier(
 		vmi.UID,
 		domainName,
 		agentStore,
-		qemuAgentSysInterval,
-		qemuAgentFileInterval,
-		qemuAgentUserInterval,
-		qemuAgentVersionInterval,
-		qemuAgentFSFreezeStatusInterval,
+		agentPollerIntervals,
 	)
 
 	// Run the event process logic in a separate go-routine to not block libvirt
@@ -446,6 +453,7 @@ func (n *Notifier) StartDomainNotifier(
 		var interfaceStatuses []api.InterfaceStatus
 		var guestOsInfo *api.GuestOSInfo
 		var fsFreezeStatus *api.FSFreeze
+		var guestDisks []api.GuestDisk
 		var eventCaller eventCaller
 
 		for {
@@ -453,7 +461,7 @@ func (n *Notifier) StartDomainNotifier(
 			case event := <-eventChan:
 				metadataCache.ResetNotification()
 				domainCache = util.NewDomainFromName(event.Domain, vmi.UID)
-				eventCaller.eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+				eventCaller.eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDisks, metadataCache)
 				log.Log.Infof("Domain name event: %v", domainCache.Spec.Name)
 				if event.AgentEvent != nil {
 					if event.AgentEvent.State == libvirt.CONNECT_DOMAIN_EVENT_AGENT_LIFECYCLE_STATE_CONNECTED {
@@ -467,9 +475,10 @@ func (n *Notifier) StartDomainNotifier(
 				interfaceStatuses = agentUpdate.DomainInfo.Interfaces
 				guestOsInfo = agentUpdate.DomainInfo.OSInfo
 				fsFreezeStatus = agentUpdate.DomainInfo.FSFreezeStatus
+				guestDisks = agentUpdate.DomainInfo.GuestDisks
 
 				eventCaller.eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
-					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDisks, metadataCache)
 			case <-reconnectChan:
 				n.SendDomainEvent(newWatchEventError(fmt.Errorf("Libvirt reconnect, domain %s", domainName)))
 
@@ -491,6 +500,7 @@ func (n *Notifier) StartDomainNotifier(
 						guestOsInfo,
 						vmi,
 						fsFreezeStatus,
+						guestDisks,
 						metadataCache,
 					)
 				}
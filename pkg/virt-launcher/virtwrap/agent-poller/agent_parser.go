@@ -10,6 +10,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var (
@@ -43,6 +44,7 @@ func stripAgentStringResponse(agentReply string) string {
 type FSDisk struct {
 	Serial  string `json:"serial,omitempty"`
 	BusType string `json:"bus-type"`
+	Dev     string `json:"dev,omitempty"`
 }
 
 // Filesystem of the host
@@ -62,6 +64,42 @@ type AgentInfo struct {
 	SupportedCommands []v1.GuestAgentCommandInfo `json:"supported_commands,omitempty"`
 }
 
+// CPUStats of a guest CPU, times are in milliseconds
+type CPUStats struct {
+	Type    string `json:"type"`
+	CPU     int    `json:"cpu"`
+	User    uint64 `json:"user"`
+	Nice    uint64 `json:"nice"`
+	System  uint64 `json:"system"`
+	Idle    uint64 `json:"idle"`
+	IOWait  uint64 `json:"iowait,omitempty"`
+	IRQ     uint64 `json:"irq,omitempty"`
+	SoftIRQ uint64 `json:"softirq,omitempty"`
+	Steal   uint64 `json:"steal,omitempty"`
+}
+
+// DiskStats of a guest block device
+type DiskStats struct {
+	Name  string          `json:"name"`
+	Major int             `json:"major"`
+	Minor int             `json:"minor"`
+	Stats DiskStatsValues `json:"stats"`
+}
+
+// DiskStatsValues are the I/O counters of a guest block device, ticks are in milliseconds
+type DiskStatsValues struct {
+	ReadSectors   uint64 `json:"read-sectors,omitempty"`
+	ReadIOs       uint64 `json:"read-ios,omitempty"`
+	ReadTicks     uint64 `json:"read-ticks,omitempty"`
+	WriteSectors  uint64 `json:"write-sectors,omitempty"`
+	WriteIOs      uint64 `json:"write-ios,omitempty"`
+	WriteTicks    uint64 `json:"write-ticks,omitempty"`
+	FlushIOs      uint64 `json:"flush-ios,omitempty"`
+	FlushTicks    uint64 `json:"flush-ticks,omitempty"`
+	IOsInProgress uint64 `json:"ios-pgr,omitempty"`
+	TotalTicks    uint64 `json:"total-ticks,omitempty"`
+}
+
 // parseFSFreezeStatus from the agent response
 func ParseFSFreezeStatus(agentReply string) (api.FSFreeze, error) {
 	response := stripAgentStringResponse(agentReply)
@@ -74,8 +112,8 @@ func ParseFSFreezeStatus(agentReply string) (api.FSFreeze, error) {
 	}, nil
 }
 
-// parseFilesystem from the agent response
-func parseFilesystem(agentReply string) ([]api.Filesystem, error) {
+// ParseFilesystem from the agent response
+func ParseFilesystem(agentReply string) ([]api.Filesystem, error) {
 	result := []Filesystem{}
 	response := stripAgentResponse(agentReply)
 
@@ -106,6 +144,7 @@ func parseFSDisks(fsDisks []FSDisk) []api.FSDisk {
 		disks = append(disks, api.FSDisk{
 			Serial:  fsDisk.Serial,
 			BusType: fsDisk.BusType,
+			Dev:     fsDisk.Dev,
 		})
 	}
 
@@ -128,3 +167,59 @@ func parseAgent(agentReply string) (AgentInfo, error) {
 
 	return gaInfo, nil
 }
+
+// parseCPUStats from the agent response
+func parseCPUStats(agentReply string) ([]stats.DomainStatsGuestCPU, error) {
+	result := []CPUStats{}
+	response := stripAgentResponse(agentReply)
+
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, err
+	}
+
+	cpuStats := []stats.DomainStatsGuestCPU{}
+	for _, cpu := range result {
+		cpuStats = append(cpuStats, stats.DomainStatsGuestCPU{
+			CPU:     cpu.CPU,
+			User:    cpu.User,
+			Nice:    cpu.Nice,
+			System:  cpu.System,
+			Idle:    cpu.Idle,
+			IOWait:  cpu.IOWait,
+			IRQ:     cpu.IRQ,
+			SoftIRQ: cpu.SoftIRQ,
+			Steal:   cpu.Steal,
+		})
+	}
+
+	return cpuStats, nil
+}
+
+// parseDiskStats from the agent response
+func parseDiskStats(agentReply string) ([]stats.DomainStatsGuestDisk, error) {
+	result := []DiskStats{}
+	response := stripAgentResponse(agentReply)
+
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, err
+	}
+
+	diskStats := []stats.DomainStatsGuestDisk{}
+	for _, disk := range result {
+		diskStats = append(diskStats, stats.DomainStatsGuestDisk{
+			Name:          disk.Name,
+			ReadSectors:   disk.Stats.ReadSectors,
+			ReadIOs:       disk.Stats.ReadIOs,
+			ReadTicks:     disk.Stats.ReadTicks,
+			WriteSectors:  disk.Stats.WriteSectors,
+			WriteIOs:      disk.Stats.WriteIOs,
+			WriteTicks:    disk.Stats.WriteTicks,
+			FlushIOs:      disk.Stats.FlushIOs,
+			FlushTicks:    disk.Stats.FlushTicks,
+			IOsInProgress: disk.Stats.IOsInProgress,
+			TotalTicks:    disk.Stats.TotalTicks,
+		})
+	}
+
+	return diskStats, nil
+}
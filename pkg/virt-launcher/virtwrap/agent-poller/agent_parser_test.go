@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var _ = Describe("Qemu agent poller", func() {
@@ -100,7 +101,69 @@ var _ = Describe("Qemu agent poller", func() {
 					},
 				},
 			}
-			Expect(parseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
+			Expect(ParseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
+		})
+
+		It("should parse CPU stats", func() {
+			jsonInput := `{
+                "return":[
+                    {"type":"linux","cpu":0,"user":1000,"nice":10,"system":500,"idle":90000,"iowait":20,"irq":1,"softirq":2,"steal":3,"guest":0,"guestnice":0},
+                    {"type":"linux","cpu":1,"user":2000,"nice":0,"system":600,"idle":80000}
+                ]
+            }`
+
+			expectedCPUStats := []stats.DomainStatsGuestCPU{
+				{CPU: 0, User: 1000, Nice: 10, System: 500, Idle: 90000, IOWait: 20, IRQ: 1, SoftIRQ: 2, Steal: 3},
+				{CPU: 1, User: 2000, System: 600, Idle: 80000},
+			}
+			Expect(parseCPUStats(jsonInput)).To(Equal(expectedCPUStats))
+		})
+
+		It("should parse disk stats", func() {
+			jsonInput := `{
+                "return":[
+                    {
+                        "name":"vda",
+                        "major":252,
+                        "minor":0,
+                        "stats":{
+                            "read-sectors":2048,
+                            "read-ios":16,
+                            "read-ticks":40,
+                            "write-sectors":1024,
+                            "write-ios":8,
+                            "write-ticks":30,
+                            "flush-ios":2,
+                            "flush-ticks":5,
+                            "ios-pgr":1,
+                            "total-ticks":70,
+                            "weight-ticks":75
+                        }
+                    }
+                ]
+            }`
+
+			expectedDiskStats := []stats.DomainStatsGuestDisk{
+				{
+					Name:          "vda",
+					ReadSectors:   2048,
+					ReadIOs:       16,
+					ReadTicks:     40,
+					WriteSectors:  1024,
+					WriteIOs:      8,
+					WriteTicks:    30,
+					FlushIOs:      2,
+					FlushTicks:    5,
+					IOsInProgress: 1,
+					TotalTicks:    70,
+				},
+			}
+			Expect(parseDiskStats(jsonInput)).To(Equal(expectedDiskStats))
+		})
+
+		It("should not parse malformed disk stats", func() {
+			_, err := parseDiskStats(`{"return":[{"name":"vda","stats":"none"}]}`)
+			Expect(err).To(HaveOccurred())
 		})
 	})
 })
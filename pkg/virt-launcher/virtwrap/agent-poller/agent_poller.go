@@ -30,6 +30,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 // AgentCommand is a command executable on guest agent
@@ -42,6 +43,8 @@ const (
 	GetFilesystem     AgentCommand = "guest-get-fsinfo"
 	GetAgent          AgentCommand = "guest-info"
 	GetFSFreezeStatus AgentCommand = "guest-fsfreeze-status"
+	GetCPUStats       AgentCommand = "guest-get-cpustats"
+	GetDiskStats      AgentCommand = "guest-get-diskstats"
 
 	pollInitialInterval = 10 * time.Second
 )
@@ -80,10 +83,11 @@ func (s *AsyncAgentStore) Store(key, value any) {
 	if updated {
 		domainInfo := api.DomainGuestInfo{}
 		switch key {
-		case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus:
+		case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, libvirt.DOMAIN_GUEST_INFO_DISKS, GetFSFreezeStatus:
 			domainInfo.OSInfo = s.GetGuestOSInfo()
 			domainInfo.Interfaces = s.GetInterfaceStatus()
 			domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
+			domainInfo.GuestDisks = s.GetGuestDisks()
 		}
 
 		s.AgentUpdated <- AgentUpdatedEvent{
@@ -92,6 +96,12 @@ func (s *AsyncAgentStore) Store(key, value any) {
 	}
 }
 
+// storeStats saves statistics which change on every poll, unlike Store
+// it does not fire the updated event since they are only read on demand
+func (s *AsyncAgentStore) storeStats(key, value any) {
+	s.store.Store(key, value)
+}
+
 // GetSysInfo returns the sysInfo information packed together.
 // Sysinfo comprises of:
 //   - Guest Hostname
@@ -144,6 +154,36 @@ func (s *AsyncAgentStore) GetGuestOSInfo() *api.GuestOSInfo {
 	return nil
 }
 
+// GetGuestDisks returns the disks Guest Agent reported
+func (s *AsyncAgentStore) GetGuestDisks() []api.GuestDisk {
+	data, ok := s.store.Load(libvirt.DOMAIN_GUEST_INFO_DISKS)
+	if ok {
+		return data.([]api.GuestDisk)
+	}
+
+	return nil
+}
+
+// GetCPUStats returns the guest CPU statistics Guest Agent reported
+func (s *AsyncAgentStore) GetCPUStats() []stats.DomainStatsGuestCPU {
+	data, ok := s.store.Load(GetCPUStats)
+	if ok {
+		return data.([]stats.DomainStatsGuestCPU)
+	}
+
+	return nil
+}
+
+// GetDiskStats returns the guest disk I/O statistics Guest Agent reported
+func (s *AsyncAgentStore) GetDiskStats() []stats.DomainStatsGuestDisk {
+	data, ok := s.store.Load(GetDiskStats)
+	if ok {
+		return data.([]stats.DomainStatsGuestDisk)
+	}
+
+	return nil
+}
+
 // GetGA returns guest agent record with its version if present
 func (s *AsyncAgentStore) GetGA() AgentInfo {
 	data, ok := s.store.Load(GetAgent)
@@ -263,51 +303,93 @@ type AgentPoller struct {
 	agentStore *AsyncAgentStore
 }
 
+// PollerIntervals defines how often each set of data is polled from the guest agent,
+// a zero interval disables polling of the related data
+type PollerIntervals struct {
+	Sys            time.Duration
+	Interfaces     time.Duration
+	File           time.Duration
+	User           time.Duration
+	Version        time.Duration
+	FSFreezeStatus time.Duration
+	Disks          time.Duration
+	CPUStats       time.Duration
+	DiskStats      time.Duration
+}
+
 // CreatePoller creates the new structure that holds guest agent pollers
 func CreatePoller(
 	connection cli.Connection,
 	vmiUID types.UID,
 	domainName string,
 	store *AsyncAgentStore,
-	qemuAgentSysInterval time.Duration,
-	qemuAgentFileInterval time.Duration,
-	qemuAgentUserInterval time.Duration,
-	qemuAgentVersionInterval time.Duration,
-	qemuAgentFSFreezeStatusInterval time.Duration,
+	intervals PollerIntervals,
 ) *AgentPoller {
-	return &AgentPoller{
+	sysInfoTypes := libvirt.DOMAIN_GUEST_INFO_OS |
+		libvirt.DOMAIN_GUEST_INFO_HOSTNAME |
+		libvirt.DOMAIN_GUEST_INFO_TIMEZONE
+	var interfacesInfoTypes libvirt.DomainGuestInfoTypes
+	// Spare a guest info call when both are polled together, as they always were
+	if intervals.Interfaces == intervals.Sys {
+		sysInfoTypes |= libvirt.DOMAIN_GUEST_INFO_INTERFACES
+	} else {
+		interfacesInfoTypes = libvirt.DOMAIN_GUEST_INFO_INTERFACES
+	}
+
+	workers := []PollerWorker{
+		// Polling for QEMU agent commands
+		{
+			CallTick:      intervals.Version,
+			AgentCommands: []AgentCommand{GetAgent},
+		},
+		{
+			CallTick:      intervals.File,
+			AgentCommands: []AgentCommand{GetFilesystem},
+		},
+		{
+			CallTick:      intervals.FSFreezeStatus,
+			AgentCommands: []AgentCommand{GetFSFreezeStatus},
+		},
+		{
+			CallTick:      intervals.CPUStats,
+			AgentCommands: []AgentCommand{GetCPUStats},
+		},
+		{
+			CallTick:      intervals.DiskStats,
+			AgentCommands: []AgentCommand{GetDiskStats},
+		},
+		// Polling for guest info API
+		{
+			CallTick:  intervals.Sys,
+			InfoTypes: sysInfoTypes,
+		},
+		{
+			CallTick:  intervals.Interfaces,
+			InfoTypes: interfacesInfoTypes,
+		},
+		{
+			CallTick:  intervals.User,
+			InfoTypes: libvirt.DOMAIN_GUEST_INFO_USERS,
+		},
+		{
+			CallTick:  intervals.Disks,
+			InfoTypes: libvirt.DOMAIN_GUEST_INFO_DISKS,
+		},
+	}
+
+	poller := &AgentPoller{
 		Connection: connection,
 		VmiUID:     vmiUID,
 		domainName: domainName,
 		agentStore: store,
-		workers: []PollerWorker{
-			// Polling for QEMU agent commands
-			{
-				CallTick:      qemuAgentVersionInterval,
-				AgentCommands: []AgentCommand{GetAgent},
-			},
-			{
-				CallTick:      qemuAgentFileInterval,
-				AgentCommands: []AgentCommand{GetFilesystem},
-			},
-			{
-				CallTick:      qemuAgentFSFreezeStatusInterval,
-				AgentCommands: []AgentCommand{GetFSFreezeStatus},
-			},
-			// Polling for guest info API
-			{
-				CallTick: qemuAgentSysInterval,
-				InfoTypes: libvirt.DOMAIN_GUEST_INFO_INTERFACES |
-					libvirt.DOMAIN_GUEST_INFO_OS |
-					libvirt.DOMAIN_GUEST_INFO_HOSTNAME |
-					libvirt.DOMAIN_GUEST_INFO_TIMEZONE,
-			},
-			{
-				CallTick:  qemuAgentUserInterval,
-				InfoTypes: libvirt.DOMAIN_GUEST_INFO_USERS,
-			},
-		},
 	}
+	for _, worker := range workers {
+		if worker.CallTick <= 0 || (len(worker.AgentCommands) == 0 && worker.InfoTypes == 0) {
+			continue
+		}
+		poller.workers = append(poller.workers, worker)
+	}
+	return poller
 }
 
 // Start the poller workers and libvirt API operations
@@ -371,7 +453,7 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 			}
 			agentPoller.agentStore.Store(GetFSFreezeStatus, fsfreezeStatus)
 		case GetFilesystem:
-			filesystems, err := parseFilesystem(cmdResult)
+			filesystems, err := ParseFilesystem(cmdResult)
 			if err != nil {
 				log.Log.Errorf("Cannot parse guest agent filesystem %s", err.Error())
 				continue
@@ -384,6 +466,20 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 				continue
 			}
 			agentPoller.agentStore.Store(GetAgent, agent)
+		case GetCPUStats:
+			cpuStats, err := parseCPUStats(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent CPU stats %s", err.Error())
+				continue
+			}
+			agentPoller.agentStore.storeStats(GetCPUStats, cpuStats)
+		case GetDiskStats:
+			diskStats, err := parseDiskStats(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent disk stats %s", err.Error())
+				continue
+			}
+			agentPoller.agentStore.storeStats(GetDiskStats, diskStats)
 		}
 	}
 }
@@ -427,6 +523,10 @@ func fetchAndStoreGuestInfo(infoTypes libvirt.DomainGuestInfoTypes, agentPoller
 	if infoTypes&libvirt.DOMAIN_GUEST_INFO_USERS != 0 {
 		agentPoller.agentStore.Store(libvirt.DOMAIN_GUEST_INFO_USERS, convertToUsers(guestInfo))
 	}
+
+	if infoTypes&libvirt.DOMAIN_GUEST_INFO_DISKS != 0 {
+		agentPoller.agentStore.Store(libvirt.DOMAIN_GUEST_INFO_DISKS, convertToGuestDisks(guestInfo))
+	}
 }
 
 func convertToInterfaces(guestInfo *libvirt.DomainGuestInfo) []api.InterfaceStatus {
@@ -512,3 +612,23 @@ func convertToUsers(guestInfo *libvirt.DomainGuestInfo) []api.User {
 	}
 	return users
 }
+
+func convertToGuestDisks(guestInfo *libvirt.DomainGuestInfo) []api.GuestDisk {
+	var disks []api.GuestDisk
+	for _, disk := range guestInfo.Disks {
+		var dependencies []string
+		for _, dependency := range disk.Dependencies {
+			dependencies = append(dependencies, dependency.Name)
+		}
+		disks = append(disks, api.GuestDisk{
+			Name:         disk.Name,
+			Partition:    disk.Partition,
+			Serial:       disk.Serial,
+			Bus:          disk.GuestBus,
+			Alias:        disk.Alias,
+			GuestAlias:   disk.GuestAlias,
+			Dependencies: dependencies,
+		})
+	}
+	return disks
+}
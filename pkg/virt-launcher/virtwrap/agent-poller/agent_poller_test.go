@@ -29,6 +29,7 @@ import (
 	"libvirt.org/go/libvirt"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
 )
 
@@ -103,6 +104,93 @@ var _ = Describe("Qemu agent poller", func() {
 			users := agentStore.GetUsers(1)
 			Expect(users[0].Name).To(Equal("admin"))
 		})
+
+		It("should store the retrieved guest disks and fire an event", func() {
+			guestInfo := &libvirt.DomainGuestInfo{
+				Disks: []libvirt.DomainGuestInfoDisk{
+					{Name: "/dev/vda", Alias: "ua-disk0", Serial: "serial0", GuestBus: "virtio"},
+					{Name: "/dev/vda1", Partition: true, Dependencies: []libvirt.DomainGuestInfoDiskDependency{{Name: "/dev/vda"}}},
+				},
+			}
+			agentPoller := &AgentPoller{
+				Connection: mockLibvirt.VirtConnection,
+				domainName: "fake",
+				agentStore: &agentStore,
+			}
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().GetGuestInfo(libvirt.DOMAIN_GUEST_INFO_DISKS, uint32(0)).Return(guestInfo, nil)
+
+			fetchAndStoreGuestInfo(libvirt.DOMAIN_GUEST_INFO_DISKS, agentPoller)
+
+			expectedDisks := []api.GuestDisk{
+				{Name: "/dev/vda", Alias: "ua-disk0", Serial: "serial0", Bus: "virtio"},
+				{Name: "/dev/vda1", Partition: true, Dependencies: []string{"/dev/vda"}},
+			}
+			Expect(agentStore.GetGuestDisks()).To(Equal(expectedDisks))
+			Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+				DomainInfo: api.DomainGuestInfo{GuestDisks: expectedDisks},
+			})))
+		})
+	})
+
+	Context("with agent commands", func() {
+		It("should store the guest statistics without firing an event", func() {
+			agentPoller := &AgentPoller{
+				Connection: mockLibvirt.VirtConnection,
+				domainName: "fake",
+				agentStore: &agentStore,
+			}
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"guest-get-cpustats"}`, "fake").
+				Return(`{"return":[{"type":"linux","cpu":0,"user":10,"nice":0,"system":5,"idle":100}]}`, nil)
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"guest-get-diskstats"}`, "fake").
+				Return(`{"return":[{"name":"vda","major":252,"minor":0,"stats":{"read-ios":4}}]}`, nil)
+
+			executeAgentCommands([]AgentCommand{GetCPUStats, GetDiskStats}, agentPoller)
+
+			Expect(agentStore.GetCPUStats()).To(Equal([]stats.DomainStatsGuestCPU{{CPU: 0, User: 10, System: 5, Idle: 100}}))
+			Expect(agentStore.GetDiskStats()).To(Equal([]stats.DomainStatsGuestDisk{{Name: "vda", ReadIOs: 4}}))
+			Expect(agentStore.AgentUpdated).ToNot(Receive())
+		})
+	})
+
+	Context("CreatePoller", func() {
+		defaultIntervals := PollerIntervals{
+			Sys:            120 * time.Second,
+			Interfaces:     120 * time.Second,
+			File:           300 * time.Second,
+			User:           10 * time.Second,
+			Version:        300 * time.Second,
+			FSFreezeStatus: 5 * time.Second,
+		}
+
+		It("should poll the interfaces with the sys info when the intervals are equal", func() {
+			poller := CreatePoller(mockLibvirt.VirtConnection, "uid", "fake", &agentStore, defaultIntervals)
+
+			Expect(poller.workers).To(HaveLen(5))
+			Expect(poller.workers).To(ContainElement(PollerWorker{
+				CallTick: 120 * time.Second,
+				InfoTypes: libvirt.DOMAIN_GUEST_INFO_OS | libvirt.DOMAIN_GUEST_INFO_HOSTNAME |
+					libvirt.DOMAIN_GUEST_INFO_TIMEZONE | libvirt.DOMAIN_GUEST_INFO_INTERFACES,
+			}))
+		})
+
+		It("should add workers for every enabled interval", func() {
+			intervals := defaultIntervals
+			intervals.Interfaces = 30 * time.Second
+			intervals.Disks = time.Minute
+			intervals.CPUStats = 15 * time.Second
+			intervals.DiskStats = 20 * time.Second
+
+			poller := CreatePoller(mockLibvirt.VirtConnection, "uid", "fake", &agentStore, intervals)
+
+			Expect(poller.workers).To(HaveLen(9))
+			Expect(poller.workers).To(ContainElements(
+				PollerWorker{CallTick: 30 * time.Second, InfoTypes: libvirt.DOMAIN_GUEST_INFO_INTERFACES},
+				PollerWorker{CallTick: time.Minute, InfoTypes: libvirt.DOMAIN_GUEST_INFO_DISKS},
+				PollerWorker{CallTick: 15 * time.Second, AgentCommands: []AgentCommand{GetCPUStats}},
+				PollerWorker{CallTick: 20 * time.Second, AgentCommands: []AgentCommand{GetDiskStats}},
+			))
+		})
 	})
 
 	Context("with AsyncAgentStore", func() {
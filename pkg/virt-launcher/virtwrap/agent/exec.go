@@ -23,6 +23,25 @@ type execStatusReturnData struct {
 	Exited   bool   `json:"exited"`
 	ExitCode int    `json:"exitcode"`
 	OutData  string `json:"out-data"`
+	ErrData  string `json:"err-data"`
+}
+
+type execArguments struct {
+	Path          string   `json:"path"`
+	Args          []string `json:"arg"`
+	CaptureOutput bool     `json:"capture-output"`
+}
+
+type execStatusArguments struct {
+	Pid int64 `json:"pid"`
+}
+
+// ExecStatus is the state of a command started with GuestExecStart
+type ExecStatus struct {
+	Exited   bool
+	ExitCode int
+	Stdout   []byte
+	Stderr   []byte
 }
 
 // ExecExitCode returned at non-zero return codes
@@ -104,3 +123,46 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 
 	return stdOut, nil
 }
+
+// GuestExecStart starts the command with args in the guest and returns the pid to poll with GuestExecStatus
+func GuestExecStart(virConn cli.Connection, domName string, command string, args []string) (int64, error) {
+	output, err := runAgentCommand(virConn, domName, "guest-exec", execArguments{Path: command, Args: args, CaptureOutput: true})
+	if err != nil {
+		return 0, err
+	}
+	execRes := &execReturn{}
+	if err := json.Unmarshal([]byte(output), execRes); err != nil {
+		return 0, err
+	}
+	if execRes.Return.Pid <= 0 {
+		return 0, fmt.Errorf("invalid pid [%d] returned from qemu agent for command [%s]", execRes.Return.Pid, command)
+	}
+	return int64(execRes.Return.Pid), nil
+}
+
+// GuestExecStatus returns the state of a command started with GuestExecStart together with the output
+// captured since the previous call. qemu-ga only reports output once the command exited.
+func GuestExecStatus(virConn cli.Connection, domName string, pid int64) (*ExecStatus, error) {
+	output, err := runAgentCommand(virConn, domName, "guest-exec-status", execStatusArguments{Pid: pid})
+	if err != nil {
+		return nil, err
+	}
+	execStatusRes := &execStatusReturn{}
+	if err := json.Unmarshal([]byte(output), execStatusRes); err != nil {
+		return nil, err
+	}
+	stdout, err := base64.StdEncoding.DecodeString(execStatusRes.Return.OutData)
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := base64.StdEncoding.DecodeString(execStatusRes.Return.ErrData)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecStatus{
+		Exited:   execStatusRes.Return.Exited,
+		ExitCode: execStatusRes.Return.ExitCode,
+		Stdout:   stdout,
+		Stderr:   stderr,
+	}, nil
+}
@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// MaxGuestFileChunkSize is the largest chunk which is read from or written to a guest file in one agent command.
+// The agent protocol transfers the data base64 encoded and libvirt limits the size of agent replies.
+const MaxGuestFileChunkSize = 48 * 1024
+
+type agentCommand struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments"`
+}
+
+type fileOpenArguments struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+type fileHandleArguments struct {
+	Handle int64 `json:"handle"`
+}
+
+type fileReadArguments struct {
+	Handle int64 `json:"handle"`
+	Count  int   `json:"count"`
+}
+
+type fileWriteArguments struct {
+	Handle int64  `json:"handle"`
+	Buffer string `json:"buf-b64"`
+}
+
+type fileOpenReturn struct {
+	Return int64 `json:"return"`
+}
+
+type fileReadReturn struct {
+	Return fileReadReturnData `json:"return"`
+}
+
+type fileReadReturnData struct {
+	Count  int    `json:"count"`
+	Buffer string `json:"buf-b64"`
+	EOF    bool   `json:"eof"`
+}
+
+type fileWriteReturn struct {
+	Return fileWriteReturnData `json:"return"`
+}
+
+type fileWriteReturnData struct {
+	Count int `json:"count"`
+}
+
+func runAgentCommand(virConn cli.Connection, domName, execute string, arguments interface{}) (string, error) {
+	cmd, err := json.Marshal(agentCommand{Execute: execute, Arguments: arguments})
+	if err != nil {
+		return "", err
+	}
+	return virConn.QemuAgentCommand(string(cmd), domName)
+}
+
+// GuestFileOpen opens the file at path in the guest with the given fopen mode and returns the agent file handle
+func GuestFileOpen(virConn cli.Connection, domName, path, mode string) (int64, error) {
+	output, err := runAgentCommand(virConn, domName, "guest-file-open", fileOpenArguments{Path: path, Mode: mode})
+	if err != nil {
+		return 0, err
+	}
+	res := &fileOpenReturn{}
+	if err := json.Unmarshal([]byte(output), res); err != nil {
+		return 0, err
+	}
+	if res.Return <= 0 {
+		return 0, fmt.Errorf("invalid file handle [%d] returned from qemu agent for %s", res.Return, path)
+	}
+	return res.Return, nil
+}
+
+// GuestFileRead reads up to count bytes from an open guest file and reports whether the end of the file was reached
+func GuestFileRead(virConn cli.Connection, domName string, handle int64, count int) ([]byte, bool, error) {
+	if count <= 0 || count > MaxGuestFileChunkSize {
+		count = MaxGuestFileChunkSize
+	}
+	output, err := runAgentCommand(virConn, domName, "guest-file-read", fileReadArguments{Handle: handle, Count: count})
+	if err != nil {
+		return nil, false, err
+	}
+	res := &fileReadReturn{}
+	if err := json.Unmarshal([]byte(output), res); err != nil {
+		return nil, false, err
+	}
+	data, err := base64.StdEncoding.DecodeString(res.Return.Buffer)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, res.Return.EOF, nil
+}
+
+// GuestFileWrite writes data to an open guest file
+func GuestFileWrite(virConn cli.Connection, domName string, handle int64, data []byte) error {
+	for len(data) > 0 {
+		chunk := data
+		if len(chunk) > MaxGuestFileChunkSize {
+			chunk = chunk[:MaxGuestFileChunkSize]
+		}
+		output, err := runAgentCommand(virConn, domName, "guest-file-write", fileWriteArguments{
+			Handle: handle,
+			Buffer: base64.StdEncoding.EncodeToString(chunk),
+		})
+		if err != nil {
+			return err
+		}
+		res := &fileWriteReturn{}
+		if err := json.Unmarshal([]byte(output), res); err != nil {
+			return err
+		}
+		if res.Return.Count <= 0 {
+			return fmt.Errorf("qemu agent wrote no data to file handle [%d]", handle)
+		}
+		data = data[res.Return.Count:]
+	}
+	return nil
+}
+
+// GuestFileClose closes an open guest file
+func GuestFileClose(virConn cli.Connection, domName string, handle int64) error {
+	_, err := runAgentCommand(virConn, domName, "guest-file-close", fileHandleArguments{Handle: handle})
+	return err
+}
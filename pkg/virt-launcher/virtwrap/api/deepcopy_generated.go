@@ -1114,6 +1114,13 @@ func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 		*out = new(FSFreeze)
 		**out = **in
 	}
+	if in.GuestDisks != nil {
+		in, out := &in.GuestDisks, &out.GuestDisks
+		*out = make([]GuestDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1237,7 +1244,7 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	if in.LaunchSecurity != nil {
 		in, out := &in.LaunchSecurity, &out.LaunchSecurity
 		*out = new(LaunchSecurity)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1264,6 +1271,13 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 	}
 	out.OSInfo = in.OSInfo
 	out.FSFreezeStatus = in.FSFreezeStatus
+	if in.GuestDisks != nil {
+		in, out := &in.GuestDisks, &out.GuestDisks
+		*out = make([]GuestDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1743,6 +1757,22 @@ func (in *FilesystemDriver) DeepCopy() *FilesystemDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilesystemGrowMetadata) DeepCopyInto(out *FilesystemGrowMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemGrowMetadata.
+func (in *FilesystemGrowMetadata) DeepCopy() *FilesystemGrowMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FilesystemGrowMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemSource) DeepCopyInto(out *FilesystemSource) {
 	*out = *in
@@ -1853,6 +1883,27 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDisk) DeepCopyInto(out *GuestDisk) {
+	*out = *in
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDisk.
+func (in *GuestDisk) DeepCopy() *GuestDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -1869,6 +1920,30 @@ func (in *GuestOSInfo) DeepCopy() *GuestOSInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationMetadata) DeepCopyInto(out *HibernationMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationMetadata.
+func (in *HibernationMetadata) DeepCopy() *HibernationMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -2252,6 +2327,26 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VolumeMigration != nil {
+		in, out := &in.VolumeMigration, &out.VolumeMigration
+		*out = new(VolumeMigrationMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FilesystemGrow != nil {
+		in, out := &in.FilesystemGrow, &out.FilesystemGrow
+		*out = new(FilesystemGrowMetadata)
+		**out = **in
+	}
+	if in.SecureBootKeys != nil {
+		in, out := &in.SecureBootKeys, &out.SecureBootKeys
+		*out = new(SecureBootKeysMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2268,6 +2363,11 @@ func (in *KubeVirtMetadata) DeepCopy() *KubeVirtMetadata {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchSecurity) DeepCopyInto(out *LaunchSecurity) {
 	*out = *in
+	if in.QuoteGenerationService != nil {
+		in, out := &in.QuoteGenerationService, &out.QuoteGenerationService
+		*out = new(QuoteGenerationService)
+		**out = **in
+	}
 	return
 }
 
@@ -2916,6 +3016,22 @@ func (in *PanicDevice) DeepCopy() *PanicDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuoteGenerationService) DeepCopyInto(out *QuoteGenerationService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuoteGenerationService.
+func (in *QuoteGenerationService) DeepCopy() *QuoteGenerationService {
+	if in == nil {
+		return nil
+	}
+	out := new(QuoteGenerationService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadOnly) DeepCopyInto(out *ReadOnly) {
 	*out = *in
@@ -3163,6 +3279,42 @@ func (in *SecretUsage) DeepCopy() *SecretUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureBootKeysMetadata) DeepCopyInto(out *SecureBootKeysMetadata) {
+	*out = *in
+	if in.PK != nil {
+		in, out := &in.PK, &out.PK
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KEK != nil {
+		in, out := &in.KEK, &out.KEK
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DB != nil {
+		in, out := &in.DB, &out.DB
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBX != nil {
+		in, out := &in.DBX, &out.DBX
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureBootKeysMetadata.
+func (in *SecureBootKeysMetadata) DeepCopy() *SecureBootKeysMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureBootKeysMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Serial) DeepCopyInto(out *Serial) {
 	*out = *in
@@ -3615,6 +3767,43 @@ func (in *VideoModel) DeepCopy() *VideoModel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMigrationMetadata) DeepCopyInto(out *VolumeMigrationMetadata) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeMigrationProgress, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMigrationMetadata.
+func (in *VolumeMigrationMetadata) DeepCopy() *VolumeMigrationMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMigrationMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMigrationProgress) DeepCopyInto(out *VolumeMigrationProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMigrationProgress.
+func (in *VolumeMigrationProgress) DeepCopy() *VolumeMigrationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMigrationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Watchdog) DeepCopyInto(out *Watchdog) {
 	*out = *in
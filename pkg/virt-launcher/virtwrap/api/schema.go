@@ -117,6 +117,7 @@ type DomainStatus struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         GuestOSInfo
 	FSFreezeStatus FSFreeze
+	GuestDisks     []GuestDisk
 }
 
 type DomainSysInfo struct {
@@ -146,6 +147,7 @@ type InterfaceStatus struct {
 type SEVNodeParameters struct {
 	PDH       string
 	CertChain string
+	CPU0ID    string
 }
 
 type Timezone struct {
@@ -160,6 +162,7 @@ type FSFreeze struct {
 type FSDisk struct {
 	Serial  string
 	BusType string
+	Dev     string
 }
 
 type Filesystem struct {
@@ -177,11 +180,22 @@ type User struct {
 	LoginTime float64
 }
 
+type GuestDisk struct {
+	Name         string
+	Partition    bool
+	Serial       string
+	Bus          string
+	Alias        string
+	GuestAlias   string
+	Dependencies []string
+}
+
 // DomainGuestInfo represent guest agent info for specific domain
 type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         *GuestOSInfo
 	FSFreezeStatus *FSFreeze
+	GuestDisks     []GuestDisk
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -390,6 +404,18 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	VolumeMigration  *VolumeMigrationMetadata  `xml:"volumeMigration,omitempty"`
+	FilesystemGrow   *FilesystemGrowMetadata   `xml:"filesystemGrow,omitempty"`
+	SecureBootKeys   *SecureBootKeysMetadata   `xml:"secureBootKeys,omitempty"`
+	Hibernation      *HibernationMetadata      `xml:"hibernation,omitempty"`
+}
+
+// SecureBootKeysMetadata holds the fingerprints of the SecureBoot keys enrolled in the EFI NVRAM
+type SecureBootKeysMetadata struct {
+	PK  []string `xml:"pk,omitempty"`
+	KEK []string `xml:"kek,omitempty"`
+	DB  []string `xml:"db,omitempty"`
+	DBX []string `xml:"dbx,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -397,6 +423,11 @@ type AccessCredentialMetadata struct {
 	Message   string `xml:"message,omitempty"`
 }
 
+type FilesystemGrowMetadata struct {
+	Succeeded bool   `xml:"succeeded,omitempty"`
+	Message   string `xml:"message,omitempty"`
+}
+
 type MemoryDumpMetadata struct {
 	FileName       string       `xml:"fileName,omitempty"`
 	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
@@ -406,6 +437,16 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+// HibernationMetadata holds the progress of saving the domain state to, or restoring it from, the hibernation PVC
+type HibernationMetadata struct {
+	Phase          v1.HibernationPhase `xml:"phase,omitempty"`
+	QEMUVersion    string              `xml:"qemuVersion,omitempty"`
+	MachineType    string              `xml:"machineType,omitempty"`
+	StartTimestamp *metav1.Time        `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time        `xml:"endTimestamp,omitempty"`
+	Message        string              `xml:"message,omitempty"`
+}
+
 type MigrationMetadata struct {
 	UID            types.UID        `xml:"uid,omitempty"`
 	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
@@ -416,6 +457,18 @@ type MigrationMetadata struct {
 	Mode           v1.MigrationMode `xml:"mode,omitempty"`
 }
 
+type VolumeMigrationMetadata struct {
+	UID     types.UID                 `xml:"uid,omitempty"`
+	Volumes []VolumeMigrationProgress `xml:"volume,omitempty"`
+}
+
+type VolumeMigrationProgress struct {
+	Name           string `xml:"name,attr"`
+	TotalBytes     int64  `xml:"totalBytes,omitempty"`
+	ProcessedBytes int64  `xml:"processedBytes,omitempty"`
+	Completed      bool   `xml:"completed,omitempty"`
+}
+
 type GracePeriodMetadata struct {
 	DeletionGracePeriodSeconds int64        `xml:"deletionGracePeriodSeconds"`
 	DeletionTimestamp          *metav1.Time `xml:"deletionTimestamp,omitempty"`
@@ -688,6 +741,7 @@ type Disk struct {
 	FilesystemOverhead *v1.Percent   `xml:"filesystemOverhead,omitempty"`
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
+	GrowFilesystem     bool          `xml:"growFilesystem,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
 }
 
@@ -1029,10 +1083,11 @@ type BootMenu struct {
 }
 
 type Loader struct {
-	ReadOnly string `xml:"readonly,attr,omitempty"`
-	Secure   string `xml:"secure,attr,omitempty"`
-	Type     string `xml:"type,attr,omitempty"`
-	Path     string `xml:",chardata"`
+	ReadOnly  string `xml:"readonly,attr,omitempty"`
+	Secure    string `xml:"secure,attr,omitempty"`
+	Type      string `xml:"type,attr,omitempty"`
+	Stateless string `xml:"stateless,attr,omitempty"`
+	Path      string `xml:",chardata"`
 }
 
 // TODO <bios rebootTimeout='0'/>
@@ -1063,6 +1118,20 @@ type LaunchSecurity struct {
 	Policy          string `xml:"policy,omitempty"`
 	DHCert          string `xml:"dhCert,omitempty"`
 	Session         string `xml:"session,omitempty"`
+	// SEV-SNP
+	AuthorKey string `xml:"authorKey,attr,omitempty"`
+	IDBlock   string `xml:"idBlock,omitempty"`
+	IDAuth    string `xml:"idAuth,omitempty"`
+	HostData  string `xml:"hostData,omitempty"`
+	// TDX
+	MRConfigID             string                  `xml:"mrConfigId,omitempty"`
+	MROwner                string                  `xml:"mrOwner,omitempty"`
+	MROwnerConfig          string                  `xml:"mrOwnerConfig,omitempty"`
+	QuoteGenerationService *QuoteGenerationService `xml:"quoteGenerationService,omitempty"`
+}
+
+type QuoteGenerationService struct {
+	Path string `xml:"path,attr,omitempty"`
 }
 
 //END LaunchSecurity --------------------
@@ -158,6 +158,20 @@ func (mr *MockConnectionMockRecorder) DomainEventMemoryDeviceSizeChangeRegister(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventMemoryDeviceSizeChangeRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventMemoryDeviceSizeChangeRegister), callback)
 }
 
+// DomainRestoreFlags mocks base method.
+func (m *MockConnection) DomainRestoreFlags(srcFile, xmlConf string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainRestoreFlags", srcFile, xmlConf, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainRestoreFlags indicates an expected call of DomainRestoreFlags.
+func (mr *MockConnectionMockRecorder) DomainRestoreFlags(srcFile, xmlConf, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainRestoreFlags", reflect.TypeOf((*MockConnection)(nil).DomainRestoreFlags), srcFile, xmlConf, flags)
+}
+
 // GetAllDomainStats mocks base method.
 func (m *MockConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -580,6 +594,21 @@ func (mr *MockVirDomainMockRecorder) GetBlockInfo(disk, flags any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockInfo), disk, flags)
 }
 
+// GetBlockJobInfo mocks base method.
+func (m *MockVirDomain) GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockJobInfo", disk, flags)
+	ret0, _ := ret[0].(*libvirt.DomainBlockJobInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockJobInfo indicates an expected call of GetBlockJobInfo.
+func (mr *MockVirDomainMockRecorder) GetBlockJobInfo(disk, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockJobInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockJobInfo), disk, flags)
+}
+
 // GetDiskErrors mocks base method.
 func (m *MockVirDomain) GetDiskErrors(flags uint32) ([]libvirt.DomainDiskError, error) {
 	m.ctrl.T.Helper()
@@ -829,6 +858,20 @@ func (mr *MockVirDomainMockRecorder) Resume() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirDomain)(nil).Resume))
 }
 
+// SaveFlags mocks base method.
+func (m *MockVirDomain) SaveFlags(destFile, destXml string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFlags", destFile, destXml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFlags indicates an expected call of SaveFlags.
+func (mr *MockVirDomainMockRecorder) SaveFlags(destFile, destXml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFlags", reflect.TypeOf((*MockVirDomain)(nil).SaveFlags), destFile, destXml, flags)
+}
+
 // SetLaunchSecurityState mocks base method.
 func (m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error {
 	m.ctrl.T.Helper()
@@ -843,6 +886,20 @@ func (mr *MockVirDomainMockRecorder) SetLaunchSecurityState(params, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLaunchSecurityState", reflect.TypeOf((*MockVirDomain)(nil).SetLaunchSecurityState), params, flags)
 }
 
+// SetMemoryFlags mocks base method.
+func (m *MockVirDomain) SetMemoryFlags(memory uint64, flags libvirt.DomainMemoryModFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemoryFlags", memory, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemoryFlags indicates an expected call of SetMemoryFlags.
+func (mr *MockVirDomainMockRecorder) SetMemoryFlags(memory, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemoryFlags", reflect.TypeOf((*MockVirDomain)(nil).SetMemoryFlags), memory, flags)
+}
+
 // SetTime mocks base method.
 func (m *MockVirDomain) SetTime(secs int64, nsecs uint, flags libvirt.DomainSetTimeFlags) error {
 	m.ctrl.T.Helper()
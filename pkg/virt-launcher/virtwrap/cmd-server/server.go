@@ -226,6 +226,23 @@ func (l *Launcher) PauseVirtualMachine(_ context.Context, request *cmdv1.VMIRequ
 	return response, nil
 }
 
+func (l *Launcher) HibernateVirtualMachine(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.HibernateVMI(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to hibernate vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Signaled vmi hibernation")
+	return response, nil
+}
+
 func (l *Launcher) UnpauseVirtualMachine(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
@@ -616,6 +633,136 @@ func (l *Launcher) GuestPing(ctx context.Context, request *cmdv1.GuestPingReques
 	return resp, nil
 }
 
+// GuestFileOpen opens a file in the guest through the guest agent
+func (l *Launcher) GuestFileOpen(_ context.Context, request *cmdv1.GuestFileOpenRequest) (*cmdv1.GuestFileOpenResponse, error) {
+	resp := &cmdv1.GuestFileOpenResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	handle, err := l.domainManager.GuestFileOpen(request.DomainName, request.Path, request.Mode)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to open guest file %s", request.Path)
+		resp.Response.Success = false
+		resp.Response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Handle = handle
+
+	return resp, nil
+}
+
+// GuestFileRead reads the next chunk of an open guest file
+func (l *Launcher) GuestFileRead(_ context.Context, request *cmdv1.GuestFileReadRequest) (*cmdv1.GuestFileReadResponse, error) {
+	resp := &cmdv1.GuestFileReadResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	data, eof, err := l.domainManager.GuestFileRead(request.DomainName, request.Handle, request.Count)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to read guest file")
+		resp.Response.Success = false
+		resp.Response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Data = data
+	resp.Eof = eof
+
+	return resp, nil
+}
+
+// GuestFileWrite writes a chunk to an open guest file
+func (l *Launcher) GuestFileWrite(_ context.Context, request *cmdv1.GuestFileWriteRequest) (*cmdv1.Response, error) {
+	response := &cmdv1.Response{
+		Success: true,
+	}
+
+	if err := l.domainManager.GuestFileWrite(request.DomainName, request.Handle, request.Data); err != nil {
+		log.Log.Reason(err).Error("Failed to write guest file")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+	}
+
+	return response, nil
+}
+
+// GuestFileClose closes an open guest file
+func (l *Launcher) GuestFileClose(_ context.Context, request *cmdv1.GuestFileCloseRequest) (*cmdv1.Response, error) {
+	response := &cmdv1.Response{
+		Success: true,
+	}
+
+	if err := l.domainManager.GuestFileClose(request.DomainName, request.Handle); err != nil {
+		log.Log.Reason(err).Error("Failed to close guest file")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+	}
+
+	return response, nil
+}
+
+// GuestExecStart starts a command in the guest through the guest agent
+func (l *Launcher) GuestExecStart(_ context.Context, request *cmdv1.GuestExecStartRequest) (*cmdv1.GuestExecStartResponse, error) {
+	resp := &cmdv1.GuestExecStartResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	pid, err := l.domainManager.GuestExecStart(request.DomainName, request.Command, request.Args)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to start guest command %s", request.Command)
+		resp.Response.Success = false
+		resp.Response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Pid = pid
+
+	return resp, nil
+}
+
+// GuestExecStatus returns the state and the new output of a command started with GuestExecStart
+func (l *Launcher) GuestExecStatus(_ context.Context, request *cmdv1.GuestExecStatusRequest) (*cmdv1.GuestExecStatusResponse, error) {
+	resp := &cmdv1.GuestExecStatusResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	status, err := l.domainManager.GuestExecStatus(request.DomainName, request.Pid)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to get the status of guest command with pid %d", request.Pid)
+		resp.Response.Success = false
+		resp.Response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Exited = status.Exited
+	resp.ExitCode = int32(status.ExitCode)
+	resp.Stdout = status.Stdout
+	resp.Stderr = status.Stderr
+
+	return resp, nil
+}
+
+// SetGuestMemoryTarget moves the memory balloon of the domain to the requested target
+func (l *Launcher) SetGuestMemoryTarget(_ context.Context, request *cmdv1.GuestMemoryTargetRequest) (*cmdv1.Response, error) {
+	response := &cmdv1.Response{
+		Success: true,
+	}
+
+	if err := l.domainManager.SetGuestMemoryTarget(request.DomainName, request.TargetKiB); err != nil {
+		log.Log.Reason(err).Errorf("Failed to set the guest memory target to %d KiB", request.TargetKiB)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
 func RunServer(socketPath string,
 	domainManager virtwrap.DomainManager,
 	stopChan chan struct{},
@@ -760,6 +907,66 @@ func (l *Launcher) InjectLaunchSecret(_ context.Context, request *cmdv1.InjectLa
 	return response, nil
 }
 
+func (l *Launcher) GetTDXQuote(_ context.Context, request *cmdv1.TDXQuoteRequest) (*cmdv1.TDXQuoteResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	tdxQuoteResponse := &cmdv1.TDXQuoteResponse{
+		Response: response,
+	}
+
+	if !tdxQuoteResponse.Response.Success {
+		return tdxQuoteResponse, nil
+	}
+
+	tdxQuoteInfo, err := l.domainManager.GetTDXQuote(vmi, request.Report)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get TDX quote")
+		tdxQuoteResponse.Response.Success = false
+		tdxQuoteResponse.Response.Message = getErrorMessage(err)
+		return tdxQuoteResponse, nil
+	}
+
+	tdxQuoteInfoJson, err := json.Marshal(tdxQuoteInfo)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to marshal TDX quote info")
+		tdxQuoteResponse.Response.Success = false
+		tdxQuoteResponse.Response.Message = getErrorMessage(err)
+		return tdxQuoteResponse, nil
+	}
+	tdxQuoteResponse.TdxQuote = tdxQuoteInfoJson
+
+	return tdxQuoteResponse, nil
+}
+
+func (l *Launcher) GetSEVSNPAttestationInfo(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.SEVSNPAttestationInfoResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	sevSNPAttestationInfoResponse := &cmdv1.SEVSNPAttestationInfoResponse{
+		Response: response,
+	}
+
+	if !sevSNPAttestationInfoResponse.Response.Success {
+		return sevSNPAttestationInfoResponse, nil
+	}
+
+	sevSNPAttestationInfo, err := l.domainManager.GetSEVSNPAttestationInfo(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get SEV-SNP attestation info")
+		sevSNPAttestationInfoResponse.Response.Success = false
+		sevSNPAttestationInfoResponse.Response.Message = getErrorMessage(err)
+		return sevSNPAttestationInfoResponse, nil
+	}
+
+	sevSNPAttestationInfoJson, err := json.Marshal(sevSNPAttestationInfo)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to marshal SEV-SNP attestation info")
+		sevSNPAttestationInfoResponse.Response.Success = false
+		sevSNPAttestationInfoResponse.Response.Message = getErrorMessage(err)
+		return sevSNPAttestationInfoResponse, nil
+	}
+	sevSNPAttestationInfoResponse.SevSNPAttestationInfo = sevSNPAttestationInfoJson
+
+	return sevSNPAttestationInfoResponse, nil
+}
+
 func (l *Launcher) SyncVirtualMachineMemory(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
@@ -20,10 +20,12 @@
 package cmdserver
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -270,6 +272,108 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(fetchedList.Items).To(Equal(fsList), "fetched list should be the same")
 		})
 
+		It("should read a guest file in chunks", func() {
+			const domainName = "default_testvmi"
+			gomock.InOrder(
+				domainManager.EXPECT().GuestFileOpen(domainName, "/etc/hosts", "r").Return(int64(1), nil),
+				domainManager.EXPECT().GuestFileRead(domainName, int64(1), gomock.Any()).Return([]byte("127.0.0.1 "), false, nil),
+				domainManager.EXPECT().GuestFileRead(domainName, int64(1), gomock.Any()).Return([]byte("localhost"), true, nil),
+				domainManager.EXPECT().GuestFileClose(domainName, int64(1)).Return(nil),
+			)
+
+			buf := &bytes.Buffer{}
+			Expect(client.GuestFileRead(domainName, "/etc/hosts", 1024, buf)).To(Succeed())
+			Expect(buf.String()).To(Equal("127.0.0.1 localhost"))
+		})
+
+		It("should fail to read a guest file larger than the limit", func() {
+			const domainName = "default_testvmi"
+			domainManager.EXPECT().GuestFileOpen(domainName, "/etc/hosts", "r").Return(int64(1), nil)
+			domainManager.EXPECT().GuestFileRead(domainName, int64(1), gomock.Any()).Return([]byte("127.0.0.1 localhost"), true, nil)
+			domainManager.EXPECT().GuestFileClose(domainName, int64(1)).Return(nil)
+
+			Expect(client.GuestFileRead(domainName, "/etc/hosts", 4, &bytes.Buffer{})).To(MatchError(cmdclient.ErrGuestFileTooLarge))
+		})
+
+		It("should write a guest file", func() {
+			const domainName = "default_testvmi"
+			gomock.InOrder(
+				domainManager.EXPECT().GuestFileOpen(domainName, "/etc/hosts", "w").Return(int64(1), nil),
+				domainManager.EXPECT().GuestFileWrite(domainName, int64(1), []byte("127.0.0.1 localhost")).Return(nil),
+				domainManager.EXPECT().GuestFileClose(domainName, int64(1)).Return(nil),
+			)
+
+			Expect(client.GuestFileWrite(domainName, "/etc/hosts", 1024, strings.NewReader("127.0.0.1 localhost"))).To(Succeed())
+		})
+
+		It("should fail to write a guest file larger than the limit", func() {
+			const domainName = "default_testvmi"
+			domainManager.EXPECT().GuestFileOpen(domainName, "/etc/hosts", "w").Return(int64(1), nil)
+			domainManager.EXPECT().GuestFileClose(domainName, int64(1)).Return(nil)
+
+			Expect(client.GuestFileWrite(domainName, "/etc/hosts", 4, strings.NewReader("127.0.0.1 localhost"))).To(MatchError(cmdclient.ErrGuestFileTooLarge))
+		})
+
+		It("should return guest agent errors when opening a guest file", func() {
+			const domainName = "default_testvmi"
+			domainManager.EXPECT().GuestFileOpen(domainName, "/missing", "r").Return(int64(0), errors.New("no such file"))
+
+			Expect(client.GuestFileRead(domainName, "/missing", 1024, &bytes.Buffer{})).To(MatchError(ContainSubstring("no such file")))
+		})
+
+		It("should set the guest memory target", func() {
+			const domainName = "default_testvmi"
+			domainManager.EXPECT().SetGuestMemoryTarget(domainName, uint64(1048576)).Return(nil)
+
+			Expect(client.SetGuestMemoryTarget(domainName, 1048576)).To(Succeed())
+		})
+
+		It("should return errors when setting the guest memory target", func() {
+			const domainName = "default_testvmi"
+			domainManager.EXPECT().SetGuestMemoryTarget(domainName, uint64(1048576)).Return(errors.New("balloon not available"))
+
+			Expect(client.SetGuestMemoryTarget(domainName, 1048576)).To(MatchError(ContainSubstring("balloon not available")))
+		})
+
+		It("should execute a guest command and pass its output on", func() {
+			const domainName = "default_testvmi"
+			gomock.InOrder(
+				domainManager.EXPECT().GuestExecStart(domainName, "/usr/bin/uname", []string{"-r"}).Return(int64(5), nil),
+				domainManager.EXPECT().GuestExecStatus(domainName, int64(5)).Return(&agent.ExecStatus{Stdout: []byte("6.")}, nil),
+				domainManager.EXPECT().GuestExecStatus(domainName, int64(5)).Return(&agent.ExecStatus{
+					Exited: true, ExitCode: 2, Stdout: []byte("1\n"), Stderr: []byte("warning"),
+				}, nil),
+			)
+
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			exitCode, err := client.GuestExec(domainName, "/usr/bin/uname", []string{"-r"}, time.Minute, func(out, errOut []byte) error {
+				stdout.Write(out)
+				stderr.Write(errOut)
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(2))
+			Expect(stdout.String()).To(Equal("6.1\n"))
+			Expect(stderr.String()).To(Equal("warning"))
+		})
+
+		It("should time out when a guest command does not exit", func() {
+			const domainName = "default_testvmi"
+			domainManager.EXPECT().GuestExecStart(domainName, "/usr/bin/sleep", []string{"100"}).Return(int64(5), nil)
+			domainManager.EXPECT().GuestExecStatus(domainName, int64(5)).Return(&agent.ExecStatus{}, nil).MinTimes(1)
+
+			_, err := client.GuestExec(domainName, "/usr/bin/sleep", []string{"100"}, 0, func(_, _ []byte) error { return nil })
+			Expect(err).To(MatchError(cmdclient.ErrGuestExecTimeout))
+		})
+
+		It("should return guest agent errors when starting a guest command", func() {
+			const domainName = "default_testvmi"
+			domainManager.EXPECT().GuestExecStart(domainName, "/missing", nil).Return(int64(0), errors.New("no such file"))
+
+			_, err := client.GuestExec(domainName, "/missing", nil, time.Minute, func(_, _ []byte) error { return nil })
+			Expect(err).To(MatchError(ContainSubstring("no such file")))
+		})
+
 		It("should finalize VM migration", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().FinalizeVirtualMachineMigration(vmi, &cmdv1.VirtualMachineOptions{}).Return(nil)
@@ -331,6 +435,33 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should return a TDX quote for a vmi", func() {
+			tdxQuoteInfo := &v1.TDXQuoteInfo{
+				Quote: "AAABBBCCC",
+			}
+			report := []byte("report")
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetTDXQuote(vmi, report).Return(tdxQuoteInfo, nil)
+			fetchedTDXQuoteInfo, err := client.GetTDXQuote(vmi, report)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchedTDXQuoteInfo).To(Equal(tdxQuoteInfo))
+		})
+
+		It("should return SEV-SNP attestation info for a vmi", func() {
+			sevSNPAttestationInfo := &v1.SEVSNPAttestationInfo{
+				ChipID:      "abcd",
+				Product:     "Genoa",
+				ReportedTCB: 0x1b00000000000003,
+				Policy:      0x30000,
+				HostData:    "AAABBBCCC",
+			}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetSEVSNPAttestationInfo(vmi).Return(sevSNPAttestationInfo, nil)
+			fetchedSEVSNPAttestationInfo, err := client.GetSEVSNPAttestationInfo(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchedSEVSNPAttestationInfo).To(Equal(sevSNPAttestationInfo))
+		})
+
 		It("should call UpdateGuestMemory", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().UpdateGuestMemory(vmi).Return(nil)
@@ -136,6 +136,23 @@ func (converterAMD64) SupportPCIHole64Disabling() bool {
 
 func (converterAMD64) LaunchSecurity(vmi *v1.VirtualMachineInstance) *api.LaunchSecurity {
 	// Set SEV launch security parameters: https://libvirt.org/formatdomain.html#launch-security
+	if util.IsSEVSNPVMI(vmi) {
+		sev := vmi.Spec.Domain.LaunchSecurity.SEV
+		launchSec := &api.LaunchSecurity{
+			Type:     "sev-snp",
+			Policy:   "0x" + strconv.FormatUint(launchsecurity.SEVSNPPolicyToBits(sev.Policy), 16),
+			IDBlock:  sev.IDBlock,
+			IDAuth:   sev.IDAuth,
+			HostData: sev.HostData,
+		}
+		if sev.AuthorKey != nil {
+			launchSec.AuthorKey = "no"
+			if *sev.AuthorKey {
+				launchSec.AuthorKey = "yes"
+			}
+		}
+		return launchSec
+	}
 	if util.IsSEVVMI(vmi) {
 		sevPolicyBits := launchsecurity.SEVPolicyToBits(vmi.Spec.Domain.LaunchSecurity.SEV.Policy)
 		// Cbitpos and ReducedPhysBits will be filled automatically by libvirt from the domain capabilities
@@ -146,5 +163,21 @@ func (converterAMD64) LaunchSecurity(vmi *v1.VirtualMachineInstance) *api.Launch
 			Session: vmi.Spec.Domain.LaunchSecurity.SEV.Session,
 		}
 	}
+	if util.IsTDXVMI(vmi) {
+		tdx := vmi.Spec.Domain.LaunchSecurity.TDX
+		launchSec := &api.LaunchSecurity{
+			Type:          "tdx",
+			Policy:        "0x" + strconv.FormatUint(launchsecurity.TDXPolicyToBits(tdx.Policy), 16),
+			MRConfigID:    tdx.MRConfigID,
+			MROwner:       tdx.MROwner,
+			MROwnerConfig: tdx.MROwnerConfig,
+		}
+		if util.IsTDXAttestationRequested(vmi) {
+			launchSec.QuoteGenerationService = &api.QuoteGenerationService{
+				Path: launchsecurity.TDXQuoteGenerationServiceSocket,
+			}
+		}
+		return launchSec
+	}
 	return nil
 }
@@ -83,6 +83,7 @@ type EFIConfiguration struct {
 	EFICode      string
 	EFIVars      string
 	SecureLoader bool
+	Stateless    bool
 }
 
 type ConverterContext struct {
@@ -115,7 +116,11 @@ type ConverterContext struct {
 	DomainAttachmentByInterfaceName map[string]string
 }
 
-func assignDiskToSCSIController(disk *api.Disk, unit int) {
+func assignDiskToSCSIController(diskDevice *v1.Disk, disk *api.Disk, unit int) error {
+	if requested := getSCSIUnit(diskDevice); requested != nil && int(*requested) != unit {
+		return fmt.Errorf("SCSI unit %d requested by disk %s is already in use", *requested, diskDevice.Name)
+	}
+
 	// Ensure we assign this disk to the correct scsi controller
 	if disk.Address == nil {
 		disk.Address = &api.Address{}
@@ -125,6 +130,7 @@ func assignDiskToSCSIController(disk *api.Disk, unit int) {
 	disk.Address.Controller = "0"
 	disk.Address.Bus = "0"
 	disk.Address.Unit = strconv.Itoa(unit)
+	return nil
 }
 
 func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk *api.Disk, prefixMap map[string]deviceNamer, numQueues *uint, volumeStatusMap map[string]v1.VolumeStatus) error {
@@ -134,7 +140,9 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		disk.Target.Bus = diskDevice.Disk.Bus
 		disk.Target.Device, unit = makeDeviceName(diskDevice.Name, diskDevice.Disk.Bus, prefixMap)
 		if diskDevice.Disk.Bus == "scsi" {
-			assignDiskToSCSIController(disk, unit)
+			if err := assignDiskToSCSIController(diskDevice, disk, unit); err != nil {
+				return err
+			}
 		}
 		if diskDevice.Disk.PciAddress != "" {
 			if diskDevice.Disk.Bus != v1.DiskBusVirtio {
@@ -168,7 +176,9 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		disk.Target.Bus = diskDevice.LUN.Bus
 		disk.Target.Device, unit = makeDeviceName(diskDevice.Name, diskDevice.LUN.Bus, prefixMap)
 		if diskDevice.LUN.Bus == "scsi" {
-			assignDiskToSCSIController(disk, unit)
+			if err := assignDiskToSCSIController(diskDevice, disk, unit); err != nil {
+				return err
+			}
 		}
 		disk.ReadOnly = toApiReadOnly(diskDevice.LUN.ReadOnly)
 		if diskDevice.LUN.Reservation {
@@ -199,6 +209,7 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 			disk.FilesystemOverhead = volumeStatus.PersistentVolumeClaimInfo.FilesystemOverhead
 			disk.Capacity = storagetypes.GetDiskCapacity(volumeStatus.PersistentVolumeClaimInfo)
 			disk.ExpandDisksEnabled = c.ExpandDisksEnabled
+			disk.GrowFilesystem = c.ExpandDisksEnabled && diskDevice.GrowFilesystem != nil && *diskDevice.GrowFilesystem
 		}
 	}
 	if numQueues != nil && disk.Target.Bus == v1.DiskBusVirtio {
@@ -811,7 +822,7 @@ func Convert_v1_EmptyDiskSource_To_api_Disk(volumeName string, _ *v1.EmptyDiskSo
 	return nil
 }
 
-func Convert_v1_ContainerDiskSource_To_api_Disk(volumeName string, _ *v1.ContainerDiskSource, disk *api.Disk, c *ConverterContext, diskIndex int) error {
+func Convert_v1_ContainerDiskSource_To_api_Disk(volumeName string, containerDisk *v1.ContainerDiskSource, disk *api.Disk, c *ConverterContext, diskIndex int) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
 	}
@@ -820,6 +831,9 @@ func Convert_v1_ContainerDiskSource_To_api_Disk(volumeName string, _ *v1.Contain
 	disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
 	disk.Driver.Discard = "unmap"
 	disk.Source.File = c.EphemeraldiskCreator.GetFilePath(volumeName)
+	if containerdisk.HasPersistentOverlay(containerDisk) {
+		disk.Source.File = containerdisk.GetOverlayPathFromLauncherView(volumeName)
+	}
 	disk.BackingStore = &api.BackingStore{
 		Format: &api.BackingStoreFormat{},
 		Source: &api.DiskSource{},
@@ -828,6 +842,10 @@ func Convert_v1_ContainerDiskSource_To_api_Disk(volumeName string, _ *v1.Contain
 	source := containerdisk.GetDiskTargetPathFromLauncherView(diskIndex)
 	if info := c.DisksInfo[volumeName]; info != nil {
 		disk.BackingStore.Format.Type = info.Format
+		if containerdisk.IsLayered(containerDisk) {
+			// The rest of the chain is picked up from the image metadata
+			source = info.Filename
+		}
 	} else {
 		return fmt.Errorf("no disk info provided for volume %s", volumeName)
 	}
@@ -1187,7 +1205,14 @@ func Convert_v1_Firmware_To_related_apis(vmi *v1.VirtualMachineInstance, domain
 		},
 	}
 
-	if vmi.IsBootloaderEFI() {
+	if vmi.IsBootloaderEFI() && c.EFIConfiguration.Stateless {
+		// Stateless firmware is loaded as a ROM and keeps no variables
+		domain.Spec.OS.BootLoader = &api.Loader{
+			Path:      c.EFIConfiguration.EFICode,
+			Type:      "rom",
+			Stateless: "yes",
+		}
+	} else if vmi.IsBootloaderEFI() {
 		domain.Spec.OS.BootLoader = &api.Loader{
 			Path:     c.EFIConfiguration.EFICode,
 			ReadOnly: "yes",
@@ -1568,6 +1593,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			isMemfdRequired = true
 		}
 	}
+	// Keep KSM from merging the guest memory of VMIs which opt out of it
+	if vmi.Annotations[v1.KSMMergeAnnotation] == "false" {
+		if domain.Spec.MemoryBacking == nil {
+			domain.Spec.MemoryBacking = &api.MemoryBacking{}
+		}
+		domain.Spec.MemoryBacking.NoSharePages = &api.NoSharePages{}
+	}
 	// virtiofs require shared access
 	if util.IsVMIVirtiofsEnabled(vmi) {
 		if domain.Spec.MemoryBacking == nil {
@@ -2080,9 +2112,45 @@ func newDeviceNamer(volumeStatuses []v1.VolumeStatus, disks []v1.Disk) map[strin
 			namer.usedDeviceMap[volumeTargetMap[disk.Name]] = disk.Name
 		}
 	}
+
+	// Reserve the names of requested SCSI units before any other disk is named
+	for _, disk := range disks {
+		unit := getSCSIUnit(&disk)
+		if unit == nil {
+			continue
+		}
+		prefix := getPrefixFromBus(v1.DiskBusSCSI)
+		if _, ok := prefixMap[prefix]; !ok {
+			prefixMap[prefix] = deviceNamer{
+				existingNameMap: make(map[string]string),
+				usedDeviceMap:   make(map[string]string),
+			}
+		}
+		namer := prefixMap[prefix]
+		name := FormatDeviceName(prefix, int(*unit))
+		if _, ok := namer.existingNameMap[disk.Name]; ok {
+			continue
+		}
+		if _, ok := namer.usedDeviceMap[name]; ok {
+			continue
+		}
+		namer.existingNameMap[disk.Name] = name
+		namer.usedDeviceMap[name] = disk.Name
+	}
 	return prefixMap
 }
 
+func getSCSIUnit(disk *v1.Disk) *uint {
+	switch {
+	case disk.Disk != nil && disk.Disk.Bus == v1.DiskBusSCSI:
+		return disk.Disk.Unit
+	case disk.LUN != nil && disk.LUN.Bus == v1.DiskBusSCSI:
+		return disk.LUN.Unit
+	default:
+		return nil
+	}
+}
+
 // GetVolumeNameByTarget returns the volume name associated to the device target in the domain (e.g vda)
 func GetVolumeNameByTarget(domain *api.Domain, target string) string {
 	for _, d := range domain.Spec.Devices.Disks {
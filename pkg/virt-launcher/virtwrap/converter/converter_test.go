@@ -433,6 +433,28 @@ var _ = Describe("Converter", func() {
 			Entry("on ppc64le", ppc64le, "virtio-non-transitional"),
 			Entry("on s390x", s390x, "virtio"),
 		)
+
+		DescribeTable("Should convert a containerDisk", func(containerDisk *v1.ContainerDiskSource, expectedSource, expectedBacking string) {
+			context := &ConverterContext{
+				EphemeraldiskCreator: EphemeralDiskImageCreator,
+				DisksInfo: map[string]*disk.DiskInfo{
+					"mydisk": {Format: "qcow2", Filename: "/var/run/kubevirt/container-disks/disk_1.layers/top.qcow2"},
+				},
+			}
+			apiDisk := api.Disk{Driver: &api.DiskDriver{}}
+			Expect(Convert_v1_ContainerDiskSource_To_api_Disk("mydisk", containerDisk, &apiDisk, context, 1)).To(Succeed())
+			Expect(apiDisk.Source.File).To(Equal(expectedSource))
+			Expect(apiDisk.BackingStore.Source.File).To(Equal(expectedBacking))
+			Expect(apiDisk.BackingStore.Format.Type).To(Equal("qcow2"))
+		},
+			Entry("standalone with an ephemeral overlay", &v1.ContainerDiskSource{},
+				"/var/run/libvirt/kubevirt-ephemeral-disk/mydisk/disk.qcow2", "/var/run/kubevirt/container-disks/disk_1.img"),
+			Entry("layered with an ephemeral overlay", &v1.ContainerDiskSource{Mode: v1.ContainerDiskModeLayered},
+				"/var/run/libvirt/kubevirt-ephemeral-disk/mydisk/disk.qcow2", "/var/run/kubevirt/container-disks/disk_1.layers/top.qcow2"),
+			Entry("layered with a persistent overlay",
+				&v1.ContainerDiskSource{Mode: v1.ContainerDiskModeLayered, Overlay: &v1.ContainerDiskOverlay{ClaimName: "overlay"}},
+				"/var/run/kubevirt-private/container-disk-overlays/mydisk/disk.qcow2", "/var/run/kubevirt/container-disks/disk_1.layers/top.qcow2"),
+		)
 	})
 
 	Context("with v1.VirtualMachineInstance", func() {
@@ -1544,6 +1566,21 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Memory.Unit).To(Equal("b"))
 		})
 
+		DescribeTable("should keep KSM from merging the guest memory", func(annotations map[string]string, expectNoSharePages bool) {
+			vmi.Annotations = annotations
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			if expectNoSharePages {
+				Expect(domainSpec.MemoryBacking).ToNot(BeNil())
+				Expect(domainSpec.MemoryBacking.NoSharePages).ToNot(BeNil())
+			} else if domainSpec.MemoryBacking != nil {
+				Expect(domainSpec.MemoryBacking.NoSharePages).To(BeNil())
+			}
+		},
+			Entry("when the VMI opts out", map[string]string{v1.KSMMergeAnnotation: "false"}, true),
+			Entry("not when the VMI opts in", map[string]string{v1.KSMMergeAnnotation: "true"}, false),
+			Entry("not by default", nil, false),
+		)
+
 		It("should use guest memory instead of requested memory if present", func() {
 			guestMemory := resource.MustParse("123Mi")
 			vmi.Spec.Domain.Memory = &v1.Memory{
@@ -3337,6 +3374,47 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.LaunchSecurity.Policy).To(Equal("0x" + strconv.FormatUint(uint64(sev.SEVPolicyNoDebug|sev.SEVPolicyEncryptedState), 16)))
 		})
 
+		It("should set LaunchSecurity domain element with 'sev-snp' type and the SEV-SNP policy", func() {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				SEV: &v1.SEV{
+					Policy: &v1.SEVPolicy{
+						SecureNestedPaging: pointer.P(true),
+					},
+				},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity).To(Equal(&api.LaunchSecurity{
+				Type:   "sev-snp",
+				Policy: "0x" + strconv.FormatUint(sev.SEVSNPPolicySMT|sev.SEVSNPPolicyReserved, 16),
+			}))
+		})
+
+		It("should pass the SEV-SNP ID block, ID authentication and host data", func() {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				SEV: &v1.SEV{
+					Policy: &v1.SEVPolicy{
+						SecureNestedPaging: pointer.P(true),
+						SingleSocket:       pointer.P(true),
+					},
+					IDBlock:   "idblock",
+					IDAuth:    "idauth",
+					AuthorKey: pointer.P(true),
+					HostData:  "hostdata",
+				},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity).To(Equal(&api.LaunchSecurity{
+				Type:      "sev-snp",
+				Policy:    "0x" + strconv.FormatUint(sev.SEVSNPPolicySMT|sev.SEVSNPPolicyReserved|sev.SEVSNPPolicySingleSocket, 16),
+				AuthorKey: "yes",
+				IDBlock:   "idblock",
+				IDAuth:    "idauth",
+				HostData:  "hostdata",
+			}))
+		})
+
 		It("should set IOMMU attribute of the RngDriver", func() {
 			rng := &api.Rng{}
 			Expect(Convert_v1_Rng_To_api_Rng(&v1.Rng{}, rng, c)).To(Succeed())
@@ -3383,6 +3461,79 @@ var _ = Describe("Converter", func() {
 		})
 	})
 
+	Context("with Intel TDX LaunchSecurity", func() {
+		var (
+			vmi *v1.VirtualMachineInstance
+			c   *ConverterContext
+		)
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				TDX: &v1.TDX{},
+			}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot: pointer.P(false),
+					},
+				},
+			}
+			c = &ConverterContext{
+				Architecture:   archconverter.NewConverter(amd64),
+				AllowEmulation: true,
+				EFIConfiguration: &EFIConfiguration{
+					EFICode:   "OVMF.inteltdx.fd",
+					Stateless: true,
+				},
+				UseLaunchSecurity: true,
+			}
+		})
+
+		It("should set LaunchSecurity domain element with 'tdx' type and the SEPT #VE disabled", func() {
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity).To(Equal(&api.LaunchSecurity{
+				Type:   "tdx",
+				Policy: "0x" + strconv.FormatUint(sev.TDXPolicySEPTVEDisable, 16),
+			}))
+		})
+
+		It("should pass the measurements and the quote generation service", func() {
+			vmi.Spec.Domain.LaunchSecurity.TDX = &v1.TDX{
+				Policy:        &v1.TDXPolicy{SEPTVEDisable: pointer.P(false)},
+				MRConfigID:    "config",
+				MROwner:       "owner",
+				MROwnerConfig: "ownerconfig",
+				Attestation:   &v1.TDXAttestation{},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity).To(Equal(&api.LaunchSecurity{
+				Type:          "tdx",
+				Policy:        "0x0",
+				MRConfigID:    "config",
+				MROwner:       "owner",
+				MROwnerConfig: "ownerconfig",
+				QuoteGenerationService: &api.QuoteGenerationService{
+					Path: sev.TDXQuoteGenerationServiceSocket,
+				},
+			}))
+		})
+
+		It("should load the stateless firmware as a ROM without NVRAM", func() {
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.OS.BootLoader).To(Equal(&api.Loader{
+				Path:      "OVMF.inteltdx.fd",
+				Type:      "rom",
+				Stateless: "yes",
+			}))
+			Expect(domain.Spec.OS.NVRam).To(BeNil())
+		})
+	})
+
 	Context("with Secure Execution LaunchSecurity", func() {
 		var (
 			vmi *v1.VirtualMachineInstance
@@ -3719,6 +3870,43 @@ var _ = Describe("disk device naming", func() {
 		Expect(res).To(Equal("sda"))
 		Expect(index).To(Equal(0))
 	})
+
+	Context("with requested SCSI units", func() {
+		convertDisks := func(disks []v1.Disk, volumeStatuses []v1.VolumeStatus) ([]api.Disk, error) {
+			c := &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH)}
+			prefixMap := newDeviceNamer(volumeStatuses, disks)
+			apiDisks := make([]api.Disk, len(disks))
+			for i := range disks {
+				if err := Convert_v1_Disk_To_api_Disk(c, &disks[i], &apiDisks[i], prefixMap, nil, make(map[string]v1.VolumeStatus)); err != nil {
+					return nil, err
+				}
+			}
+			return apiDisks, nil
+		}
+
+		It("should place disks on the requested units ahead of automatically named disks", func() {
+			apiDisks, err := convertDisks([]v1.Disk{
+				{Name: "auto", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}},
+				{Name: "fixed", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(0))}}},
+				{Name: "lun", DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(4))}}},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiDisks[0].Target.Device).To(Equal("sdb"))
+			Expect(apiDisks[0].Address.Unit).To(Equal("1"))
+			Expect(apiDisks[1].Target.Device).To(Equal("sda"))
+			Expect(apiDisks[1].Address.Unit).To(Equal("0"))
+			Expect(apiDisks[2].Target.Device).To(Equal("sde"))
+			Expect(apiDisks[2].Address.Unit).To(Equal("4"))
+		})
+
+		It("should fail when the requested unit is used by a running disk", func() {
+			_, err := convertDisks([]v1.Disk{
+				{Name: "running", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}},
+				{Name: "hotplugged", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI, Unit: pointer.P(uint(0))}}},
+			}, []v1.VolumeStatus{{Name: "running", Target: "sda"}})
+			Expect(err).To(MatchError("SCSI unit 0 requested by disk hotplugged is already in use"))
+		})
+	})
 })
 
 var _ = Describe("direct IO checker", func() {
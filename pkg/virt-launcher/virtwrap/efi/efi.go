@@ -33,6 +33,10 @@ const (
 	EFIVarsSecureBoot = "OVMF_VARS.secboot.fd"
 	EFICodeSEV        = "OVMF_CODE.cc.fd"
 	EFIVarsSEV        = EFIVars
+	// EFICodeTDX is the stateless TDVF firmware, it comes without a variable store
+	EFICodeTDX = "OVMF.inteltdx.fd"
+	// EFICodeSNP is the stateless firmware measured at launch of SEV-SNP guests
+	EFICodeSNP = "OVMF.amdsev.fd"
 )
 
 type EFIEnvironment struct {
@@ -42,6 +46,8 @@ type EFIEnvironment struct {
 	varsSecureBoot string
 	codeSEV        string
 	varsSEV        string
+	codeTDX        string
+	codeSNP        string
 }
 
 func (e *EFIEnvironment) Bootable(secureBoot, sev bool) bool {
@@ -74,6 +80,22 @@ func (e *EFIEnvironment) EFIVars(secureBoot, sev bool) string {
 	}
 }
 
+func (e *EFIEnvironment) BootableTDX() bool {
+	return e.codeTDX != ""
+}
+
+func (e *EFIEnvironment) EFICodeTDX() string {
+	return e.codeTDX
+}
+
+func (e *EFIEnvironment) BootableSNP() bool {
+	return e.codeSNP != ""
+}
+
+func (e *EFIEnvironment) EFICodeSNP() string {
+	return e.codeSNP
+}
+
 func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
 	if arch == "arm64" {
 		codeArm64 := getEFIBinaryIfExists(ovmfPath, EFICodeAARCH64)
@@ -102,6 +124,12 @@ func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
 	codeWithSEV := getEFIBinaryIfExists(ovmfPath, EFICodeSEV)
 	varsWithSEV := getEFIBinaryIfExists(ovmfPath, EFIVarsSEV)
 
+	// detect EFI with TDX
+	codeWithTDX := getEFIBinaryIfExists(ovmfPath, EFICodeTDX)
+
+	// detect EFI with SEV-SNP
+	codeWithSNP := getEFIBinaryIfExists(ovmfPath, EFICodeSNP)
+
 	return &EFIEnvironment{
 		codeSecureBoot: codeWithSB,
 		varsSecureBoot: varsWithSB,
@@ -109,6 +137,8 @@ func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
 		vars:           vars,
 		codeSEV:        codeWithSEV,
 		varsSEV:        varsWithSEV,
+		codeTDX:        codeWithTDX,
+		codeSNP:        codeWithSNP,
 	}
 }
 
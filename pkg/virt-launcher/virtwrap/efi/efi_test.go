@@ -105,4 +105,36 @@ var _ = Describe("EFI environment detection", func() {
 		Expect(efiEnv.EFIVars(!secureBootEnabled, sevEnabled)).To(Equal(varsSEV))
 		Expect(efiEnv.EFIVars(!secureBootEnabled, !sevEnabled)).To(Equal(varsSEV)) // same as EFIVars
 	})
+
+	It("TDX EFI Roms", func() {
+		ovmfPath := createEFIRoms(EFICode, EFIVars)
+		defer os.RemoveAll(ovmfPath)
+
+		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath)
+		Expect(efiEnv.BootableTDX()).To(BeFalse())
+
+		ovmfPath = createEFIRoms(EFICodeTDX)
+		defer os.RemoveAll(ovmfPath)
+
+		efiEnv = DetectEFIEnvironment("x86_64", ovmfPath)
+		Expect(efiEnv.BootableTDX()).To(BeTrue())
+		Expect(efiEnv.EFICodeTDX()).To(Equal(filepath.Join(ovmfPath, EFICodeTDX)))
+		Expect(efiEnv.Bootable(!secureBootEnabled, !sevEnabled)).To(BeFalse())
+	})
+
+	It("SEV-SNP EFI Roms", func() {
+		ovmfPath := createEFIRoms(EFICode, EFIVars, EFICodeSEV)
+		defer os.RemoveAll(ovmfPath)
+
+		efiEnv := DetectEFIEnvironment("x86_64", ovmfPath)
+		Expect(efiEnv.BootableSNP()).To(BeFalse())
+
+		ovmfPath = createEFIRoms(EFICodeSNP)
+		defer os.RemoveAll(ovmfPath)
+
+		efiEnv = DetectEFIEnvironment("x86_64", ovmfPath)
+		Expect(efiEnv.BootableSNP()).To(BeTrue())
+		Expect(efiEnv.EFICodeSNP()).To(Equal(filepath.Join(ovmfPath, EFICodeSNP)))
+		Expect(efiEnv.Bootable(!secureBootEnabled, sevEnabled)).To(BeFalse())
+	})
 })
@@ -0,0 +1,323 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package efi
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Names of the SecureBoot key files in the key source and of the EFI variables they are enrolled to
+const (
+	PlatformKey          = "PK"
+	KeyExchangeKey       = "KEK"
+	SignatureDatabase    = "db"
+	ForbiddenSignatureDB = "dbx"
+
+	secureBootEnableName = "SecureBootEnable"
+	customModeName       = "CustomMode"
+)
+
+// Layout of EFI_SIGNATURE_LIST and EFI_SIGNATURE_DATA
+const (
+	signatureListHeaderLen = 28
+	signatureOwnerLen      = 16
+)
+
+var (
+	GlobalVariableGUID           = MustParseGUID("8be4df61-93ca-11d2-aa0d-00e098032b8c")
+	ImageSecurityDatabaseGUID    = MustParseGUID("d719b2cb-3d3a-4596-a3bc-dad00e67656f")
+	SecureBootEnableDisableGUID  = MustParseGUID("f0a30bc7-af08-4556-99c4-001009c93a44")
+	CustomModeEnableGUID         = MustParseGUID("c076ec0c-7028-4399-a072-71ee5c448b9f")
+	CertX509GUID                 = MustParseGUID("a5c059a1-94e4-4aa7-87b5-ab155c2bf072")
+	CertSHA256GUID               = MustParseGUID("c1c41626-504c-4092-aca9-41f936934328")
+	KubeVirtSignatureOwnerGUID   = MustParseGUID("3b0a4e5c-9f2d-4d8e-a1c6-6b7e2f0d8a91")
+	secureBootVariableAttributes = VariableNonVolatile | VariableBootServiceAccess | VariableRuntimeAccess |
+		VariableTimeBasedAuthenticatedWriteAccess
+)
+
+// SecureBootKeys are the certificates and hashes enrolled as the SecureBoot keys
+type SecureBootKeys struct {
+	PK        [][]byte
+	KEK       [][]byte
+	DB        [][]byte
+	DBX       [][]byte
+	DBXHashes [][]byte
+}
+
+// SecureBootKeyFingerprints are the hex encoded SHA-256 fingerprints of the enrolled SecureBoot keys
+type SecureBootKeyFingerprints struct {
+	PK  []string
+	KEK []string
+	DB  []string
+	DBX []string
+}
+
+// LoadSecureBootKeys reads the SecureBoot keys from the files in the key source directory
+func LoadSecureBootKeys(dir string) (*SecureBootKeys, error) {
+	keys := &SecureBootKeys{}
+	var err error
+	if keys.PK, _, err = loadKeyFile(dir, PlatformKey, true, false); err != nil {
+		return nil, err
+	}
+	if len(keys.PK) != 1 {
+		return nil, fmt.Errorf("%s must contain exactly one certificate, found %d", PlatformKey, len(keys.PK))
+	}
+	if keys.KEK, _, err = loadKeyFile(dir, KeyExchangeKey, true, false); err != nil {
+		return nil, err
+	}
+	if keys.DB, _, err = loadKeyFile(dir, SignatureDatabase, true, false); err != nil {
+		return nil, err
+	}
+	if keys.DBX, keys.DBXHashes, err = loadKeyFile(dir, ForbiddenSignatureDB, false, true); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func loadKeyFile(dir, name string, required, allowHashes bool) ([][]byte, [][]byte, error) {
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if errors.Is(err, fs.ErrNotExist) && !required {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the SecureBoot key %s: %v", name, err)
+	}
+
+	certs, hashes, err := parseKeyFile(content, allowHashes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid SecureBoot key %s: %v", name, err)
+	}
+	if required && len(certs) == 0 {
+		return nil, nil, fmt.Errorf("SecureBoot key %s does not contain a certificate", name)
+	}
+	return certs, hashes, nil
+}
+
+// parseKeyFile returns the DER encoded PEM certificates and, if allowed, the hex encoded SHA-256 hashes of a key file
+func parseKeyFile(content []byte, allowHashes bool) ([][]byte, [][]byte, error) {
+	var certs, hashes [][]byte
+	var block *bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "-----BEGIN "):
+			block = &bytes.Buffer{}
+			block.WriteString(line + "\n")
+		case block != nil:
+			block.WriteString(line + "\n")
+			if !strings.HasPrefix(line, "-----END ") {
+				continue
+			}
+			decoded, _ := pem.Decode(block.Bytes())
+			if decoded == nil || decoded.Type != "CERTIFICATE" {
+				return nil, nil, fmt.Errorf("expected PEM encoded certificates")
+			}
+			if _, err := x509.ParseCertificate(decoded.Bytes); err != nil {
+				return nil, nil, err
+			}
+			certs = append(certs, decoded.Bytes)
+			block = nil
+		case line == "":
+		default:
+			hash, err := hex.DecodeString(line)
+			if !allowHashes || err != nil || len(hash) != sha256.Size {
+				return nil, nil, fmt.Errorf("unexpected content %q", line)
+			}
+			hashes = append(hashes, hash)
+		}
+	}
+	if block != nil {
+		return nil, nil, fmt.Errorf("truncated PEM block")
+	}
+	return certs, hashes, scanner.Err()
+}
+
+// EnrollSecureBootKeys replaces the SecureBoot keys of the variable store and enables SecureBoot
+func (s *VarStore) EnrollSecureBootKeys(keys *SecureBootKeys, now time.Time) {
+	timestamp := EFITime(now)
+	setOrDelete := func(name string, vendor GUID, data []byte) {
+		if len(data) == 0 {
+			s.Delete(name, vendor)
+			return
+		}
+		s.Set(&Variable{
+			Name:       name,
+			VendorGUID: vendor,
+			Attributes: secureBootVariableAttributes,
+			TimeStamp:  timestamp,
+			Data:       data,
+		})
+	}
+
+	setOrDelete(PlatformKey, GlobalVariableGUID, x509SignatureLists(keys.PK))
+	setOrDelete(KeyExchangeKey, GlobalVariableGUID, x509SignatureLists(keys.KEK))
+	setOrDelete(SignatureDatabase, ImageSecurityDatabaseGUID, x509SignatureLists(keys.DB))
+	setOrDelete(ForbiddenSignatureDB, ImageSecurityDatabaseGUID,
+		append(x509SignatureLists(keys.DBX), sha256SignatureList(keys.DBXHashes)...))
+
+	s.Set(&Variable{
+		Name:       secureBootEnableName,
+		VendorGUID: SecureBootEnableDisableGUID,
+		Attributes: VariableNonVolatile | VariableBootServiceAccess,
+		Data:       []byte{1},
+	})
+	s.Set(&Variable{
+		Name:       customModeName,
+		VendorGUID: CustomModeEnableGUID,
+		Attributes: VariableNonVolatile | VariableBootServiceAccess,
+		Data:       []byte{0},
+	})
+}
+
+// SecureBootKeyFingerprints returns the fingerprints of the SecureBoot keys in the variable store
+func (s *VarStore) SecureBootKeyFingerprints() (*SecureBootKeyFingerprints, error) {
+	fingerprints := &SecureBootKeyFingerprints{}
+	for _, key := range []struct {
+		name   string
+		vendor GUID
+		result *[]string
+	}{
+		{PlatformKey, GlobalVariableGUID, &fingerprints.PK},
+		{KeyExchangeKey, GlobalVariableGUID, &fingerprints.KEK},
+		{SignatureDatabase, ImageSecurityDatabaseGUID, &fingerprints.DB},
+		{ForbiddenSignatureDB, ImageSecurityDatabaseGUID, &fingerprints.DBX},
+	} {
+		variable := s.Get(key.name, key.vendor)
+		if variable == nil {
+			continue
+		}
+		result, err := signatureListFingerprints(variable.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature list in %s: %v", key.name, err)
+		}
+		*key.result = result
+	}
+	return fingerprints, nil
+}
+
+// CreateSecureBootKeysTemplate enrolls the SecureBoot keys found in keysDir into a copy of the variable store template
+func CreateSecureBootKeysTemplate(template, keysDir, output string, now time.Time) error {
+	keys, err := LoadSecureBootKeys(keysDir)
+	if err != nil {
+		return err
+	}
+	image, err := os.ReadFile(template)
+	if err != nil {
+		return err
+	}
+	store, err := ParseVarStore(image)
+	if err != nil {
+		return fmt.Errorf("failed to parse the EFI variable store %s: %v", template, err)
+	}
+	store.EnrollSecureBootKeys(keys, now)
+	image, err = store.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(output, image, 0o644)
+}
+
+// ReadSecureBootKeyFingerprints returns the fingerprints of the SecureBoot keys enrolled in the variable store file
+func ReadSecureBootKeyFingerprints(path string) (*SecureBootKeyFingerprints, error) {
+	image, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	store, err := ParseVarStore(image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the EFI variable store %s: %v", path, err)
+	}
+	return store.SecureBootKeyFingerprints()
+}
+
+// x509SignatureLists encodes each certificate into its own EFI_SIGNATURE_LIST, their sizes differ
+func x509SignatureLists(certs [][]byte) []byte {
+	var lists []byte
+	for _, cert := range certs {
+		lists = append(lists, signatureList(CertX509GUID, [][]byte{cert})...)
+	}
+	return lists
+}
+
+func sha256SignatureList(hashes [][]byte) []byte {
+	if len(hashes) == 0 {
+		return nil
+	}
+	return signatureList(CertSHA256GUID, hashes)
+}
+
+func signatureList(signatureType GUID, signatures [][]byte) []byte {
+	signatureSize := signatureOwnerLen + len(signatures[0])
+	list := make([]byte, signatureListHeaderLen, signatureListHeaderLen+len(signatures)*signatureSize)
+	copy(list, signatureType[:])
+	binary.LittleEndian.PutUint32(list[16:], uint32(cap(list)))
+	binary.LittleEndian.PutUint32(list[24:], uint32(signatureSize))
+	for _, signature := range signatures {
+		list = append(list, KubeVirtSignatureOwnerGUID[:]...)
+		list = append(list, signature...)
+	}
+	return list
+}
+
+func signatureListFingerprints(lists []byte) ([]string, error) {
+	var fingerprints []string
+	for len(lists) > 0 {
+		if len(lists) < signatureListHeaderLen {
+			return nil, fmt.Errorf("truncated signature list")
+		}
+		signatureType := GUID(lists[:16])
+		listSize := int(binary.LittleEndian.Uint32(lists[16:]))
+		headerSize := int(binary.LittleEndian.Uint32(lists[20:]))
+		signatureSize := int(binary.LittleEndian.Uint32(lists[24:]))
+		if listSize > len(lists) || signatureSize <= signatureOwnerLen ||
+			(listSize-signatureListHeaderLen-headerSize) < 0 || (listSize-signatureListHeaderLen-headerSize)%signatureSize != 0 {
+			return nil, fmt.Errorf("malformed signature list")
+		}
+
+		for pos := signatureListHeaderLen + headerSize; pos < listSize; pos += signatureSize {
+			data := lists[pos+signatureOwnerLen : pos+signatureSize]
+			switch signatureType {
+			case CertX509GUID:
+				sum := sha256.Sum256(data)
+				fingerprints = append(fingerprints, hex.EncodeToString(sum[:]))
+			case CertSHA256GUID:
+				fingerprints = append(fingerprints, hex.EncodeToString(data))
+			}
+		}
+		lists = lists[listSize:]
+	}
+	return fingerprints, nil
+}
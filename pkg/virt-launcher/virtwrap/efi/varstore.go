@@ -0,0 +1,288 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package efi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// GUID is an EFI GUID in its binary, mixed endian, representation
+type GUID [16]byte
+
+// MustParseGUID parses the canonical textual representation of a GUID
+func MustParseGUID(s string) GUID {
+	raw, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil || len(raw) != 16 {
+		panic(fmt.Sprintf("invalid GUID %q", s))
+	}
+	var g GUID
+	// The first three fields are stored little endian
+	binary.LittleEndian.PutUint32(g[0:], binary.BigEndian.Uint32(raw[0:]))
+	binary.LittleEndian.PutUint16(g[4:], binary.BigEndian.Uint16(raw[4:]))
+	binary.LittleEndian.PutUint16(g[6:], binary.BigEndian.Uint16(raw[6:]))
+	copy(g[8:], raw[8:])
+	return g
+}
+
+var (
+	// AuthenticatedVariableStoreGUID identifies variable stores holding authenticated variable headers
+	AuthenticatedVariableStoreGUID = MustParseGUID("aaf32c78-947b-439a-a180-2e144ec37792")
+)
+
+// Variable attributes as defined in the UEFI specification
+const (
+	VariableNonVolatile                       uint32 = 0x1
+	VariableBootServiceAccess                 uint32 = 0x2
+	VariableRuntimeAccess                     uint32 = 0x4
+	VariableTimeBasedAuthenticatedWriteAccess uint32 = 0x20
+)
+
+// Layout of the variable store in the OVMF_VARS firmware volume, see MdeModulePkg/Include/Guid/VariableFormat.h
+const (
+	fvSignature          = "_FVH"
+	fvSignatureOffset    = 40
+	fvHeaderLengthOffset = 48
+
+	varStoreHeaderSize         = 28
+	varStoreHeaderSizeOffset   = 16
+	varStoreHeaderFormatOffset = 20
+	varStoreHeaderStateOffset  = 21
+	varStoreFormatted          = 0x5a
+	varStoreHealthy            = 0xfe
+	varStoreAlignment          = 4
+	maxVariableStoreSize       = 64 * 1024 * 1024
+
+	// AUTHENTICATED_VARIABLE_HEADER
+	varHeaderSize                 = 60
+	varHeaderStateOffset          = 2
+	varHeaderAttributesOffset     = 4
+	varHeaderMonotonicCountOffset = 8
+	varHeaderTimeStampOffset      = 16
+	varHeaderPubKeyIndexOffset    = 32
+	varHeaderNameSizeOffset       = 36
+	varHeaderDataSizeOffset       = 40
+	varHeaderVendorGUIDOffset     = 44
+	varStartID                    = 0x55aa
+
+	varAdded                    = 0x3f
+	varAddedInDeletedTransition = 0x3e
+
+	efiTimeSize                = 16
+	efiTimeTimeZoneOffset      = 12
+	efiTimeUnspecifiedTimeZone = 0x07ff
+)
+
+// Variable is an authenticated EFI variable
+type Variable struct {
+	Name           string
+	VendorGUID     GUID
+	Attributes     uint32
+	MonotonicCount uint64
+	TimeStamp      [efiTimeSize]byte
+	PubKeyIndex    uint32
+	Data           []byte
+}
+
+// VarStore is the authenticated variable store of an OVMF_VARS firmware volume
+type VarStore struct {
+	image []byte
+	start int
+	end   int
+	vars  []*Variable
+}
+
+// ParseVarStore parses the variables of an OVMF_VARS firmware volume
+func ParseVarStore(image []byte) (*VarStore, error) {
+	if len(image) < fvHeaderLengthOffset+2 || string(image[fvSignatureOffset:fvSignatureOffset+4]) != fvSignature {
+		return nil, fmt.Errorf("not an EFI firmware volume")
+	}
+	headerOffset := int(binary.LittleEndian.Uint16(image[fvHeaderLengthOffset:]))
+	if len(image) < headerOffset+varStoreHeaderSize {
+		return nil, fmt.Errorf("firmware volume is truncated")
+	}
+	header := image[headerOffset:]
+	if GUID(header[:16]) != AuthenticatedVariableStoreGUID {
+		return nil, fmt.Errorf("only authenticated variable stores are supported")
+	}
+	if header[varStoreHeaderFormatOffset] != varStoreFormatted || header[varStoreHeaderStateOffset] != varStoreHealthy {
+		return nil, fmt.Errorf("variable store is not formatted or not healthy")
+	}
+	size := int(binary.LittleEndian.Uint32(header[varStoreHeaderSizeOffset:]))
+	if size > maxVariableStoreSize || headerOffset+size > len(image) {
+		return nil, fmt.Errorf("variable store size %d exceeds the firmware volume", size)
+	}
+
+	store := &VarStore{
+		image: image,
+		start: align(headerOffset + varStoreHeaderSize),
+		end:   headerOffset + size,
+	}
+
+	var inTransition []*Variable
+	for pos := store.start; pos+varHeaderSize <= store.end; {
+		hdr := image[pos : pos+varHeaderSize]
+		if binary.LittleEndian.Uint16(hdr) != varStartID {
+			break
+		}
+		nameSize := int(binary.LittleEndian.Uint32(hdr[varHeaderNameSizeOffset:]))
+		dataSize := int(binary.LittleEndian.Uint32(hdr[varHeaderDataSizeOffset:]))
+		nameStart := pos + varHeaderSize
+		dataStart := nameStart + nameSize
+		if nameSize < 0 || dataSize < 0 || dataStart+dataSize > store.end || nameSize%2 != 0 {
+			return nil, fmt.Errorf("variable at offset 0x%x exceeds the variable store", pos)
+		}
+
+		variable := &Variable{
+			Name:           decodeVariableName(image[nameStart:dataStart]),
+			VendorGUID:     GUID(hdr[varHeaderVendorGUIDOffset : varHeaderVendorGUIDOffset+16]),
+			Attributes:     binary.LittleEndian.Uint32(hdr[varHeaderAttributesOffset:]),
+			MonotonicCount: binary.LittleEndian.Uint64(hdr[varHeaderMonotonicCountOffset:]),
+			TimeStamp:      [efiTimeSize]byte(hdr[varHeaderTimeStampOffset : varHeaderTimeStampOffset+efiTimeSize]),
+			PubKeyIndex:    binary.LittleEndian.Uint32(hdr[varHeaderPubKeyIndexOffset:]),
+			Data:           append([]byte(nil), image[dataStart:dataStart+dataSize]...),
+		}
+		switch hdr[varHeaderStateOffset] {
+		case varAdded:
+			store.vars = append(store.vars, variable)
+		case varAddedInDeletedTransition:
+			// Only valid when the update interrupted during its transition did not complete
+			inTransition = append(inTransition, variable)
+		}
+		pos = align(dataStart + dataSize)
+	}
+	for _, variable := range inTransition {
+		if store.Get(variable.Name, variable.VendorGUID) == nil {
+			store.vars = append(store.vars, variable)
+		}
+	}
+
+	return store, nil
+}
+
+// Get returns the variable with the given name and vendor GUID or nil
+func (s *VarStore) Get(name string, vendor GUID) *Variable {
+	for _, variable := range s.vars {
+		if variable.Name == name && variable.VendorGUID == vendor {
+			return variable
+		}
+	}
+	return nil
+}
+
+// Set adds the variable or replaces the variable with the same name and vendor GUID
+func (s *VarStore) Set(variable *Variable) {
+	for i := range s.vars {
+		if s.vars[i].Name == variable.Name && s.vars[i].VendorGUID == variable.VendorGUID {
+			s.vars[i] = variable
+			return
+		}
+	}
+	s.vars = append(s.vars, variable)
+}
+
+// Delete removes the variable with the given name and vendor GUID
+func (s *VarStore) Delete(name string, vendor GUID) {
+	for i := range s.vars {
+		if s.vars[i].Name == name && s.vars[i].VendorGUID == vendor {
+			s.vars = append(s.vars[:i], s.vars[i+1:]...)
+			return
+		}
+	}
+}
+
+// Bytes returns the firmware volume with the variable store rewritten to hold the current variables
+func (s *VarStore) Bytes() ([]byte, error) {
+	image := append([]byte(nil), s.image...)
+	for i := s.start; i < s.end; i++ {
+		image[i] = 0xff
+	}
+
+	pos := s.start
+	for _, variable := range s.vars {
+		name := encodeVariableName(variable.Name)
+		next := align(pos + varHeaderSize + len(name) + len(variable.Data))
+		if pos+varHeaderSize+len(name)+len(variable.Data) > s.end {
+			return nil, fmt.Errorf("variable store is too small to hold variable %s", variable.Name)
+		}
+
+		hdr := image[pos : pos+varHeaderSize]
+		binary.LittleEndian.PutUint16(hdr, varStartID)
+		hdr[varHeaderStateOffset] = varAdded
+		hdr[varHeaderStateOffset+1] = 0
+		binary.LittleEndian.PutUint32(hdr[varHeaderAttributesOffset:], variable.Attributes)
+		binary.LittleEndian.PutUint64(hdr[varHeaderMonotonicCountOffset:], variable.MonotonicCount)
+		copy(hdr[varHeaderTimeStampOffset:], variable.TimeStamp[:])
+		binary.LittleEndian.PutUint32(hdr[varHeaderPubKeyIndexOffset:], variable.PubKeyIndex)
+		binary.LittleEndian.PutUint32(hdr[varHeaderNameSizeOffset:], uint32(len(name)))
+		binary.LittleEndian.PutUint32(hdr[varHeaderDataSizeOffset:], uint32(len(variable.Data)))
+		copy(hdr[varHeaderVendorGUIDOffset:], variable.VendorGUID[:])
+		copy(image[pos+varHeaderSize:], name)
+		copy(image[pos+varHeaderSize+len(name):], variable.Data)
+
+		pos = next
+	}
+
+	return image, nil
+}
+
+// EFITime encodes the time as EFI_TIME
+func EFITime(t time.Time) [efiTimeSize]byte {
+	var et [efiTimeSize]byte
+	t = t.UTC()
+	binary.LittleEndian.PutUint16(et[0:], uint16(t.Year()))
+	et[2] = byte(t.Month())
+	et[3] = byte(t.Day())
+	et[4] = byte(t.Hour())
+	et[5] = byte(t.Minute())
+	et[6] = byte(t.Second())
+	binary.LittleEndian.PutUint16(et[efiTimeTimeZoneOffset:], efiTimeUnspecifiedTimeZone)
+	return et
+}
+
+func align(pos int) int {
+	return (pos + varStoreAlignment - 1) &^ (varStoreAlignment - 1)
+}
+
+func decodeVariableName(raw []byte) string {
+	chars := make([]uint16, 0, len(raw)/2)
+	for i := 0; i+1 < len(raw); i += 2 {
+		c := binary.LittleEndian.Uint16(raw[i:])
+		if c == 0 {
+			break
+		}
+		chars = append(chars, c)
+	}
+	return string(utf16.Decode(chars))
+}
+
+func encodeVariableName(name string) []byte {
+	chars := utf16.Encode([]rune(name))
+	// The name is NUL terminated
+	raw := make([]byte, len(chars)*2+2)
+	for i, c := range chars {
+		binary.LittleEndian.PutUint16(raw[i*2:], c)
+	}
+	return raw
+}
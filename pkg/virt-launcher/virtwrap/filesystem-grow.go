@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	growFilesystemTimeoutSeconds = 60
+	// growpart exits with 1 when the partition already fills the disk
+	growpartNoChangeExitCode = 1
+)
+
+type guestExecFunc func(command string, args []string) error
+
+// growGuestFilesystem grows the partitions and the filesystems on the expanded disk through the guest agent and
+// stores the result in the metadata. The grows are serialized, since they run in the background after the disks
+// have been resized.
+func (l *LibvirtDomainManager) growGuestFilesystem(vmi *v1.VirtualMachineInstance, disk api.Disk) {
+	l.filesystemGrowLock.Lock()
+	defer l.filesystemGrowLock.Unlock()
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	exec := func(command string, args []string) error {
+		_, err := l.Exec(domainName, command, args, growFilesystemTimeoutSeconds)
+		return err
+	}
+
+	diskName := filesystemGrowDiskName(disk)
+	result := api.FilesystemGrowMetadata{Succeeded: true}
+	// The filesystems cached by the agent poller may predate the resize of the disk
+	filesystems, err := l.getGuestFilesystems(domainName)
+	if err == nil {
+		err = growFilesystemsOnDisk(filesystems, disk.Serial, exec)
+	}
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("failed to grow the filesystem on disk %s", diskName)
+		result.Succeeded = false
+		result.Message = fmt.Sprintf("failed to grow the filesystem on disk %s: %v", diskName, err)
+	} else {
+		result.Message = fmt.Sprintf("grew the filesystem on disk %s", diskName)
+	}
+	l.setFilesystemGrowResult(diskName, result)
+}
+
+// filesystemGrowDiskName returns the name of the volume of the disk, or its target if the disk has no alias
+func filesystemGrowDiskName(disk api.Disk) string {
+	if disk.Alias != nil {
+		return disk.Alias.GetName()
+	}
+	return disk.Target.Device
+}
+
+func (l *LibvirtDomainManager) getGuestFilesystems(domainName string) ([]api.Filesystem, error) {
+	cmdResult, err := l.virConn.QemuAgentCommand(`{"execute":"`+string(agentpoller.GetFilesystem)+`"}`, domainName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the filesystems of the guest: %v", err)
+	}
+	return agentpoller.ParseFilesystem(cmdResult)
+}
+
+// setFilesystemGrowResult records the result of the filesystem grow of a disk, and stores the results of all disks
+// in the metadata
+func (l *LibvirtDomainManager) setFilesystemGrowResult(diskName string, result api.FilesystemGrowMetadata) {
+	l.filesystemGrowResultsLock.Lock()
+	defer l.filesystemGrowResultsLock.Unlock()
+
+	if l.filesystemGrowResults == nil {
+		l.filesystemGrowResults = make(map[string]api.FilesystemGrowMetadata)
+	}
+	l.filesystemGrowResults[diskName] = result
+	l.metadataCache.FilesystemGrow.Store(mergeFilesystemGrowResults(l.filesystemGrowResults))
+}
+
+// mergeFilesystemGrowResults merges the results of the disks, the merged result only succeeds if all disks succeeded
+func mergeFilesystemGrowResults(results map[string]api.FilesystemGrowMetadata) api.FilesystemGrowMetadata {
+	diskNames := make([]string, 0, len(results))
+	for diskName := range results {
+		diskNames = append(diskNames, diskName)
+	}
+	sort.Strings(diskNames)
+
+	merged := api.FilesystemGrowMetadata{Succeeded: true}
+	messages := make([]string, 0, len(results))
+	for _, diskName := range diskNames {
+		merged.Succeeded = merged.Succeeded && results[diskName].Succeeded
+		messages = append(messages, results[diskName].Message)
+	}
+	merged.Message = strings.Join(messages, "; ")
+	return merged
+}
+
+func growFilesystemsOnDisk(filesystems []api.Filesystem, serial string, exec guestExecFunc) error {
+	grown := make(map[string]bool)
+	for _, fs := range filesystems {
+		dev, ok := diskDevForSerial(fs, serial)
+		if !ok || grown[fs.Name] {
+			continue
+		}
+		if err := growPartition(fs, dev, exec); err != nil {
+			return err
+		}
+		if err := growFilesystem(fs, exec); err != nil {
+			return err
+		}
+		grown[fs.Name] = true
+	}
+	if len(grown) == 0 {
+		return fmt.Errorf("no filesystem reported by the guest agent")
+	}
+	return nil
+}
+
+func diskDevForSerial(fs api.Filesystem, serial string) (string, bool) {
+	for _, d := range fs.Disk {
+		if d.Serial == serial {
+			return d.Dev, true
+		}
+	}
+	return "", false
+}
+
+// growPartition grows the partition which contains the filesystem, filesystems created directly on the disk are skipped
+func growPartition(fs api.Filesystem, dev string, exec guestExecFunc) error {
+	diskName := filepath.Base(dev)
+	if dev == "" || fs.Name == diskName {
+		return nil
+	}
+	if !strings.HasPrefix(fs.Name, diskName) {
+		return fmt.Errorf("unsupported layout for filesystem %s on %s", fs.Name, dev)
+	}
+	partition := strings.TrimPrefix(strings.TrimPrefix(fs.Name, diskName), "p")
+	if _, err := strconv.Atoi(partition); err != nil {
+		return fmt.Errorf("unsupported layout for filesystem %s on %s", fs.Name, dev)
+	}
+	err := exec("growpart", []string{dev, partition})
+	var exitCode agent.ExecExitCode
+	if errors.As(err, &exitCode) && exitCode.ExitCode == growpartNoChangeExitCode {
+		return nil
+	}
+	return err
+}
+
+func growFilesystem(fs api.Filesystem, exec guestExecFunc) error {
+	switch fs.Type {
+	case "ext2", "ext3", "ext4":
+		return exec("resize2fs", []string{filepath.Join("/dev", fs.Name)})
+	case "xfs":
+		return exec("xfs_growfs", []string{fs.Mountpoint})
+	case "btrfs":
+		return exec("btrfs", []string{"filesystem", "resize", "max", fs.Mountpoint})
+	}
+	return fmt.Errorf("unsupported filesystem type %s", fs.Type)
+}
@@ -0,0 +1,204 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("growFilesystemsOnDisk", func() {
+	const serial = "data"
+
+	var (
+		commands []string
+		exec     guestExecFunc
+	)
+
+	newFilesystem := func(name, fsType, dev string) api.Filesystem {
+		return api.Filesystem{
+			Name:       name,
+			Mountpoint: "/data",
+			Type:       fsType,
+			Disk:       []api.FSDisk{{Serial: serial, Dev: dev}},
+		}
+	}
+
+	BeforeEach(func() {
+		commands = nil
+		exec = func(command string, args []string) error {
+			commands = append(commands, strings.Join(append([]string{command}, args...), " "))
+			return nil
+		}
+	})
+
+	DescribeTable("should grow the partition and the filesystem", func(fs api.Filesystem, expectedCommands []string) {
+		other := api.Filesystem{Name: "vda1", Type: "ext4", Disk: []api.FSDisk{{Serial: "root", Dev: "/dev/vda"}}}
+		Expect(growFilesystemsOnDisk([]api.Filesystem{other, fs, fs}, serial, exec)).To(Succeed())
+		Expect(commands).To(Equal(expectedCommands))
+	},
+		Entry("with ext4 on a partition", newFilesystem("vdb1", "ext4", "/dev/vdb"),
+			[]string{"growpart /dev/vdb 1", "resize2fs /dev/vdb1"}),
+		Entry("with xfs on a nvme partition", newFilesystem("nvme0n1p2", "xfs", "/dev/nvme0n1"),
+			[]string{"growpart /dev/nvme0n1 2", "xfs_growfs /data"}),
+		Entry("with btrfs on the whole disk", newFilesystem("vdb", "btrfs", "/dev/vdb"),
+			[]string{"btrfs filesystem resize max /data"}),
+	)
+
+	It("should ignore that the partition already fills the disk", func() {
+		exec = func(command string, args []string) error {
+			commands = append(commands, command)
+			if command == "growpart" {
+				return agent.ExecExitCode{ExitCode: growpartNoChangeExitCode}
+			}
+			return nil
+		}
+		Expect(growFilesystemsOnDisk([]api.Filesystem{newFilesystem("vdb1", "ext4", "/dev/vdb")}, serial, exec)).To(Succeed())
+		Expect(commands).To(Equal([]string{"growpart", "resize2fs"}))
+	})
+
+	DescribeTable("should fail", func(filesystems []api.Filesystem, execErr error, expectedErr string) {
+		if execErr != nil {
+			exec = func(_ string, _ []string) error { return execErr }
+		}
+		Expect(growFilesystemsOnDisk(filesystems, serial, exec)).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("without any filesystem on the disk", nil, nil, "no filesystem reported by the guest agent"),
+		Entry("with an unsupported filesystem", []api.Filesystem{newFilesystem("vdb1", "vfat", "/dev/vdb")}, nil,
+			"unsupported filesystem type vfat"),
+		Entry("with a filesystem on a device mapper", []api.Filesystem{newFilesystem("dm-0", "xfs", "/dev/vdb")}, nil,
+			"unsupported layout for filesystem dm-0 on /dev/vdb"),
+		Entry("when the command fails in the guest", []api.Filesystem{newFilesystem("vdb1", "ext4", "/dev/vdb")},
+			agent.ExecExitCode{ExitCode: 2}, "exited with error code:2"),
+		Entry("when the guest agent fails", []api.Filesystem{newFilesystem("vdb", "ext4", "/dev/vdb")},
+			fmt.Errorf("agent not connected"), "agent not connected"),
+	)
+})
+
+var _ = Describe("growGuestFilesystem", func() {
+	var (
+		mockConn      *cli.MockConnection
+		metadataCache *metadata.Cache
+		manager       *LibvirtDomainManager
+		vmi           *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		mockConn = cli.NewMockConnection(gomock.NewController(GinkgoT()))
+		metadataCache = metadata.NewCache()
+		manager = &LibvirtDomainManager{virConn: mockConn, metadataCache: metadataCache}
+		vmi = libvmi.New(libvmi.WithNamespace("default"), libvmi.WithName("testvmi"))
+	})
+
+	newDisk := func(name string) api.Disk {
+		return api.Disk{Alias: api.NewUserDefinedAlias(name), Serial: name}
+	}
+
+	It("should read the filesystems from the guest after the resize", func() {
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GetFilesystem)+`"}`, "default_testvmi").
+			Return(`{"return":[{"name":"vdb1","mountpoint":"/data","type":"vfat","disk":[{"serial":"data"}]}]}`, nil)
+
+		manager.growGuestFilesystem(vmi, newDisk("data"))
+		result, exists := metadataCache.FilesystemGrow.Load()
+		Expect(exists).To(BeTrue())
+		Expect(result.Succeeded).To(BeFalse())
+		Expect(result.Message).To(Equal("failed to grow the filesystem on disk data: unsupported filesystem type vfat"))
+	})
+
+	It("should keep the result of each disk", func() {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), gomock.Any()).Return(`{"return":[]}`, nil)
+		manager.setFilesystemGrowResult("root", api.FilesystemGrowMetadata{Succeeded: true, Message: "grew the filesystem on disk root"})
+
+		manager.growGuestFilesystem(vmi, newDisk("data"))
+		result, _ := metadataCache.FilesystemGrow.Load()
+		Expect(result.Succeeded).To(BeFalse())
+		Expect(result.Message).To(Equal("failed to grow the filesystem on disk data: no filesystem reported by the guest agent; " +
+			"grew the filesystem on disk root"))
+	})
+
+	It("should fail if the guest agent doesn't report the filesystems", func() {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("agent not connected"))
+
+		manager.growGuestFilesystem(vmi, newDisk("data"))
+		result, _ := metadataCache.FilesystemGrow.Load()
+		Expect(result.Succeeded).To(BeFalse())
+		Expect(result.Message).To(ContainSubstring("failed to get the filesystems of the guest: agent not connected"))
+	})
+})
+
+var _ = Describe("onlineExpansionSize", func() {
+	var mockDomain *cli.MockVirDomain
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+	})
+
+	newDisk := func(capacity int64) api.Disk {
+		return api.Disk{
+			Source:             api.DiskSource{Dev: "/dev/data"},
+			Capacity:           pointer.P(capacity),
+			FilesystemOverhead: pointer.P(v1.Percent("0")),
+			ExpandDisksEnabled: true,
+		}
+	}
+
+	DescribeTable("should decide whether to expand the disk", func(guestSize, capacity int64, expectedExpand bool) {
+		mockDomain.EXPECT().GetBlockInfo("/dev/data", uint32(0)).Return(&libvirt.DomainBlockInfo{Capacity: uint64(guestSize)}, nil)
+		size, expand, err := onlineExpansionSize(mockDomain, newDisk(capacity))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expand).To(Equal(expectedExpand))
+		if expand {
+			Expect(size).To(Equal(capacity))
+		}
+	},
+		Entry("when the capacity grew", int64(1<<30), int64(2<<30), true),
+		Entry("but not when the capacity didn't change", int64(1<<30), int64(1<<30), false),
+	)
+
+	It("should refuse to shrink the disk", func() {
+		mockDomain.EXPECT().GetBlockInfo("/dev/data", uint32(0)).Return(&libvirt.DomainBlockInfo{Capacity: 2 << 30}, nil)
+		_, expand, err := onlineExpansionSize(mockDomain, newDisk(1<<30))
+		Expect(err).To(MatchError(ContainSubstring("not shrinking disk /dev/data from 2147483648 to 1073741824 bytes")))
+		Expect(expand).To(BeFalse())
+	})
+
+	It("should not expand the disk if the feature is disabled", func() {
+		disk := newDisk(2 << 30)
+		disk.ExpandDisksEnabled = false
+		_, expand, err := onlineExpansionSize(mockDomain, disk)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expand).To(BeFalse())
+	})
+})
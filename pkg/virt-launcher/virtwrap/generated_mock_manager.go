@@ -15,9 +15,9 @@ import (
 
 	gomock "go.uber.org/mock/gomock"
 	v1 "kubevirt.io/api/core/v1"
-
 	v10 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	agent "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
@@ -234,6 +234,36 @@ func (mr *MockDomainManagerMockRecorder) GetSEVInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockDomainManager)(nil).GetSEVInfo))
 }
 
+// GetSEVSNPAttestationInfo mocks base method.
+func (m *MockDomainManager) GetSEVSNPAttestationInfo(arg0 *v1.VirtualMachineInstance) (*v1.SEVSNPAttestationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationInfo", arg0)
+	ret0, _ := ret[0].(*v1.SEVSNPAttestationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationInfo indicates an expected call of GetSEVSNPAttestationInfo.
+func (mr *MockDomainManagerMockRecorder) GetSEVSNPAttestationInfo(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationInfo", reflect.TypeOf((*MockDomainManager)(nil).GetSEVSNPAttestationInfo), arg0)
+}
+
+// GetTDXQuote mocks base method.
+func (m *MockDomainManager) GetTDXQuote(arg0 *v1.VirtualMachineInstance, arg1 []byte) (*v1.TDXQuoteInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTDXQuote", arg0, arg1)
+	ret0, _ := ret[0].(*v1.TDXQuoteInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTDXQuote indicates an expected call of GetTDXQuote.
+func (mr *MockDomainManagerMockRecorder) GetTDXQuote(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTDXQuote", reflect.TypeOf((*MockDomainManager)(nil).GetTDXQuote), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockDomainManager) GetUsers() []v1.VirtualMachineInstanceGuestOSUser {
 	m.ctrl.T.Helper()
@@ -248,6 +278,95 @@ func (mr *MockDomainManagerMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockDomainManager)(nil).GetUsers))
 }
 
+// GuestExecStart mocks base method.
+func (m *MockDomainManager) GuestExecStart(domainName, command string, args []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStart", domainName, command, args)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStart indicates an expected call of GuestExecStart.
+func (mr *MockDomainManagerMockRecorder) GuestExecStart(domainName, command, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStart", reflect.TypeOf((*MockDomainManager)(nil).GuestExecStart), domainName, command, args)
+}
+
+// GuestExecStatus mocks base method.
+func (m *MockDomainManager) GuestExecStatus(domainName string, pid int64) (*agent.ExecStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExecStatus", domainName, pid)
+	ret0, _ := ret[0].(*agent.ExecStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExecStatus indicates an expected call of GuestExecStatus.
+func (mr *MockDomainManagerMockRecorder) GuestExecStatus(domainName, pid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExecStatus", reflect.TypeOf((*MockDomainManager)(nil).GuestExecStatus), domainName, pid)
+}
+
+// GuestFileClose mocks base method.
+func (m *MockDomainManager) GuestFileClose(domainName string, handle int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileClose", domainName, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileClose indicates an expected call of GuestFileClose.
+func (mr *MockDomainManagerMockRecorder) GuestFileClose(domainName, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileClose", reflect.TypeOf((*MockDomainManager)(nil).GuestFileClose), domainName, handle)
+}
+
+// GuestFileOpen mocks base method.
+func (m *MockDomainManager) GuestFileOpen(domainName, path, mode string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileOpen", domainName, path, mode)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileOpen indicates an expected call of GuestFileOpen.
+func (mr *MockDomainManagerMockRecorder) GuestFileOpen(domainName, path, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileOpen", reflect.TypeOf((*MockDomainManager)(nil).GuestFileOpen), domainName, path, mode)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockDomainManager) GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", domainName, handle, count)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockDomainManagerMockRecorder) GuestFileRead(domainName, handle, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockDomainManager)(nil).GuestFileRead), domainName, handle, count)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockDomainManager) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", domainName, handle, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockDomainManagerMockRecorder) GuestFileWrite(domainName, handle, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockDomainManager)(nil).GuestFileWrite), domainName, handle, data)
+}
+
 // GuestPing mocks base method.
 func (m *MockDomainManager) GuestPing(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -262,6 +381,20 @@ func (mr *MockDomainManagerMockRecorder) GuestPing(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestPing", reflect.TypeOf((*MockDomainManager)(nil).GuestPing), arg0)
 }
 
+// HibernateVMI mocks base method.
+func (m *MockDomainManager) HibernateVMI(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HibernateVMI", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HibernateVMI indicates an expected call of HibernateVMI.
+func (mr *MockDomainManagerMockRecorder) HibernateVMI(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HibernateVMI", reflect.TypeOf((*MockDomainManager)(nil).HibernateVMI), arg0)
+}
+
 // HotplugHostDevices mocks base method.
 func (m *MockDomainManager) HotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -415,6 +548,20 @@ func (mr *MockDomainManagerMockRecorder) ResetVMI(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVMI", reflect.TypeOf((*MockDomainManager)(nil).ResetVMI), arg0)
 }
 
+// SetGuestMemoryTarget mocks base method.
+func (m *MockDomainManager) SetGuestMemoryTarget(domainName string, targetKiB uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuestMemoryTarget", domainName, targetKiB)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuestMemoryTarget indicates an expected call of SetGuestMemoryTarget.
+func (mr *MockDomainManagerMockRecorder) SetGuestMemoryTarget(domainName, targetKiB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuestMemoryTarget", reflect.TypeOf((*MockDomainManager)(nil).SetGuestMemoryTarget), domainName, targetKiB)
+}
+
 // SignalShutdownVMI mocks base method.
 func (m *MockDomainManager) SignalShutdownVMI(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
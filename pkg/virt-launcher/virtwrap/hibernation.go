@@ -0,0 +1,487 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const (
+	maxConcurrentHibernations = 1
+
+	failedDomainHibernation = "Domain hibernation failed"
+	failedDomainRestore     = "Domain restore from hibernation failed"
+	failedLiveFork          = "Live fork identity reset failed"
+)
+
+var (
+	// These are vars so they can be changed by the unit tests
+	hibernationStateFile = filepath.Join(config.HibernationStateDir, "domain.save")
+	hibernationInfoFile  = filepath.Join(config.HibernationStateDir, "state.json")
+
+	liveForkPollInterval     = 2 * time.Second
+	liveForkInterfaceTimeout = 1 * time.Minute
+	liveForkAgentTimeout     = 5 * time.Minute
+)
+
+// hibernationInfo describes what the domain state was saved with. The state can only be restored by the same QEMU
+// version with the same machine type. The domain name and the MAC addresses of its interfaces tell whether the
+// state is restored into a live fork of the domain it was saved from.
+type hibernationInfo struct {
+	QEMUVersion  string            `json:"qemuVersion"`
+	MachineType  string            `json:"machineType"`
+	DomainName   string            `json:"domainName,omitempty"`
+	MACAddresses map[string]string `json:"macAddresses,omitempty"`
+}
+
+// liveFork describes how a domain restored from the state of another domain takes on its own identity
+type liveFork struct {
+	// interfaces maps the name of each interface restored with the MAC address of the source to its own MAC address
+	interfaces           map[string]string
+	identityResetCommand []string
+}
+
+// HibernateVMI saves the state of the domain to the hibernation PVC in the background. libvirt stops the domain once
+// its state is saved.
+func (l *LibvirtDomainManager) HibernateVMI(vmi *v1.VirtualMachineInstance) error {
+	if vmi.Spec.Hibernation == nil {
+		return fmt.Errorf("VMI %s does not have a hibernation PVC", vmi.Name)
+	}
+
+	select {
+	case l.hibernationInProgress <- struct{}{}:
+	default:
+		log.Log.Object(vmi).Infof("hibernation is in progress")
+		return nil
+	}
+
+	go func() {
+		defer func() { <-l.hibernationInProgress }()
+		if err := l.hibernate(vmi); err != nil {
+			log.Log.Object(vmi).Reason(err).Error(failedDomainHibernation)
+		}
+	}()
+	return nil
+}
+
+func (l *LibvirtDomainManager) hibernate(vmi *v1.VirtualMachineInstance) error {
+	logger := log.Log.Object(vmi)
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	info, err := l.getHibernationInfo(dom)
+	if err != nil {
+		return err
+	}
+	now := metav1.Now()
+	l.metadataCache.Hibernation.Store(api.HibernationMetadata{
+		Phase:          v1.HibernationSaving,
+		QEMUVersion:    info.QEMUVersion,
+		MachineType:    info.MachineType,
+		StartTimestamp: &now,
+	})
+
+	removeHibernationState()
+	if err := writeHibernationInfo(info); err != nil {
+		l.setHibernationResult(v1.HibernationFailed, fmt.Sprintf("%s: %v", failedDomainHibernation, err))
+		return err
+	}
+
+	logger.Infof("Saving the domain state to %s", hibernationStateFile)
+	if err := dom.SaveFlags(hibernationStateFile, "", libvirt.DOMAIN_SAVE_RUNNING); err != nil {
+		removeHibernationState()
+		l.setHibernationResult(v1.HibernationFailed, fmt.Sprintf("%s: %v", failedDomainHibernation, err))
+		return err
+	}
+	logger.Info("Saved the domain state")
+
+	l.setHibernationResult(v1.HibernationSaved, "")
+	return nil
+}
+
+// restoreHibernatedDomain starts the domain from the state saved on the hibernation PVC. It returns false when the
+// domain still has to be booted, because there is nothing to restore, the state was discarded on request or the
+// restore failed. A state which cannot be restored into the domain is never discarded implicitly, the domain does
+// not boot until the VMI is started with the request to discard it.
+func (l *LibvirtDomainManager) restoreHibernatedDomain(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) (bool, error) {
+	if vmi.Spec.Hibernation == nil {
+		return false, nil
+	}
+
+	logger := log.Log.Object(vmi)
+	if metav1.HasAnnotation(vmi.ObjectMeta, v1.HibernationDiscardStateAnnotation) {
+		logger.Info("Discarding the hibernation state on request, booting the domain")
+		removeHibernationState()
+		return false, nil
+	}
+
+	hasState := hibernationStateExists()
+	if !hasState && !metav1.HasAnnotation(vmi.ObjectMeta, v1.HibernationRestoreAnnotation) {
+		return false, nil
+	}
+
+	now := metav1.Now()
+	l.metadataCache.Hibernation.Store(api.HibernationMetadata{
+		Phase:          v1.HibernationRestoring,
+		StartTimestamp: &now,
+	})
+
+	if !hasState {
+		logger.Error("The hibernation state is missing, booting the domain instead")
+		l.setHibernationResult(v1.HibernationFailed, fmt.Sprintf("%s: no saved domain state", failedDomainRestore))
+		return false, nil
+	}
+
+	domainXML, fork, err := l.prepareHibernationRestore(dom)
+	if err != nil {
+		l.setHibernationResult(v1.HibernationFailed, fmt.Sprintf("%s: %v", failedDomainRestore, err))
+		return false, fmt.Errorf("%s: %v, the state is kept until the VM is started with the request to discard it", failedDomainRestore, err)
+	}
+
+	// The disks change as soon as the guest runs again, restoring the state afterwards would corrupt them
+	err = l.virConn.DomainRestoreFlags(hibernationStateFile, domainXML, libvirt.DOMAIN_SAVE_RUNNING)
+	removeHibernationState()
+	if err != nil {
+		logger.Reason(err).Error("Failed to restore the domain state, booting the domain instead")
+		l.setHibernationResult(v1.HibernationFailed, fmt.Sprintf("%s: %v", failedDomainRestore, err))
+		return false, nil
+	}
+
+	logger.Info("Domain restored from the hibernation state.")
+	l.setHibernationResult(v1.HibernationRestored, "")
+
+	if fork != nil {
+		fork.identityResetCommand, err = getIdentityResetCommand(vmi)
+		if err != nil {
+			logger.Reason(err).Error(failedLiveFork)
+		}
+		go l.finishLiveFork(vmi, fork)
+	}
+	return true, nil
+}
+
+// prepareHibernationRestore checks that the saved state can be restored into the domain and returns the domain XML
+// to restore it with. A state saved from another domain is only restored as a live fork of that domain.
+func (l *LibvirtDomainManager) prepareHibernationRestore(dom cli.VirDomain) (string, *liveFork, error) {
+	saved, err := readHibernationInfo()
+	if err != nil {
+		return "", nil, err
+	}
+	l.metadataCache.Hibernation.WithSafeBlock(func(hibernationMetadata *api.HibernationMetadata, _ bool) {
+		hibernationMetadata.QEMUVersion = saved.QEMUVersion
+		hibernationMetadata.MachineType = saved.MachineType
+	})
+
+	current, err := l.getHibernationInfo(dom)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := checkHibernationCompatibility(saved, current); err != nil {
+		return "", nil, err
+	}
+
+	domainXML, err := dom.GetXMLDesc(0)
+	if err != nil {
+		return "", nil, err
+	}
+	if saved.DomainName == "" || saved.DomainName == current.DomainName {
+		return domainXML, nil, nil
+	}
+	return liveForkDomainXML(domainXML, saved)
+}
+
+// liveForkDomainXML returns the XML to restore the state of another domain with. The domain keeps its own UUID and
+// SMBIOS information, each fork restores its own copy of the state. The MAC addresses are part of the saved device
+// state, so the interfaces get the MAC addresses of the source until they are swapped.
+func liveForkDomainXML(domainXML string, saved *hibernationInfo) (string, *liveFork, error) {
+	domSpec := &api.DomainSpec{}
+	if err := xml.Unmarshal([]byte(domainXML), domSpec); err != nil {
+		return "", nil, fmt.Errorf("failed to parse the domain XML: %v", err)
+	}
+
+	fork := &liveFork{interfaces: map[string]string{}}
+	interfaces := 0
+	for i := range domSpec.Devices.Interfaces {
+		iface := &domSpec.Devices.Interfaces[i]
+		if iface.Alias == nil || iface.MAC == nil {
+			continue
+		}
+		interfaces++
+		savedMAC, exists := saved.MACAddresses[iface.Alias.GetName()]
+		if !exists {
+			return "", nil, fmt.Errorf("the interface %s is not part of the state saved from %s", iface.Alias.GetName(), saved.DomainName)
+		}
+		if iface.MAC.MAC != savedMAC {
+			fork.interfaces[iface.Alias.GetName()] = iface.MAC.MAC
+			iface.MAC.MAC = savedMAC
+		}
+	}
+	if interfaces != len(saved.MACAddresses) {
+		return "", nil, fmt.Errorf("the state was saved from %s with %d interfaces, the domain has %d", saved.DomainName, len(saved.MACAddresses), interfaces)
+	}
+
+	forkXML, err := xml.Marshal(domSpec)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(forkXML), fork, nil
+}
+
+// finishLiveFork gives the interfaces of a domain restored from the state of another domain their own MAC addresses
+// and runs the identity reset command in the guest. A failure is reported in the message of the restored phase.
+func (l *LibvirtDomainManager) finishLiveFork(vmi *v1.VirtualMachineInstance, fork *liveFork) {
+	logger := log.Log.Object(vmi)
+	if err := l.swapLiveForkInterfaces(vmi, fork.interfaces); err != nil {
+		logger.Reason(err).Error(failedLiveFork)
+		l.setHibernationResult(v1.HibernationRestored, fmt.Sprintf("%s: %v", failedLiveFork, err))
+		return
+	}
+	if len(fork.identityResetCommand) == 0 {
+		return
+	}
+	if err := l.runIdentityResetCommand(vmi, fork.identityResetCommand); err != nil {
+		logger.Reason(err).Error(failedLiveFork)
+		l.setHibernationResult(v1.HibernationRestored, fmt.Sprintf("%s: %v", failedLiveFork, err))
+		return
+	}
+	logger.Info("Ran the live fork identity reset command")
+}
+
+// swapLiveForkInterfaces replaces each interface which still has the MAC address of the source with the same
+// interface carrying its own MAC address. The guest sees a NIC being unplugged and a new one being plugged.
+func (l *LibvirtDomainManager) swapLiveForkInterfaces(vmi *v1.VirtualMachineInstance, interfaces map[string]string) error {
+	if len(interfaces) == 0 {
+		return nil
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	dom, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	for name, mac := range interfaces {
+		iface, err := lookupLiveForkInterface(dom, name)
+		if err != nil {
+			return err
+		}
+		if iface == nil {
+			return fmt.Errorf("interface %s is missing in the domain", name)
+		}
+		ifaceXML, err := xml.Marshal(iface)
+		if err != nil {
+			return err
+		}
+		if err := dom.DetachDeviceFlags(strings.ToLower(string(ifaceXML)), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("failed to detach interface %s: %v", name, err)
+		}
+		err = wait.PollUntilContextTimeout(context.Background(), liveForkPollInterval, liveForkInterfaceTimeout, true, func(context.Context) (bool, error) {
+			detached, err := lookupLiveForkInterface(dom, name)
+			return detached == nil, err
+		})
+		if err != nil {
+			return fmt.Errorf("interface %s was not detached: %v", name, err)
+		}
+
+		iface.MAC.MAC = mac
+		ifaceXML, err = xml.Marshal(iface)
+		if err != nil {
+			return err
+		}
+		if err := dom.AttachDeviceFlags(strings.ToLower(string(ifaceXML)), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("failed to attach interface %s: %v", name, err)
+		}
+		log.Log.Object(vmi).Infof("Swapped interface %s to MAC address %s", name, mac)
+	}
+	return nil
+}
+
+func lookupLiveForkInterface(dom cli.VirDomain, name string) (*api.Interface, error) {
+	domSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, iface := range domSpec.Devices.Interfaces {
+		if iface.Alias != nil && iface.Alias.GetName() == name {
+			return &iface, nil
+		}
+	}
+	return nil, nil
+}
+
+// runIdentityResetCommand runs the command via the guest agent, which connects again some time after the restore
+func (l *LibvirtDomainManager) runIdentityResetCommand(vmi *v1.VirtualMachineInstance, command []string) error {
+	domName := api.VMINamespaceKeyFunc(vmi)
+
+	log.Log.Object(vmi).
+		With("command", command[0]).
+		With("args", fmt.Sprintf("%q", command[1:])).
+		Info("Executing the live fork identity reset command in guest")
+
+	var pid int64
+	err := wait.PollUntilContextTimeout(context.Background(), liveForkPollInterval, liveForkAgentTimeout, true, func(context.Context) (bool, error) {
+		var err error
+		pid, err = agent.GuestExecStart(l.virConn, domName, command[0], command[1:])
+		return err == nil, nil
+	})
+	if err != nil {
+		return fmt.Errorf("the guest agent did not start %s: %v", command[0], err)
+	}
+
+	var status *agent.ExecStatus
+	err = wait.PollUntilContextTimeout(context.Background(), liveForkPollInterval, liveForkAgentTimeout, true, func(context.Context) (bool, error) {
+		var err error
+		status, err = agent.GuestExecStatus(l.virConn, domName, pid)
+		if err != nil {
+			return false, err
+		}
+		return status.Exited, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s did not finish: %v", command[0], err)
+	}
+	if status.ExitCode != 0 {
+		return fmt.Errorf("%s exited with code %d: %s", command[0], status.ExitCode, strings.TrimSpace(string(status.Stderr)))
+	}
+	return nil
+}
+
+func getIdentityResetCommand(vmi *v1.VirtualMachineInstance) ([]string, error) {
+	value, exists := vmi.Annotations[v1.LiveForkIdentityResetCommandAnnotation]
+	if !exists {
+		return nil, nil
+	}
+	var command []string
+	if err := json.Unmarshal([]byte(value), &command); err != nil {
+		return nil, fmt.Errorf("failed to parse the identity reset command: %v", err)
+	}
+	return command, nil
+}
+
+func checkHibernationCompatibility(saved, current *hibernationInfo) error {
+	if saved.QEMUVersion != current.QEMUVersion {
+		return fmt.Errorf("the state was saved with QEMU %s, the domain runs QEMU %s", saved.QEMUVersion, current.QEMUVersion)
+	}
+	if saved.MachineType != current.MachineType {
+		return fmt.Errorf("the state was saved with machine type %s, the domain has machine type %s", saved.MachineType, current.MachineType)
+	}
+	return nil
+}
+
+func (l *LibvirtDomainManager) getHibernationInfo(dom cli.VirDomain) (*hibernationInfo, error) {
+	qemuVersion, err := l.virConn.GetQemuVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the QEMU version: %v", err)
+	}
+	domSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the domain machine type: %v", err)
+	}
+	info := &hibernationInfo{
+		QEMUVersion: qemuVersion,
+		MachineType: domSpec.OS.Type.Machine,
+		DomainName:  domSpec.Name,
+	}
+	for _, iface := range domSpec.Devices.Interfaces {
+		if iface.Alias == nil || iface.MAC == nil {
+			continue
+		}
+		if info.MACAddresses == nil {
+			info.MACAddresses = map[string]string{}
+		}
+		info.MACAddresses[iface.Alias.GetName()] = iface.MAC.MAC
+	}
+	return info, nil
+}
+
+func (l *LibvirtDomainManager) setHibernationResult(phase v1.HibernationPhase, message string) {
+	l.metadataCache.Hibernation.WithSafeBlock(func(hibernationMetadata *api.HibernationMetadata, _ bool) {
+		now := metav1.Now()
+		hibernationMetadata.Phase = phase
+		hibernationMetadata.EndTimestamp = &now
+		hibernationMetadata.Message = message
+	})
+	log.Log.V(4).Infof("set hibernation results in metadata: %s", l.metadataCache.Hibernation.String())
+}
+
+func writeHibernationInfo(info *hibernationInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(hibernationInfoFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to write the hibernation info: %v", err)
+	}
+	return nil
+}
+
+func hibernationStateExists() bool {
+	_, err := os.Stat(hibernationStateFile)
+	return err == nil
+}
+
+func readHibernationInfo() (*hibernationInfo, error) {
+	data, err := os.ReadFile(hibernationInfoFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the hibernation info: %v", err)
+	}
+	info := &hibernationInfo{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("failed to parse the hibernation info: %v", err)
+	}
+	return info, nil
+}
+
+func removeHibernationState() {
+	for _, path := range []string{hibernationStateFile, hibernationInfoFile} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.Log.Reason(err).Errorf("failed to remove %s", path)
+		}
+	}
+}
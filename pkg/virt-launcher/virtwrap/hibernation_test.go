@@ -0,0 +1,387 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
+)
+
+var _ = Describe("Hibernation", func() {
+	const (
+		qemuVersion = "9.1.0"
+		machineType = "pc-q35-rhel9.6.0"
+		domainXML   = `<domain type="kvm"><name>default_testvmi</name><os><type machine="pc-q35-rhel9.6.0">hvm</type></os></domain>`
+	)
+
+	var (
+		mockLibvirt              *testing.Libvirt
+		manager                  *LibvirtDomainManager
+		origHibernationStateFile string
+		origHibernationInfoFile  string
+	)
+
+	newHibernationVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(append([]libvmi.Option{libvmi.WithName("testvmi"), libvmi.WithNamespace("default")}, opts...)...)
+		vmi.Spec.Hibernation = &v1.Hibernation{ClaimName: "hibernation-pvc"}
+		return vmi
+	}
+
+	writeState := func(info *hibernationInfo) {
+		Expect(os.WriteFile(hibernationStateFile, []byte("state"), 0600)).To(Succeed())
+		Expect(writeHibernationInfo(info)).To(Succeed())
+	}
+
+	expectStateRemoved := func() {
+		Expect(hibernationStateFile).ToNot(BeAnExistingFile())
+		Expect(hibernationInfoFile).ToNot(BeAnExistingFile())
+	}
+
+	BeforeEach(func() {
+		origHibernationStateFile, origHibernationInfoFile = hibernationStateFile, hibernationInfoFile
+		dir := GinkgoT().TempDir()
+		hibernationStateFile = filepath.Join(dir, "domain.save")
+		hibernationInfoFile = filepath.Join(dir, "state.json")
+
+		mockLibvirt = testing.NewLibvirt(gomock.NewController(GinkgoT()))
+		manager = &LibvirtDomainManager{
+			virConn:               mockLibvirt.VirtConnection,
+			metadataCache:         metadata.NewCache(),
+			hibernationInProgress: make(chan struct{}, maxConcurrentHibernations),
+		}
+	})
+
+	AfterEach(func() {
+		hibernationStateFile, hibernationInfoFile = origHibernationStateFile, origHibernationInfoFile
+	})
+
+	Context("saving", func() {
+		BeforeEach(func() {
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName("default_testvmi").Return(mockLibvirt.VirtDomain, nil)
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(domainXML, nil)
+		})
+
+		It("should save the domain state with the QEMU version and machine type", func() {
+			mockLibvirt.DomainEXPECT().SaveFlags(hibernationStateFile, "", libvirt.DOMAIN_SAVE_RUNNING).Return(nil)
+
+			Expect(manager.HibernateVMI(newHibernationVMI())).To(Succeed())
+			Eventually(func() v1.HibernationPhase {
+				hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+				return hibernationMetadata.Phase
+			}).Should(Equal(v1.HibernationSaved))
+
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			Expect(hibernationMetadata.QEMUVersion).To(Equal(qemuVersion))
+			Expect(hibernationMetadata.MachineType).To(Equal(machineType))
+			Expect(hibernationMetadata.StartTimestamp).ToNot(BeNil())
+			Expect(hibernationMetadata.EndTimestamp).ToNot(BeNil())
+
+			info, err := os.ReadFile(hibernationInfoFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info).To(MatchJSON(`{"qemuVersion":"9.1.0","machineType":"pc-q35-rhel9.6.0","domainName":"default_testvmi"}`))
+		})
+
+		It("should report the failure and remove the partial state", func() {
+			mockLibvirt.DomainEXPECT().SaveFlags(hibernationStateFile, "", libvirt.DOMAIN_SAVE_RUNNING).DoAndReturn(
+				func(_, _ string, _ libvirt.DomainSaveRestoreFlags) error {
+					Expect(os.WriteFile(hibernationStateFile, []byte("partial"), 0600)).To(Succeed())
+					return fmt.Errorf("no space left on device")
+				})
+
+			Expect(manager.HibernateVMI(newHibernationVMI())).To(Succeed())
+			Eventually(func() v1.HibernationPhase {
+				hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+				return hibernationMetadata.Phase
+			}).Should(Equal(v1.HibernationFailed))
+
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			Expect(hibernationMetadata.Message).To(ContainSubstring("no space left on device"))
+			expectStateRemoved()
+		})
+	})
+
+	It("should record the MAC addresses of the interfaces with the saved state", func() {
+		mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+		mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(
+			`<domain type="kvm"><name>default_testvmi</name><os><type machine="pc-q35-rhel9.6.0">hvm</type></os>`+
+				`<devices><interface type="ethernet"><mac address="02:00:00:00:00:01"></mac><alias name="ua-default"></alias></interface></devices></domain>`, nil)
+
+		info, err := manager.getHibernationInfo(mockLibvirt.VirtDomain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.MACAddresses).To(Equal(map[string]string{"default": "02:00:00:00:00:01"}))
+	})
+
+	It("should refuse to hibernate a VMI without a hibernation PVC", func() {
+		vmi := newHibernationVMI()
+		vmi.Spec.Hibernation = nil
+		Expect(manager.HibernateVMI(vmi)).To(MatchError(ContainSubstring("does not have a hibernation PVC")))
+	})
+
+	Context("restoring", func() {
+		restoreVMI := func() *v1.VirtualMachineInstance {
+			return newHibernationVMI(libvmi.WithAnnotation(v1.HibernationRestoreAnnotation, ""))
+		}
+
+		It("should restore the saved domain state", func() {
+			writeState(&hibernationInfo{QEMUVersion: qemuVersion, MachineType: machineType})
+			mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(domainXML, nil).Times(2)
+			mockLibvirt.ConnectionEXPECT().DomainRestoreFlags(hibernationStateFile, domainXML, libvirt.DOMAIN_SAVE_RUNNING).Return(nil)
+
+			restored, err := manager.restoreHibernatedDomain(restoreVMI(), mockLibvirt.VirtDomain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeTrue())
+
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			Expect(hibernationMetadata.Phase).To(Equal(v1.HibernationRestored))
+			Expect(hibernationMetadata.QEMUVersion).To(Equal(qemuVersion))
+			expectStateRemoved()
+		})
+
+		It("should restore a saved state left by a failed restore", func() {
+			writeState(&hibernationInfo{QEMUVersion: qemuVersion, MachineType: machineType})
+			mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(domainXML, nil).Times(2)
+			mockLibvirt.ConnectionEXPECT().DomainRestoreFlags(hibernationStateFile, domainXML, libvirt.DOMAIN_SAVE_RUNNING).Return(nil)
+
+			restored, err := manager.restoreHibernatedDomain(newHibernationVMI(), mockLibvirt.VirtDomain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeTrue())
+			expectStateRemoved()
+		})
+
+		DescribeTable("should keep the state and refuse to boot the domain when the state is incompatible", func(saved *hibernationInfo, message string) {
+			writeState(saved)
+			mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(domainXML, nil)
+
+			restored, err := manager.restoreHibernatedDomain(restoreVMI(), mockLibvirt.VirtDomain)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+			Expect(restored).To(BeFalse())
+
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			Expect(hibernationMetadata.Phase).To(Equal(v1.HibernationFailed))
+			Expect(hibernationMetadata.Message).To(ContainSubstring(message))
+			Expect(hibernationStateFile).To(BeAnExistingFile())
+			Expect(hibernationInfoFile).To(BeAnExistingFile())
+		},
+			Entry("with another QEMU version", &hibernationInfo{QEMUVersion: "8.2.0", MachineType: machineType},
+				"the state was saved with QEMU 8.2.0, the domain runs QEMU 9.1.0"),
+			Entry("with another machine type", &hibernationInfo{QEMUVersion: qemuVersion, MachineType: "pc-q35-rhel9.4.0"},
+				"the state was saved with machine type pc-q35-rhel9.4.0, the domain has machine type pc-q35-rhel9.6.0"),
+		)
+
+		It("should remove the state and boot the domain when the restore failed", func() {
+			writeState(&hibernationInfo{QEMUVersion: qemuVersion, MachineType: machineType})
+			mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(domainXML, nil).Times(2)
+			mockLibvirt.ConnectionEXPECT().DomainRestoreFlags(hibernationStateFile, domainXML, libvirt.DOMAIN_SAVE_RUNNING).Return(fmt.Errorf("corrupt state"))
+
+			restored, err := manager.restoreHibernatedDomain(restoreVMI(), mockLibvirt.VirtDomain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeFalse())
+
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			Expect(hibernationMetadata.Phase).To(Equal(v1.HibernationFailed))
+			Expect(hibernationMetadata.Message).To(ContainSubstring("corrupt state"))
+			expectStateRemoved()
+		})
+
+		It("should boot the domain when there is no saved state", func() {
+			restored, err := manager.restoreHibernatedDomain(restoreVMI(), mockLibvirt.VirtDomain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeFalse())
+
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			Expect(hibernationMetadata.Phase).To(Equal(v1.HibernationFailed))
+			Expect(hibernationMetadata.Message).To(ContainSubstring("no saved domain state"))
+		})
+
+		It("should discard the saved state on request", func() {
+			writeState(&hibernationInfo{QEMUVersion: "8.2.0", MachineType: machineType})
+
+			restored, err := manager.restoreHibernatedDomain(
+				newHibernationVMI(libvmi.WithAnnotation(v1.HibernationDiscardStateAnnotation, "")), mockLibvirt.VirtDomain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeFalse())
+
+			_, exists := manager.metadataCache.Hibernation.Load()
+			Expect(exists).To(BeFalse())
+			expectStateRemoved()
+		})
+	})
+
+	Context("restoring a live fork", func() {
+		const (
+			sourceMAC            = "02:00:00:00:00:01"
+			forkMAC              = "02:00:00:00:00:02"
+			identityResetCommand = `["/usr/local/bin/reset-identity","--hostname"]`
+			forkUUID             = "5d307ca9-b3ef-428c-8861-06e72d69f223"
+			forkSerial           = "fork-serial"
+		)
+
+		var origLiveForkPollInterval time.Duration
+
+		forkDomainXML := func(mac string) string {
+			return fmt.Sprintf(`<domain type="kvm"><name>default_testvmi</name><uuid>%s</uuid><os><type machine="%s">hvm</type></os>`+
+				`<sysinfo type="smbios"><system><entry name="serial">%s</entry></system></sysinfo>`+
+				`<devices><interface type="ethernet"><mac address="%s"></mac><alias name="ua-default"></alias></interface></devices></domain>`, forkUUID, machineType, forkSerial, mac)
+		}
+
+		// expectDomainXML returns the given domain XMLs one after the other, and the last one from then on
+		expectDomainXML := func(domainXMLs ...string) {
+			var calls atomic.Int32
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).DoAndReturn(func(_ libvirt.DomainXMLFlags) (string, error) {
+				call := int(calls.Add(1)) - 1
+				return domainXMLs[min(call, len(domainXMLs)-1)], nil
+			}).AnyTimes()
+		}
+
+		expectRestoreWithSourceMAC := func() {
+			mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+			mockLibvirt.ConnectionEXPECT().DomainRestoreFlags(hibernationStateFile, gomock.Any(), libvirt.DOMAIN_SAVE_RUNNING).DoAndReturn(
+				func(_, restoreXML string, _ libvirt.DomainSaveRestoreFlags) error {
+					Expect(restoreXML).To(ContainSubstring(sourceMAC))
+					Expect(restoreXML).ToNot(ContainSubstring(forkMAC))
+					// the fork keeps its own identity, only the MAC addresses are part of the saved device state
+					Expect(restoreXML).To(ContainSubstring("<uuid>" + forkUUID + "</uuid>"))
+					Expect(restoreXML).To(ContainSubstring(`<entry name="serial">` + forkSerial + "</entry>"))
+					return nil
+				})
+		}
+
+		restoreForkVMI := func() *v1.VirtualMachineInstance {
+			return newHibernationVMI(libvmi.WithAnnotation(v1.LiveForkIdentityResetCommandAnnotation, identityResetCommand))
+		}
+
+		hibernationMessage := func() string {
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			return hibernationMetadata.Message
+		}
+
+		BeforeEach(func() {
+			origLiveForkPollInterval = liveForkPollInterval
+			liveForkPollInterval = time.Millisecond
+			writeState(&hibernationInfo{
+				QEMUVersion:  qemuVersion,
+				MachineType:  machineType,
+				DomainName:   "default_sourcevmi",
+				MACAddresses: map[string]string{"default": sourceMAC},
+			})
+		})
+
+		AfterEach(func() {
+			liveForkPollInterval = origLiveForkPollInterval
+		})
+
+		It("should restore with the MAC addresses of the source, then swap the interfaces and reset the identity", func() {
+			withoutInterface := fmt.Sprintf(`<domain type="kvm"><name>default_testvmi</name><os><type machine="%s">hvm</type></os></domain>`, machineType)
+			expectDomainXML(forkDomainXML(forkMAC), forkDomainXML(forkMAC), forkDomainXML(sourceMAC), withoutInterface)
+			expectRestoreWithSourceMAC()
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName("default_testvmi").Return(mockLibvirt.VirtDomain, nil)
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().DetachDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).DoAndReturn(
+				func(ifaceXML string, _ libvirt.DomainDeviceModifyFlags) error {
+					Expect(ifaceXML).To(ContainSubstring(sourceMAC))
+					return nil
+				})
+			mockLibvirt.DomainEXPECT().AttachDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).DoAndReturn(
+				func(ifaceXML string, _ libvirt.DomainDeviceModifyFlags) error {
+					Expect(ifaceXML).To(ContainSubstring(forkMAC))
+					Expect(ifaceXML).To(ContainSubstring(`<alias name="ua-default">`))
+					return nil
+				})
+
+			var agentConnected, commandExited atomic.Bool
+			mockLibvirt.ConnectionEXPECT().QemuAgentCommand(gomock.Any(), "default_testvmi").DoAndReturn(func(command, _ string) (string, error) {
+				switch {
+				case strings.Contains(command, "guest-exec-status"):
+					commandExited.Store(true)
+					return `{"return":{"exited":true,"exitcode":0}}`, nil
+				case !agentConnected.Swap(true):
+					return "", fmt.Errorf("guest agent is not connected")
+				default:
+					Expect(command).To(ContainSubstring(`"path":"/usr/local/bin/reset-identity"`))
+					Expect(command).To(ContainSubstring(`"arg":["--hostname"]`))
+					return `{"return":{"pid":42}}`, nil
+				}
+			}).Times(3)
+
+			restored, err := manager.restoreHibernatedDomain(restoreForkVMI(), mockLibvirt.VirtDomain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeTrue())
+			expectStateRemoved()
+
+			Eventually(commandExited.Load).Should(BeTrue())
+			Consistently(hibernationMessage).Should(BeEmpty())
+		})
+
+		It("should report a failed interface swap", func() {
+			expectDomainXML(forkDomainXML(forkMAC), forkDomainXML(forkMAC), forkDomainXML(sourceMAC))
+			expectRestoreWithSourceMAC()
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName("default_testvmi").Return(mockLibvirt.VirtDomain, nil)
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().DetachDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).Return(fmt.Errorf("device busy"))
+
+			restored, err := manager.restoreHibernatedDomain(restoreForkVMI(), mockLibvirt.VirtDomain)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeTrue())
+
+			Eventually(hibernationMessage).Should(ContainSubstring("failed to detach interface default: device busy"))
+			hibernationMetadata, _ := manager.metadataCache.Hibernation.Load()
+			Expect(hibernationMetadata.Phase).To(Equal(v1.HibernationRestored))
+		})
+
+		It("should keep the state of a source with other interfaces", func() {
+			writeState(&hibernationInfo{
+				QEMUVersion:  qemuVersion,
+				MachineType:  machineType,
+				DomainName:   "default_sourcevmi",
+				MACAddresses: map[string]string{"other": sourceMAC},
+			})
+			expectDomainXML(forkDomainXML(forkMAC))
+			mockLibvirt.ConnectionEXPECT().GetQemuVersion().Return(qemuVersion, nil)
+
+			restored, err := manager.restoreHibernatedDomain(restoreForkVMI(), mockLibvirt.VirtDomain)
+			Expect(err).To(MatchError(ContainSubstring("the interface default is not part of the state saved from default_sourcevmi")))
+			Expect(restored).To(BeFalse())
+			Expect(hibernationStateFile).To(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	ps "github.com/mitchellh/go-ps"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var (
+	// These are vars so they can be changed by the unit tests
+	procPath      = "/proc"
+	listProcesses = ps.Processes
+)
+
+var qemuProcessExecutablePrefixes = []string{"qemu-system", "qemu-kvm"}
+
+func findQEMUPid() (int, error) {
+	processes, err := listProcesses()
+	if err != nil {
+		return 0, err
+	}
+	for _, process := range processes {
+		for _, prefix := range qemuProcessExecutablePrefixes {
+			if strings.HasPrefix(process.Executable(), prefix) {
+				return process.Pid(), nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no QEMU process found")
+}
+
+// setKSMStats adds to the memory stats how much guest memory KSM merged and how much memory it saved,
+// net of its own metadata, in KiB. The KSM stats of a process are only exposed since Linux 6.1,
+// on older kernels they are left unset.
+func setKSMStats(memory *stats.DomainStatsMemory) {
+	pid, err := findQEMUPid()
+	if err != nil {
+		log.Log.Reason(err).V(4).Info("failed to find the QEMU process for the KSM stats")
+		return
+	}
+
+	f, err := os.Open(filepath.Join(procPath, strconv.Itoa(pid), "ksm_stat"))
+	if err != nil {
+		log.Log.Reason(err).V(4).Info("KSM stats are not available")
+		return
+	}
+	defer f.Close()
+
+	pageSizeKiB := uint64(os.Getpagesize() / 1024)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		switch fields[0] {
+		case "ksm_merging_pages":
+			if pages, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+				memory.KSMMergedSet = true
+				memory.KSMMerged = pages * pageSizeKiB
+			}
+		case "ksm_process_profit":
+			// In bytes, negative when the metadata outweighs the merged pages
+			if profit, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+				memory.KSMProfitSet = true
+				memory.KSMProfit = profit / 1024
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"os"
+	"path/filepath"
+
+	ps "github.com/mitchellh/go-ps"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+type fakeProcess struct {
+	pid        int
+	executable string
+}
+
+func (p fakeProcess) Pid() int           { return p.pid }
+func (p fakeProcess) PPid() int          { return 1 }
+func (p fakeProcess) Executable() string { return p.executable }
+
+var _ = Describe("setKSMStats", func() {
+	const qemuPid = 42
+
+	var origProcPath string
+	var origListProcesses func() ([]ps.Process, error)
+
+	BeforeEach(func() {
+		origProcPath = procPath
+		origListProcesses = listProcesses
+		procPath = GinkgoT().TempDir()
+		listProcesses = func() ([]ps.Process, error) {
+			return []ps.Process{
+				fakeProcess{pid: 1, executable: "virt-launcher-monitor"},
+				fakeProcess{pid: 12, executable: "virtqemud"},
+				fakeProcess{pid: qemuPid, executable: "qemu-kvm"},
+			}, nil
+		}
+		DeferCleanup(func() {
+			procPath = origProcPath
+			listProcesses = origListProcesses
+		})
+	})
+
+	writeKSMStat := func(content string) {
+		Expect(os.MkdirAll(filepath.Join(procPath, "42"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(procPath, "42", "ksm_stat"), []byte(content), 0644)).To(Succeed())
+	}
+
+	It("should report the merged guest memory and the profit of the QEMU process", func() {
+		writeKSMStat("ksm_rmap_items 512\nksm_zero_pages 0\nksm_merging_pages 256\nksm_process_profit -20480\nksm_merge_any: no\nksm_mergeable: yes\n")
+
+		memory := &stats.DomainStatsMemory{}
+		setKSMStats(memory)
+		Expect(memory.KSMMergedSet).To(BeTrue())
+		Expect(memory.KSMMerged).To(Equal(uint64(256 * os.Getpagesize() / 1024)))
+		Expect(memory.KSMProfitSet).To(BeTrue())
+		Expect(memory.KSMProfit).To(Equal(int64(-20)))
+	})
+
+	It("should only report the stats the kernel exposes", func() {
+		writeKSMStat("ksm_rmap_items 512\n")
+
+		memory := &stats.DomainStatsMemory{}
+		setKSMStats(memory)
+		Expect(memory.KSMMergedSet).To(BeFalse())
+		Expect(memory.KSMProfitSet).To(BeFalse())
+	})
+
+	It("should not report the stats without a QEMU process", func() {
+		listProcesses = func() ([]ps.Process, error) {
+			return []ps.Process{fakeProcess{pid: 1, executable: "virt-launcher-monitor"}}, nil
+		}
+		writeKSMStat("ksm_merging_pages 256\n")
+
+		memory := &stats.DomainStatsMemory{}
+		setKSMStats(memory)
+		Expect(memory.KSMMergedSet).To(BeFalse())
+	})
+})
@@ -0,0 +1,227 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launchsecurity
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// Guest policy bits as defined in the AMD SEV-SNP firmware ABI specification
+	SEVSNPPolicySMT          uint64 = 1 << 16
+	SEVSNPPolicyReserved     uint64 = 1 << 17
+	SEVSNPPolicyMigrateMA    uint64 = 1 << 18
+	SEVSNPPolicyDebug        uint64 = 1 << 19
+	SEVSNPPolicySingleSocket uint64 = 1 << 20
+)
+
+const (
+	// Sizes of the launch parameters of a SEV-SNP guest
+	SEVSNPIDBlockSize  = 96
+	SEVSNPIDAuthSize   = 4096
+	SEVSNPHostDataSize = 32
+)
+
+const (
+	// Processor product lines as named by the AMD Key Distribution Service
+	SEVSNPProductMilan = "Milan"
+	SEVSNPProductGenoa = "Genoa"
+	SEVSNPProductTurin = "Turin"
+)
+
+func SEVSNPPolicyToBits(policy *v1.SEVPolicy) uint64 {
+	// SMT is allowed and the reserved bit must be set, debugging is always off
+	bits := SEVSNPPolicySMT | SEVSNPPolicyReserved
+
+	if policy != nil && policy.SingleSocket != nil && *policy.SingleSocket {
+		bits |= SEVSNPPolicySingleSocket
+	}
+
+	return bits
+}
+
+// SEVSNPProduct identifies the processor product line from the contents of /proc/cpuinfo
+func SEVSNPProduct(cpuinfo io.Reader) (string, error) {
+	family, model := int64(-1), int64(-1)
+	scanner := bufio.NewScanner(cpuinfo)
+	for scanner.Scan() && (family < 0 || model < 0) {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		var err error
+		switch strings.TrimSpace(key) {
+		case "cpu family":
+			family, err = strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		case "model":
+			model, err = strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to parse cpuinfo: %v", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	switch {
+	case family == 0x19 && model >= 0x00 && model <= 0x0f:
+		return SEVSNPProductMilan, nil
+	case family == 0x19 && (model >= 0x10 && model <= 0x1f || model >= 0xa0 && model <= 0xaf):
+		return SEVSNPProductGenoa, nil
+	case family == 0x1a:
+		return SEVSNPProductTurin, nil
+	}
+	return "", fmt.Errorf("processor family 0x%x model 0x%x does not support SEV-SNP", family, model)
+}
+
+// SEV firmware commands issued through /dev/sev, see include/uapi/linux/psp-sev.h
+const (
+	SEVDevice = "/dev/sev"
+
+	sevIssueCmd          = 0xc0105300
+	sevSNPPlatformStatus = 9
+	sevSNPStatusSize     = 32
+	sevSNPReportedTCBOff = 24
+)
+
+// GetSEVSNPReportedTCB reads the TCB version the platform reports to its SEV-SNP guests
+func GetSEVSNPReportedTCB(devicePath string) (uint64, error) {
+	dev, err := os.Open(devicePath)
+	if err != nil {
+		return 0, err
+	}
+	defer dev.Close()
+
+	status := make([]byte, sevSNPStatusSize)
+	// struct sev_issue_cmd is packed: u32 cmd, u64 data, u32 error
+	cmd := make([]byte, 16)
+	binary.LittleEndian.PutUint32(cmd[0:], sevSNPPlatformStatus)
+	binary.LittleEndian.PutUint64(cmd[4:], uint64(uintptr(unsafe.Pointer(&status[0]))))
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, dev.Fd(), sevIssueCmd, uintptr(unsafe.Pointer(&cmd[0])))
+	runtime.KeepAlive(status)
+	if errno != 0 {
+		return 0, fmt.Errorf("SNP_PLATFORM_STATUS failed: %v, firmware error 0x%x", errno, binary.LittleEndian.Uint32(cmd[12:]))
+	}
+
+	return binary.LittleEndian.Uint64(status[sevSNPReportedTCBOff:]), nil
+}
+
+// VCEKURL returns where the VCEK of the given chip and TCB version can be fetched from
+func VCEKURL(baseURL, product, chipID string, tcb uint64) (string, error) {
+	spl := func(shift uint) uint64 { return (tcb >> shift) & 0xff }
+	switch product {
+	case SEVSNPProductMilan, SEVSNPProductGenoa:
+		return fmt.Sprintf("%s/vcek/v1/%s/%s?blSPL=%d&teeSPL=%d&snpSPL=%d&ucodeSPL=%d",
+			baseURL, product, chipID, spl(0), spl(8), spl(48), spl(56)), nil
+	case SEVSNPProductTurin:
+		// Turin chips are identified by the first 8 bytes of their ID only
+		if len(chipID) > 16 {
+			chipID = chipID[:16]
+		}
+		return fmt.Sprintf("%s/vcek/v1/%s/%s?fmcSPL=%d&blSPL=%d&teeSPL=%d&snpSPL=%d&ucodeSPL=%d",
+			baseURL, product, chipID, spl(0), spl(8), spl(16), spl(24), spl(56)), nil
+	}
+	return "", fmt.Errorf("unknown SEV-SNP product %q", product)
+}
+
+const kdsTimeout = 30 * time.Second
+
+// VCEKFetcher fetches VCEK certificate chains from the AMD Key Distribution Service or a mirror of it.
+// Fetched certificates are cached, the service rate limits its clients.
+type VCEKFetcher struct {
+	client *http.Client
+
+	lock  sync.Mutex
+	cache map[string]string
+}
+
+func NewVCEKFetcher(client *http.Client) *VCEKFetcher {
+	if client == nil {
+		client = &http.Client{Timeout: kdsTimeout}
+	}
+	return &VCEKFetcher{
+		client: client,
+		cache:  map[string]string{},
+	}
+}
+
+// FetchChain returns the PEM encoded VCEK followed by the ASK and ARK of the product line, as served from baseURL
+func (f *VCEKFetcher) FetchChain(baseURL, product, chipID string, reportedTCB uint64) (string, error) {
+	vcekURL, err := VCEKURL(baseURL, product, chipID, reportedTCB)
+	if err != nil {
+		return "", err
+	}
+	vcek, err := f.fetch(vcekURL, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the VCEK: %v", err)
+	}
+	chain, err := f.fetch(fmt.Sprintf("%s/vcek/v1/%s/cert_chain", baseURL, product), false)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the certificate chain: %v", err)
+	}
+	return vcek + chain, nil
+}
+
+func (f *VCEKFetcher) fetch(url string, der bool) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if cert, ok := f.cache[url]; ok {
+		return cert, nil
+	}
+
+	resp, err := f.client.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	cert := string(body)
+	if der {
+		// The VCEK is served DER encoded
+		cert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: body}))
+	}
+	f.cache[url] = cert
+	return cert, nil
+}
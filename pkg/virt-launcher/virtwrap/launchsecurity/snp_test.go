@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launchsecurity_test
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+)
+
+var _ = Describe("LaunchSecurity: AMD Secure Nested Paging (SEV-SNP)", func() {
+	const defaultPolicy = launchsecurity.SEVSNPPolicySMT | launchsecurity.SEVSNPPolicyReserved
+
+	DescribeTable("SEV-SNP policy conversion", func(policy *v1.SEVPolicy, expected uint64) {
+		Expect(launchsecurity.SEVSNPPolicyToBits(policy)).To(Equal(expected))
+	},
+		Entry("should allow SMT by default", nil, defaultPolicy),
+		Entry("should allow SMT when unset", &v1.SEVPolicy{SecureNestedPaging: pointer.P(true)}, defaultPolicy),
+		Entry("should restrict to a single socket when requested",
+			&v1.SEVPolicy{SecureNestedPaging: pointer.P(true), SingleSocket: pointer.P(true)},
+			defaultPolicy|launchsecurity.SEVSNPPolicySingleSocket),
+		Entry("should not restrict to a single socket when disabled",
+			&v1.SEVPolicy{SecureNestedPaging: pointer.P(true), SingleSocket: pointer.P(false)}, defaultPolicy),
+	)
+
+	DescribeTable("product detection", func(family, model string, expected string) {
+		cpuinfo := "processor\t: 0\nvendor_id\t: AuthenticAMD\ncpu family\t: " + family +
+			"\nmodel\t\t: " + model + "\nmodel name\t: AMD EPYC\n"
+		Expect(launchsecurity.SEVSNPProduct(strings.NewReader(cpuinfo))).To(Equal(expected))
+	},
+		Entry("should detect Milan", "25", "1", launchsecurity.SEVSNPProductMilan),
+		Entry("should detect Genoa", "25", "17", launchsecurity.SEVSNPProductGenoa),
+		Entry("should detect Bergamo as Genoa", "25", "160", launchsecurity.SEVSNPProductGenoa),
+		Entry("should detect Turin", "26", "2", launchsecurity.SEVSNPProductTurin),
+	)
+
+	It("should fail to detect processors without SEV-SNP", func() {
+		cpuinfo := "cpu family\t: 23\nmodel\t\t: 49\n"
+		_, err := launchsecurity.SEVSNPProduct(strings.NewReader(cpuinfo))
+		Expect(err).To(MatchError(ContainSubstring("does not support SEV-SNP")))
+	})
+
+	DescribeTable("VCEK URL", func(product, chipID string, expected string) {
+		const tcb = uint64(0x1b_16_00_00_00_00_04_03)
+		Expect(launchsecurity.VCEKURL("https://kds", product, chipID, tcb)).To(Equal(expected))
+	},
+		Entry("should encode the TCB of Milan", launchsecurity.SEVSNPProductMilan, "abcd",
+			"https://kds/vcek/v1/Milan/abcd?blSPL=3&teeSPL=4&snpSPL=22&ucodeSPL=27"),
+		Entry("should encode the TCB of Genoa", launchsecurity.SEVSNPProductGenoa, "abcd",
+			"https://kds/vcek/v1/Genoa/abcd?blSPL=3&teeSPL=4&snpSPL=22&ucodeSPL=27"),
+		Entry("should encode the TCB and shorten the chip ID of Turin", launchsecurity.SEVSNPProductTurin, strings.Repeat("ab", 64),
+			"https://kds/vcek/v1/Turin/abababababababab?fmcSPL=3&blSPL=4&teeSPL=0&snpSPL=0&ucodeSPL=27"),
+	)
+
+	Context("VCEK chain retrieval", func() {
+		const chain = "-----BEGIN CERTIFICATE-----\nASK\n-----END CERTIFICATE-----\n"
+
+		var (
+			server   *httptest.Server
+			requests []string
+			vcek     = []byte{0x30, 0x82, 0x01, 0x02}
+		)
+
+		BeforeEach(func() {
+			requests = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.URL.Path)
+				switch r.URL.Path {
+				case "/vcek/v1/Milan/abcd":
+					_, _ = w.Write(vcek)
+				case "/vcek/v1/Milan/cert_chain":
+					_, _ = w.Write([]byte(chain))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("should return the PEM encoded VCEK followed by the chain and cache them", func() {
+			fetcher := launchsecurity.NewVCEKFetcher(server.Client())
+
+			for range 2 {
+				res, err := fetcher.FetchChain(server.URL, launchsecurity.SEVSNPProductMilan, "abcd", 0)
+				Expect(err).ToNot(HaveOccurred())
+				block, rest := pem.Decode([]byte(res))
+				Expect(block).ToNot(BeNil())
+				Expect(block.Bytes).To(Equal(vcek))
+				Expect(string(rest)).To(Equal(chain))
+			}
+			Expect(requests).To(Equal([]string{"/vcek/v1/Milan/abcd", "/vcek/v1/Milan/cert_chain"}))
+		})
+
+		It("should fail when the VCEK is unknown", func() {
+			fetcher := launchsecurity.NewVCEKFetcher(server.Client())
+
+			_, err := fetcher.FetchChain(server.URL, launchsecurity.SEVSNPProductMilan, "ffff", 0)
+			Expect(err).To(MatchError(ContainSubstring("failed to fetch the VCEK")))
+		})
+	})
+})
@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launchsecurity
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// TD attributes as defined in the Intel TDX module specification
+	TDXPolicyDebug         uint64 = 1 << 0
+	TDXPolicySEPTVEDisable uint64 = 1 << 28
+)
+
+const (
+	// TDXQuoteGenerationServiceDir holds the socket of the quote generation service of the node
+	TDXQuoteGenerationServiceDir    = "/var/run/tdx-qgs"
+	TDXQuoteGenerationServiceSocket = TDXQuoteGenerationServiceDir + "/qgs.socket"
+
+	// TDXReportSize is the size of a TDREPORT_STRUCT generated by the guest
+	TDXReportSize = 1024
+)
+
+func TDXPolicyToBits(policy *v1.TDXPolicy) uint64 {
+	// Debug is always off, SEPT_VE_DISABLE is on unless disabled explicitly
+	bits := TDXPolicySEPTVEDisable
+
+	if policy != nil && policy.SEPTVEDisable != nil && !*policy.SEPTVEDisable {
+		bits &^= TDXPolicySEPTVEDisable
+	}
+
+	return bits
+}
+
+// Messages of the quote generation service, see qgs_msg_lib.h of the Intel SGX DCAP
+const (
+	qgsMsgMajorVersion = 1
+	qgsMsgMinorVersion = 0
+	qgsMsgGetQuoteReq  = 0
+	qgsMsgGetQuoteResp = 1
+
+	// qgsMaxMsgSize bounds the responses read from the service, quotes are a few KiB
+	qgsMaxMsgSize = 1 << 20
+	qgsTimeout    = 30 * time.Second
+)
+
+type qgsMsgHeader struct {
+	MajorVersion uint16
+	MinorVersion uint16
+	Type         uint32
+	Size         uint32
+	ErrorCode    uint32
+}
+
+type qgsGetQuoteReq struct {
+	Header     qgsMsgHeader
+	ReportSize uint32
+	IDListSize uint32
+}
+
+type qgsGetQuoteResp struct {
+	Header         qgsMsgHeader
+	SelectedIDSize uint32
+	QuoteSize      uint32
+}
+
+// GetTDXQuote asks the quote generation service listening on socketPath to sign the TD report of a guest
+func GetTDXQuote(socketPath string, report []byte) ([]byte, error) {
+	if len(report) != TDXReportSize {
+		return nil, fmt.Errorf("TD report must be %d bytes, got %d", TDXReportSize, len(report))
+	}
+
+	conn, err := net.DialTimeout("unix", socketPath, qgsTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the quote generation service: %v", err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(qgsTimeout)); err != nil {
+		return nil, err
+	}
+
+	if err := writeQGSMessage(conn, encodeGetQuoteRequest(report)); err != nil {
+		return nil, fmt.Errorf("failed to send the quote request: %v", err)
+	}
+	msg, err := readQGSMessage(conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the quote response: %v", err)
+	}
+	return decodeGetQuoteResponse(msg)
+}
+
+func encodeGetQuoteRequest(report []byte) []byte {
+	req := qgsGetQuoteReq{
+		Header: qgsMsgHeader{
+			MajorVersion: qgsMsgMajorVersion,
+			MinorVersion: qgsMsgMinorVersion,
+			Type:         qgsMsgGetQuoteReq,
+			Size:         uint32(binary.Size(qgsGetQuoteReq{}) + len(report)),
+		},
+		ReportSize: uint32(len(report)),
+	}
+	buf := &bytes.Buffer{}
+	// writing to a bytes.Buffer does not fail
+	_ = binary.Write(buf, binary.LittleEndian, req)
+	buf.Write(report)
+	return buf.Bytes()
+}
+
+func decodeGetQuoteResponse(msg []byte) ([]byte, error) {
+	resp := qgsGetQuoteResp{}
+	if err := binary.Read(bytes.NewReader(msg), binary.LittleEndian, &resp); err != nil {
+		return nil, fmt.Errorf("malformed quote response: %v", err)
+	}
+	if resp.Header.MajorVersion != qgsMsgMajorVersion || resp.Header.Type != qgsMsgGetQuoteResp {
+		return nil, fmt.Errorf("unexpected quote response version %d type %d", resp.Header.MajorVersion, resp.Header.Type)
+	}
+	if resp.Header.ErrorCode != 0 {
+		return nil, fmt.Errorf("quote generation failed with error 0x%x", resp.Header.ErrorCode)
+	}
+
+	offset := uint64(binary.Size(resp)) + uint64(resp.SelectedIDSize)
+	if offset+uint64(resp.QuoteSize) > uint64(len(msg)) || resp.QuoteSize == 0 {
+		return nil, fmt.Errorf("malformed quote response: quote of %d bytes does not fit into %d bytes", resp.QuoteSize, len(msg))
+	}
+	return msg[offset : offset+uint64(resp.QuoteSize)], nil
+}
+
+// Messages are framed by their size as a big endian uint32
+func writeQGSMessage(w io.Writer, msg []byte) error {
+	frame := make([]byte, 4, 4+len(msg))
+	binary.BigEndian.PutUint32(frame, uint32(len(msg)))
+	_, err := w.Write(append(frame, msg...))
+	return err
+}
+
+func readQGSMessage(r io.Reader) ([]byte, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+	if size > qgsMaxMsgSize {
+		return nil, fmt.Errorf("message of %d bytes exceeds the limit of %d bytes", size, qgsMaxMsgSize)
+	}
+	msg := make([]byte, size)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launchsecurity_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+)
+
+var _ = Describe("LaunchSecurity: Intel Trust Domain Extensions (TDX)", func() {
+	DescribeTable("TDX policy conversion", func(policy *v1.TDXPolicy, expected uint64) {
+		Expect(launchsecurity.TDXPolicyToBits(policy)).To(Equal(expected))
+	},
+		Entry("should disable SEPT #VE by default", nil, launchsecurity.TDXPolicySEPTVEDisable),
+		Entry("should disable SEPT #VE when unset", &v1.TDXPolicy{}, launchsecurity.TDXPolicySEPTVEDisable),
+		Entry("should disable SEPT #VE when requested", &v1.TDXPolicy{SEPTVEDisable: pointer.P(true)}, launchsecurity.TDXPolicySEPTVEDisable),
+		Entry("should enable SEPT #VE when requested", &v1.TDXPolicy{SEPTVEDisable: pointer.P(false)}, uint64(0)),
+	)
+
+	Context("quote retrieval", func() {
+		const (
+			headerSize = 16
+			quote      = "signed-quote"
+			selectedID = "id"
+		)
+
+		var (
+			socketPath string
+			listener   net.Listener
+			report     []byte
+		)
+
+		readFrame := func(conn net.Conn) []byte {
+			var size uint32
+			ExpectWithOffset(1, binary.Read(conn, binary.BigEndian, &size)).To(Succeed())
+			msg := make([]byte, size)
+			_, err := io.ReadFull(conn, msg)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return msg
+		}
+
+		writeFrame := func(conn net.Conn, msg []byte) {
+			ExpectWithOffset(1, binary.Write(conn, binary.BigEndian, uint32(len(msg)))).To(Succeed())
+			_, err := conn.Write(msg)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		}
+
+		response := func(errorCode uint32, quoteSize uint32) []byte {
+			buf := &bytes.Buffer{}
+			for _, field := range []any{
+				uint16(1), uint16(0), uint32(1),
+				uint32(headerSize + 8 + len(selectedID) + len(quote)), errorCode,
+				uint32(len(selectedID)), quoteSize,
+			} {
+				Expect(binary.Write(buf, binary.LittleEndian, field)).To(Succeed())
+			}
+			buf.WriteString(selectedID)
+			buf.WriteString(quote)
+			return buf.Bytes()
+		}
+
+		serve := func(resp []byte) <-chan []byte {
+			requests := make(chan []byte, 1)
+			go func() {
+				defer GinkgoRecover()
+				conn, err := listener.Accept()
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+				requests <- readFrame(conn)
+				writeFrame(conn, resp)
+			}()
+			return requests
+		}
+
+		BeforeEach(func() {
+			var err error
+			socketPath = filepath.Join(GinkgoT().TempDir(), "qgs.socket")
+			listener, err = net.Listen("unix", socketPath)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(listener.Close)
+			report = bytes.Repeat([]byte{0xab}, launchsecurity.TDXReportSize)
+		})
+
+		It("should send the TD report and return the quote", func() {
+			requests := serve(response(0, uint32(len(quote))))
+
+			res, err := launchsecurity.GetTDXQuote(socketPath, report)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(res)).To(Equal(quote))
+
+			var req []byte
+			Eventually(requests).Should(Receive(&req))
+			Expect(req).To(HaveLen(headerSize + 8 + launchsecurity.TDXReportSize))
+			Expect(binary.LittleEndian.Uint16(req[0:])).To(BeEquivalentTo(1))
+			Expect(binary.LittleEndian.Uint32(req[4:])).To(BeEquivalentTo(0))
+			Expect(binary.LittleEndian.Uint32(req[8:])).To(BeEquivalentTo(len(req)))
+			Expect(binary.LittleEndian.Uint32(req[headerSize:])).To(BeEquivalentTo(launchsecurity.TDXReportSize))
+			Expect(binary.LittleEndian.Uint32(req[headerSize+4:])).To(BeEquivalentTo(0))
+			Expect(req[headerSize+8:]).To(Equal(report))
+		})
+
+		It("should fail when the service reports an error", func() {
+			serve(response(0x11, uint32(len(quote))))
+
+			_, err := launchsecurity.GetTDXQuote(socketPath, report)
+			Expect(err).To(MatchError(ContainSubstring("error 0x11")))
+		})
+
+		It("should fail when the quote exceeds the response", func() {
+			serve(response(0, uint32(len(quote)+1)))
+
+			_, err := launchsecurity.GetTDXQuote(socketPath, report)
+			Expect(err).To(MatchError(ContainSubstring("malformed quote response")))
+		})
+
+		It("should reject reports of the wrong size", func() {
+			_, err := launchsecurity.GetTDXQuote(socketPath, report[:64])
+			Expect(err).To(MatchError(ContainSubstring("TD report must be 1024 bytes")))
+		})
+	})
+})
@@ -31,7 +31,9 @@ import (
 	"libvirt.org/go/libvirtxml"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -88,6 +90,9 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	// migratedVolumeTargets maps the name of the migrated volumes to the target device of the disk
+	migratedVolumeTargets map[string]string
 }
 
 type inflightMigrationAborted struct {
@@ -709,6 +714,7 @@ func (m *migrationMonitor) startMonitor() {
 				m.l.setMigrationResult(true, aborted.message, aborted.abortStatus)
 				return
 			}
+			m.updateVolumeMigrationProgress(dom, migrationUID)
 			logInterval++
 			if logInterval%monitorLogInterval == 0 {
 				logMigrationInfo(logger, string(migrationUID), stats)
@@ -723,6 +729,85 @@ func (m *migrationMonitor) startMonitor() {
 	}
 }
 
+// updateVolumeMigrationProgress stores the progress of the copy of the migrated volumes in the metadata
+func (m *migrationMonitor) updateVolumeMigrationProgress(dom cli.VirDomain, migrationUID types.UID) {
+	if len(m.vmi.Status.MigratedVolumes) == 0 {
+		return
+	}
+	if m.migratedVolumeTargets == nil {
+		targets, err := getMigratedVolumeTargets(dom, m.vmi)
+		if err != nil {
+			log.Log.Object(m.vmi).Reason(err).Warning("failed to get the disks of the migrated volumes")
+			return
+		}
+		m.migratedVolumeTargets = targets
+	}
+
+	progress := &api.VolumeMigrationMetadata{UID: migrationUID}
+	for _, v := range m.vmi.Status.MigratedVolumes {
+		target, ok := m.migratedVolumeTargets[v.VolumeName]
+		if !ok {
+			continue
+		}
+		info, err := dom.GetBlockJobInfo(target, 0)
+		if err != nil {
+			log.Log.Object(m.vmi).Reason(err).V(4).Infof("failed to get the block job info for volume %s", v.VolumeName)
+			continue
+		}
+		// The copy of the disk hasn't started yet
+		if info == nil || info.End == 0 {
+			continue
+		}
+		progress.Volumes = append(progress.Volumes, api.VolumeMigrationProgress{
+			Name:           v.VolumeName,
+			TotalBytes:     int64(info.End),
+			ProcessedBytes: int64(info.Cur),
+			Completed:      info.Cur == info.End,
+		})
+	}
+
+	m.l.metadataCache.VolumeMigration.WithSafeBlock(func(volumeMigrationMetadata **api.VolumeMigrationMetadata, _ bool) {
+		if !equality.Semantic.DeepEqual(*volumeMigrationMetadata, progress) {
+			*volumeMigrationMetadata = progress
+		}
+	})
+}
+
+// setVolumeMigrationCompleted marks the copy of all the migrated volumes as completed
+func (l *LibvirtDomainManager) setVolumeMigrationCompleted() {
+	volumeMigrationMetadata, exists := l.metadataCache.VolumeMigration.Load()
+	if !exists || volumeMigrationMetadata == nil {
+		return
+	}
+	progress := volumeMigrationMetadata.DeepCopy()
+	for i := range progress.Volumes {
+		progress.Volumes[i].ProcessedBytes = progress.Volumes[i].TotalBytes
+		progress.Volumes[i].Completed = true
+	}
+	l.metadataCache.VolumeMigration.Store(progress)
+}
+
+func getMigratedVolumeTargets(dom cli.VirDomain, vmi *v1.VirtualMachineInstance) (map[string]string, error) {
+	migratedVols := make(map[string]bool)
+	for _, v := range vmi.Status.MigratedVolumes {
+		migratedVols[v.VolumeName] = true
+	}
+	disks, err := getAllDomainDisks(dom)
+	if err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string)
+	for _, disk := range disks {
+		if disk.Alias == nil {
+			continue
+		}
+		if name := disk.Alias.GetName(); migratedVols[name] {
+			targets[name] = disk.Target.Device
+		}
+	}
+	return targets, nil
+}
+
 // logMigrationInfo logs the same migration info as `virsh -r domjobinfo`
 func logMigrationInfo(logger *log.FilteredLogger, uid string, info *libvirt.DomainJobInfo) {
 	bToMiB := func(bytes uint64) uint64 {
@@ -965,6 +1050,7 @@ func configureLocalDiskToMigrate(dom *libvirtxml.Domain, vmi *v1.VirtualMachineI
 	migDisks := classifyVolumesForMigration(vmi)
 	fsSrcBlockDstVols := getFsSrcBlockDstVols(vmi)
 	blockSrcFsDstVols := getBlockSrcFsDstVols(vmi)
+	nonPVCSrcVols := getNonPVCSrcVols(vmi)
 	hotplugVols := make(map[string]bool)
 
 	for _, v := range vmi.Spec.Volumes {
@@ -1025,11 +1111,49 @@ func configureLocalDiskToMigrate(dom *libvirtxml.Domain, vmi *v1.VirtualMachineI
 			}
 			dom.Devices.Disks[i].Source.Block = nil
 		}
+		if migVol, ok := nonPVCSrcVols[name]; ok {
+			configureNonPVCSrcDiskToMigrate(&dom.Devices.Disks[i], migVol)
+		}
 	}
 
 	return nil
 }
 
+// getNonPVCSrcVols returns the migrated volumes whose source isn't backed by a PVC, like hostDisks and containerDisks
+func getNonPVCSrcVols(vmi *v1.VirtualMachineInstance) map[string]*v1.StorageMigratedVolumeInfo {
+	res := make(map[string]*v1.StorageMigratedVolumeInfo)
+	for i, v := range vmi.Status.MigratedVolumes {
+		if v.SourceVolume == nil || v.DestinationPVCInfo == nil {
+			continue
+		}
+		res[v.VolumeName] = &vmi.Status.MigratedVolumes[i]
+	}
+	return res
+}
+
+// configureNonPVCSrcDiskToMigrate points the disk to the destination PVC. The destination is always a raw image, hence the
+// backing chain of the source, like the image of a containerDisk, is flattened into the destination during the copy.
+func configureNonPVCSrcDiskToMigrate(disk *libvirtxml.DomainDisk, migVol *v1.StorageMigratedVolumeInfo) {
+	name := migVol.VolumeName
+	if storagetypes.IsPVCBlock(migVol.DestinationPVCInfo.VolumeMode) {
+		log.Log.V(2).Infof("Replace source with block destination for volume %s", name)
+		disk.Source.Block = &libvirtxml.DomainDiskSourceBlock{
+			Dev: filepath.Join(string(filepath.Separator), "dev", name),
+		}
+		disk.Source.File = nil
+	} else {
+		log.Log.V(2).Infof("Replace source with filesystem destination for volume %s", name)
+		disk.Source.File = &libvirtxml.DomainDiskSourceFile{
+			File: filepath.Join(hostdisk.GetMountedHostDiskDir(name), "disk.img"),
+		}
+		disk.Source.Block = nil
+	}
+	if disk.Driver != nil {
+		disk.Driver.Type = "raw"
+	}
+	disk.BackingStore = nil
+}
+
 func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) error {
 
 	var err error
@@ -1089,6 +1213,7 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 	}
 
 	log.Log.Object(vmi).Errorf("migration completed successfully")
+	l.setVolumeMigrationCompleted()
 	l.setMigrationResult(false, "", "")
 
 	return nil
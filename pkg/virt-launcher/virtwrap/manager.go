@@ -28,6 +28,8 @@ package virtwrap
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -96,6 +98,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/sriov"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 	virtcache "kubevirt.io/kubevirt/tools/cache"
@@ -152,13 +155,23 @@ type DomainManager interface {
 	GetGuestOSInfo() *api.GuestOSInfo
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
+	GuestFileOpen(domainName, path, mode string) (int64, error)
+	GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error)
+	GuestFileWrite(domainName string, handle int64, data []byte) error
+	GuestFileClose(domainName string, handle int64) error
+	GuestExecStart(domainName, command string, args []string) (int64, error)
+	GuestExecStatus(domainName string, pid int64) (*agent.ExecStatus, error)
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	HibernateVMI(*v1.VirtualMachineInstance) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
+	GetTDXQuote(*v1.VirtualMachineInstance, []byte) (*v1.TDXQuoteInfo, error)
+	GetSEVSNPAttestationInfo(*v1.VirtualMachineInstance) (*v1.SEVSNPAttestationInfo, error)
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
+	SetGuestMemoryTarget(domainName string, targetKiB uint64) error
 	GetDomainDirtyRateStats(calculationDuration time.Duration) (*stats.DomainStatsDirtyRate, error)
 }
 
@@ -169,11 +182,18 @@ type LibvirtDomainManager struct {
 	domainModifyLock sync.Mutex
 	// mutex to control access to the guest time context
 	setGuestTimeLock sync.Mutex
+	// serializes the filesystem grows in the guest
+	filesystemGrowLock sync.Mutex
+	// protects filesystemGrowResults
+	filesystemGrowResultsLock sync.Mutex
+	// result of the last filesystem grow of each disk
+	filesystemGrowResults map[string]api.FilesystemGrowMetadata
 
 	credManager *accesscredentials.AccessCredentialManager
 
 	hotplugHostDevicesInProgress chan struct{}
 	memoryDumpInProgress         chan struct{}
+	hibernationInProgress        chan struct{}
 
 	virtShareDir             string
 	ephemeralDiskDir         string
@@ -255,6 +275,7 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
 	manager.memoryDumpInProgress = make(chan struct{}, maxConcurrentMemoryDumps)
+	manager.hibernationInProgress = make(chan struct{}, maxConcurrentHibernations)
 	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache)
 
 	reCalcDomainStats := func() (*stats.DomainStats, error) {
@@ -267,7 +288,15 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 			return nil, nil
 		}
 
-		return list[0], nil
+		domainStats := list[0]
+		if domainStats.Memory != nil {
+			setKSMStats(domainStats.Memory)
+		}
+		if manager.agentData != nil {
+			domainStats.GuestCPU = manager.agentData.GetCPUStats()
+			domainStats.GuestDisk = manager.agentData.GetDiskStats()
+		}
+		return domainStats, nil
 	}
 
 	var err error
@@ -372,6 +401,23 @@ func (l *LibvirtDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+// SetGuestMemoryTarget inflates or deflates the memory balloon of the running domain,
+// so that the guest is left with targetKiB of memory
+func (l *LibvirtDomainManager) SetGuestMemoryTarget(domainName string, targetKiB uint64) error {
+	const errMsgPrefix = "failed to set the guest memory target"
+
+	dom, err := l.virConn.LookupDomainByName(domainName)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	defer dom.Free()
+
+	if err := dom.SetMemoryFlags(targetKiB, libvirt.DOMAIN_MEM_LIVE); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	return nil
+}
+
 func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) {
 	// Try to set VM time to the current value.  This is typically useful
 	// when clock wasn't running on the VM for some time (e.g. during
@@ -636,6 +682,30 @@ func (l *LibvirtDomainManager) GuestPing(domainName string) error {
 	return err
 }
 
+func (l *LibvirtDomainManager) GuestFileOpen(domainName, path, mode string) (int64, error) {
+	return agent.GuestFileOpen(l.virConn, domainName, path, mode)
+}
+
+func (l *LibvirtDomainManager) GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error) {
+	return agent.GuestFileRead(l.virConn, domainName, handle, int(count))
+}
+
+func (l *LibvirtDomainManager) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	return agent.GuestFileWrite(l.virConn, domainName, handle, data)
+}
+
+func (l *LibvirtDomainManager) GuestFileClose(domainName string, handle int64) error {
+	return agent.GuestFileClose(l.virConn, domainName, handle)
+}
+
+func (l *LibvirtDomainManager) GuestExecStart(domainName, command string, args []string) (int64, error) {
+	return agent.GuestExecStart(l.virConn, domainName, command, args)
+}
+
+func (l *LibvirtDomainManager) GuestExecStatus(domainName string, pid int64) (*agent.ExecStatus, error) {
+	return agent.GuestExecStatus(l.virConn, domainName, pid)
+}
+
 func getVMIEphemeralDisksTotalSize(ephemeralDiskDir string) *resource.Quantity {
 	totalSize := int64(0)
 	err := filepath.Walk(ephemeralDiskDir, func(path string, f os.FileInfo, err error) error {
@@ -1019,7 +1089,18 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 
 		_, existInCache := l.disksInfo[volume.Name]
-		if volume.ContainerDisk != nil && !existInCache {
+		if containerdisk.IsLayered(volume.ContainerDisk) && !existInCache {
+			topPath, err := containerdisk.ResolveLayeredImage(containerdisk.GetLayeredDiskTargetDirFromLauncherView(diskIndex), volume.ContainerDisk.Path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find the top of the layered containerDisk %v: %v", volume.Name, err)
+			}
+			info, err := containerdisk.VerifyLayeredImage(topPath, l.diskMemoryLimitBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid layered image in containerDisk %v: %v", volume.Name, err)
+			}
+			info.Filename = topPath
+			l.disksInfo[volume.Name] = info
+		} else if volume.ContainerDisk != nil && !existInCache {
 			info, err := osdisk.GetDiskInfoWithValidation(containerdisk.GetDiskTargetPathFromLauncherView(diskIndex), l.diskMemoryLimitBytes)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get container disk info: %v", err)
@@ -1032,7 +1113,27 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 	}
 
 	var efiConf *converter.EFIConfiguration
-	if vmi.IsBootloaderEFI() {
+	if vmi.IsBootloaderEFI() && kutil.IsTDXVMI(vmi) {
+		if !l.efiEnvironment.BootableTDX() {
+			log.Log.Errorf("EFI OVMF rom %s missing for booting with TDX", efi.EFICodeTDX)
+			return nil, fmt.Errorf("EFI OVMF rom %s missing for booting with TDX", efi.EFICodeTDX)
+		}
+
+		efiConf = &converter.EFIConfiguration{
+			EFICode:   l.efiEnvironment.EFICodeTDX(),
+			Stateless: true,
+		}
+	} else if vmi.IsBootloaderEFI() && kutil.IsSEVSNPVMI(vmi) {
+		if !l.efiEnvironment.BootableSNP() {
+			log.Log.Errorf("EFI OVMF rom %s missing for booting with SEV-SNP", efi.EFICodeSNP)
+			return nil, fmt.Errorf("EFI OVMF rom %s missing for booting with SEV-SNP", efi.EFICodeSNP)
+		}
+
+		efiConf = &converter.EFIConfiguration{
+			EFICode:   l.efiEnvironment.EFICodeSNP(),
+			Stateless: true,
+		}
+	} else if vmi.IsBootloaderEFI() {
 		secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
 		sev := kutil.IsSEVVMI(vmi)
 
@@ -1046,6 +1147,14 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, sev),
 			SecureLoader: secureBoot,
 		}
+
+		if kutil.SecureBootKeysSource(&vmi.Spec) != nil {
+			efiVars, err := l.prepareSecureBootKeysTemplate(vmi, efiConf.EFIVars)
+			if err != nil {
+				return nil, err
+			}
+			efiConf.EFIVars = efiVars
+		}
 	}
 
 	// Map the VirtualMachineInstance to the Domain
@@ -1137,7 +1246,11 @@ func isFreePageReportingEnabled(clusterFreePageReportingDisabled bool, vmi *v1.V
 }
 
 func isSerialConsoleLogEnabled(clusterSerialConsoleLogDisabled bool, vmi *v1.VirtualMachineInstance) bool {
-	return (vmi.Spec.Domain.Devices.LogSerialConsole != nil && *vmi.Spec.Domain.Devices.LogSerialConsole) || (vmi.Spec.Domain.Devices.LogSerialConsole == nil && !clusterSerialConsoleLogDisabled)
+	if vmi.Spec.Domain.Devices.LogSerialConsole != nil {
+		return *vmi.Spec.Domain.Devices.LogSerialConsole
+	}
+	// A recorded serial console is always logged, the recording is made from the log
+	return vmi.Spec.Domain.Devices.SerialConsoleRecording != nil || !clusterSerialConsoleLogDisabled
 }
 
 func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmulation bool, options *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error) {
@@ -1299,16 +1412,24 @@ func (l *LibvirtDomainManager) syncDisks(
 
 	// Resize and notify the VM about changed disks
 	for _, disk := range domain.Spec.Devices.Disks {
-		if shouldExpandOnline(dom, disk) {
-			possibleGuestSize, ok := possibleGuestSize(disk)
-			if !ok {
-				logger.Warningf("Failed to get possible guest size from disk %v", disk)
-				break
-			}
-			err := dom.BlockResize(getSourceFile(disk), uint64(possibleGuestSize), libvirt.DOMAIN_BLOCK_RESIZE_BYTES)
-			if err != nil {
-				logger.Reason(err).Errorf("libvirt failed to expand disk image %v", disk)
+		size, expand, err := onlineExpansionSize(dom, disk)
+		if err != nil {
+			logger.Reason(err).Errorf("refusing to resize disk %s", getSourceFile(disk))
+			if disk.GrowFilesystem {
+				l.setFilesystemGrowResult(filesystemGrowDiskName(disk), api.FilesystemGrowMetadata{Message: err.Error()})
 			}
+			continue
+		}
+		if !expand {
+			continue
+		}
+		err = dom.BlockResize(getSourceFile(disk), uint64(size), libvirt.DOMAIN_BLOCK_RESIZE_BYTES)
+		if err != nil {
+			logger.Reason(err).Errorf("libvirt failed to expand disk image %v", disk)
+			continue
+		}
+		if disk.GrowFilesystem {
+			go l.growGuestFilesystem(vmi, disk)
 		}
 	}
 
@@ -1354,6 +1475,10 @@ func (l *LibvirtDomainManager) startDomain(
 		return err
 	}
 
+	if restored, err := l.restoreHibernatedDomain(vmi, dom); restored || err != nil {
+		return err
+	}
+
 	createFlags := getDomainCreateFlags(vmi)
 	if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
@@ -1600,22 +1725,31 @@ func isBlockDeviceVolumeFunc(volumeName string) (bool, error) {
 	return false, fmt.Errorf("error checking for block device: %v", err)
 }
 
-func shouldExpandOnline(dom cli.VirDomain, disk api.Disk) bool {
+// onlineExpansionSize returns the size the disk can be expanded to while the VM is running, and if it has to be
+// expanded at all. An error is returned if the disk would have to shrink, since it would truncate the guest data.
+func onlineExpansionSize(dom cli.VirDomain, disk api.Disk) (int64, bool, error) {
 	if !disk.ExpandDisksEnabled {
 		log.DefaultLogger().V(3).Infof("Not expanding disks, ExpandDisks featuregate disabled")
-		return false
+		return 0, false, nil
 	}
 	blockInfo, err := dom.GetBlockInfo(getSourceFile(disk), 0)
 	if err != nil {
 		log.DefaultLogger().Reason(err).Error("Failed to get block info")
-		return false
+		return 0, false, nil
 	}
-	guestSize := blockInfo.Capacity
+	guestSize := int64(blockInfo.Capacity)
 	possibleGuestSize, ok := possibleGuestSize(disk)
-	if !ok || possibleGuestSize <= int64(guestSize) {
-		return false
+	switch {
+	case !ok:
+		log.DefaultLogger().Warningf("Failed to get possible guest size from disk %v", disk)
+		return 0, false, nil
+	case possibleGuestSize < guestSize:
+		return 0, false, fmt.Errorf("not shrinking disk %s from %d to %d bytes, shrinking disks isn't supported",
+			getSourceFile(disk), guestSize, possibleGuestSize)
+	case possibleGuestSize == guestSize:
+		return 0, false, nil
 	}
-	return true
+	return possibleGuestSize, true, nil
 }
 
 func (l *LibvirtDomainManager) getDomainSpec(dom cli.VirDomain) (*api.DomainSpec, error) {
@@ -2533,6 +2667,88 @@ func (l *LibvirtDomainManager) InjectLaunchSecret(vmi *v1.VirtualMachineInstance
 	return nil
 }
 
+func (l *LibvirtDomainManager) GetTDXQuote(vmi *v1.VirtualMachineInstance, report []byte) (*v1.TDXQuoteInfo, error) {
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return nil, err
+	}
+	defer dom.Free()
+
+	quote, err := launchsecurity.GetTDXQuote(launchsecurity.TDXQuoteGenerationServiceSocket, report)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting TDX quote failed")
+		return nil, err
+	}
+
+	return &v1.TDXQuoteInfo{
+		Quote: base64.StdEncoding.EncodeToString(quote),
+	}, nil
+}
+
+func (l *LibvirtDomainManager) GetSEVSNPAttestationInfo(vmi *v1.VirtualMachineInstance) (*v1.SEVSNPAttestationInfo, error) {
+	if !kutil.IsSEVSNPVMI(vmi) {
+		return nil, fmt.Errorf("VMI does not use SEV-SNP")
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return nil, err
+	}
+	defer dom.Free()
+
+	const flags = uint32(0)
+	domainLaunchSecurityParameters, err := dom.GetLaunchSecurityInfo(flags)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting launch security info failed")
+		return nil, err
+	}
+
+	sevNodeParameters, err := l.virConn.GetSEVInfo()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting SEV platform info failed")
+		return nil, err
+	}
+	// libvirt reports the chip ID base64 encoded, the AMD Key Distribution Service expects it hex encoded
+	chipID, err := base64.StdEncoding.DecodeString(sevNodeParameters.CPU0ID)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Decoding the chip ID failed")
+		return nil, err
+	}
+
+	reportedTCB, err := launchsecurity.GetSEVSNPReportedTCB(launchsecurity.SEVDevice)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting the SEV-SNP platform status failed")
+		return nil, err
+	}
+
+	cpuinfo, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return nil, err
+	}
+	defer cpuinfo.Close()
+	product, err := launchsecurity.SEVSNPProduct(cpuinfo)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Identifying the processor product failed")
+		return nil, err
+	}
+
+	info := &v1.SEVSNPAttestationInfo{
+		ChipID:      hex.EncodeToString(chipID),
+		Product:     product,
+		ReportedTCB: reportedTCB,
+		HostData:    vmi.Spec.Domain.LaunchSecurity.SEV.HostData,
+	}
+	if domainLaunchSecurityParameters.SEVSNPPolicySet {
+		info.Policy = domainLaunchSecurityParameters.SEVSNPPolicy
+	}
+
+	return info, nil
+}
+
 func (l *LibvirtDomainManager) parseFSDisks(fsDisks []api.FSDisk) []v1.VirtualMachineInstanceFileSystemDisk {
 	disks := []v1.VirtualMachineInstanceFileSystemDisk{}
 	for _, fsDisk := range fsDisks {
@@ -2552,6 +2768,19 @@ func (l *LibvirtDomainManager) linkImageVolumeFilePaths(vmi *v1.VirtualMachineIn
 		if volume.ContainerDisk == nil {
 			continue
 		}
+		if containerdisk.IsLayered(volume.ContainerDisk) {
+			// The whole directory is linked so that the backing chain resolves within the image
+			layersDir := containerdisk.GetLayeredDiskTargetDirFromLauncherView(volumeIndex)
+			dirToSoftLink, err := getLayersDirFromImageVolumeView(volumeIndex, volume.ContainerDisk.Path)
+			if err != nil {
+				return fmt.Errorf("failed to find layers directory from ImageVolume: %v", err)
+			}
+			err = os.Symlink(unsafepath.UnsafeAbsolute(dirToSoftLink.Raw()), layersDir)
+			if err != nil && !os.IsExist(err) {
+				return fmt.Errorf("error creating symlink for layered containerDisk: %v", err)
+			}
+			continue
+		}
 		backingFile := containerdisk.GetDiskTargetPathFromLauncherView(volumeIndex)
 		fileToSoftLink, err := getDiskTargetPathFromImageVolumeView(volumeIndex, volume.ContainerDisk.Path)
 		if err != nil {
@@ -2616,6 +2845,14 @@ func getDiskTargetPathFromImageVolumeView(volumeIndex int, volumePath string) (*
 	return safepath.JoinAndResolveWithRelativeRoot(imageVolumeDir, files[0].Name())
 }
 
+func getLayersDirFromImageVolumeView(volumeIndex int, volumePath string) (*safepath.Path, error) {
+	root, err := safepath.JoinAndResolveWithRelativeRoot(kutil.VirtImageVolumeDir, fmt.Sprintf("disk_%d", volumeIndex))
+	if err != nil {
+		return nil, err
+	}
+	return containerdisk.GetImageDir(root, volumePath)
+}
+
 func getKernelBootArtifactPathFromImageVolumeView(artifact string) (*safepath.Path, error) {
 	return safepath.JoinAndResolveWithRelativeRoot(kutil.VirtKernelBootVolumeDir, artifact)
 }
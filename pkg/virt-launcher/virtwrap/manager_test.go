@@ -2416,6 +2416,26 @@ var _ = Describe("Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(domStats).ToNot(BeNil())
 		})
+
+		It("should include the statistics polled from the guest agent", func() {
+			fakeDomainStats := []*stats.DomainStats{
+				{},
+			}
+			mockLibvirt.ConnectionEXPECT().GetDomainStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeDomainStats, nil)
+
+			cpuStats := []stats.DomainStatsGuestCPU{{CPU: 0, User: 10}}
+			diskStats := []stats.DomainStatsGuestDisk{{Name: "vda", ReadIOs: 4}}
+			agentStore := agentpoller.NewAsyncAgentStore()
+			agentStore.Store(agentpoller.GetCPUStats, cpuStats)
+			agentStore.Store(agentpoller.GetDiskStats, diskStats)
+
+			manager, _ := NewLibvirtDomainManager(mockLibvirt.VirtConnection, testVirtShareDir, testEphemeralDiskDir, &agentStore, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter, false)
+			domStats, err := manager.GetDomainStats()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domStats.GuestCPU).To(Equal(cpuStats))
+			Expect(domStats.GuestDisk).To(Equal(diskStats))
+		})
 	})
 
 	Context("on failed GetDomainSpecWithRuntimeInfo", func() {
@@ -3698,6 +3718,76 @@ var _ = Describe("Manager helper functions", func() {
 			Entry("filesystem source and block destination with hostdisks", false, true, volHostDisk),
 			Entry("block source and filesystem destination with hostdisks", true, false, volHostDisk),
 		)
+
+		DescribeTable("replace migrated volumes not backed by a PVC", func(isDstBlock bool, srcVol *v1.MigratedSourceVolume) {
+			getDiskVirtualSizeFunc = func(disk *libvirtxml.DomainDisk) (int64, error) {
+				return 2028994560, nil
+			}
+			vmi := &v1.VirtualMachineInstance{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Volumes: []v1.Volume{volPVC},
+				},
+				Status: v1.VirtualMachineInstanceStatus{
+					MigratedVolumes: []v1.StorageMigratedVolumeInfo{
+						{
+							VolumeName:         testvol,
+							SourceVolume:       srcVol,
+							DestinationPVCInfo: &infoFs,
+						},
+					},
+				},
+			}
+			if isDstBlock {
+				vmi.Status.MigratedVolumes[0].DestinationPVCInfo = &infoBlock
+			}
+			dom := &libvirtxml.Domain{
+				Devices: &libvirtxml.DomainDeviceList{
+					Disks: []libvirtxml.DomainDisk{
+						{
+							Driver: &libvirtxml.DomainDiskDriver{Type: "qcow2"},
+							Source: &libvirtxml.DomainDiskSource{
+								File: &libvirtxml.DomainDiskSourceFile{
+									File: "/var/run/kubevirt-ephemeral-disks/disk-data/test/disk.qcow2",
+								},
+							},
+							BackingStore: &libvirtxml.DomainDiskBackingStore{
+								Format: &libvirtxml.DomainDiskFormat{Type: "raw"},
+								Source: &libvirtxml.DomainDiskSource{
+									File: &libvirtxml.DomainDiskSourceFile{
+										File: "/var/run/kubevirt/container-disks/disk_0.img",
+									},
+								},
+							},
+							Alias: &libvirtxml.DomainAlias{
+								Name: fmt.Sprintf("ua-%s", testvol),
+							},
+						},
+					},
+				},
+			}
+
+			Expect(configureLocalDiskToMigrate(dom, vmi)).To(Succeed())
+			disk := dom.Devices.Disks[0]
+			Expect(disk.Driver.Type).To(Equal("raw"))
+			Expect(disk.BackingStore).To(BeNil())
+			Expect(disk.Source.Slices).ToNot(BeNil())
+			if isDstBlock {
+				Expect(disk.Source.File).To(BeNil())
+				Expect(disk.Source.Block).To(HaveValue(Equal(libvirtxml.DomainDiskSourceBlock{Dev: getBlockPath(testvol)})))
+			} else {
+				Expect(disk.Source.Block).To(BeNil())
+				Expect(disk.Source.File).To(HaveValue(Equal(libvirtxml.DomainDiskSourceFile{File: getFsImagePath(testvol)})))
+			}
+		},
+			Entry("hostDisk source and filesystem destination", false,
+				&v1.MigratedSourceVolume{HostDisk: &v1.HostDisk{Path: "/disks/disk.img"}}),
+			Entry("hostDisk source and block destination", true,
+				&v1.MigratedSourceVolume{HostDisk: &v1.HostDisk{Path: "/disks/disk.img"}}),
+			Entry("containerDisk source and filesystem destination", false,
+				&v1.MigratedSourceVolume{ContainerDisk: &v1.ContainerDiskSource{Image: "image"}}),
+			Entry("containerDisk source and block destination", true,
+				&v1.MigratedSourceVolume{ContainerDisk: &v1.ContainerDiskSource{Image: "image"}}),
+		)
 	})
 
 	Context("shouldConfigureParallelMigration", func() {
@@ -0,0 +1,69 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
+)
+
+// secureBootKeysTemplateDir holds the EFI variable store templates with the custom SecureBoot keys enrolled
+var secureBootKeysTemplateDir = filepath.Join(util.VirtPrivateDir, "secureboot")
+
+// prepareSecureBootKeysTemplate enrolls the custom SecureBoot keys into a copy of the EFI variable store template
+// and returns its path. libvirt only creates the NVRAM from the template when it does not exist yet, so the keys are
+// enrolled at first boot and kept on the backend storage afterwards. The keys actually enrolled in the NVRAM are
+// reported in the metadata.
+func (l *LibvirtDomainManager) prepareSecureBootKeysTemplate(vmi *v1.VirtualMachineInstance, template string) (string, error) {
+	output := filepath.Join(secureBootKeysTemplateDir, vmi.Name+"_VARS.fd")
+	if err := efi.CreateSecureBootKeysTemplate(template, config.SecureBootKeysSourceDir, output, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to enroll the SecureBoot keys: %v", err)
+	}
+
+	nvram := filepath.Join(services.PathForNVram(vmi), vmi.Name+"_VARS.fd")
+	if _, err := os.Stat(nvram); err != nil {
+		nvram = output
+	} else {
+		log.Log.Object(vmi).Infof("EFI NVRAM %s exists, the SecureBoot keys are not enrolled again", nvram)
+	}
+	fingerprints, err := efi.ReadSecureBootKeyFingerprints(nvram)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the enrolled SecureBoot keys: %v", err)
+	}
+	l.metadataCache.SecureBootKeys.Store(&api.SecureBootKeysMetadata{
+		PK:  fingerprints.PK,
+		KEK: fingerprints.KEK,
+		DB:  fingerprints.DB,
+		DBX: fingerprints.DBX,
+	})
+
+	return output, nil
+}
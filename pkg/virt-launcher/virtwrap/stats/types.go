@@ -72,6 +72,9 @@ type DomainStats struct {
 	CPUMap    [][]bool
 	NrVirtCpu uint
 	DirtyRate *DomainStatsDirtyRate
+	// reported by the guest agent, only when polling is enabled
+	GuestCPU  []DomainStatsGuestCPU
+	GuestDisk []DomainStatsGuestDisk
 }
 
 type DomainStatsCPU struct {
@@ -176,6 +179,11 @@ type DomainStatsMemory struct {
 	Usable           uint64
 	TotalSet         bool
 	Total            uint64
+	// taken from the KSM stats of the QEMU process
+	KSMMergedSet bool
+	KSMMerged    uint64
+	KSMProfitSet bool
+	KSMProfit    int64
 }
 
 // mimic existing structs, but data is taken from
@@ -203,3 +211,33 @@ type DomainStatsDirtyRate struct {
 	MegabytesPerSecondSet bool
 	MegabytesPerSecond    int64
 }
+
+// DomainStatsGuestCPU holds the times in milliseconds a guest CPU spent
+// in each state, as reported by guest-get-cpustats
+type DomainStatsGuestCPU struct {
+	CPU     int
+	User    uint64
+	Nice    uint64
+	System  uint64
+	Idle    uint64
+	IOWait  uint64
+	IRQ     uint64
+	SoftIRQ uint64
+	Steal   uint64
+}
+
+// DomainStatsGuestDisk holds the I/O counters of a guest block device,
+// as reported by guest-get-diskstats. Ticks are in milliseconds.
+type DomainStatsGuestDisk struct {
+	Name          string
+	ReadSectors   uint64
+	ReadIOs       uint64
+	ReadTicks     uint64
+	WriteSectors  uint64
+	WriteIOs      uint64
+	WriteTicks    uint64
+	FlushIOs      uint64
+	FlushTicks    uint64
+	IOsInProgress uint64
+	TotalTicks    uint64
+}
@@ -189,7 +189,11 @@ var Testdataexpected = `{
      "Usable": 0,
      "UsableSet": false,
      "Total": 0,
-     "TotalSet": false
+     "TotalSet": false,
+     "KSMMerged": 0,
+     "KSMMergedSet": false,
+     "KSMProfit": 0,
+     "KSMProfitSet": false
    }, 
    "MigrateDomainJobInfo": {
 	 "DataTotal": 0,
@@ -284,7 +288,9 @@ var Testdataexpected = `{
      "CalcPeriod": 0,
      "MegabytesPerSecondSet": false,
      "MegabytesPerSecond": 0
-   }
+   },
+   "GuestCPU": null,
+   "GuestDisk": null
  }`
 
 func LoadStats() ([]libvirt.DomainStats, error) {
@@ -62,6 +62,7 @@ var (
 	VIRTUALMACHINEINSTANCEPRESET     = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
 	VIRTUALMACHINEINSTANCEREPLICASET = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
 	VIRTUALMACHINEINSTANCEMIGRATION  = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
+	VIRTUALMACHINEDISKTASK           = "virtualmachinedisktasks." + virtv1.VirtualMachineDiskTaskGroupVersionKind.Group
 	KUBEVIRT                         = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
@@ -341,6 +342,47 @@ func NewVirtualMachineInstanceMigrationCrd() (*extv1.CustomResourceDefinition, e
 	return crd, nil
 }
 
+func NewVirtualMachineDiskTaskCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEDISKTASK
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group:    virtv1.VirtualMachineDiskTaskGroupVersionKind.Group,
+		Versions: newCRDVersions(),
+		Scope:    "Namespaced",
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinedisktasks",
+			Singular:   "virtualmachinedisktask",
+			Kind:       virtv1.VirtualMachineDiskTaskGroupVersionKind.Kind,
+			ShortNames: []string{"vmdt", "vmdts"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "VM", Type: "string", JSONPath: ".spec.vmName",
+				Description: "The name of the VM whose volumes are processed"},
+			{Name: "Operation", Type: "string", JSONPath: ".spec.operation",
+				Description: "The libguestfs operation of the task"},
+			{Name: "Phase", Type: "string", JSONPath: phaseJSONPath,
+				Description: "The current phase of the task"},
+		}, &extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 // Used by manifest generation
 // If you change something here, you probably need to change the CSV manifest too,
 // see /manifests/release/kubevirt.VERSION.csv.yaml.in
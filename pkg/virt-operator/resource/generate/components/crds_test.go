@@ -49,6 +49,7 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachinePreference", NewVirtualMachinePreferenceCrd),
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd),
+		Entry("for VirtualMachineDiskTask", NewVirtualMachineDiskTaskCrd),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
 	)
 
@@ -142,6 +143,7 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachinePreference", NewVirtualMachinePreferenceCrd),
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd, "Phase", "SourceVirtualMachine", "TargetVirtualMachine"),
+		Entry("for VirtualMachineDiskTask", NewVirtualMachineDiskTaskCrd, "VM", "Operation", "Phase"),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
 	)
 
@@ -332,6 +334,18 @@ var _ = Describe("CRDs", func() {
 			},
 			"RestoreInProgress", "test-source", "test-target",
 		),
+		Entry("for VirtualMachineDiskTask", NewVirtualMachineDiskTaskCrd,
+			v1.VirtualMachineDiskTask{
+				Spec: v1.VirtualMachineDiskTaskSpec{
+					VMName:    "test-vm",
+					Operation: v1.DiskTaskOperationSparsify,
+				},
+				Status: v1.VirtualMachineDiskTaskStatus{
+					Phase: v1.DiskTaskRunning,
+				},
+			},
+			"test-vm", "Sparsify", "Running",
+		),
 	)
 })
 
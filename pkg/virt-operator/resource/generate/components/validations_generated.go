@@ -1000,6 +1000,51 @@ var CRDsValidation map[string]string = map[string]string{
                 migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
                 field is set it overrides the cluster level one.
               type: string
+            guestAgentPolling:
+              description: |-
+                GuestAgentPolling configures how often virt-launcher polls data from the guest agent.
+                It can be overridden per VirtualMachineInstance with the kubevirt.io/guest-agent-polling annotation.
+              properties:
+                cpuStatsInterval:
+                  description: |-
+                    CPUStatsInterval is the interval to poll the guest CPU statistics, exposed as metrics.
+                    Disabled when unset or zero.
+                  type: string
+                diskStatsInterval:
+                  description: |-
+                    DiskStatsInterval is the interval to poll the guest disk I/O statistics, exposed as metrics.
+                    Disabled when unset or zero.
+                  type: string
+                disksInterval:
+                  description: |-
+                    DisksInterval is the interval to poll the guest disks, reported in the VMI status.
+                    Disabled when unset or zero.
+                  type: string
+                filesystemInterval:
+                  description: FilesystemInterval is the interval to poll the guest
+                    filesystems. Defaults to 300s.
+                  type: string
+                fsFreezeStatusInterval:
+                  description: FSFreezeStatusInterval is the interval to poll the
+                    guest filesystem freeze status. Defaults to 5s.
+                  type: string
+                interfacesInterval:
+                  description: InterfacesInterval is the interval to poll the guest
+                    network interfaces. Defaults to the SysInterval.
+                  type: string
+                sysInterval:
+                  description: SysInterval is the interval to poll the guest OS info,
+                    hostname and timezone. Defaults to 120s.
+                  type: string
+                userInterval:
+                  description: UserInterval is the interval to poll the users logged
+                    into the guest. Defaults to 10s.
+                  type: string
+                versionInterval:
+                  description: VersionInterval is the interval to poll the guest agent
+                    version and supported commands. Defaults to 300s.
+                  type: string
+              type: object
             handlerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1057,6 +1102,12 @@ var CRDsValidation map[string]string = map[string]string{
               description: KSMConfiguration holds the information regarding the enabling
                 the KSM in the nodes (if available).
               properties:
+                memoryMergeByDefault:
+                  description: |-
+                    MemoryMergeByDefault defines whether KSM merges the guest memory of the VMIs which neither opt in nor out
+                    with the kubevirt.io/ksm-merge annotation, or the kubevirt.io/ksm-merge label of their namespace.
+                    Defaults to true
+                  type: boolean
                 nodeLabelSelector:
                   description: |-
                     NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.
@@ -1188,6 +1239,27 @@ var CRDsValidation map[string]string = map[string]string{
             memBalloonStatsPeriod:
               format: int32
               type: integer
+            memoryOvercommitController:
+              description: |-
+                MemoryOvercommitController configures the virt-handler controller which reclaims unused guest memory
+                through the memory balloon when a node is under memory pressure.
+                It is only active when the MemoryOvercommitController feature gate is enabled.
+              properties:
+                guaranteedMemoryPercent:
+                  description: |-
+                    GuaranteedMemoryPercent is the percentage of the guest memory which is never reclaimed from a VMI. Defaults to 50.
+                    It can be overridden per VirtualMachineInstance with the kubevirt.io/guaranteed-memory annotation.
+                  type: integer
+                hostMemoryPressureThreshold:
+                  description: |-
+                    HostMemoryPressureThreshold is the percentage of used node memory above which guest memory is reclaimed.
+                    Reclaimed memory is given back to the guests once the node memory usage drops below it. Defaults to 80.
+                  type: integer
+                interval:
+                  description: Interval is how often the guest memory targets are
+                    adjusted. Defaults to 10s.
+                  type: string
+              type: object
             migrations:
               description: |-
                 MigrationConfiguration holds migration options.
@@ -1469,6 +1541,21 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             selinuxLauncherType:
               type: string
+            sevSNPAttestation:
+              description: SEVSNPAttestation configures how virt-handler gathers
+                the evidence for the attestation of SEV-SNP guests.
+              properties:
+                disableVCEKChainFetch:
+                  description: |-
+                    DisableVCEKChainFetch keeps virt-handler from fetching the VCEK certificates, e.g. in air-gapped clusters.
+                    The verifier then fetches them itself, by the chip ID and TCB version of the attestation info.
+                  type: boolean
+                keyDistributionServiceURL:
+                  description: |-
+                    KeyDistributionServiceURL is the base URL of the AMD Key Distribution Service or of a mirror of it, which
+                    virt-handler fetches the VCEK certificates from. Defaults to https://kdsintf.amd.com.
+                  type: string
+              type: object
             smbios:
               properties:
                 family:
@@ -1591,6 +1678,34 @@ var CRDsValidation map[string]string = map[string]string{
               - LiveUpdate
               nullable: true
               type: string
+            vmStateEncryption:
+              description: VMStateEncryption enables the encryption at rest of the
+                PVCs created to preserve VM state, like TPM and EFI.
+              properties:
+                kms:
+                  description: KMS wraps a data key per VM with a key management service
+                    instead of encrypting the state with the keys of a Secret.
+                  properties:
+                    keySecretName:
+                      description: |-
+                        KeySecretName is the name of a Secret, in the namespace of each VM, holding the key encryption keys
+                        of the local provider, in the same format as VMStateEncryption.SecretName.
+                      type: string
+                    provider:
+                      description: Provider is the KMS plugin. Only "local" is supported.
+                      type: string
+                  required:
+                  - keySecretName
+                  - provider
+                  type: object
+                secretName:
+                  description: |-
+                    SecretName is the name of a Secret, in the namespace of each VM, holding the keys which encrypt the VM state.
+                    Every entry of the Secret is a 32 byte key named by its key ID, except for the entry "active"
+                    which holds the ID of the key used to encrypt new state. To rotate the key, add a new key and
+                    point "active" to it; the previous keys are still used to decrypt existing state until it is rewritten.
+                  type: string
+              type: object
             vmStateStorageClass:
               description: VMStateStorageClass is the name of the storage class to
                 use for the PVCs created to preserve VM state, like TPM.
@@ -6122,11 +6237,22 @@ var CRDsValidation map[string]string = map[string]string{
                                       ReadOnly.
                                       Defaults to false.
                                     type: boolean
+                                  unit:
+                                    description: |-
+                                      Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                      It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                    type: integer
                                 type: object
                               errorPolicy:
                                 description: If specified, it can change the default
                                   error policy (stop) for the disk
                                 type: string
+                              growFilesystem:
+                                description: |-
+                                  GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                                  after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                                  Defaults to false.
+                                type: boolean
                               io:
                                 description: |-
                                   IO specifies which QEMU disk IO mode should be used.
@@ -6150,6 +6276,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       needs to support the persistent reservation
                                       for the SCSI disk
                                     type: boolean
+                                  unit:
+                                    description: |-
+                                      Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                      It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                    type: integer
                                 type: object
                               name:
                                 description: Name is the device name
@@ -6174,6 +6305,17 @@ var CRDsValidation map[string]string = map[string]string{
                         downwardMetrics:
                           description: DownwardMetrics creates a virtio serials for
                             exposing the downward metrics to the vmi.
+                          properties:
+                            metricGroups:
+                              description: |-
+                                MetricGroups selects the groups of metrics published to the guest.
+                                Defaults to the Host, CPU and Memory groups.
+                              items:
+                                description: DownwardMetricsGroup is a group of metrics
+                                  published to the guest through the downward metrics
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
                           type: object
                         filesystems:
                           description: Filesystems describes filesystem which is connected
@@ -6500,6 +6642,19 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        serialConsoleRecording:
+                          description: |-
+                            SerialConsoleRecording records the output of the auto-attached default serial console,
+                            with the time it was written at, in the asciicast v2 format of asciinema.
+                            The serial console is logged when it is recorded.
+                          properties:
+                            claimName:
+                              description: |-
+                                ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,
+                                in one file per VirtualMachineInstance named after its UID.
+                                When not set, the recording is written to the log of the 'guest-console-log' container.
+                              type: string
+                          type: object
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -6867,6 +7022,44 @@ var CRDsValidation map[string]string = map[string]string{
                                     Requires SMM to be enabled.
                                     Defaults to true
                                   type: boolean
+                                secureBootKeys:
+                                  description: |-
+                                    SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                                    of the VM instead of the keys shipped with the firmware.
+                                    They are enrolled when the EFI NVRAM is created at first boot.
+                                    Requires SecureBoot and Persistent to be enabled.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        that contains the SecureBoot keys.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        that contains the SecureBoot keys.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                  type: object
                               type: object
                           type: object
                         kernelBoot:
@@ -6943,10 +7136,30 @@ var CRDsValidation map[string]string = map[string]string{
                               description: If specified, run the attestation process
                                 for a vmi.
                               type: object
+                            authorKey:
+                              description: |-
+                                The ID authentication information contains the author key signing the ID key.
+                                Requires an ID authentication information structure.
+                                Defaults to false.
+                              type: boolean
                             dhCert:
                               description: Base64 encoded guest owner's Diffie-Hellman
                                 key.
                               type: string
+                            hostData:
+                              description: Base64 encoded 32 bytes of data provided
+                                by the host, which are included in the SEV-SNP attestation
+                                reports of the guest.
+                              type: string
+                            idAuth:
+                              description: |-
+                                Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                                Requires an ID block.
+                              type: string
+                            idBlock:
+                              description: Base64 encoded SEV-SNP ID block of 96 bytes,
+                                holding the expected launch digest and guest policy.
+                              type: string
                             policy:
                               description: |-
                                 Guest policy flags as defined in AMD SEV API specification.
@@ -6957,11 +7170,55 @@ var CRDsValidation map[string]string = map[string]string{
                                     SEV-ES is required.
                                     Defaults to false.
                                   type: boolean
+                                secureNestedPaging:
+                                  description: |-
+                                    SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                                    Defaults to false.
+                                  type: boolean
+                                singleSocket:
+                                  description: |-
+                                    The SEV-SNP guest can only be activated on a single socket.
+                                    Requires SEV-SNP.
+                                    Defaults to false.
+                                  type: boolean
                               type: object
                             session:
                               description: Base64 encoded session blob.
                               type: string
                           type: object
+                        tdx:
+                          description: Intel Trust Domain Extensions (TDX).
+                          properties:
+                            attestation:
+                              description: |-
+                                If specified, the quote generation service of the node is made available to the guest
+                                and quotes can be fetched for TD reports of the guest.
+                              type: object
+                            mrConfigID:
+                              description: Base64 encoded SHA384 digest identifying
+                                the guest owner defined configuration of the trust
+                                domain.
+                              type: string
+                            mrOwner:
+                              description: Base64 encoded SHA384 digest identifying
+                                the owner of the trust domain.
+                              type: string
+                            mrOwnerConfig:
+                              description: Base64 encoded SHA384 digest of the owner
+                                defined configuration of the trust domain.
+                              type: string
+                            policy:
+                              description: |-
+                                Guest policy flags as defined in the Intel TDX module specification.
+                                Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                              properties:
+                                septVEDisable:
+                                  description: |-
+                                    Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                          type: object
                       type: object
                     machine:
                       description: Machine type.
@@ -7048,6 +7305,19 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                hibernation:
+                  description: |-
+                    Hibernation configures the PersistentVolumeClaim the memory and device state of the
+                    VirtualMachineInstance is saved to when its VirtualMachine is hibernated.
+                  properties:
+                    claimName:
+                      description: |-
+                        ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to.
+                        It must be large enough to hold the guest memory and must not be used by a volume of the VMI.
+                      type: string
+                  required:
+                  - claimName
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -7450,6 +7720,13 @@ var CRDsValidation map[string]string = map[string]string{
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                sharedDiskGroup:
+                  description: |-
+                    SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with
+                    SCSI persistent reservation, e.g. the nodes of a guest failover cluster.
+                    All members of a group must use the same shareable reservation LUNs and are
+                    scheduled on different nodes.
+                  type: string
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -7847,6 +8124,27 @@ var CRDsValidation map[string]string = map[string]string{
                               registry secret required to pull the image. The secret
                               must already exist.
                             type: string
+                          mode:
+                            description: |-
+                              Mode defines how the disk is served from the image.
+                              Standalone expects a single self-contained disk file.
+                              Layered serves a qcow2 backing chain spread over the image layers in place,
+                              so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                              Defaults to Standalone.
+                            type: string
+                          overlay:
+                            description: |-
+                              Overlay configures the writable layer on top of the image.
+                              By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                            properties:
+                              claimName:
+                                description: |-
+                                  ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                                  so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                                type: string
+                            required:
+                            - claimName
+                            type: object
                           path:
                             description: Path defines the path to disk file in the
                               container
@@ -7953,6 +8251,17 @@ var CRDsValidation map[string]string = map[string]string{
                         description: |-
                           DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
                           metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                        properties:
+                          metricGroups:
+                            description: |-
+                              MetricGroups selects the groups of metrics published to the guest.
+                              Defaults to the Host, CPU and Memory groups.
+                            items:
+                              description: DownwardMetricsGroup is a group of metrics
+                                published to the guest through the downward metrics
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       emptyDisk:
                         description: |-
@@ -8200,6 +8509,41 @@ var CRDsValidation map[string]string = map[string]string{
             updated through an Update() before ObservedGeneration in Status.
           format: int64
           type: integer
+        diskTaskInProgress:
+          description: DiskTaskInProgress is the name of the VirtualMachineDiskTask
+            currently processing the volumes of the VM
+          type: string
+        hibernation:
+          description: Hibernation reports the last hibernation of the VM and the
+            restoring of its saved state
+          nullable: true
+          properties:
+            endTimestamp:
+              description: EndTimestamp represents the time the state was saved or
+                restored
+              format: date-time
+              type: string
+            machineType:
+              description: MachineType is the machine type the state was saved with
+              type: string
+            message:
+              description: Message is a detailed message about failure of the saving
+                or restoring
+              type: string
+            phase:
+              description: Phase represents the hibernation phase
+              type: string
+            qemuVersion:
+              description: QEMUVersion is the version of QEMU the state was saved
+                with
+              type: string
+            startTimestamp:
+              description: StartTimestamp represents the time the current phase started
+              format: date-time
+              type: string
+          required:
+          - phase
+          type: object
         instancetypeRef:
           description: InstancetypeRef captures the state of any referenced instance
             type from the VirtualMachine
@@ -8270,6 +8614,41 @@ var CRDsValidation map[string]string = map[string]string{
             started.
           format: int64
           type: integer
+        persistentReservations:
+          description: PersistentReservations reports the SCSI persistent reservation
+            state of the LUNs with reservation of the running VMI
+          items:
+            description: VolumePersistentReservation shows the SCSI persistent reservation
+              state of a volume
+            properties:
+              name:
+                description: Name is the name of the volume
+                type: string
+              reservation:
+                description: Reservation is the persistent reservation state of the
+                  volume
+                properties:
+                  holder:
+                    description: Holder is the key of the current reservation holder,
+                      empty if the LUN is not reserved
+                    type: string
+                  keys:
+                    description: Keys are the reservation keys registered on the LUN
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  type:
+                    description: Type is the type of the current reservation, e.g.
+                      WriteExclusiveRegistrantsOnly
+                    type: string
+                type: object
+            required:
+            - name
+            - reservation
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         preferenceRef:
           description: PreferenceRef captures the state of any referenced preference
             from the VirtualMachine
@@ -8316,6 +8695,36 @@ var CRDsValidation map[string]string = map[string]string{
             RunStrategy tracks the last recorded RunStrategy used by the VM.
             This is needed to correctly process the next strategy (for now only the RerunOnFailure)
           type: string
+        secureBootKeys:
+          description: SecureBootKeys reports the SecureBoot keys last enrolled in
+            the persistent EFI NVRAM of the VM
+          nullable: true
+          properties:
+            db:
+              description: DB are the fingerprints of the allowed signature database
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            dbx:
+              description: DBX are the fingerprints of the forbidden signature database
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            kek:
+              description: KEK are the fingerprints of the key exchange keys
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            pk:
+              description: PK is the fingerprint of the platform key
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         snapshotInProgress:
           description: SnapshotInProgress is the name of the VirtualMachineSnapshot
             currently executing
@@ -8462,11 +8871,22 @@ var CRDsValidation map[string]string = map[string]string{
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          unit:
+                            description: |-
+                              Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                            type: integer
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
                           policy (stop) for the disk
                         type: string
+                      growFilesystem:
+                        description: |-
+                          GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                          after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                          Defaults to false.
+                        type: boolean
                       io:
                         description: |-
                           IO specifies which QEMU disk IO mode should be used.
@@ -8489,6 +8909,11 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Reservation indicates if the disk needs to
                               support the persistent reservation for the SCSI disk
                             type: boolean
+                          unit:
+                            description: |-
+                              Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                            type: integer
                         type: object
                       name:
                         description: Name is the device name
@@ -8687,6 +9112,25 @@ var CRDsValidation map[string]string = map[string]string{
                               Value of Filesystem is implied when not included in claim spec.
                             type: string
                         type: object
+                      progress:
+                        description: Progress reports the progress of the copy of
+                          the volume to the destination
+                        properties:
+                          completed:
+                            description: Completed indicates if the copy of the volume
+                              has completed
+                            type: boolean
+                          processedBytes:
+                            description: ProcessedBytes is the amount of data already
+                              copied to the destination
+                            format: int64
+                            type: integer
+                          totalBytes:
+                            description: TotalBytes is the amount of data to copy
+                              to the destination
+                            format: int64
+                            type: integer
+                        type: object
                       sourcePVCInfo:
                         description: SourcePVCInfo contains the information about
                           the source PVC
@@ -8737,6 +9181,89 @@ var CRDsValidation map[string]string = map[string]string{
                               Value of Filesystem is implied when not included in claim spec.
                             type: string
                         type: object
+                      sourceVolume:
+                        description: SourceVolume contains the source of the migrated
+                          volume when it isn't backed by a PVC
+                        properties:
+                          containerDisk:
+                            description: |-
+                              ContainerDisk is the containerDisk the volume is migrated from. The writable overlay is copied
+                              together with its backing image, hence the destination contains the whole disk content.
+                            properties:
+                              image:
+                                description: Image is the name of the image with the
+                                  embedded disk.
+                                type: string
+                              imagePullPolicy:
+                                description: |-
+                                  Image pull policy.
+                                  One of Always, Never, IfNotPresent.
+                                  Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                                  Cannot be updated.
+                                  More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
+                                type: string
+                              imagePullSecret:
+                                description: ImagePullSecret is the name of the Docker
+                                  registry secret required to pull the image. The
+                                  secret must already exist.
+                                type: string
+                              mode:
+                                description: |-
+                                  Mode defines how the disk is served from the image.
+                                  Standalone expects a single self-contained disk file.
+                                  Layered serves a qcow2 backing chain spread over the image layers in place,
+                                  so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                                  Defaults to Standalone.
+                                type: string
+                              overlay:
+                                description: |-
+                                  Overlay configures the writable layer on top of the image.
+                                  By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                                properties:
+                                  claimName:
+                                    description: |-
+                                      ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                                      so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                                    type: string
+                                required:
+                                - claimName
+                                type: object
+                              path:
+                                description: Path defines the path to disk file in
+                                  the container
+                                type: string
+                            required:
+                            - image
+                            type: object
+                          hostDisk:
+                            description: HostDisk is the hostDisk the volume is migrated
+                              from
+                            properties:
+                              capacity:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Capacity of the sparse disk
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              path:
+                                description: The path to HostDisk image located on
+                                  the cluster
+                                type: string
+                              shared:
+                                description: Shared indicate whether the path is shared
+                                  between nodes
+                                type: boolean
+                              type:
+                                description: |-
+                                  Contains information if disk.img exists or should be created
+                                  allowed options are 'Disk' and 'DiskOrCreate'
+                                type: string
+                            required:
+                            - path
+                            - type
+                            type: object
+                        type: object
                       volumeName:
                         description: VolumeName is the name of the volume that is
                           being migrated
@@ -8791,6 +9318,22 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        liveFork:
+          description: |-
+            LiveFork clones the source including its memory, so that the target resumes where the source was
+            hibernated. A running source is hibernated first and started again once its state is copied. The
+            target keeps the MAC addresses of the source until the guest has resumed.
+            Requires a VirtualMachine source with a hibernation PVC.
+          properties:
+            identityResetCommand:
+              description: |-
+                IdentityResetCommand is run in the guest via the guest agent once the target resumed and its
+                interfaces got their own MAC addresses. Example: ["/usr/local/bin/reset-identity", "--hostname"]
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         newMacAddresses:
           additionalProperties:
             type: string
@@ -8812,6 +9355,13 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        persistentState:
+          description: |-
+            PersistentState defines how the persistent state of the source, its vTPM and EFI NVRAM, is handled.
+            Regenerate lets the target start with a new state, so that it does not share keys sealed by the vTPM
+            of the source. Include copies the state of the source.
+            Defaults to Regenerate
+          type: string
         source:
           description: |-
             Source is the object that would be cloned. Currently supported source types are:
@@ -9110,9 +9660,28 @@ var CRDsValidation map[string]string = map[string]string{
                 attestation:
                   description: If specified, run the attestation process for a vmi.
                   type: object
+                authorKey:
+                  description: |-
+                    The ID authentication information contains the author key signing the ID key.
+                    Requires an ID authentication information structure.
+                    Defaults to false.
+                  type: boolean
                 dhCert:
                   description: Base64 encoded guest owner's Diffie-Hellman key.
                   type: string
+                hostData:
+                  description: Base64 encoded 32 bytes of data provided by the host,
+                    which are included in the SEV-SNP attestation reports of the guest.
+                  type: string
+                idAuth:
+                  description: |-
+                    Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                    Requires an ID block.
+                  type: string
+                idBlock:
+                  description: Base64 encoded SEV-SNP ID block of 96 bytes, holding
+                    the expected launch digest and guest policy.
+                  type: string
                 policy:
                   description: |-
                     Guest policy flags as defined in AMD SEV API specification.
@@ -9123,22 +9692,65 @@ var CRDsValidation map[string]string = map[string]string{
                         SEV-ES is required.
                         Defaults to false.
                       type: boolean
+                    secureNestedPaging:
+                      description: |-
+                        SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                        Defaults to false.
+                      type: boolean
+                    singleSocket:
+                      description: |-
+                        The SEV-SNP guest can only be activated on a single socket.
+                        Requires SEV-SNP.
+                        Defaults to false.
+                      type: boolean
                   type: object
                 session:
                   description: Base64 encoded session blob.
                   type: string
               type: object
-          type: object
-        memory:
-          description: Required Memory related attributes of the instancetype.
-          properties:
-            guest:
-              anyOf:
-              - type: integer
-              - type: string
-              description: Required amount of memory which is visible inside the guest
-                OS.
-              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            tdx:
+              description: Intel Trust Domain Extensions (TDX).
+              properties:
+                attestation:
+                  description: |-
+                    If specified, the quote generation service of the node is made available to the guest
+                    and quotes can be fetched for TD reports of the guest.
+                  type: object
+                mrConfigID:
+                  description: Base64 encoded SHA384 digest identifying the guest
+                    owner defined configuration of the trust domain.
+                  type: string
+                mrOwner:
+                  description: Base64 encoded SHA384 digest identifying the owner
+                    of the trust domain.
+                  type: string
+                mrOwnerConfig:
+                  description: Base64 encoded SHA384 digest of the owner defined configuration
+                    of the trust domain.
+                  type: string
+                policy:
+                  description: |-
+                    Guest policy flags as defined in the Intel TDX module specification.
+                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                  properties:
+                    septVEDisable:
+                      description: |-
+                        Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                        Defaults to true.
+                      type: boolean
+                  type: object
+              type: object
+          type: object
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest
+                OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
               x-kubernetes-int-or-string: true
             hugepages:
               description: Optionally enables the use of hugepages for the VirtualMachineInstance
@@ -9783,6 +10395,44 @@ var CRDsValidation map[string]string = map[string]string{
                     Requires SMM to be enabled.
                     Defaults to true
                   type: boolean
+                secureBootKeys:
+                  description: |-
+                    SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                    of the VM instead of the keys shipped with the firmware.
+                    They are enrolled when the EFI NVRAM is created at first boot.
+                    Requires SecureBoot and Persistent to be enabled.
+                  properties:
+                    configMap:
+                      description: ConfigMap references a ConfigMap that contains
+                        the SecureBoot keys.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    secret:
+                      description: Secret references a k8s Secret that contains the
+                        SecureBoot keys.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                  type: object
               type: object
             preferredUseBios:
               description: PreferredUseBios optionally enables BIOS
@@ -9871,6 +10521,115 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinedisktask": `openAPIV3Schema:
+  description: |-
+    VirtualMachineDiskTask runs an offline libguestfs operation against the volumes of a stopped VirtualMachine.
+    The VirtualMachine can not be started while the task is running.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        customize:
+          description: Customize configures the Customize operation
+          properties:
+            commands:
+              description: Commands are virt-customize commands, as accepted one per
+                line by --commands-from-file
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - commands
+          type: object
+        operation:
+          description: Operation is the libguestfs operation to run
+          type: string
+        resizeFilesystem:
+          description: ResizeFilesystem configures the ResizeFilesystem operation
+          properties:
+            device:
+              description: |-
+                Device is the filesystem to grow as seen by libguestfs, e.g. /dev/sda2.
+                If it is a partition, the partition is first grown to the end of the disk.
+              type: string
+          required:
+          - device
+          type: object
+        sysprep:
+          description: Sysprep configures the Sysprep operation
+          properties:
+            operations:
+              description: Operations enables only the listed virt-sysprep operations,
+                the default operations run if empty
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+        vmName:
+          description: |-
+            The name of the VirtualMachine whose volumes are processed. The VirtualMachine must exist in the
+            namespace of the task and must be stopped.
+          type: string
+        volumes:
+          description: |-
+            Volumes restricts the task to the named persistentVolumeClaim and dataVolume volumes of the VirtualMachine.
+            All of them are processed if empty. Disks are attached in the order of the VirtualMachine volumes.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+      required:
+      - operation
+      - vmName
+      type: object
+    status:
+      description: VirtualMachineDiskTaskStatus reports the progress and the result
+        of a VirtualMachineDiskTask
+      properties:
+        completionTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        logs:
+          description: Logs holds the last lines of the output of the task once it
+            finished
+          type: string
+        message:
+          description: Message describes the current state or the result of the task
+          type: string
+        phase:
+          description: VirtualMachineDiskTaskPhase is the phase of a VirtualMachineDiskTask
+          type: string
+        podName:
+          description: The pod running the libguestfs tools
+          type: string
+        startTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachineexport": `openAPIV3Schema:
   description: VirtualMachineExport defines the operation of exporting a VM source
@@ -9896,6 +10655,12 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
         resource
       properties:
+        persistentState:
+          description: |-
+            PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,
+            is exported along with the volumes.
+            Defaults to Include
+          type: string
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -11575,11 +12340,22 @@ var CRDsValidation map[string]string = map[string]string{
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          unit:
+                            description: |-
+                              Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                            type: integer
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
                           policy (stop) for the disk
                         type: string
+                      growFilesystem:
+                        description: |-
+                          GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                          after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                          Defaults to false.
+                        type: boolean
                       io:
                         description: |-
                           IO specifies which QEMU disk IO mode should be used.
@@ -11602,6 +12378,11 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Reservation indicates if the disk needs to
                               support the persistent reservation for the SCSI disk
                             type: boolean
+                          unit:
+                            description: |-
+                              Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                            type: integer
                         type: object
                       name:
                         description: Name is the device name
@@ -11626,6 +12407,17 @@ var CRDsValidation map[string]string = map[string]string{
                 downwardMetrics:
                   description: DownwardMetrics creates a virtio serials for exposing
                     the downward metrics to the vmi.
+                  properties:
+                    metricGroups:
+                      description: |-
+                        MetricGroups selects the groups of metrics published to the guest.
+                        Defaults to the Host, CPU and Memory groups.
+                      items:
+                        description: DownwardMetricsGroup is a group of metrics published
+                          to the guest through the downward metrics
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
                   type: object
                 filesystems:
                   description: Filesystems describes filesystem which is connected
@@ -11948,6 +12740,19 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                serialConsoleRecording:
+                  description: |-
+                    SerialConsoleRecording records the output of the auto-attached default serial console,
+                    with the time it was written at, in the asciicast v2 format of asciinema.
+                    The serial console is logged when it is recorded.
+                  properties:
+                    claimName:
+                      description: |-
+                        ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,
+                        in one file per VirtualMachineInstance named after its UID.
+                        When not set, the recording is written to the log of the 'guest-console-log' container.
+                      type: string
+                  type: object
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -12311,6 +13116,44 @@ var CRDsValidation map[string]string = map[string]string{
                             Requires SMM to be enabled.
                             Defaults to true
                           type: boolean
+                        secureBootKeys:
+                          description: |-
+                            SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                            of the VM instead of the keys shipped with the firmware.
+                            They are enrolled when the EFI NVRAM is created at first boot.
+                            Requires SecureBoot and Persistent to be enabled.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap that contains
+                                the SecureBoot keys.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            secret:
+                              description: Secret references a k8s Secret that contains
+                                the SecureBoot keys.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                          type: object
                       type: object
                   type: object
                 kernelBoot:
@@ -12385,9 +13228,29 @@ var CRDsValidation map[string]string = map[string]string{
                       description: If specified, run the attestation process for a
                         vmi.
                       type: object
+                    authorKey:
+                      description: |-
+                        The ID authentication information contains the author key signing the ID key.
+                        Requires an ID authentication information structure.
+                        Defaults to false.
+                      type: boolean
                     dhCert:
                       description: Base64 encoded guest owner's Diffie-Hellman key.
                       type: string
+                    hostData:
+                      description: Base64 encoded 32 bytes of data provided by the
+                        host, which are included in the SEV-SNP attestation reports
+                        of the guest.
+                      type: string
+                    idAuth:
+                      description: |-
+                        Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                        Requires an ID block.
+                      type: string
+                    idBlock:
+                      description: Base64 encoded SEV-SNP ID block of 96 bytes, holding
+                        the expected launch digest and guest policy.
+                      type: string
                     policy:
                       description: |-
                         Guest policy flags as defined in AMD SEV API specification.
@@ -12398,11 +13261,54 @@ var CRDsValidation map[string]string = map[string]string{
                             SEV-ES is required.
                             Defaults to false.
                           type: boolean
+                        secureNestedPaging:
+                          description: |-
+                            SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                            Defaults to false.
+                          type: boolean
+                        singleSocket:
+                          description: |-
+                            The SEV-SNP guest can only be activated on a single socket.
+                            Requires SEV-SNP.
+                            Defaults to false.
+                          type: boolean
                       type: object
                     session:
                       description: Base64 encoded session blob.
                       type: string
                   type: object
+                tdx:
+                  description: Intel Trust Domain Extensions (TDX).
+                  properties:
+                    attestation:
+                      description: |-
+                        If specified, the quote generation service of the node is made available to the guest
+                        and quotes can be fetched for TD reports of the guest.
+                      type: object
+                    mrConfigID:
+                      description: Base64 encoded SHA384 digest identifying the guest
+                        owner defined configuration of the trust domain.
+                      type: string
+                    mrOwner:
+                      description: Base64 encoded SHA384 digest identifying the owner
+                        of the trust domain.
+                      type: string
+                    mrOwnerConfig:
+                      description: Base64 encoded SHA384 digest of the owner defined
+                        configuration of the trust domain.
+                      type: string
+                    policy:
+                      description: |-
+                        Guest policy flags as defined in the Intel TDX module specification.
+                        Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                      properties:
+                        septVEDisable:
+                          description: |-
+                            Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                            Defaults to true.
+                          type: boolean
+                      type: object
+                  type: object
               type: object
             machine:
               description: Machine type.
@@ -12488,6 +13394,19 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        hibernation:
+          description: |-
+            Hibernation configures the PersistentVolumeClaim the memory and device state of the
+            VirtualMachineInstance is saved to when its VirtualMachine is hibernated.
+          properties:
+            claimName:
+              description: |-
+                ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to.
+                It must be large enough to hold the guest memory and must not be used by a volume of the VMI.
+              type: string
+          required:
+          - claimName
+          type: object
         hostname:
           description: |-
             Specifies the hostname of the vmi
@@ -12889,6 +13808,13 @@ var CRDsValidation map[string]string = map[string]string{
             If specified, the VMI will be dispatched by specified scheduler.
             If not specified, the VMI will be dispatched by default scheduler.
           type: string
+        sharedDiskGroup:
+          description: |-
+            SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with
+            SCSI persistent reservation, e.g. the nodes of a guest failover cluster.
+            All members of a group must use the same shareable reservation LUNs and are
+            scheduled on different nodes.
+          type: string
         startStrategy:
           description: StartStrategy can be set to "Paused" if Virtual Machine should
             be started in paused state.
@@ -13282,6 +14208,27 @@ var CRDsValidation map[string]string = map[string]string{
                     description: ImagePullSecret is the name of the Docker registry
                       secret required to pull the image. The secret must already exist.
                     type: string
+                  mode:
+                    description: |-
+                      Mode defines how the disk is served from the image.
+                      Standalone expects a single self-contained disk file.
+                      Layered serves a qcow2 backing chain spread over the image layers in place,
+                      so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                      Defaults to Standalone.
+                    type: string
+                  overlay:
+                    description: |-
+                      Overlay configures the writable layer on top of the image.
+                      By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                    properties:
+                      claimName:
+                        description: |-
+                          ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                          so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                        type: string
+                    required:
+                    - claimName
+                    type: object
                   path:
                     description: Path defines the path to disk file in the container
                     type: string
@@ -13385,6 +14332,17 @@ var CRDsValidation map[string]string = map[string]string{
                 description: |-
                   DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
                   metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                properties:
+                  metricGroups:
+                    description: |-
+                      MetricGroups selects the groups of metrics published to the guest.
+                      Defaults to the Host, CPU and Memory groups.
+                    items:
+                      description: DownwardMetricsGroup is a group of metrics published
+                        to the guest through the downward metrics
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
                 type: object
               emptyDisk:
                 description: |-
@@ -13744,6 +14702,47 @@ var CRDsValidation map[string]string = map[string]string{
             It will be set to "frozen" if the request was made, or unset otherwise.
             This does not reflect the actual state of the guest filesystem.
           type: string
+        guestDisks:
+          description: |-
+            GuestDisks lists the disks reported by the guest agent.
+            It is only populated when polling of the guest disks is enabled in the guest agent polling configuration.
+          items:
+            description: VirtualMachineInstanceGuestDisk describes a disk as seen
+              from inside the guest
+            properties:
+              alias:
+                description: Alias is the name the guest uses for the disk, like a
+                  LVM logical volume name
+                type: string
+              bus:
+                description: Bus is the bus type of the disk as reported by the guest
+                type: string
+              dependencies:
+                description: Dependencies lists the names of the devices this disk
+                  depends on
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              name:
+                description: Name is the device name of the disk in the guest
+                type: string
+              partition:
+                description: Partition is true when the device is a partition of another
+                  disk
+                type: boolean
+              serial:
+                description: Serial is the serial number of the disk
+                type: string
+              volumeName:
+                description: VolumeName is the name of the VMI volume backing the
+                  disk, if known
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         guestOSInfo:
           description: Guest OS Information
           properties:
@@ -13772,6 +14771,37 @@ var CRDsValidation map[string]string = map[string]string{
               description: Version ID of the Guest OS
               type: string
           type: object
+        hibernation:
+          description: |-
+            Hibernation reports the saving of the state of the VMI when its VirtualMachine
+            is hibernated, or the restoring of the state the VMI was started from.
+          properties:
+            endTimestamp:
+              description: EndTimestamp represents the time the state was saved or
+                restored
+              format: date-time
+              type: string
+            machineType:
+              description: MachineType is the machine type the state was saved with
+              type: string
+            message:
+              description: Message is a detailed message about failure of the saving
+                or restoring
+              type: string
+            phase:
+              description: Phase represents the hibernation phase
+              type: string
+            qemuVersion:
+              description: QEMUVersion is the version of QEMU the state was saved
+                with
+              type: string
+            startTimestamp:
+              description: StartTimestamp represents the time the current phase started
+              format: date-time
+              type: string
+          required:
+          - phase
+          type: object
         interfaces:
           description: Interfaces represent the details of available network interfaces.
           items:
@@ -13933,6 +14963,24 @@ var CRDsValidation map[string]string = map[string]string{
                       Value of Filesystem is implied when not included in claim spec.
                     type: string
                 type: object
+              progress:
+                description: Progress reports the progress of the copy of the volume
+                  to the destination
+                properties:
+                  completed:
+                    description: Completed indicates if the copy of the volume has
+                      completed
+                    type: boolean
+                  processedBytes:
+                    description: ProcessedBytes is the amount of data already copied
+                      to the destination
+                    format: int64
+                    type: integer
+                  totalBytes:
+                    description: TotalBytes is the amount of data to copy to the destination
+                    format: int64
+                    type: integer
+                type: object
               sourcePVCInfo:
                 description: SourcePVCInfo contains the information about the source
                   PVC
@@ -13983,6 +15031,86 @@ var CRDsValidation map[string]string = map[string]string{
                       Value of Filesystem is implied when not included in claim spec.
                     type: string
                 type: object
+              sourceVolume:
+                description: SourceVolume contains the source of the migrated volume
+                  when it isn't backed by a PVC
+                properties:
+                  containerDisk:
+                    description: |-
+                      ContainerDisk is the containerDisk the volume is migrated from. The writable overlay is copied
+                      together with its backing image, hence the destination contains the whole disk content.
+                    properties:
+                      image:
+                        description: Image is the name of the image with the embedded
+                          disk.
+                        type: string
+                      imagePullPolicy:
+                        description: |-
+                          Image pull policy.
+                          One of Always, Never, IfNotPresent.
+                          Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                          Cannot be updated.
+                          More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
+                        type: string
+                      imagePullSecret:
+                        description: ImagePullSecret is the name of the Docker registry
+                          secret required to pull the image. The secret must already
+                          exist.
+                        type: string
+                      mode:
+                        description: |-
+                          Mode defines how the disk is served from the image.
+                          Standalone expects a single self-contained disk file.
+                          Layered serves a qcow2 backing chain spread over the image layers in place,
+                          so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                          Defaults to Standalone.
+                        type: string
+                      overlay:
+                        description: |-
+                          Overlay configures the writable layer on top of the image.
+                          By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                        properties:
+                          claimName:
+                            description: |-
+                              ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                              so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                            type: string
+                        required:
+                        - claimName
+                        type: object
+                      path:
+                        description: Path defines the path to disk file in the container
+                        type: string
+                    required:
+                    - image
+                    type: object
+                  hostDisk:
+                    description: HostDisk is the hostDisk the volume is migrated from
+                    properties:
+                      capacity:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Capacity of the sparse disk
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      path:
+                        description: The path to HostDisk image located on the cluster
+                        type: string
+                      shared:
+                        description: Shared indicate whether the path is shared between
+                          nodes
+                        type: boolean
+                      type:
+                        description: |-
+                          Contains information if disk.img exists or should be created
+                          allowed options are 'Disk' and 'DiskOrCreate'
+                        type: string
+                    required:
+                    - path
+                    - type
+                    type: object
+                type: object
               volumeName:
                 description: VolumeName is the name of the volume that is being migrated
                 type: string
@@ -14338,6 +15466,36 @@ var CRDsValidation map[string]string = map[string]string{
             launcher
           format: int64
           type: integer
+        secureBootKeys:
+          description: |-
+            SecureBootKeys reports the SecureBoot keys enrolled in the EFI NVRAM
+            of the VMI when custom SecureBoot keys are requested.
+          properties:
+            db:
+              description: DB are the fingerprints of the allowed signature database
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            dbx:
+              description: DBX are the fingerprints of the forbidden signature database
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            kek:
+              description: KEK are the fingerprints of the key exchange keys
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            pk:
+              description: PK is the fingerprint of the platform key
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         selinuxContext:
           description: SELinuxContext is the actual SELinux context of the virt-launcher
             pod
@@ -14348,6 +15506,25 @@ var CRDsValidation map[string]string = map[string]string{
               format: int64
               type: integer
           type: object
+        vcpus:
+          description: |-
+            Vcpus shows how many vCPUs are requested from and plugged into the guest.
+            Both differ while the guest releases hot-unplugged vCPUs.
+          properties:
+            plugged:
+              description: Plugged is the number of vCPUs currently plugged into the
+                guest.
+              format: int64
+              type: integer
+            requested:
+              description: Requested is the number of vCPUs the guest was asked to
+                use.
+              format: int64
+              type: integer
+          required:
+          - plugged
+          - requested
+          type: object
         virtualMachineRevisionName:
           description: |-
             VirtualMachineRevisionName is used to get the vm revision of the vmi when doing
@@ -14409,6 +15586,25 @@ var CRDsValidation map[string]string = map[string]string{
               name:
                 description: Name is the name of the volume
                 type: string
+              persistentReservation:
+                description: PersistentReservation shows the SCSI persistent reservation
+                  state of the volume, if the volume is a LUN with reservation
+                properties:
+                  holder:
+                    description: Holder is the key of the current reservation holder,
+                      empty if the LUN is not reserved
+                    type: string
+                  keys:
+                    description: Keys are the reservation keys registered on the LUN
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  type:
+                    description: Type is the type of the current reservation, e.g.
+                      WriteExclusiveRegistrantsOnly
+                    type: string
+                type: object
               persistentVolumeClaimInfo:
                 description: PersistentVolumeClaimInfo is information about the PVC
                   that handler requires during start flow
@@ -15274,11 +16470,22 @@ var CRDsValidation map[string]string = map[string]string{
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          unit:
+                            description: |-
+                              Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                            type: integer
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
                           policy (stop) for the disk
                         type: string
+                      growFilesystem:
+                        description: |-
+                          GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                          after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                          Defaults to false.
+                        type: boolean
                       io:
                         description: |-
                           IO specifies which QEMU disk IO mode should be used.
@@ -15301,6 +16508,11 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Reservation indicates if the disk needs to
                               support the persistent reservation for the SCSI disk
                             type: boolean
+                          unit:
+                            description: |-
+                              Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                            type: integer
                         type: object
                       name:
                         description: Name is the device name
@@ -15325,6 +16537,17 @@ var CRDsValidation map[string]string = map[string]string{
                 downwardMetrics:
                   description: DownwardMetrics creates a virtio serials for exposing
                     the downward metrics to the vmi.
+                  properties:
+                    metricGroups:
+                      description: |-
+                        MetricGroups selects the groups of metrics published to the guest.
+                        Defaults to the Host, CPU and Memory groups.
+                      items:
+                        description: DownwardMetricsGroup is a group of metrics published
+                          to the guest through the downward metrics
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
                   type: object
                 filesystems:
                   description: Filesystems describes filesystem which is connected
@@ -15647,6 +16870,19 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                serialConsoleRecording:
+                  description: |-
+                    SerialConsoleRecording records the output of the auto-attached default serial console,
+                    with the time it was written at, in the asciicast v2 format of asciinema.
+                    The serial console is logged when it is recorded.
+                  properties:
+                    claimName:
+                      description: |-
+                        ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,
+                        in one file per VirtualMachineInstance named after its UID.
+                        When not set, the recording is written to the log of the 'guest-console-log' container.
+                      type: string
+                  type: object
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -16010,6 +17246,44 @@ var CRDsValidation map[string]string = map[string]string{
                             Requires SMM to be enabled.
                             Defaults to true
                           type: boolean
+                        secureBootKeys:
+                          description: |-
+                            SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                            of the VM instead of the keys shipped with the firmware.
+                            They are enrolled when the EFI NVRAM is created at first boot.
+                            Requires SecureBoot and Persistent to be enabled.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap that contains
+                                the SecureBoot keys.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            secret:
+                              description: Secret references a k8s Secret that contains
+                                the SecureBoot keys.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                          type: object
                       type: object
                   type: object
                 kernelBoot:
@@ -16084,9 +17358,29 @@ var CRDsValidation map[string]string = map[string]string{
                       description: If specified, run the attestation process for a
                         vmi.
                       type: object
+                    authorKey:
+                      description: |-
+                        The ID authentication information contains the author key signing the ID key.
+                        Requires an ID authentication information structure.
+                        Defaults to false.
+                      type: boolean
                     dhCert:
                       description: Base64 encoded guest owner's Diffie-Hellman key.
                       type: string
+                    hostData:
+                      description: Base64 encoded 32 bytes of data provided by the
+                        host, which are included in the SEV-SNP attestation reports
+                        of the guest.
+                      type: string
+                    idAuth:
+                      description: |-
+                        Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                        Requires an ID block.
+                      type: string
+                    idBlock:
+                      description: Base64 encoded SEV-SNP ID block of 96 bytes, holding
+                        the expected launch digest and guest policy.
+                      type: string
                     policy:
                       description: |-
                         Guest policy flags as defined in AMD SEV API specification.
@@ -16097,11 +17391,54 @@ var CRDsValidation map[string]string = map[string]string{
                             SEV-ES is required.
                             Defaults to false.
                           type: boolean
+                        secureNestedPaging:
+                          description: |-
+                            SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                            Defaults to false.
+                          type: boolean
+                        singleSocket:
+                          description: |-
+                            The SEV-SNP guest can only be activated on a single socket.
+                            Requires SEV-SNP.
+                            Defaults to false.
+                          type: boolean
                       type: object
                     session:
                       description: Base64 encoded session blob.
                       type: string
                   type: object
+                tdx:
+                  description: Intel Trust Domain Extensions (TDX).
+                  properties:
+                    attestation:
+                      description: |-
+                        If specified, the quote generation service of the node is made available to the guest
+                        and quotes can be fetched for TD reports of the guest.
+                      type: object
+                    mrConfigID:
+                      description: Base64 encoded SHA384 digest identifying the guest
+                        owner defined configuration of the trust domain.
+                      type: string
+                    mrOwner:
+                      description: Base64 encoded SHA384 digest identifying the owner
+                        of the trust domain.
+                      type: string
+                    mrOwnerConfig:
+                      description: Base64 encoded SHA384 digest of the owner defined
+                        configuration of the trust domain.
+                      type: string
+                    policy:
+                      description: |-
+                        Guest policy flags as defined in the Intel TDX module specification.
+                        Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                      properties:
+                        septVEDisable:
+                          description: |-
+                            Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                            Defaults to true.
+                          type: boolean
+                      type: object
+                  type: object
               type: object
             machine:
               description: Machine type.
@@ -17758,11 +19095,22 @@ var CRDsValidation map[string]string = map[string]string{
                                       ReadOnly.
                                       Defaults to false.
                                     type: boolean
+                                  unit:
+                                    description: |-
+                                      Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                      It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                    type: integer
                                 type: object
                               errorPolicy:
                                 description: If specified, it can change the default
                                   error policy (stop) for the disk
                                 type: string
+                              growFilesystem:
+                                description: |-
+                                  GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                                  after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                                  Defaults to false.
+                                type: boolean
                               io:
                                 description: |-
                                   IO specifies which QEMU disk IO mode should be used.
@@ -17786,6 +19134,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       needs to support the persistent reservation
                                       for the SCSI disk
                                     type: boolean
+                                  unit:
+                                    description: |-
+                                      Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                      It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                    type: integer
                                 type: object
                               name:
                                 description: Name is the device name
@@ -17810,6 +19163,17 @@ var CRDsValidation map[string]string = map[string]string{
                         downwardMetrics:
                           description: DownwardMetrics creates a virtio serials for
                             exposing the downward metrics to the vmi.
+                          properties:
+                            metricGroups:
+                              description: |-
+                                MetricGroups selects the groups of metrics published to the guest.
+                                Defaults to the Host, CPU and Memory groups.
+                              items:
+                                description: DownwardMetricsGroup is a group of metrics
+                                  published to the guest through the downward metrics
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
                           type: object
                         filesystems:
                           description: Filesystems describes filesystem which is connected
@@ -18136,6 +19500,19 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        serialConsoleRecording:
+                          description: |-
+                            SerialConsoleRecording records the output of the auto-attached default serial console,
+                            with the time it was written at, in the asciicast v2 format of asciinema.
+                            The serial console is logged when it is recorded.
+                          properties:
+                            claimName:
+                              description: |-
+                                ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,
+                                in one file per VirtualMachineInstance named after its UID.
+                                When not set, the recording is written to the log of the 'guest-console-log' container.
+                              type: string
+                          type: object
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -18503,6 +19880,44 @@ var CRDsValidation map[string]string = map[string]string{
                                     Requires SMM to be enabled.
                                     Defaults to true
                                   type: boolean
+                                secureBootKeys:
+                                  description: |-
+                                    SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                                    of the VM instead of the keys shipped with the firmware.
+                                    They are enrolled when the EFI NVRAM is created at first boot.
+                                    Requires SecureBoot and Persistent to be enabled.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        that contains the SecureBoot keys.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        that contains the SecureBoot keys.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                  type: object
                               type: object
                           type: object
                         kernelBoot:
@@ -18579,10 +19994,30 @@ var CRDsValidation map[string]string = map[string]string{
                               description: If specified, run the attestation process
                                 for a vmi.
                               type: object
+                            authorKey:
+                              description: |-
+                                The ID authentication information contains the author key signing the ID key.
+                                Requires an ID authentication information structure.
+                                Defaults to false.
+                              type: boolean
                             dhCert:
                               description: Base64 encoded guest owner's Diffie-Hellman
                                 key.
                               type: string
+                            hostData:
+                              description: Base64 encoded 32 bytes of data provided
+                                by the host, which are included in the SEV-SNP attestation
+                                reports of the guest.
+                              type: string
+                            idAuth:
+                              description: |-
+                                Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                                Requires an ID block.
+                              type: string
+                            idBlock:
+                              description: Base64 encoded SEV-SNP ID block of 96 bytes,
+                                holding the expected launch digest and guest policy.
+                              type: string
                             policy:
                               description: |-
                                 Guest policy flags as defined in AMD SEV API specification.
@@ -18593,11 +20028,55 @@ var CRDsValidation map[string]string = map[string]string{
                                     SEV-ES is required.
                                     Defaults to false.
                                   type: boolean
+                                secureNestedPaging:
+                                  description: |-
+                                    SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                                    Defaults to false.
+                                  type: boolean
+                                singleSocket:
+                                  description: |-
+                                    The SEV-SNP guest can only be activated on a single socket.
+                                    Requires SEV-SNP.
+                                    Defaults to false.
+                                  type: boolean
                               type: object
                             session:
                               description: Base64 encoded session blob.
                               type: string
                           type: object
+                        tdx:
+                          description: Intel Trust Domain Extensions (TDX).
+                          properties:
+                            attestation:
+                              description: |-
+                                If specified, the quote generation service of the node is made available to the guest
+                                and quotes can be fetched for TD reports of the guest.
+                              type: object
+                            mrConfigID:
+                              description: Base64 encoded SHA384 digest identifying
+                                the guest owner defined configuration of the trust
+                                domain.
+                              type: string
+                            mrOwner:
+                              description: Base64 encoded SHA384 digest identifying
+                                the owner of the trust domain.
+                              type: string
+                            mrOwnerConfig:
+                              description: Base64 encoded SHA384 digest of the owner
+                                defined configuration of the trust domain.
+                              type: string
+                            policy:
+                              description: |-
+                                Guest policy flags as defined in the Intel TDX module specification.
+                                Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                              properties:
+                                septVEDisable:
+                                  description: |-
+                                    Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                          type: object
                       type: object
                     machine:
                       description: Machine type.
@@ -18684,6 +20163,19 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                hibernation:
+                  description: |-
+                    Hibernation configures the PersistentVolumeClaim the memory and device state of the
+                    VirtualMachineInstance is saved to when its VirtualMachine is hibernated.
+                  properties:
+                    claimName:
+                      description: |-
+                        ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to.
+                        It must be large enough to hold the guest memory and must not be used by a volume of the VMI.
+                      type: string
+                  required:
+                  - claimName
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -19086,6 +20578,13 @@ var CRDsValidation map[string]string = map[string]string{
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                sharedDiskGroup:
+                  description: |-
+                    SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with
+                    SCSI persistent reservation, e.g. the nodes of a guest failover cluster.
+                    All members of a group must use the same shareable reservation LUNs and are
+                    scheduled on different nodes.
+                  type: string
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -19483,6 +20982,27 @@ var CRDsValidation map[string]string = map[string]string{
                               registry secret required to pull the image. The secret
                               must already exist.
                             type: string
+                          mode:
+                            description: |-
+                              Mode defines how the disk is served from the image.
+                              Standalone expects a single self-contained disk file.
+                              Layered serves a qcow2 backing chain spread over the image layers in place,
+                              so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                              Defaults to Standalone.
+                            type: string
+                          overlay:
+                            description: |-
+                              Overlay configures the writable layer on top of the image.
+                              By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                            properties:
+                              claimName:
+                                description: |-
+                                  ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                                  so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                                type: string
+                            required:
+                            - claimName
+                            type: object
                           path:
                             description: Path defines the path to disk file in the
                               container
@@ -19589,6 +21109,17 @@ var CRDsValidation map[string]string = map[string]string{
                         description: |-
                           DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
                           metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                        properties:
+                          metricGroups:
+                            description: |-
+                              MetricGroups selects the groups of metrics published to the guest.
+                              Defaults to the Host, CPU and Memory groups.
+                            items:
+                              description: DownwardMetricsGroup is a group of metrics
+                                published to the guest through the downward metrics
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       emptyDisk:
                         description: |-
@@ -20014,9 +21545,28 @@ var CRDsValidation map[string]string = map[string]string{
                 attestation:
                   description: If specified, run the attestation process for a vmi.
                   type: object
+                authorKey:
+                  description: |-
+                    The ID authentication information contains the author key signing the ID key.
+                    Requires an ID authentication information structure.
+                    Defaults to false.
+                  type: boolean
                 dhCert:
                   description: Base64 encoded guest owner's Diffie-Hellman key.
                   type: string
+                hostData:
+                  description: Base64 encoded 32 bytes of data provided by the host,
+                    which are included in the SEV-SNP attestation reports of the guest.
+                  type: string
+                idAuth:
+                  description: |-
+                    Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                    Requires an ID block.
+                  type: string
+                idBlock:
+                  description: Base64 encoded SEV-SNP ID block of 96 bytes, holding
+                    the expected launch digest and guest policy.
+                  type: string
                 policy:
                   description: |-
                     Guest policy flags as defined in AMD SEV API specification.
@@ -20027,11 +21577,54 @@ var CRDsValidation map[string]string = map[string]string{
                         SEV-ES is required.
                         Defaults to false.
                       type: boolean
+                    secureNestedPaging:
+                      description: |-
+                        SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                        Defaults to false.
+                      type: boolean
+                    singleSocket:
+                      description: |-
+                        The SEV-SNP guest can only be activated on a single socket.
+                        Requires SEV-SNP.
+                        Defaults to false.
+                      type: boolean
                   type: object
                 session:
                   description: Base64 encoded session blob.
                   type: string
               type: object
+            tdx:
+              description: Intel Trust Domain Extensions (TDX).
+              properties:
+                attestation:
+                  description: |-
+                    If specified, the quote generation service of the node is made available to the guest
+                    and quotes can be fetched for TD reports of the guest.
+                  type: object
+                mrConfigID:
+                  description: Base64 encoded SHA384 digest identifying the guest
+                    owner defined configuration of the trust domain.
+                  type: string
+                mrOwner:
+                  description: Base64 encoded SHA384 digest identifying the owner
+                    of the trust domain.
+                  type: string
+                mrOwnerConfig:
+                  description: Base64 encoded SHA384 digest of the owner defined configuration
+                    of the trust domain.
+                  type: string
+                policy:
+                  description: |-
+                    Guest policy flags as defined in the Intel TDX module specification.
+                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                  properties:
+                    septVEDisable:
+                      description: |-
+                        Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                        Defaults to true.
+                      type: boolean
+                  type: object
+              type: object
           type: object
         memory:
           description: Required Memory related attributes of the instancetype.
@@ -22423,11 +24016,22 @@ var CRDsValidation map[string]string = map[string]string{
                                               ReadOnly.
                                               Defaults to false.
                                             type: boolean
+                                          unit:
+                                            description: |-
+                                              Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                            type: integer
                                         type: object
                                       errorPolicy:
                                         description: If specified, it can change the
                                           default error policy (stop) for the disk
                                         type: string
+                                      growFilesystem:
+                                        description: |-
+                                          GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                                          after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                                          Defaults to false.
+                                        type: boolean
                                       io:
                                         description: |-
                                           IO specifies which QEMU disk IO mode should be used.
@@ -22452,6 +24056,11 @@ var CRDsValidation map[string]string = map[string]string{
                                               the disk needs to support the persistent
                                               reservation for the SCSI disk
                                             type: boolean
+                                          unit:
+                                            description: |-
+                                              Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                              It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                            type: integer
                                         type: object
                                       name:
                                         description: Name is the device name
@@ -22478,6 +24087,18 @@ var CRDsValidation map[string]string = map[string]string{
                                 downwardMetrics:
                                   description: DownwardMetrics creates a virtio serials
                                     for exposing the downward metrics to the vmi.
+                                  properties:
+                                    metricGroups:
+                                      description: |-
+                                        MetricGroups selects the groups of metrics published to the guest.
+                                        Defaults to the Host, CPU and Memory groups.
+                                      items:
+                                        description: DownwardMetricsGroup is a group
+                                          of metrics published to the guest through
+                                          the downward metrics
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                   type: object
                                 filesystems:
                                   description: Filesystems describes filesystem which
@@ -22813,6 +24434,19 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Whether to have random number generator
                                     from host
                                   type: object
+                                serialConsoleRecording:
+                                  description: |-
+                                    SerialConsoleRecording records the output of the auto-attached default serial console,
+                                    with the time it was written at, in the asciicast v2 format of asciinema.
+                                    The serial console is logged when it is recorded.
+                                  properties:
+                                    claimName:
+                                      description: |-
+                                        ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,
+                                        in one file per VirtualMachineInstance named after its UID.
+                                        When not set, the recording is written to the log of the 'guest-console-log' container.
+                                      type: string
+                                  type: object
                                 sound:
                                   description: Whether to emulate a sound device.
                                   properties:
@@ -23184,6 +24818,46 @@ var CRDsValidation map[string]string = map[string]string{
                                             Requires SMM to be enabled.
                                             Defaults to true
                                           type: boolean
+                                        secureBootKeys:
+                                          description: |-
+                                            SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                                            of the VM instead of the keys shipped with the firmware.
+                                            They are enrolled when the EFI NVRAM is created at first boot.
+                                            Requires SecureBoot and Persistent to be enabled.
+                                          properties:
+                                            configMap:
+                                              description: ConfigMap references a
+                                                ConfigMap that contains the SecureBoot
+                                                keys.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            secret:
+                                              description: Secret references a k8s
+                                                Secret that contains the SecureBoot
+                                                keys.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                          type: object
                                       type: object
                                   type: object
                                 kernelBoot:
@@ -23263,10 +24937,31 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: If specified, run the attestation
                                         process for a vmi.
                                       type: object
+                                    authorKey:
+                                      description: |-
+                                        The ID authentication information contains the author key signing the ID key.
+                                        Requires an ID authentication information structure.
+                                        Defaults to false.
+                                      type: boolean
                                     dhCert:
                                       description: Base64 encoded guest owner's Diffie-Hellman
                                         key.
                                       type: string
+                                    hostData:
+                                      description: Base64 encoded 32 bytes of data
+                                        provided by the host, which are included in
+                                        the SEV-SNP attestation reports of the guest.
+                                      type: string
+                                    idAuth:
+                                      description: |-
+                                        Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                                        Requires an ID block.
+                                      type: string
+                                    idBlock:
+                                      description: Base64 encoded SEV-SNP ID block
+                                        of 96 bytes, holding the expected launch digest
+                                        and guest policy.
+                                      type: string
                                     policy:
                                       description: |-
                                         Guest policy flags as defined in AMD SEV API specification.
@@ -23277,11 +24972,56 @@ var CRDsValidation map[string]string = map[string]string{
                                             SEV-ES is required.
                                             Defaults to false.
                                           type: boolean
+                                        secureNestedPaging:
+                                          description: |-
+                                            SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                                            Defaults to false.
+                                          type: boolean
+                                        singleSocket:
+                                          description: |-
+                                            The SEV-SNP guest can only be activated on a single socket.
+                                            Requires SEV-SNP.
+                                            Defaults to false.
+                                          type: boolean
                                       type: object
                                     session:
                                       description: Base64 encoded session blob.
                                       type: string
                                   type: object
+                                tdx:
+                                  description: Intel Trust Domain Extensions (TDX).
+                                  properties:
+                                    attestation:
+                                      description: |-
+                                        If specified, the quote generation service of the node is made available to the guest
+                                        and quotes can be fetched for TD reports of the guest.
+                                      type: object
+                                    mrConfigID:
+                                      description: Base64 encoded SHA384 digest identifying
+                                        the guest owner defined configuration of the
+                                        trust domain.
+                                      type: string
+                                    mrOwner:
+                                      description: Base64 encoded SHA384 digest identifying
+                                        the owner of the trust domain.
+                                      type: string
+                                    mrOwnerConfig:
+                                      description: Base64 encoded SHA384 digest of
+                                        the owner defined configuration of the trust
+                                        domain.
+                                      type: string
+                                    policy:
+                                      description: |-
+                                        Guest policy flags as defined in the Intel TDX module specification.
+                                        Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                                      properties:
+                                        septVEDisable:
+                                          description: |-
+                                            Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                                            Defaults to true.
+                                          type: boolean
+                                      type: object
+                                  type: object
                               type: object
                             machine:
                               description: Machine type.
@@ -23371,6 +25111,19 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        hibernation:
+                          description: |-
+                            Hibernation configures the PersistentVolumeClaim the memory and device state of the
+                            VirtualMachineInstance is saved to when its VirtualMachine is hibernated.
+                          properties:
+                            claimName:
+                              description: |-
+                                ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to.
+                                It must be large enough to hold the guest memory and must not be used by a volume of the VMI.
+                              type: string
+                          required:
+                          - claimName
+                          type: object
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
@@ -23773,6 +25526,13 @@ var CRDsValidation map[string]string = map[string]string{
                             If specified, the VMI will be dispatched by specified scheduler.
                             If not specified, the VMI will be dispatched by default scheduler.
                           type: string
+                        sharedDiskGroup:
+                          description: |-
+                            SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with
+                            SCSI persistent reservation, e.g. the nodes of a guest failover cluster.
+                            All members of a group must use the same shareable reservation LUNs and are
+                            scheduled on different nodes.
+                          type: string
                         startStrategy:
                           description: StartStrategy can be set to "Paused" if Virtual
                             Machine should be started in paused state.
@@ -24173,6 +25933,27 @@ var CRDsValidation map[string]string = map[string]string{
                                       Docker registry secret required to pull the
                                       image. The secret must already exist.
                                     type: string
+                                  mode:
+                                    description: |-
+                                      Mode defines how the disk is served from the image.
+                                      Standalone expects a single self-contained disk file.
+                                      Layered serves a qcow2 backing chain spread over the image layers in place,
+                                      so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                                      Defaults to Standalone.
+                                    type: string
+                                  overlay:
+                                    description: |-
+                                      Overlay configures the writable layer on top of the image.
+                                      By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                                    properties:
+                                      claimName:
+                                        description: |-
+                                          ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                                          so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                                        type: string
+                                    required:
+                                    - claimName
+                                    type: object
                                   path:
                                     description: Path defines the path to disk file
                                       in the container
@@ -24285,6 +26066,18 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: |-
                                   DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
                                   metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                                properties:
+                                  metricGroups:
+                                    description: |-
+                                      MetricGroups selects the groups of metrics published to the guest.
+                                      Defaults to the Host, CPU and Memory groups.
+                                    items:
+                                      description: DownwardMetricsGroup is a group
+                                        of metrics published to the guest through
+                                        the downward metrics
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                               emptyDisk:
                                 description: |-
@@ -25123,6 +26916,44 @@ var CRDsValidation map[string]string = map[string]string{
                     Requires SMM to be enabled.
                     Defaults to true
                   type: boolean
+                secureBootKeys:
+                  description: |-
+                    SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                    of the VM instead of the keys shipped with the firmware.
+                    They are enrolled when the EFI NVRAM is created at first boot.
+                    Requires SecureBoot and Persistent to be enabled.
+                  properties:
+                    configMap:
+                      description: ConfigMap references a ConfigMap that contains
+                        the SecureBoot keys.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    secret:
+                      description: Secret references a k8s Secret that contains the
+                        SecureBoot keys.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                  type: object
               type: object
             preferredUseBios:
               description: PreferredUseBios optionally enables BIOS
@@ -25246,6 +27077,13 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        persistentState:
+          description: |-
+            PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,
+            is restored. When excluded, an existing target keeps its current state and a new target
+            starts with a new state.
+            Defaults to Include
+          type: string
         target:
           description: initially only VirtualMachine type supported
           properties:
@@ -25405,6 +27243,12 @@ var CRDsValidation map[string]string = map[string]string{
             as failed.
             Defaults to DefaultFailureDeadline - 5min
           type: string
+        persistentState:
+          description: |-
+            PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,
+            is part of the snapshot.
+            Defaults to Include
+          type: string
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -27774,12 +29618,23 @@ var CRDsValidation map[string]string = map[string]string{
                                                   ReadOnly.
                                                   Defaults to false.
                                                 type: boolean
+                                              unit:
+                                                description: |-
+                                                  Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                                  It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                                type: integer
                                             type: object
                                           errorPolicy:
                                             description: If specified, it can change
                                               the default error policy (stop) for
                                               the disk
                                             type: string
+                                          growFilesystem:
+                                            description: |-
+                                              GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                                              after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                                              Defaults to false.
+                                            type: boolean
                                           io:
                                             description: |-
                                               IO specifies which QEMU disk IO mode should be used.
@@ -27805,6 +29660,11 @@ var CRDsValidation map[string]string = map[string]string{
                                                   persistent reservation for the SCSI
                                                   disk
                                                 type: boolean
+                                              unit:
+                                                description: |-
+                                                  Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                                  It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                                type: integer
                                             type: object
                                           name:
                                             description: Name is the device name
@@ -27833,6 +29693,18 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: DownwardMetrics creates a virtio
                                         serials for exposing the downward metrics
                                         to the vmi.
+                                      properties:
+                                        metricGroups:
+                                          description: |-
+                                            MetricGroups selects the groups of metrics published to the guest.
+                                            Defaults to the Host, CPU and Memory groups.
+                                          items:
+                                            description: DownwardMetricsGroup is a
+                                              group of metrics published to the guest
+                                              through the downward metrics
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
                                       type: object
                                     filesystems:
                                       description: Filesystems describes filesystem
@@ -28171,6 +30043,19 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Whether to have random number generator
                                         from host
                                       type: object
+                                    serialConsoleRecording:
+                                      description: |-
+                                        SerialConsoleRecording records the output of the auto-attached default serial console,
+                                        with the time it was written at, in the asciicast v2 format of asciinema.
+                                        The serial console is logged when it is recorded.
+                                      properties:
+                                        claimName:
+                                          description: |-
+                                            ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,
+                                            in one file per VirtualMachineInstance named after its UID.
+                                            When not set, the recording is written to the log of the 'guest-console-log' container.
+                                          type: string
+                                      type: object
                                     sound:
                                       description: Whether to emulate a sound device.
                                       properties:
@@ -28542,6 +30427,46 @@ var CRDsValidation map[string]string = map[string]string{
                                                 Requires SMM to be enabled.
                                                 Defaults to true
                                               type: boolean
+                                            secureBootKeys:
+                                              description: |-
+                                                SecureBootKeys references the certificates enrolled as the SecureBoot keys
+                                                of the VM instead of the keys shipped with the firmware.
+                                                They are enrolled when the EFI NVRAM is created at first boot.
+                                                Requires SecureBoot and Persistent to be enabled.
+                                              properties:
+                                                configMap:
+                                                  description: ConfigMap references
+                                                    a ConfigMap that contains the
+                                                    SecureBoot keys.
+                                                  properties:
+                                                    name:
+                                                      default: ""
+                                                      description: |-
+                                                        Name of the referent.
+                                                        This field is effectively required, but due to backwards compatibility is
+                                                        allowed to be empty. Instances of this type with an empty value here are
+                                                        almost certainly wrong.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                      type: string
+                                                  type: object
+                                                  x-kubernetes-map-type: atomic
+                                                secret:
+                                                  description: Secret references a
+                                                    k8s Secret that contains the SecureBoot
+                                                    keys.
+                                                  properties:
+                                                    name:
+                                                      default: ""
+                                                      description: |-
+                                                        Name of the referent.
+                                                        This field is effectively required, but due to backwards compatibility is
+                                                        allowed to be empty. Instances of this type with an empty value here are
+                                                        almost certainly wrong.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                      type: string
+                                                  type: object
+                                                  x-kubernetes-map-type: atomic
+                                              type: object
                                           type: object
                                       type: object
                                     kernelBoot:
@@ -28622,10 +30547,32 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: If specified, run the attestation
                                             process for a vmi.
                                           type: object
+                                        authorKey:
+                                          description: |-
+                                            The ID authentication information contains the author key signing the ID key.
+                                            Requires an ID authentication information structure.
+                                            Defaults to false.
+                                          type: boolean
                                         dhCert:
                                           description: Base64 encoded guest owner's
                                             Diffie-Hellman key.
                                           type: string
+                                        hostData:
+                                          description: Base64 encoded 32 bytes of
+                                            data provided by the host, which are included
+                                            in the SEV-SNP attestation reports of
+                                            the guest.
+                                          type: string
+                                        idAuth:
+                                          description: |-
+                                            Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+                                            Requires an ID block.
+                                          type: string
+                                        idBlock:
+                                          description: Base64 encoded SEV-SNP ID block
+                                            of 96 bytes, holding the expected launch
+                                            digest and guest policy.
+                                          type: string
                                         policy:
                                           description: |-
                                             Guest policy flags as defined in AMD SEV API specification.
@@ -28636,11 +30583,56 @@ var CRDsValidation map[string]string = map[string]string{
                                                 SEV-ES is required.
                                                 Defaults to false.
                                               type: boolean
+                                            secureNestedPaging:
+                                              description: |-
+                                                SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+                                                Defaults to false.
+                                              type: boolean
+                                            singleSocket:
+                                              description: |-
+                                                The SEV-SNP guest can only be activated on a single socket.
+                                                Requires SEV-SNP.
+                                                Defaults to false.
+                                              type: boolean
                                           type: object
                                         session:
                                           description: Base64 encoded session blob.
                                           type: string
                                       type: object
+                                    tdx:
+                                      description: Intel Trust Domain Extensions (TDX).
+                                      properties:
+                                        attestation:
+                                          description: |-
+                                            If specified, the quote generation service of the node is made available to the guest
+                                            and quotes can be fetched for TD reports of the guest.
+                                          type: object
+                                        mrConfigID:
+                                          description: Base64 encoded SHA384 digest
+                                            identifying the guest owner defined configuration
+                                            of the trust domain.
+                                          type: string
+                                        mrOwner:
+                                          description: Base64 encoded SHA384 digest
+                                            identifying the owner of the trust domain.
+                                          type: string
+                                        mrOwnerConfig:
+                                          description: Base64 encoded SHA384 digest
+                                            of the owner defined configuration of
+                                            the trust domain.
+                                          type: string
+                                        policy:
+                                          description: |-
+                                            Guest policy flags as defined in the Intel TDX module specification.
+                                            Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                                          properties:
+                                            septVEDisable:
+                                              description: |-
+                                                Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+                                                Defaults to true.
+                                              type: boolean
+                                          type: object
+                                      type: object
                                   type: object
                                 machine:
                                   description: Machine type.
@@ -28730,6 +30722,19 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            hibernation:
+                              description: |-
+                                Hibernation configures the PersistentVolumeClaim the memory and device state of the
+                                VirtualMachineInstance is saved to when its VirtualMachine is hibernated.
+                              properties:
+                                claimName:
+                                  description: |-
+                                    ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to.
+                                    It must be large enough to hold the guest memory and must not be used by a volume of the VMI.
+                                  type: string
+                              required:
+                              - claimName
+                              type: object
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
@@ -29135,6 +31140,13 @@ var CRDsValidation map[string]string = map[string]string{
                                 If specified, the VMI will be dispatched by specified scheduler.
                                 If not specified, the VMI will be dispatched by default scheduler.
                               type: string
+                            sharedDiskGroup:
+                              description: |-
+                                SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with
+                                SCSI persistent reservation, e.g. the nodes of a guest failover cluster.
+                                All members of a group must use the same shareable reservation LUNs and are
+                                scheduled on different nodes.
+                              type: string
                             startStrategy:
                               description: StartStrategy can be set to "Paused" if
                                 Virtual Machine should be started in paused state.
@@ -29541,6 +31553,27 @@ var CRDsValidation map[string]string = map[string]string{
                                           the Docker registry secret required to pull
                                           the image. The secret must already exist.
                                         type: string
+                                      mode:
+                                        description: |-
+                                          Mode defines how the disk is served from the image.
+                                          Standalone expects a single self-contained disk file.
+                                          Layered serves a qcow2 backing chain spread over the image layers in place,
+                                          so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                                          Defaults to Standalone.
+                                        type: string
+                                      overlay:
+                                        description: |-
+                                          Overlay configures the writable layer on top of the image.
+                                          By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                                        properties:
+                                          claimName:
+                                            description: |-
+                                              ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                                              so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                                            type: string
+                                        required:
+                                        - claimName
+                                        type: object
                                       path:
                                         description: Path defines the path to disk
                                           file in the container
@@ -29654,6 +31687,18 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: |-
                                       DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
                                       metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                                    properties:
+                                      metricGroups:
+                                        description: |-
+                                          MetricGroups selects the groups of metrics published to the guest.
+                                          Defaults to the Host, CPU and Memory groups.
+                                        items:
+                                          description: DownwardMetricsGroup is a group
+                                            of metrics published to the guest through
+                                            the downward metrics
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
                                     type: object
                                   emptyDisk:
                                     description: |-
@@ -29907,6 +31952,43 @@ var CRDsValidation map[string]string = map[string]string{
                         updated through an Update() before ObservedGeneration in Status.
                       format: int64
                       type: integer
+                    diskTaskInProgress:
+                      description: DiskTaskInProgress is the name of the VirtualMachineDiskTask
+                        currently processing the volumes of the VM
+                      type: string
+                    hibernation:
+                      description: Hibernation reports the last hibernation of the
+                        VM and the restoring of its saved state
+                      nullable: true
+                      properties:
+                        endTimestamp:
+                          description: EndTimestamp represents the time the state
+                            was saved or restored
+                          format: date-time
+                          type: string
+                        machineType:
+                          description: MachineType is the machine type the state was
+                            saved with
+                          type: string
+                        message:
+                          description: Message is a detailed message about failure
+                            of the saving or restoring
+                          type: string
+                        phase:
+                          description: Phase represents the hibernation phase
+                          type: string
+                        qemuVersion:
+                          description: QEMUVersion is the version of QEMU the state
+                            was saved with
+                          type: string
+                        startTimestamp:
+                          description: StartTimestamp represents the time the current
+                            phase started
+                          format: date-time
+                          type: string
+                      required:
+                      - phase
+                      type: object
                     instancetypeRef:
                       description: InstancetypeRef captures the state of any referenced
                         instance type from the VirtualMachine
@@ -29980,6 +32062,43 @@ var CRDsValidation map[string]string = map[string]string{
                         the vmi when started.
                       format: int64
                       type: integer
+                    persistentReservations:
+                      description: PersistentReservations reports the SCSI persistent
+                        reservation state of the LUNs with reservation of the running
+                        VMI
+                      items:
+                        description: VolumePersistentReservation shows the SCSI persistent
+                          reservation state of a volume
+                        properties:
+                          name:
+                            description: Name is the name of the volume
+                            type: string
+                          reservation:
+                            description: Reservation is the persistent reservation
+                              state of the volume
+                            properties:
+                              holder:
+                                description: Holder is the key of the current reservation
+                                  holder, empty if the LUN is not reserved
+                                type: string
+                              keys:
+                                description: Keys are the reservation keys registered
+                                  on the LUN
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              type:
+                                description: Type is the type of the current reservation,
+                                  e.g. WriteExclusiveRegistrantsOnly
+                                type: string
+                            type: object
+                        required:
+                        - name
+                        - reservation
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     preferenceRef:
                       description: PreferenceRef captures the state of any referenced
                         preference from the VirtualMachine
@@ -30027,6 +32146,39 @@ var CRDsValidation map[string]string = map[string]string{
                         RunStrategy tracks the last recorded RunStrategy used by the VM.
                         This is needed to correctly process the next strategy (for now only the RerunOnFailure)
                       type: string
+                    secureBootKeys:
+                      description: SecureBootKeys reports the SecureBoot keys last
+                        enrolled in the persistent EFI NVRAM of the VM
+                      nullable: true
+                      properties:
+                        db:
+                          description: DB are the fingerprints of the allowed signature
+                            database
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        dbx:
+                          description: DBX are the fingerprints of the forbidden signature
+                            database
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        kek:
+                          description: KEK are the fingerprints of the key exchange
+                            keys
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        pk:
+                          description: PK is the fingerprint of the platform key
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
                     snapshotInProgress:
                       description: SnapshotInProgress is the name of the VirtualMachineSnapshot
                         currently executing
@@ -30180,11 +32332,22 @@ var CRDsValidation map[string]string = map[string]string{
                                           ReadOnly.
                                           Defaults to false.
                                         type: boolean
+                                      unit:
+                                        description: |-
+                                          Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                          It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                        type: integer
                                     type: object
                                   errorPolicy:
                                     description: If specified, it can change the default
                                       error policy (stop) for the disk
                                     type: string
+                                  growFilesystem:
+                                    description: |-
+                                      GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+                                      after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+                                      Defaults to false.
+                                    type: boolean
                                   io:
                                     description: |-
                                       IO specifies which QEMU disk IO mode should be used.
@@ -30208,6 +32371,11 @@ var CRDsValidation map[string]string = map[string]string{
                                           disk needs to support the persistent reservation
                                           for the SCSI disk
                                         type: boolean
+                                      unit:
+                                        description: |-
+                                          Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+                                          It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+                                        type: integer
                                     type: object
                                   name:
                                     description: Name is the device name
@@ -30410,6 +32578,25 @@ var CRDsValidation map[string]string = map[string]string{
                                           Value of Filesystem is implied when not included in claim spec.
                                         type: string
                                     type: object
+                                  progress:
+                                    description: Progress reports the progress of
+                                      the copy of the volume to the destination
+                                    properties:
+                                      completed:
+                                        description: Completed indicates if the copy
+                                          of the volume has completed
+                                        type: boolean
+                                      processedBytes:
+                                        description: ProcessedBytes is the amount
+                                          of data already copied to the destination
+                                        format: int64
+                                        type: integer
+                                      totalBytes:
+                                        description: TotalBytes is the amount of data
+                                          to copy to the destination
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   sourcePVCInfo:
                                     description: SourcePVCInfo contains the information
                                       about the source PVC
@@ -30461,6 +32648,91 @@ var CRDsValidation map[string]string = map[string]string{
                                           Value of Filesystem is implied when not included in claim spec.
                                         type: string
                                     type: object
+                                  sourceVolume:
+                                    description: SourceVolume contains the source
+                                      of the migrated volume when it isn't backed
+                                      by a PVC
+                                    properties:
+                                      containerDisk:
+                                        description: |-
+                                          ContainerDisk is the containerDisk the volume is migrated from. The writable overlay is copied
+                                          together with its backing image, hence the destination contains the whole disk content.
+                                        properties:
+                                          image:
+                                            description: Image is the name of the
+                                              image with the embedded disk.
+                                            type: string
+                                          imagePullPolicy:
+                                            description: |-
+                                              Image pull policy.
+                                              One of Always, Never, IfNotPresent.
+                                              Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                                              Cannot be updated.
+                                              More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
+                                            type: string
+                                          imagePullSecret:
+                                            description: ImagePullSecret is the name
+                                              of the Docker registry secret required
+                                              to pull the image. The secret must already
+                                              exist.
+                                            type: string
+                                          mode:
+                                            description: |-
+                                              Mode defines how the disk is served from the image.
+                                              Standalone expects a single self-contained disk file.
+                                              Layered serves a qcow2 backing chain spread over the image layers in place,
+                                              so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+                                              Defaults to Standalone.
+                                            type: string
+                                          overlay:
+                                            description: |-
+                                              Overlay configures the writable layer on top of the image.
+                                              By default the overlay is ephemeral and all writes are lost when the VMI stops.
+                                            properties:
+                                              claimName:
+                                                description: |-
+                                                  ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+                                                  so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+                                                type: string
+                                            required:
+                                            - claimName
+                                            type: object
+                                          path:
+                                            description: Path defines the path to
+                                              disk file in the container
+                                            type: string
+                                        required:
+                                        - image
+                                        type: object
+                                      hostDisk:
+                                        description: HostDisk is the hostDisk the
+                                          volume is migrated from
+                                        properties:
+                                          capacity:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: Capacity of the sparse disk
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          path:
+                                            description: The path to HostDisk image
+                                              located on the cluster
+                                            type: string
+                                          shared:
+                                            description: Shared indicate whether the
+                                              path is shared between nodes
+                                            type: boolean
+                                          type:
+                                            description: |-
+                                              Contains information if disk.img exists or should be created
+                                              allowed options are 'Disk' and 'DiskOrCreate'
+                                            type: string
+                                        required:
+                                        - path
+                                        - type
+                                        type: object
+                                    type: object
                                   volumeName:
                                     description: VolumeName is the name of the volume
                                       that is being migrated
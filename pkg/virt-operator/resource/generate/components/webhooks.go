@@ -303,6 +303,7 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 	vmSnapshotValidatePath := VMSnapshotValidatePath
 	vmRestoreValidatePath := VMRestoreValidatePath
 	vmExportValidatePath := VMExportValidatePath
+	vmDiskTaskValidatePath := VMDiskTaskValidatePath
 	VmInstancetypeValidatePath := VMInstancetypeValidatePath
 	VmClusterInstancetypeValidatePath := VMClusterInstancetypeValidatePath
 	vmPreferenceValidatePath := VMPreferenceValidatePath
@@ -632,6 +633,31 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinedisktask-validator.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{core.GroupName},
+						APIVersions: virtv1.ApiSupportedWebhookVersions,
+						Resources:   []string{"virtualmachinedisktasks"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmDiskTaskValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachineinstancetype-validator.instancetype.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -883,6 +909,8 @@ const VMRestoreValidatePath = "/virtualmachinerestores-validate"
 
 const VMExportValidatePath = "/virtualmachineexports-validate"
 
+const VMDiskTaskValidatePath = "/virtualmachinedisktasks-validate"
+
 const VMInstancetypeValidatePath = "/virtualmachineinstancetypes-validate"
 
 const VMClusterInstancetypeValidatePath = "/virtualmachineclusterinstancetypes-validate"
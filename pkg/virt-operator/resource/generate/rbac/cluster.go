@@ -48,6 +48,7 @@ const (
 	apiVMIPresets         = "virtualmachineinstancepresets"
 	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
 	apiVMIMigrations      = "virtualmachineinstancemigrations"
+	apiVMDiskTasks        = "virtualmachinedisktasks"
 	apiVMSnapshots        = "virtualmachinesnapshots"
 	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
 	apiVMRestores         = "virtualmachinerestores"
@@ -64,6 +65,7 @@ const (
 	apiVMRemoveVolume = "virtualmachines/removevolume"
 	apiVMMigrate      = "virtualmachines/migrate"
 	apiVMMemoryDump   = "virtualmachines/memorydump"
+	apiVMHibernate    = "virtualmachines/hibernate"
 	apiVMObjectGraph  = "virtualmachines/objectgraph"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
@@ -81,10 +83,16 @@ const (
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesStats                     = "virtualmachineinstances/stats"
+	apiVMInstancesGuestFile                 = "virtualmachineinstances/guestfile"
+	apiVMInstancesGuestExec                 = "virtualmachineinstances/guestexec"
+	apiVMInstancesConsoleToken              = "virtualmachineinstances/consoletoken"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	apiVMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
+	apiVMInstancesSEVFetchSNPAttestation    = "virtualmachineinstances/sev/fetchsnpattestationinfo"
+	apiVMInstancesTDXFetchQuote             = "virtualmachineinstances/tdx/fetchquote"
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
 	apiVMInstancesObjectGraph               = "virtualmachineinstances/objectgraph"
 )
@@ -202,8 +210,12 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
+					apiVMInstancesGuestFile,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
+					apiVMInstancesSEVFetchSNPAttestation,
+					apiVMInstancesTDXFetchQuote,
 					apiVMInstancesUSBRedir,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
@@ -227,6 +239,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesGuestFile,
+					apiVMInstancesGuestExec,
+					apiVMInstancesConsoleToken,
 				},
 				Verbs: []string{
 					"update",
@@ -255,6 +270,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMHibernate,
 				},
 				Verbs: []string{
 					"update",
@@ -280,6 +296,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstances,
 					apiVMIPresets,
 					apiVMIReplicasets,
+					apiVMDiskTasks,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -397,8 +414,12 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
+					apiVMInstancesGuestFile,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
+					apiVMInstancesSEVFetchSNPAttestation,
+					apiVMInstancesTDXFetchQuote,
 					apiVMInstancesUSBRedir,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
@@ -422,6 +443,9 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesGuestFile,
+					apiVMInstancesGuestExec,
+					apiVMInstancesConsoleToken,
 				},
 				Verbs: []string{
 					"update",
@@ -450,6 +474,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMHibernate,
 				},
 				Verbs: []string{
 					"update",
@@ -475,6 +500,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstances,
 					apiVMIPresets,
 					apiVMIReplicasets,
+					apiVMDiskTasks,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -650,6 +676,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMObjectGraph,
@@ -680,6 +707,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMIPresets,
 					apiVMIReplicasets,
 					apiVMIMigrations,
+					apiVMDiskTasks,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
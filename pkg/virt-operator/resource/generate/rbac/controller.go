@@ -261,6 +261,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"pods/log",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -381,6 +392,7 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachines/finalizers",
 					"virtualmachineinstances/finalizers",
+					"virtualmachinedisktasks/finalizers",
 				},
 				Verbs: []string{
 					"update",
@@ -391,7 +403,9 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"subresources.kubevirt.io",
 				},
 				Resources: []string{
+					"virtualmachines/start",
 					"virtualmachines/stop",
+					"virtualmachines/hibernate",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
 					"virtualmachineinstances/freeze",
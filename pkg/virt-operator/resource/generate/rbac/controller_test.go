@@ -57,6 +57,7 @@ var _ = Describe("RBAC", func() {
 			Entry("for vmsnapshotcontents", "snapshot.kubevirt.io", "virtualmachinesnapshotcontents"),
 			Entry("for vms", "kubevirt.io", "virtualmachines"),
 			Entry("for vmis", "kubevirt.io", "virtualmachineinstances"),
+			Entry("for vmdisktasks", "kubevirt.io", "virtualmachinedisktasks"),
 		)
 	})
 })
@@ -79,6 +79,10 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 	results = append(results, validateCustomizeComponents(newKV.Spec.CustomizeComponents)...)
 	results = append(results, validateCertificates(newKV.Spec.CertificateRotationStrategy.SelfSigned)...)
 	results = append(results, validateGuestToRequestHeadroom(newKV.Spec.Configuration.AdditionalGuestMemoryOverheadRatio)...)
+	results = append(results, validateVMStateEncryption(field.NewPath("spec", "configuration", "vmStateEncryption"), newKV.Spec.Configuration.VMStateEncryption)...)
+	results = append(results, virtconfig.ValidateGuestAgentPolling(field.NewPath("spec", "configuration", "guestAgentPolling"), newKV.Spec.Configuration.GuestAgentPolling)...)
+	results = append(results, virtconfig.ValidateMemoryOvercommitController(field.NewPath("spec", "configuration", "memoryOvercommitController"), newKV.Spec.Configuration.MemoryOvercommitController)...)
+	results = append(results, virtconfig.ValidateSEVSNPAttestation(field.NewPath("spec", "configuration", "sevSNPAttestation"), newKV.Spec.Configuration.SEVSNPAttestation)...)
 
 	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.TLSConfiguration, newKV.Spec.Configuration.TLSConfiguration) {
 		if newKV.Spec.Configuration.TLSConfiguration != nil {
@@ -485,3 +489,39 @@ func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.Status
 
 	return
 }
+
+func validateVMStateEncryption(field *field.Path, encryption *v1.VMStateEncryption) (causes []metav1.StatusCause) {
+	if encryption == nil {
+		return
+	}
+
+	if (encryption.SecretName == "") == (encryption.KMS == nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("exactly one of %s or %s must be set", field.Child("secretName"), field.Child("kms")),
+			Field:   field.String(),
+		})
+		return
+	}
+
+	if encryption.KMS == nil {
+		return
+	}
+
+	if encryption.KMS.Provider != v1.VMStateKMSProviderLocal {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("KMS provider %q is not supported, only %q is", encryption.KMS.Provider, v1.VMStateKMSProviderLocal),
+			Field:   field.Child("kms", "provider").String(),
+		})
+	}
+	if encryption.KMS.KeySecretName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be set", field.Child("kms", "keySecretName")),
+			Field:   field.Child("kms", "keySecretName").String(),
+		})
+	}
+
+	return
+}
@@ -164,6 +164,30 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	DescribeTable("validateVMStateEncryption", func(encryption *v1.VMStateEncryption, expectedFields []string) {
+		causes := validateVMStateEncryption(field.NewPath("spec", "configuration", "vmStateEncryption"), encryption)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, cause := range causes {
+			Expect(cause.Field).To(Equal(expectedFields[i]))
+		}
+	},
+		Entry("should accept no encryption", nil, nil),
+		Entry("should accept a Secret", &v1.VMStateEncryption{SecretName: "keys"}, nil),
+		Entry("should accept the local KMS",
+			&v1.VMStateEncryption{KMS: &v1.VMStateKMS{Provider: v1.VMStateKMSProviderLocal, KeySecretName: "kek"}}, nil),
+		Entry("should reject neither Secret nor KMS", &v1.VMStateEncryption{},
+			[]string{"spec.configuration.vmStateEncryption"}),
+		Entry("should reject both Secret and KMS",
+			&v1.VMStateEncryption{SecretName: "keys", KMS: &v1.VMStateKMS{Provider: v1.VMStateKMSProviderLocal, KeySecretName: "kek"}},
+			[]string{"spec.configuration.vmStateEncryption"}),
+		Entry("should reject an unknown KMS provider",
+			&v1.VMStateEncryption{KMS: &v1.VMStateKMS{Provider: "vault", KeySecretName: "kek"}},
+			[]string{"spec.configuration.vmStateEncryption.kms.provider"}),
+		Entry("should reject a KMS without key Secret",
+			&v1.VMStateEncryption{KMS: &v1.VMStateKMS{Provider: v1.VMStateKMSProviderLocal}},
+			[]string{"spec.configuration.vmStateEncryption.kms.keySecretName"}),
+	)
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
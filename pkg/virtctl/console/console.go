@@ -40,7 +40,8 @@ import (
 const defaultTimeoutMinutes = 5
 
 type consoleCommand struct {
-	timeout int
+	timeout  int
+	readOnly bool
 }
 
 func NewCommand() *cobra.Command {
@@ -54,6 +55,8 @@ func NewCommand() *cobra.Command {
 	}
 	cmd.Flags().IntVar(&c.timeout, "timeout", defaultTimeoutMinutes,
 		"The number of minutes to wait for the virtual machine instance to be ready.")
+	cmd.Flags().BoolVar(&c.readOnly, "read-only", false,
+		"Only watch the console. The input is discarded, and the connection which owns the console is not disconnected.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -62,7 +65,9 @@ func usage() string {
 	usage := `  # Connect to the console on VirtualMachineInstance 'myvmi':
   {{ProgramName}} console myvmi
   # Configure one minute timeout (default 5 minutes)
-  {{ProgramName}} console --timeout=1 myvmi`
+  {{ProgramName}} console --timeout=1 myvmi
+  # Watch the console of VirtualMachineInstance 'myvmi' next to the user connected to it:
+  {{ProgramName}} console --read-only myvmi`
 
 	return usage
 }
@@ -92,7 +97,7 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 
 	go func() {
 		con, err := client.VirtualMachineInstance(namespace).SerialConsole(vmi,
-			&kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute})
+			&kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute, ReadOnly: c.readOnly})
 		runningChan <- err
 
 		if err != nil {
@@ -115,9 +120,11 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 			return err
 		}
 	}
-	err := Attach(stdinReader, stdoutReader, stdinWriter, stdoutWriter,
-		fmt.Sprintf("Successfully connected to %s console. Press Ctrl+] or Ctrl+5 to exit console.\n", vmi),
-		resChan)
+	message := fmt.Sprintf("Successfully connected to %s console. Press Ctrl+] or Ctrl+5 to exit console.\n", vmi)
+	if c.readOnly {
+		message = fmt.Sprintf("Successfully connected to %s console in read-only mode. Press Ctrl+] or Ctrl+5 to exit console.\n", vmi)
+	}
+	err := Attach(stdinReader, stdoutReader, stdinWriter, stdoutWriter, message, resChan)
 	if err != nil {
 		if e, ok := err.(*websocket.CloseError); ok && e.Code == websocket.CloseAbnormalClosure {
 			fmt.Fprint(os.Stderr, "\n"+
@@ -0,0 +1,203 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/create/params"
+)
+
+// vmFromExisting returns a VM with the spec of an existing VM, without the fields identifying the existing VM
+func (c *createVM) vmFromExisting() (*v1.VirtualMachine, error) {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(c.cmd.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	srcNamespace, srcName, err := params.SplitPrefixedName(c.fromVM)
+	if err != nil {
+		return nil, params.FlagErr(FromVMFlag, "%w", err)
+	}
+	if srcNamespace == "" {
+		srcNamespace = namespace
+	}
+
+	src, err := virtClient.VirtualMachine(srcNamespace).Get(c.cmd.Context(), srcName, metav1.GetOptions{})
+	if err != nil {
+		return nil, params.FlagErr(FromVMFlag, "failed to get VirtualMachine \"%s/%s\": %w", srcNamespace, srcName, err)
+	}
+
+	if src.Spec.Template == nil {
+		return nil, params.FlagErr(FromVMFlag, "VirtualMachine \"%s/%s\" has no template", srcNamespace, srcName)
+	}
+
+	vm := &v1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        c.name,
+			Namespace:   c.namespace,
+			Labels:      userLabels(src.Labels),
+			Annotations: userAnnotations(src.Annotations),
+		},
+		Spec: *src.Spec.DeepCopy(),
+	}
+
+	stripIdentity(vm)
+	renameDataVolumeTemplates(vm, src.Name)
+	c.warnSharedPVCs(vm)
+
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		if disk.BootOrder != nil {
+			c.bootOrders[*disk.BootOrder] = disk.Name
+		}
+	}
+
+	return vm, nil
+}
+
+// userAnnotations returns the annotations without the ones added by KubeVirt and kubectl
+func userAnnotations(annotations map[string]string) map[string]string {
+	var filtered map[string]string
+	for key, value := range annotations {
+		switch key {
+		case v1.ControllerAPILatestVersionObservedAnnotation,
+			v1.ControllerAPIStorageVersionObservedAnnotation,
+			k8sv1.LastAppliedConfigAnnotation:
+			continue
+		}
+		if filtered == nil {
+			filtered = map[string]string{}
+		}
+		filtered[key] = value
+	}
+
+	return filtered
+}
+
+// userLabels returns the labels without the ones in the kubevirt.io domain, which are added by KubeVirt
+func userLabels(labels map[string]string) map[string]string {
+	var filtered map[string]string
+	for key, value := range labels {
+		if prefix, _, found := strings.Cut(key, "/"); found &&
+			(prefix == core.GroupName || strings.HasSuffix(prefix, "."+core.GroupName)) {
+			continue
+		}
+		if filtered == nil {
+			filtered = map[string]string{}
+		}
+		filtered[key] = value
+	}
+
+	return filtered
+}
+
+// stripIdentity clears the fields of the spec that identify a VM and would clash when copied to another VM
+func stripIdentity(vm *v1.VirtualMachine) {
+	// Labels like kubevirt.io/domain would make Services and NetworkPolicies select the new VM as well
+	vm.Spec.Template.ObjectMeta.Labels = userLabels(vm.Spec.Template.ObjectMeta.Labels)
+	vm.Spec.Template.ObjectMeta.Annotations = userAnnotations(vm.Spec.Template.ObjectMeta.Annotations)
+
+	spec := &vm.Spec.Template.Spec
+
+	spec.Hostname = ""
+	// The saved state of the existing VM cannot be shared, and the new VM cannot resume from it
+	spec.Hibernation = nil
+
+	if fw := spec.Domain.Firmware; fw != nil {
+		fw.UUID = ""
+		fw.Serial = ""
+	}
+
+	for i := range spec.Domain.Devices.Interfaces {
+		spec.Domain.Devices.Interfaces[i].MacAddress = ""
+	}
+
+	// The revisions are specific to the existing VM and are recreated for the new one
+	if vm.Spec.Instancetype != nil {
+		vm.Spec.Instancetype.RevisionName = ""
+	}
+	if vm.Spec.Preference != nil {
+		vm.Spec.Preference.RevisionName = ""
+	}
+}
+
+// renameDataVolumeTemplates renames the DataVolumeTemplates after the new VM, so that the new VM gets its own
+// DataVolumes. Names starting with the name of the existing VM get the name of the new VM as prefix instead.
+func renameDataVolumeTemplates(vm *v1.VirtualMachine, srcName string) {
+	renamed := map[string]string{}
+	for i := range vm.Spec.DataVolumeTemplates {
+		dvt := &vm.Spec.DataVolumeTemplates[i]
+
+		name := vm.Name + "-" + dvt.Name
+		if suffix, found := strings.CutPrefix(dvt.Name, srcName); found {
+			name = vm.Name + suffix
+		}
+		renamed[dvt.Name] = name
+
+		dvt.ObjectMeta = metav1.ObjectMeta{
+			Name:        name,
+			Labels:      dvt.Labels,
+			Annotations: dvt.Annotations,
+		}
+	}
+
+	for _, vol := range vm.Spec.Template.Spec.Volumes {
+		if vol.DataVolume == nil {
+			continue
+		}
+		if name, ok := renamed[vol.DataVolume.Name]; ok {
+			vol.DataVolume.Name = name
+		}
+	}
+}
+
+func (c *createVM) warnSharedPVCs(vm *v1.VirtualMachine) {
+	for _, vol := range vm.Spec.Template.Spec.Volumes {
+		switch {
+		case vol.PersistentVolumeClaim != nil:
+			c.cmd.PrintErrf("WARNING: --%s: volume \"%s\" uses PVC \"%s\" of the existing VM\n",
+				FromVMFlag, vol.Name, vol.PersistentVolumeClaim.ClaimName)
+		case vol.DataVolume != nil && !dataVolumeTemplateExists(vm, vol.DataVolume.Name):
+			c.cmd.PrintErrf("WARNING: --%s: volume \"%s\" uses DataVolume \"%s\" of the existing VM\n",
+				FromVMFlag, vol.Name, vol.DataVolume.Name)
+		}
+	}
+}
+
+func dataVolumeTemplateExists(vm *v1.VirtualMachine, name string) bool {
+	for _, dvt := range vm.Spec.DataVolumeTemplates {
+		if dvt.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
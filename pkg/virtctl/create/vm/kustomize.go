@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/create/params"
+)
+
+const (
+	cloudInitStep = "cloud-init"
+	inferenceStep = "inference"
+
+	kustomizationFile = "kustomization.yaml"
+	baseFile          = "vm.yaml"
+)
+
+// step is the state of the VM after a flag or a generated part of the manifest was applied to it
+type step struct {
+	name string
+	vm   *v1.VirtualMachine
+}
+
+type kustomization struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Resources  []string         `json:"resources"`
+	Patches    []kustomizePatch `json:"patches,omitempty"`
+}
+
+type kustomizePatch struct {
+	Path string `json:"path"`
+}
+
+type file struct {
+	name string
+	data []byte
+}
+
+// record keeps the state of the VM after a step, the steps become the patches of the kustomization
+func (c *createVM) record(name string, vm *v1.VirtualMachine) {
+	if c.outputFormat != outputFormatKustomize {
+		return
+	}
+
+	c.steps = append(c.steps, step{
+		name: name,
+		vm:   vm.DeepCopy(),
+	})
+}
+
+// writeKustomization writes the base VM and a patch per step that changed the VM to the output directory.
+// Applying the patches to the base in order results in the same VM as the yaml output format.
+func (c *createVM) writeKustomization(base *v1.VirtualMachine) error {
+	out, err := yaml.Marshal(base)
+	if err != nil {
+		return err
+	}
+	files := []file{{name: baseFile, data: out}}
+
+	k := kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+		Resources:  []string{baseFile},
+	}
+
+	prev := base
+	for _, s := range c.steps {
+		patch, err := mergePatch(prev, s.vm)
+		if err != nil {
+			return err
+		}
+		prev = s.vm
+
+		if patch == nil {
+			continue
+		}
+
+		name := fmt.Sprintf("patch-%s.yaml", s.name)
+		files = append(files, file{name: name, data: patch})
+		k.Patches = append(k.Patches, kustomizePatch{Path: name})
+	}
+
+	out, err = yaml.Marshal(k)
+	if err != nil {
+		return err
+	}
+	files = append(files, file{name: kustomizationFile, data: out})
+
+	if err := os.MkdirAll(c.outputDir, 0o755); err != nil {
+		return params.FlagErr(OutputDirFlag, "%w", err)
+	}
+
+	for _, f := range files {
+		path := filepath.Join(c.outputDir, f.name)
+		if err := writeNewFile(path, f.data); err != nil {
+			return params.FlagErr(OutputDirFlag, "%w", err)
+		}
+		c.cmd.Println(path)
+	}
+
+	return nil
+}
+
+// mergePatch returns a JSON merge patch from prev to cur, with the fields kustomize needs to find the patched VM.
+// It returns nil if the VM did not change.
+func mergePatch(prev, cur *v1.VirtualMachine) ([]byte, error) {
+	prevJSON, err := json.Marshal(prev)
+	if err != nil {
+		return nil, err
+	}
+	curJSON, err := json.Marshal(cur)
+	if err != nil {
+		return nil, err
+	}
+
+	patchJSON, err := jsonpatch.CreateMergePatch(prevJSON, curJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := map[string]interface{}{}
+	if err := json.Unmarshal(patchJSON, &patch); err != nil {
+		return nil, err
+	}
+	if len(patch) == 0 {
+		return nil, nil
+	}
+
+	metadata, ok := patch["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+	}
+	metadata["name"] = cur.Name
+	if cur.Namespace != "" {
+		metadata["namespace"] = cur.Namespace
+	}
+	patch["metadata"] = metadata
+	patch["apiVersion"] = cur.APIVersion
+	patch["kind"] = cur.Kind
+
+	return yaml.Marshal(patch)
+}
+
+// writeNewFile writes data to path, it fails if the file exists already to not overwrite an existing kustomization
+func writeNewFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
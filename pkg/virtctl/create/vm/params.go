@@ -34,6 +34,26 @@ type volumeSource struct {
 	BootOrder *uint  `param:"bootorder"`
 }
 
+type networkInterface struct {
+	Name    string `param:"name"`
+	Type    string `param:"type"`
+	Source  string `param:"src"`
+	Binding string `param:"binding"`
+	Model   string `param:"model"`
+}
+
+type device struct {
+	Name       string `param:"name"`
+	DeviceName string `param:"devicename"`
+}
+
+type toleration struct {
+	Key      string `param:"key"`
+	Operator string `param:"operator"`
+	Value    string `param:"value"`
+	Effect   string `param:"effect"`
+}
+
 type sysprepVolumeSource struct {
 	Source string `param:"src"`
 	Type   string `param:"type"`
@@ -73,6 +73,23 @@ const (
 	CloudInitUserDataFlag    = "cloud-init-user-data"
 	CloudInitNetworkDataFlag = "cloud-init-network-data"
 
+	NetworkFlag       = "network"
+	GPUFlag           = "gpu"
+	HostDeviceFlag    = "host-device"
+	TPMFlag           = "tpm"
+	TPMPersistentFlag = "tpm-persistent"
+	EFIFlag           = "efi"
+	SecureBootFlag    = "secure-boot"
+
+	NodeSelectorFlag  = "node-selector"
+	TolerationFlag    = "toleration"
+	LabelFlag         = "label"
+	TemplateLabelFlag = "template-label"
+
+	FromVMFlag       = "from-vm"
+	OutputFormatFlag = "output-format"
+	OutputDirFlag    = "output-dir"
+
 	// Deprecated flags
 	DataSourceVolumeFlag = "volume-datasource"
 	ClonePvcVolumeFlag   = "volume-clone-pvc"
@@ -92,6 +109,16 @@ const (
 	accessCredTypePassword = "password"
 	accessCredMethodGA     = "ga"
 
+	networkTypePod     = "pod"
+	networkTypeMultus  = "multus"
+	bindingMasquerade  = "masquerade"
+	bindingBridge      = "bridge"
+	bindingSRIOV       = "sriov"
+	defaultNetworkName = "default"
+
+	outputFormatYAML      = "yaml"
+	outputFormatKustomize = "kustomize"
+
 	blank    = "blank"
 	gcs      = "gcs"
 	http     = "http"
@@ -138,6 +165,23 @@ type createVM struct {
 	cloudInitUserData    string
 	cloudInitNetworkData string
 
+	networks      []string
+	gpus          []string
+	hostDevices   []string
+	tpm           bool
+	tpmPersistent bool
+	efi           bool
+	secureBoot    bool
+
+	nodeSelector   map[string]string
+	tolerations    []string
+	labels         map[string]string
+	templateLabels map[string]string
+
+	fromVM       string
+	outputFormat string
+	outputDir    string
+
 	// Deprecated fields
 	dataSourceVolumes []string
 	clonePvcVolumes   []string
@@ -150,6 +194,7 @@ type createVM struct {
 
 	cmd        *cobra.Command
 	bootOrders map[uint]string
+	steps      []step
 }
 
 // Unless the boot order is specified by the user volumes have the following fixed boot order:
@@ -160,6 +205,8 @@ type createVM struct {
 // as these are processed after the AccessCred flag.
 var flags = []string{
 	RunStrategyFlag,
+	TerminationGracePeriodFlag,
+	MemoryFlag,
 	InstancetypeFlag,
 	PreferenceFlag,
 	ContainerdiskVolumeFlag,
@@ -170,6 +217,17 @@ var flags = []string{
 	VolumeImportFlag,
 	SysprepVolumeFlag,
 	AccessCredFlag,
+	NetworkFlag,
+	GPUFlag,
+	HostDeviceFlag,
+	TPMFlag,
+	TPMPersistentFlag,
+	EFIFlag,
+	SecureBootFlag,
+	NodeSelectorFlag,
+	TolerationFlag,
+	LabelFlag,
+	TemplateLabelFlag,
 }
 
 var volumeImportOptions = map[string]func(string) (*cdiv1.DataVolumeSpec, *uint, error){
@@ -290,6 +348,48 @@ func NewCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, SSHKeyFlag)
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, GAManageSSHFlag)
 
+	cmd.Flags().StringArrayVar(&c.networks, NetworkFlag, c.networks,
+		fmt.Sprintf("Specify a network and its interface to be used by the VM. Can be provided multiple times.\n"+
+			"Supported network types: %s, %s (src is the NetworkAttachmentDefinition)\n"+
+			"Supported bindings: %s, %s, %s or the name of a network binding plugin\n"+
+			"Supported parameters: %s", networkTypePod, networkTypeMultus,
+			bindingMasquerade, bindingBridge, bindingSRIOV, params.Supported(networkInterface{})))
+	cmd.Flags().StringArrayVar(&c.gpus, GPUFlag, c.gpus,
+		fmt.Sprintf("Specify a GPU to be assigned to the VM. Can be provided multiple times.\n"+
+			"Supported parameters: %s", params.Supported(device{})))
+	cmd.Flags().StringArrayVar(&c.hostDevices, HostDeviceFlag, c.hostDevices,
+		fmt.Sprintf("Specify a host device to be assigned to the VM. Can be provided multiple times.\n"+
+			"Supported parameters: %s", params.Supported(device{})))
+	cmd.Flags().BoolVar(&c.tpm, TPMFlag, c.tpm,
+		"Specify if the VM should have a vTPM. Setting it to false disables a vTPM enabled by a preference.")
+	cmd.Flags().BoolVar(&c.tpmPersistent, TPMPersistentFlag, c.tpmPersistent,
+		"Specify if the state of the vTPM of the VM should be kept across reboots. Implies --tpm.")
+	cmd.Flags().BoolVar(&c.efi, EFIFlag, c.efi,
+		"Specify if the VM should boot with EFI instead of BIOS.")
+	cmd.Flags().BoolVar(&c.secureBoot, SecureBootFlag, c.secureBoot,
+		"Specify if the VM should boot with EFI and SecureBoot. Implies --efi and enables SMM.")
+
+	cmd.Flags().StringToStringVar(&c.nodeSelector, NodeSelectorFlag, c.nodeSelector,
+		"Specify the labels of the nodes the VM may be scheduled on, e.g. key1=value1,key2=value2.")
+	cmd.Flags().StringArrayVar(&c.tolerations, TolerationFlag, c.tolerations,
+		fmt.Sprintf("Specify a toleration of the VM. Can be provided multiple times.\n"+
+			"Supported parameters: %s", params.Supported(toleration{})))
+	cmd.Flags().StringToStringVar(&c.labels, LabelFlag, c.labels,
+		"Specify the labels of the VM, e.g. key1=value1,key2=value2.")
+	cmd.Flags().StringToStringVar(&c.templateLabels, TemplateLabelFlag, c.templateLabels,
+		"Specify the labels of the VMIs created from the VM, e.g. key1=value1,key2=value2.")
+
+	cmd.Flags().StringVar(&c.fromVM, FromVMFlag, c.fromVM,
+		"Specify an existing VM to create the manifest from. Its identity (UID, MAC addresses, firmware UUID, status, ...) is not copied.\n"+
+			"The other flags are applied on top of it. A VM in another namespace can be specified as namespace/name.")
+	cmd.Flags().StringVar(&c.outputFormat, OutputFormatFlag, c.outputFormat,
+		fmt.Sprintf("Specify the output format of the manifest.\n"+
+			"Supported values: %s, %s (writes a base manifest and a patch per flag to --%s)",
+			outputFormatYAML, outputFormatKustomize, OutputDirFlag))
+	cmd.Flags().StringVar(&c.outputDir, OutputDirFlag, c.outputDir,
+		fmt.Sprintf("Specify the directory to write the kustomization to. Defaults to the name of the VM.\n"+
+			"Can only be used with --%s=%s.", OutputFormatFlag, outputFormatKustomize))
+
 	// Deprecated flags
 	cmd.Flags().StringArrayVar(&c.dataSourceVolumes, DataSourceVolumeFlag, c.dataSourceVolumes,
 		"Specify a DataSource to be cloned by the VM. Can be provided multiple times.\n"+
@@ -318,6 +418,7 @@ func defaultCreateVM() createVM {
 		inferInstancetype:      true,
 		inferPreference:        true,
 		cloudInit:              cloudInitNoCloud,
+		outputFormat:           outputFormatYAML,
 		bootOrders:             map[uint]string{},
 	}
 }
@@ -392,26 +493,34 @@ func (c *createVM) run(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	vm, err := c.newVM()
+	base, err := c.baseVM()
 	if err != nil {
 		return err
 	}
 
+	vm := base.DeepCopy()
 	for _, flag := range flags {
 		if cmd.Flags().Changed(flag) {
 			if flagErr := c.optFns()[flag](vm); flagErr != nil {
 				return flagErr
 			}
+			c.record(flag, vm)
 		}
 	}
 
 	if cloudInitErr := c.cloudInitConfig(vm); cloudInitErr != nil {
 		return cloudInitErr
 	}
+	c.record(cloudInitStep, vm)
 
 	if inferErr := c.inferFromVolume(vm); inferErr != nil {
 		return inferErr
 	}
+	c.record(inferenceStep, vm)
+
+	if c.outputFormat == outputFormatKustomize {
+		return c.writeKustomization(base)
+	}
 
 	out, err := yaml.Marshal(vm)
 	if err != nil {
@@ -446,22 +555,55 @@ func (c *createVM) setDefaults(cmd *cobra.Command) error {
 
 	c.memoryChanged = cmd.Flags().Changed(MemoryFlag)
 
+	// The instancetype and preference of an existing VM are only inferred if requested explicitly
+	if c.fromVM != "" {
+		c.inferInstancetype = c.inferInstancetype && c.explicitInstancetypeInference
+		c.inferPreference = c.inferPreference && c.explicitPreferenceInference
+	}
+
+	switch c.outputFormat {
+	case outputFormatYAML:
+		if cmd.Flags().Changed(OutputDirFlag) {
+			return params.FlagErr(OutputDirFlag, "can only be used with --%s=%s", OutputFormatFlag, outputFormatKustomize)
+		}
+	case outputFormatKustomize:
+		if c.outputDir == "" {
+			c.outputDir = c.name
+		}
+	default:
+		return params.FlagErr(OutputFormatFlag, "invalid output format \"%s\", supported values are: %s, %s",
+			c.outputFormat, outputFormatYAML, outputFormatKustomize)
+	}
+
 	return nil
 }
 
 func (c *createVM) optFns() map[string]func(*v1.VirtualMachine) error {
 	return map[string]func(*v1.VirtualMachine) error{
-		RunStrategyFlag:         c.withRunStrategy,
-		InstancetypeFlag:        c.withInstancetype,
-		PreferenceFlag:          c.withPreference,
-		ContainerdiskVolumeFlag: c.withContainerdiskVolume,
-		DataSourceVolumeFlag:    c.withDataSourceVolume,
-		ClonePvcVolumeFlag:      c.withClonePvcVolume,
-		PvcVolumeFlag:           c.withPvcVolume,
-		BlankVolumeFlag:         c.withBlankVolume,
-		VolumeImportFlag:        c.withImportedVolume,
-		SysprepVolumeFlag:       c.withSysprepVolume,
-		AccessCredFlag:          c.withAccessCredential,
+		RunStrategyFlag:            c.withRunStrategy,
+		TerminationGracePeriodFlag: c.withTerminationGracePeriod,
+		MemoryFlag:                 c.withMemory,
+		InstancetypeFlag:           c.withInstancetype,
+		PreferenceFlag:             c.withPreference,
+		ContainerdiskVolumeFlag:    c.withContainerdiskVolume,
+		DataSourceVolumeFlag:       c.withDataSourceVolume,
+		ClonePvcVolumeFlag:         c.withClonePvcVolume,
+		PvcVolumeFlag:              c.withPvcVolume,
+		BlankVolumeFlag:            c.withBlankVolume,
+		VolumeImportFlag:           c.withImportedVolume,
+		SysprepVolumeFlag:          c.withSysprepVolume,
+		AccessCredFlag:             c.withAccessCredential,
+		NetworkFlag:                c.withNetwork,
+		GPUFlag:                    c.withGPU,
+		HostDeviceFlag:             c.withHostDevice,
+		TPMFlag:                    c.withTPM,
+		TPMPersistentFlag:          c.withPersistentTPM,
+		EFIFlag:                    c.withEFI,
+		SecureBootFlag:             c.withSecureBoot,
+		NodeSelectorFlag:           c.withNodeSelector,
+		TolerationFlag:             c.withToleration,
+		LabelFlag:                  c.withLabels,
+		TemplateLabelFlag:          c.withTemplateLabels,
 	}
 }
 
@@ -537,7 +679,37 @@ func (c *createVM) usage() string {
   {{ProgramName}} create vm --access-cred=type:password,src:my-pws
 
   # Create a manifest for a VirtualMachine with a Containerdisk and a Sysprep volume (source ConfigMap needs to exist)
-  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm`
+  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm
+
+  # Create a manifest for a VirtualMachine connected to the pod network and to a secondary network defined by the NetworkAttachmentDefinition my-nad
+  {{ProgramName}} create vm --network=type:pod --network=type:multus,src:my-nad,binding:bridge,model:virtio
+
+  # Create a manifest for a VirtualMachine with a GPU and a host device provided by device plugins
+  {{ProgramName}} create vm --gpu=devicename:nvidia.com/GP102GL_Tesla_P40 --host-device=name:my-nic,devicename:intel.com/qat
+
+  # Create a manifest for a VirtualMachine booting with EFI and SecureBoot and with a persistent vTPM
+  {{ProgramName}} create vm --secure-boot --tpm-persistent
+
+  # Create a manifest for a VirtualMachine scheduled on nodes labeled zone=east that tolerate the dedicated=vms:NoSchedule taint
+  {{ProgramName}} create vm --node-selector=zone=east --toleration=key:dedicated,value:vms,effect:NoSchedule
+
+  # Create a manifest for a VirtualMachine with labels on the VM and on its VMIs
+  {{ProgramName}} create vm --label=app=my-app --template-label=app=my-app,tier=backend
+
+  # Create a manifest for a new VirtualMachine from the existing VirtualMachine my-vm with more memory
+  {{ProgramName}} create vm --from-vm=my-vm --name=my-new-vm --memory=4Gi
+
+  # Create a kustomization in the directory my-vm with the base manifest and a patch per flag
+  {{ProgramName}} create vm --name=my-vm --volume-containerdisk=src:my.registry/my-image:my-tag --output-format=kustomize`
+}
+
+// baseVM returns the VM the flags are applied to, either a new one or one created from an existing VM
+func (c *createVM) baseVM() (*v1.VirtualMachine, error) {
+	if c.fromVM != "" {
+		return c.vmFromExisting()
+	}
+
+	return c.newVM()
 }
 
 func (c *createVM) newVM() (*v1.VirtualMachine, error) {
@@ -852,6 +1024,7 @@ func (c *createVM) withRunStrategy(vm *v1.VirtualMachine) error {
 
 	for _, runStrategy := range runStrategies {
 		if strings.EqualFold(runStrategy, c.runStrategy) {
+			vm.Spec.Running = nil
 			vm.Spec.RunStrategy = pointer.P(v1.VirtualMachineRunStrategy(runStrategy))
 			return nil
 		}
@@ -861,6 +1034,25 @@ func (c *createVM) withRunStrategy(vm *v1.VirtualMachine) error {
 		c.runStrategy, strings.Join(runStrategies, ", "))
 }
 
+func (c *createVM) withTerminationGracePeriod(vm *v1.VirtualMachine) error {
+	vm.Spec.Template.Spec.TerminationGracePeriodSeconds = pointer.P(c.terminationGracePeriod)
+	return nil
+}
+
+func (c *createVM) withMemory(vm *v1.VirtualMachine) error {
+	memory, err := resource.ParseQuantity(c.memory)
+	if err != nil {
+		return params.FlagErr(MemoryFlag, "%w", err)
+	}
+
+	if vm.Spec.Template.Spec.Domain.Memory == nil {
+		vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{}
+	}
+	vm.Spec.Template.Spec.Domain.Memory.Guest = &memory
+
+	return nil
+}
+
 func (c *createVM) withInstancetype(vm *v1.VirtualMachine) error {
 	kind, name, err := params.SplitPrefixedName(c.instancetype)
 	if err != nil {
@@ -1554,6 +1746,398 @@ func (c *createVM) withAccessCredentialPassword(src *accessCredential) (*v1.Acce
 
 // Deprecated optFns
 
+func (c *createVM) withNetwork(vm *v1.VirtualMachine) error {
+	for _, networkParams := range c.networks {
+		iface := networkInterface{}
+		if err := params.Map(NetworkFlag, networkParams, &iface); err != nil {
+			return err
+		}
+
+		network := v1.Network{}
+		switch strings.ToLower(iface.Type) {
+		case "", networkTypePod:
+			if iface.Source != "" {
+				return params.FlagErr(NetworkFlag, "src cannot be specified with network type %s", networkTypePod)
+			}
+			if podNetworkExists(vm) {
+				return params.FlagErr(NetworkFlag, "there can only be one network of type %s", networkTypePod)
+			}
+			if iface.Name == "" {
+				iface.Name = defaultNetworkName
+			}
+			if iface.Binding == "" {
+				iface.Binding = bindingMasquerade
+			}
+			network.Pod = &v1.PodNetwork{}
+		case networkTypeMultus:
+			if iface.Source == "" {
+				return params.FlagErr(NetworkFlag, "src must be specified with network type %s", networkTypeMultus)
+			}
+			_, name, err := params.SplitPrefixedName(iface.Source)
+			if err != nil {
+				return params.FlagErr(NetworkFlag, "src invalid: %w", err)
+			}
+			if iface.Name == "" {
+				iface.Name = name
+			}
+			if iface.Binding == "" {
+				iface.Binding = bindingBridge
+			}
+			network.Multus = &v1.MultusNetwork{
+				NetworkName: iface.Source,
+			}
+		default:
+			return params.FlagErr(NetworkFlag, "invalid network type \"%s\", supported values are: %s, %s",
+				iface.Type, networkTypePod, networkTypeMultus)
+		}
+
+		if errs := validation.IsDNS1123Label(iface.Name); len(errs) > 0 {
+			return params.FlagErr(NetworkFlag, "invalid name \"%s\": %s", iface.Name, strings.Join(errs, ","))
+		}
+
+		for _, n := range vm.Spec.Template.Spec.Networks {
+			if n.Name == iface.Name {
+				return params.FlagErr(NetworkFlag, "there is already a network with name \"%s\"", iface.Name)
+			}
+		}
+
+		binding := strings.ToLower(iface.Binding)
+		if binding == bindingMasquerade && network.Pod == nil {
+			return params.FlagErr(NetworkFlag, "binding %s can only be used with network type %s", bindingMasquerade, networkTypePod)
+		}
+
+		network.Name = iface.Name
+		vm.Spec.Template.Spec.Networks = append(vm.Spec.Template.Spec.Networks, network)
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces = append(vm.Spec.Template.Spec.Domain.Devices.Interfaces,
+			newInterface(iface.Name, iface.Model, binding))
+	}
+
+	return nil
+}
+
+func podNetworkExists(vm *v1.VirtualMachine) bool {
+	for _, network := range vm.Spec.Template.Spec.Networks {
+		if network.Pod != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newInterface returns an interface with one of the core bindings or, for any other name, a network binding plugin
+func newInterface(name, model, binding string) v1.Interface {
+	iface := v1.Interface{
+		Name:  name,
+		Model: model,
+	}
+
+	switch binding {
+	case bindingMasquerade:
+		iface.Masquerade = &v1.InterfaceMasquerade{}
+	case bindingBridge:
+		iface.Bridge = &v1.InterfaceBridge{}
+	case bindingSRIOV:
+		iface.SRIOV = &v1.InterfaceSRIOV{}
+	default:
+		iface.Binding = &v1.PluginBinding{
+			Name: binding,
+		}
+	}
+
+	return iface
+}
+
+func (c *createVM) withGPU(vm *v1.VirtualMachine) error {
+	existing := map[string]bool{}
+	for _, gpu := range vm.Spec.Template.Spec.Domain.Devices.GPUs {
+		existing[gpu.Name] = true
+	}
+
+	devices, err := parseDevices(GPUFlag, "gpu", c.gpus, existing)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		vm.Spec.Template.Spec.Domain.Devices.GPUs = append(vm.Spec.Template.Spec.Domain.Devices.GPUs, v1.GPU{
+			Name:       d.Name,
+			DeviceName: d.DeviceName,
+		})
+	}
+
+	return nil
+}
+
+func (c *createVM) withHostDevice(vm *v1.VirtualMachine) error {
+	existing := map[string]bool{}
+	for _, hostDevice := range vm.Spec.Template.Spec.Domain.Devices.HostDevices {
+		existing[hostDevice.Name] = true
+	}
+
+	devices, err := parseDevices(HostDeviceFlag, "hostdevice", c.hostDevices, existing)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		vm.Spec.Template.Spec.Domain.Devices.HostDevices = append(vm.Spec.Template.Spec.Domain.Devices.HostDevices, v1.HostDevice{
+			Name:       d.Name,
+			DeviceName: d.DeviceName,
+		})
+	}
+
+	return nil
+}
+
+// parseDevices parses the devices passed to flag. Unnamed devices are named after namePrefix and their index.
+// existing contains the names already in use and is updated with the names of the parsed devices.
+func parseDevices(flag, namePrefix string, deviceParams []string, existing map[string]bool) ([]device, error) {
+	var devices []device
+	for i, deviceParam := range deviceParams {
+		d := device{}
+		if err := params.Map(flag, deviceParam, &d); err != nil {
+			return nil, err
+		}
+
+		if d.DeviceName == "" {
+			return nil, params.FlagErr(flag, "devicename must be specified")
+		}
+
+		if d.Name == "" {
+			d.Name = fmt.Sprintf("%s-%d", namePrefix, i)
+		}
+
+		if errs := validation.IsDNS1123Label(d.Name); len(errs) > 0 {
+			return nil, params.FlagErr(flag, "invalid name \"%s\": %s", d.Name, strings.Join(errs, ","))
+		}
+
+		if existing[d.Name] {
+			return nil, params.FlagErr(flag, "there is already a device with name \"%s\"", d.Name)
+		}
+		existing[d.Name] = true
+
+		devices = append(devices, d)
+	}
+
+	return devices, nil
+}
+
+func (c *createVM) withTPM(vm *v1.VirtualMachine) error {
+	vm.Spec.Template.Spec.Domain.Devices.TPM = &v1.TPMDevice{}
+
+	// Explicitly disable the vTPM so that it is not enabled by a preference either
+	if !c.tpm {
+		vm.Spec.Template.Spec.Domain.Devices.TPM.Enabled = pointer.P(false)
+	}
+
+	return nil
+}
+
+func (c *createVM) withPersistentTPM(vm *v1.VirtualMachine) error {
+	if c.cmd.Flags().Changed(TPMFlag) && !c.tpm {
+		return params.FlagErr(TPMPersistentFlag, "cannot be used with --%s=false", TPMFlag)
+	}
+
+	if vm.Spec.Template.Spec.Domain.Devices.TPM == nil {
+		vm.Spec.Template.Spec.Domain.Devices.TPM = &v1.TPMDevice{}
+	}
+	vm.Spec.Template.Spec.Domain.Devices.TPM.Persistent = pointer.P(c.tpmPersistent)
+
+	return nil
+}
+
+func (c *createVM) withEFI(vm *v1.VirtualMachine) error {
+	if !c.efi {
+		firmware(vm).Bootloader = &v1.Bootloader{
+			BIOS: &v1.BIOS{},
+		}
+		return nil
+	}
+
+	// SecureBoot defaults to true on the backend, it is only enabled with the SecureBootFlag
+	efiBootloader(vm).SecureBoot = pointer.P(false)
+
+	return nil
+}
+
+func (c *createVM) withSecureBoot(vm *v1.VirtualMachine) error {
+	if c.cmd.Flags().Changed(EFIFlag) && !c.efi {
+		return params.FlagErr(SecureBootFlag, "cannot be used with --%s=false", EFIFlag)
+	}
+
+	efiBootloader(vm).SecureBoot = pointer.P(c.secureBoot)
+
+	// SecureBoot requires SMM
+	if c.secureBoot {
+		if vm.Spec.Template.Spec.Domain.Features == nil {
+			vm.Spec.Template.Spec.Domain.Features = &v1.Features{}
+		}
+		vm.Spec.Template.Spec.Domain.Features.SMM = &v1.FeatureState{
+			Enabled: pointer.P(true),
+		}
+	}
+
+	return nil
+}
+
+func firmware(vm *v1.VirtualMachine) *v1.Firmware {
+	if vm.Spec.Template.Spec.Domain.Firmware == nil {
+		vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{}
+	}
+
+	return vm.Spec.Template.Spec.Domain.Firmware
+}
+
+// efiBootloader returns the EFI settings of the VM, switching it from BIOS to EFI if needed
+func efiBootloader(vm *v1.VirtualMachine) *v1.EFI {
+	fw := firmware(vm)
+	if fw.Bootloader == nil || fw.Bootloader.EFI == nil {
+		fw.Bootloader = &v1.Bootloader{
+			EFI: &v1.EFI{},
+		}
+	}
+
+	return fw.Bootloader.EFI
+}
+
+func (c *createVM) withNodeSelector(vm *v1.VirtualMachine) error {
+	if err := validateLabels(NodeSelectorFlag, c.nodeSelector); err != nil {
+		return err
+	}
+
+	vm.Spec.Template.Spec.NodeSelector = mergeLabels(vm.Spec.Template.Spec.NodeSelector, c.nodeSelector)
+
+	return nil
+}
+
+func (c *createVM) withToleration(vm *v1.VirtualMachine) error {
+	for _, tolerationParams := range c.tolerations {
+		t := toleration{}
+		if err := params.Map(TolerationFlag, tolerationParams, &t); err != nil {
+			return err
+		}
+
+		operator, err := tolerationOperator(&t)
+		if err != nil {
+			return err
+		}
+
+		effect, err := tolerationEffect(t.Effect)
+		if err != nil {
+			return err
+		}
+
+		vm.Spec.Template.Spec.Tolerations = append(vm.Spec.Template.Spec.Tolerations, k8sv1.Toleration{
+			Key:      t.Key,
+			Operator: operator,
+			Value:    t.Value,
+			Effect:   effect,
+		})
+	}
+
+	return nil
+}
+
+// tolerationOperator returns the operator of the toleration, which defaults to Exists without a value and to Equal otherwise
+func tolerationOperator(t *toleration) (k8sv1.TolerationOperator, error) {
+	var operator k8sv1.TolerationOperator
+	switch {
+	case t.Operator == "" && t.Value == "":
+		operator = k8sv1.TolerationOpExists
+	case t.Operator == "":
+		operator = k8sv1.TolerationOpEqual
+	case strings.EqualFold(t.Operator, string(k8sv1.TolerationOpExists)):
+		operator = k8sv1.TolerationOpExists
+	case strings.EqualFold(t.Operator, string(k8sv1.TolerationOpEqual)):
+		operator = k8sv1.TolerationOpEqual
+	default:
+		return "", params.FlagErr(TolerationFlag, "invalid operator \"%s\", supported values are: %s, %s",
+			t.Operator, k8sv1.TolerationOpExists, k8sv1.TolerationOpEqual)
+	}
+
+	if operator == k8sv1.TolerationOpExists && t.Value != "" {
+		return "", params.FlagErr(TolerationFlag, "value cannot be specified with operator %s", k8sv1.TolerationOpExists)
+	}
+
+	if operator == k8sv1.TolerationOpEqual && t.Key == "" {
+		return "", params.FlagErr(TolerationFlag, "key must be specified with operator %s", k8sv1.TolerationOpEqual)
+	}
+
+	return operator, nil
+}
+
+func tolerationEffect(effect string) (k8sv1.TaintEffect, error) {
+	effects := []k8sv1.TaintEffect{
+		k8sv1.TaintEffectNoSchedule,
+		k8sv1.TaintEffectPreferNoSchedule,
+		k8sv1.TaintEffectNoExecute,
+	}
+
+	if effect == "" {
+		return "", nil
+	}
+
+	for _, e := range effects {
+		if strings.EqualFold(string(e), effect) {
+			return e, nil
+		}
+	}
+
+	return "", params.FlagErr(TolerationFlag, "invalid effect \"%s\", supported values are: %s, %s, %s",
+		effect, effects[0], effects[1], effects[2])
+}
+
+func (c *createVM) withLabels(vm *v1.VirtualMachine) error {
+	if err := validateLabels(LabelFlag, c.labels); err != nil {
+		return err
+	}
+
+	vm.Labels = mergeLabels(vm.Labels, c.labels)
+
+	return nil
+}
+
+func (c *createVM) withTemplateLabels(vm *v1.VirtualMachine) error {
+	if err := validateLabels(TemplateLabelFlag, c.templateLabels); err != nil {
+		return err
+	}
+
+	vm.Spec.Template.ObjectMeta.Labels = mergeLabels(vm.Spec.Template.ObjectMeta.Labels, c.templateLabels)
+
+	return nil
+}
+
+func validateLabels(flag string, labels map[string]string) error {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return params.FlagErr(flag, "invalid key \"%s\": %s", key, strings.Join(errs, ","))
+		}
+		if errs := validation.IsValidLabelValue(labels[key]); len(errs) > 0 {
+			return params.FlagErr(flag, "invalid value \"%s\": %s", labels[key], strings.Join(errs, ","))
+		}
+	}
+
+	return nil
+}
+
+func mergeLabels(labels, added map[string]string) map[string]string {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for key, value := range added {
+		labels[key] = value
+	}
+
+	return labels
+}
+
 func (c *createVM) withDataSourceVolume(_ *v1.VirtualMachine) error {
 	return aliasToVolumeImport(c.cmd, DataSourceVolumeFlag, ds, c.dataSourceVolumes, &c.volumeImport)
 }
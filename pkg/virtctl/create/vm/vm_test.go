@@ -2,6 +2,7 @@
 package vm_test
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -9,17 +10,24 @@ import (
 	"strconv"
 	"strings"
 
+	jsonpatch "github.com/evanphx/json-patch"
+	"go.uber.org/mock/gomock"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/yaml"
 
 	v1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	generatedscheme "kubevirt.io/client-go/kubevirt/scheme"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -1382,6 +1390,175 @@ chpasswd: { expire: False }`
 				},
 			))
 		})
+
+		DescribeTable("VM with specified network", func(param string, network v1.Network, iface v1.Interface) {
+			out, err := runCmd(setFlag(NetworkFlag, param))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Networks).To(ConsistOf(network))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(ConsistOf(iface))
+		},
+			Entry("with type pod",
+				"type:pod",
+				v1.Network{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+				v1.Interface{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			),
+			Entry("with type pod by default and model",
+				"model:virtio",
+				v1.Network{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+				v1.Interface{Name: "default", Model: "virtio", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			),
+			Entry("with type pod and binding plugin",
+				"type:pod,name:my-net,binding:passt",
+				v1.Network{Name: "my-net", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+				v1.Interface{Name: "my-net", Binding: &v1.PluginBinding{Name: "passt"}},
+			),
+			Entry("with type multus",
+				"type:multus,src:my-nad",
+				v1.Network{Name: "my-nad", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "my-nad"}}},
+				v1.Interface{Name: "my-nad", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			),
+			Entry("with type multus and namespaced src",
+				"type:multus,src:my-ns/my-nad",
+				v1.Network{Name: "my-nad", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "my-ns/my-nad"}}},
+				v1.Interface{Name: "my-nad", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			),
+			Entry("with type multus and binding sriov",
+				"type:multus,src:my-nad,name:my-sriov,binding:sriov",
+				v1.Network{Name: "my-sriov", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "my-nad"}}},
+				v1.Interface{Name: "my-sriov", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+			),
+		)
+
+		It("VM with multiple networks", func() {
+			out, err := runCmd(
+				setFlag(NetworkFlag, "type:pod"),
+				setFlag(NetworkFlag, "type:multus,src:my-nad1"),
+				setFlag(NetworkFlag, "type:multus,src:my-nad2"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Networks).To(HaveLen(3))
+			Expect(vm.Spec.Template.Spec.Networks[0].Name).To(Equal("default"))
+			Expect(vm.Spec.Template.Spec.Networks[1].Name).To(Equal("my-nad1"))
+			Expect(vm.Spec.Template.Spec.Networks[2].Name).To(Equal("my-nad2"))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(3))
+		})
+
+		It("VM with GPUs and host devices", func() {
+			out, err := runCmd(
+				setFlag(GPUFlag, "devicename:nvidia.com/GP102GL_Tesla_P40"),
+				setFlag(GPUFlag, "devicename:nvidia.com/GP102GL_Tesla_P40"),
+				setFlag(HostDeviceFlag, "name:my-device,devicename:intel.com/qat"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Domain.Devices.GPUs).To(ConsistOf(
+				v1.GPU{Name: "gpu-0", DeviceName: "nvidia.com/GP102GL_Tesla_P40"},
+				v1.GPU{Name: "gpu-1", DeviceName: "nvidia.com/GP102GL_Tesla_P40"},
+			))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.HostDevices).To(ConsistOf(
+				v1.HostDevice{Name: "my-device", DeviceName: "intel.com/qat"},
+			))
+		})
+
+		DescribeTable("VM with vTPM", func(tpm *v1.TPMDevice, args ...string) {
+			out, err := runCmd(args...)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Domain.Devices.TPM).To(Equal(tpm))
+		},
+			Entry("enabled", &v1.TPMDevice{}, "--"+TPMFlag),
+			Entry("disabled", &v1.TPMDevice{Enabled: pointer.P(false)}, setFlag(TPMFlag, "false")),
+			Entry("persistent", &v1.TPMDevice{Persistent: pointer.P(true)}, "--"+TPMPersistentFlag),
+			Entry("enabled and persistent", &v1.TPMDevice{Persistent: pointer.P(true)}, "--"+TPMFlag, "--"+TPMPersistentFlag),
+		)
+
+		DescribeTable("VM with firmware", func(bootloader *v1.Bootloader, smm bool, args ...string) {
+			out, err := runCmd(args...)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Domain.Firmware).ToNot(BeNil())
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.Bootloader).To(Equal(bootloader))
+			if smm {
+				Expect(vm.Spec.Template.Spec.Domain.Features).ToNot(BeNil())
+				Expect(vm.Spec.Template.Spec.Domain.Features.SMM).To(Equal(&v1.FeatureState{Enabled: pointer.P(true)}))
+			} else {
+				Expect(vm.Spec.Template.Spec.Domain.Features).To(BeNil())
+			}
+		},
+			Entry("EFI", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}, false, "--"+EFIFlag),
+			Entry("BIOS", &v1.Bootloader{BIOS: &v1.BIOS{}}, false, setFlag(EFIFlag, "false")),
+			Entry("SecureBoot", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(true)}}, true, "--"+SecureBootFlag),
+			Entry("EFI and SecureBoot", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(true)}}, true, "--"+EFIFlag, "--"+SecureBootFlag),
+			Entry("EFI without SecureBoot", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}, false, setFlag(SecureBootFlag, "false")),
+		)
+
+		It("VM with node selector", func() {
+			out, err := runCmd(
+				setFlag(NodeSelectorFlag, "zone=east,kubernetes.io/arch=amd64"),
+				setFlag(NodeSelectorFlag, "disktype=ssd"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{
+				"zone":               "east",
+				"kubernetes.io/arch": "amd64",
+				"disktype":           "ssd",
+			}))
+		})
+
+		DescribeTable("VM with specified toleration", func(param string, toleration k8sv1.Toleration) {
+			out, err := runCmd(setFlag(TolerationFlag, param))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Tolerations).To(ConsistOf(toleration))
+		},
+			Entry("with key, value and effect",
+				"key:dedicated,value:vms,effect:noschedule",
+				k8sv1.Toleration{Key: "dedicated", Operator: k8sv1.TolerationOpEqual, Value: "vms", Effect: k8sv1.TaintEffectNoSchedule},
+			),
+			Entry("with key only",
+				"key:dedicated",
+				k8sv1.Toleration{Key: "dedicated", Operator: k8sv1.TolerationOpExists},
+			),
+			Entry("with operator Exists and effect",
+				"operator:Exists,effect:NoExecute",
+				k8sv1.Toleration{Operator: k8sv1.TolerationOpExists, Effect: k8sv1.TaintEffectNoExecute},
+			),
+		)
+
+		It("VM with labels and template labels", func() {
+			out, err := runCmd(
+				setFlag(LabelFlag, "app=my-app,example.com/team=my-team"),
+				setFlag(TemplateLabelFlag, "app=my-app"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Labels).To(Equal(map[string]string{
+				"app":              "my-app",
+				"example.com/team": "my-team",
+			}))
+			Expect(vm.Spec.Template.ObjectMeta.Labels).To(Equal(map[string]string{
+				"app": "my-app",
+			}))
+		})
 	})
 
 	Describe("Manifest is not created successfully", func() {
@@ -1866,6 +2043,358 @@ chpasswd: { expire: False }`
 			Entry("type ssh (explicit) and configdrive vs nocloud", "type:ssh,src:my-src,method:configdrive", cloudInitNoCloud, "configdrive vs nocloud"),
 			Entry("type ssh (explicit) and configdrive vs none", "type:ssh,src:my-src,method:configdrive", cloudInitNone, "configdrive vs none"),
 		)
+
+		DescribeTable("Invalid arguments to NetworkFlag", func(errMsg string, args ...string) {
+			out, err := runCmd(args...)
+			Expect(err).To(MatchError("failed to parse \"--network\" flag: " + errMsg))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("Empty params", paramsEmptyError, setFlag(NetworkFlag, "")),
+			Entry("Invalid param", paramsInvalidError, setFlag(NetworkFlag, "test=test")),
+			Entry("Unknown param", paramsUnknownError, setFlag(NetworkFlag, "test:test")),
+			Entry("Invalid type", "invalid network type \"madeup\", supported values are: pod, multus", setFlag(NetworkFlag, "type:madeup")),
+			Entry("src with type pod", "src cannot be specified with network type pod", setFlag(NetworkFlag, "type:pod,src:my-nad")),
+			Entry("Missing src with type multus", "src must be specified with network type multus", setFlag(NetworkFlag, "type:multus")),
+			Entry("Invalid slashes count in src", srcInvalidSlashCountError, setFlag(NetworkFlag, "type:multus,src:my-ns/my-nad/madeup")),
+			Entry("Binding masquerade with type multus", "binding masquerade can only be used with network type pod", setFlag(NetworkFlag, "type:multus,src:my-nad,binding:masquerade")),
+			Entry("Invalid name", nameDotsError, setFlag(NetworkFlag, "name:name.with.dot")),
+			Entry("Multiple networks of type pod", "there can only be one network of type pod", setFlag(NetworkFlag, "type:pod"), setFlag(NetworkFlag, "type:pod,name:my-net")),
+			Entry("Duplicate name", "there is already a network with name \"my-nad\"", setFlag(NetworkFlag, "type:multus,src:my-nad"), setFlag(NetworkFlag, "type:multus,src:my-ns/my-nad")),
+		)
+
+		DescribeTable("Invalid arguments to GPUFlag and HostDeviceFlag", func(flag, errMsg string, args ...string) {
+			var flags []string
+			for _, arg := range args {
+				flags = append(flags, setFlag(flag, arg))
+			}
+			out, err := runCmd(flags...)
+			Expect(err).To(MatchError(fmt.Sprintf("failed to parse \"--%s\" flag: %s", flag, errMsg)))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("GPU with empty params", GPUFlag, paramsEmptyError, ""),
+			Entry("GPU with unknown param", GPUFlag, paramsUnknownError, "test:test"),
+			Entry("GPU without devicename", GPUFlag, "devicename must be specified", "name:my-gpu"),
+			Entry("GPU with invalid name", GPUFlag, nameUpperCaseError, "name:NOTALLOWED,devicename:my-device"),
+			Entry("GPU with duplicate name", GPUFlag, "there is already a device with name \"gpu-1\"", "name:gpu-1,devicename:my-device", "devicename:my-device"),
+			Entry("Host device without devicename", HostDeviceFlag, "devicename must be specified", "name:my-device"),
+			Entry("Host device with duplicate name", HostDeviceFlag, "there is already a device with name \"my-device\"", "name:my-device,devicename:my-device", "name:my-device,devicename:my-device"),
+		)
+
+		It("TPMPersistentFlag with disabled vTPM", func() {
+			out, err := runCmd(setFlag(TPMFlag, "false"), "--"+TPMPersistentFlag)
+			Expect(err).To(MatchError("failed to parse \"--tpm-persistent\" flag: cannot be used with --tpm=false"))
+			Expect(out).To(BeEmpty())
+		})
+
+		It("SecureBootFlag with disabled EFI", func() {
+			out, err := runCmd(setFlag(EFIFlag, "false"), "--"+SecureBootFlag)
+			Expect(err).To(MatchError("failed to parse \"--secure-boot\" flag: cannot be used with --efi=false"))
+			Expect(out).To(BeEmpty())
+		})
+
+		DescribeTable("Invalid arguments to TolerationFlag", func(param, errMsg string) {
+			out, err := runCmd(setFlag(TolerationFlag, param))
+			Expect(err).To(MatchError("failed to parse \"--toleration\" flag: " + errMsg))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("Empty params", "", paramsEmptyError),
+			Entry("Unknown param", "test:test", paramsUnknownError),
+			Entry("Invalid operator", "key:my-key,operator:madeup", "invalid operator \"madeup\", supported values are: Exists, Equal"),
+			Entry("Value with operator Exists", "key:my-key,operator:exists,value:my-value", "value cannot be specified with operator Exists"),
+			Entry("Missing key with operator Equal", "value:my-value", "key must be specified with operator Equal"),
+			Entry("Invalid effect", "key:my-key,effect:madeup", "invalid effect \"madeup\", supported values are: NoSchedule, PreferNoSchedule, NoExecute"),
+		)
+
+		DescribeTable("Invalid arguments to label flags", func(flag, param, errMsg string) {
+			out, err := runCmd(setFlag(flag, param))
+			Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("failed to parse \"--%s\" flag: %s", flag, errMsg))))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("LabelFlag with invalid key", LabelFlag, "-app=my-app", "invalid key \"-app\""),
+			Entry("LabelFlag with invalid value", LabelFlag, "app=my app", "invalid value \"my app\""),
+			Entry("TemplateLabelFlag with invalid key", TemplateLabelFlag, "a/b/c=my-app", "invalid key \"a/b/c\""),
+			Entry("NodeSelectorFlag with invalid value", NodeSelectorFlag, "zone=-east", "invalid value \"-east\""),
+		)
+
+		It("Invalid OutputFormatFlag", func() {
+			out, err := runCmd(setFlag(OutputFormatFlag, "json"))
+			Expect(err).To(MatchError("failed to parse \"--output-format\" flag: invalid output format \"json\", supported values are: yaml, kustomize"))
+			Expect(out).To(BeEmpty())
+		})
+
+		It("OutputDirFlag with output format yaml", func() {
+			out, err := runCmd(setFlag(OutputDirFlag, "my-dir"))
+			Expect(err).To(MatchError("failed to parse \"--output-dir\" flag: can only be used with --output-format=kustomize"))
+			Expect(out).To(BeEmpty())
+		})
+	})
+
+	Context("from an existing VM", func() {
+		const (
+			srcName      = "src-vm"
+			srcNamespace = "src-ns"
+			name         = "my-vm"
+		)
+
+		var virtClient *kubevirtfake.Clientset
+
+		BeforeEach(func() {
+			virtClient = kubevirtfake.NewSimpleClientset()
+
+			ctrl := gomock.NewController(GinkgoT())
+			kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+			kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(gomock.Any()).
+				DoAndReturn(func(namespace string) kubecli.VirtualMachineInterface {
+					return virtClient.KubevirtV1().VirtualMachines(namespace)
+				}).AnyTimes()
+
+			src := &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            srcName,
+					Namespace:       metav1.NamespaceDefault,
+					UID:             "9f3b9a0e-4d0c-4bb4-9d49-2f6f0b1c3a11",
+					ResourceVersion: "1234",
+					Generation:      3,
+					Labels: map[string]string{
+						"app":                         "my-app",
+						"kubevirt.io/created-by":      "my-controller",
+						"vm.kubevirt.io/template":     "my-template",
+						"my.domain/kubevirt.io-owner": "me",
+					},
+					Annotations: map[string]string{
+						"my-annotation": "my-value",
+						v1.ControllerAPILatestVersionObservedAnnotation:  "v1",
+						v1.ControllerAPIStorageVersionObservedAnnotation: "v1",
+						k8sv1.LastAppliedConfigAnnotation:                "{}",
+					},
+				},
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyHalted),
+					Preference: &v1.PreferenceMatcher{
+						Name:         "my-preference",
+						RevisionName: "src-vm-my-preference-1",
+					},
+					DataVolumeTemplates: []v1.DataVolumeTemplateSpec{{
+						ObjectMeta: metav1.ObjectMeta{Name: srcName + "-rootdisk"},
+						Spec: cdiv1.DataVolumeSpec{
+							SourceRef: &cdiv1.DataVolumeSourceRef{Kind: "DataSource", Name: "my-ds"},
+						},
+					}},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app":                srcName,
+								"kubevirt.io/vm":     srcName,
+								"kubevirt.io/domain": srcName,
+							},
+							Annotations: map[string]string{
+								"my-template-annotation":                        "my-value",
+								v1.ControllerAPILatestVersionObservedAnnotation: "v1",
+							},
+						},
+						Spec: v1.VirtualMachineInstanceSpec{
+							Hostname:    srcName,
+							Hibernation: &v1.Hibernation{ClaimName: srcName + "-hibernation"},
+							Domain: v1.DomainSpec{
+								Memory: &v1.Memory{Guest: pointer.P(resource.MustParse("2Gi"))},
+								Firmware: &v1.Firmware{
+									UUID:       "5d307ca9-b3ef-428c-8861-06e72d69f223",
+									Serial:     "my-serial",
+									Bootloader: &v1.Bootloader{EFI: &v1.EFI{Persistent: pointer.P(true)}},
+								},
+								Devices: v1.Devices{
+									Disks: []v1.Disk{{Name: "rootdisk", BootOrder: pointer.P(uint(1))}},
+									Interfaces: []v1.Interface{{
+										Name:                   "default",
+										MacAddress:             "02:00:00:00:00:01",
+										InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+									}},
+								},
+							},
+							Networks: []v1.Network{*v1.DefaultPodNetwork()},
+							Volumes: []v1.Volume{{
+								Name: "rootdisk",
+								VolumeSource: v1.VolumeSource{
+									DataVolume: &v1.DataVolumeSource{Name: srcName + "-rootdisk"},
+								},
+							}},
+						},
+					},
+				},
+				Status: v1.VirtualMachineStatus{
+					Created:         true,
+					PrintableStatus: v1.VirtualMachineStatusStopped,
+				},
+			}
+			_, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), src, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			src.Namespace = srcNamespace
+			_, err = virtClient.KubevirtV1().VirtualMachines(srcNamespace).Create(context.Background(), src, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("VM without the identity of the existing VM", func(fromVM string) {
+			out, err := runCmd(setFlag(FromVMFlag, fromVM), setFlag(NameFlag, name))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Name).To(Equal(name))
+			Expect(vm.Namespace).To(BeEmpty())
+			Expect(vm.UID).To(BeEmpty())
+			Expect(vm.ResourceVersion).To(BeEmpty())
+			Expect(vm.Generation).To(BeZero())
+			Expect(vm.Labels).To(Equal(map[string]string{"app": "my-app", "my.domain/kubevirt.io-owner": "me"}))
+			Expect(vm.Annotations).To(Equal(map[string]string{"my-annotation": "my-value"}))
+			Expect(vm.Status).To(Equal(v1.VirtualMachineStatus{}))
+
+			Expect(vm.Spec.RunStrategy).To(PointTo(Equal(v1.RunStrategyHalted)))
+			Expect(vm.Spec.Instancetype).To(BeNil())
+			Expect(vm.Spec.Preference).To(Equal(&v1.PreferenceMatcher{Name: "my-preference"}))
+
+			Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal(name + "-rootdisk"))
+			Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal(name + "-rootdisk"))
+
+			Expect(vm.Spec.Template.ObjectMeta.Labels).To(Equal(map[string]string{"app": srcName}))
+			Expect(vm.Spec.Template.ObjectMeta.Annotations).To(Equal(map[string]string{"my-template-annotation": "my-value"}))
+			Expect(vm.Spec.Template.Spec.Hostname).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec.Hibernation).To(BeNil())
+			Expect(vm.Spec.Template.Spec.Domain.Memory.Guest).To(PointTo(Equal(resource.MustParse("2Gi"))))
+			Expect(vm.Spec.Template.Spec.Domain.Firmware).To(Equal(&v1.Firmware{
+				Bootloader: &v1.Bootloader{EFI: &v1.EFI{Persistent: pointer.P(true)}},
+			}))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(1))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+		},
+			Entry("in the same namespace", srcName),
+			Entry("in another namespace", srcNamespace+"/"+srcName),
+		)
+
+		It("VM with flags applied on top of the existing VM", func() {
+			out, err := runCmd(
+				setFlag(FromVMFlag, srcName),
+				setFlag(NameFlag, name),
+				setFlag(MemoryFlag, "4Gi"),
+				setFlag(RunStrategyFlag, string(v1.RunStrategyAlways)),
+				setFlag(NetworkFlag, "type:multus,src:my-nad"),
+				setFlag(ContainerdiskVolumeFlag, "src:my.registry/my-image:my-tag,bootorder:2"),
+				"--"+SecureBootFlag,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.RunStrategy).To(PointTo(Equal(v1.RunStrategyAlways)))
+			Expect(vm.Spec.Template.Spec.Domain.Memory.Guest).To(PointTo(Equal(resource.MustParse("4Gi"))))
+			Expect(vm.Spec.Template.Spec.Networks).To(HaveLen(2))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(2))
+			Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(2))
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI).To(Equal(&v1.EFI{
+				SecureBoot: pointer.P(true),
+				Persistent: pointer.P(true),
+			}))
+		})
+
+		It("should fail with a boot order used by the existing VM", func() {
+			out, err := runCmd(
+				setFlag(FromVMFlag, srcName),
+				setFlag(ContainerdiskVolumeFlag, "src:my.registry/my-image:my-tag,bootorder:1"),
+			)
+			Expect(err).To(MatchError("failed to parse \"--volume-containerdisk\" flag: bootorder 1 was specified multiple times"))
+			Expect(out).To(BeEmpty())
+		})
+
+		It("should fail if the existing VM does not exist", func() {
+			out, err := runCmd(setFlag(FromVMFlag, "does-not-exist"))
+			Expect(err).To(MatchError(ContainSubstring("failed to parse \"--from-vm\" flag: failed to get VirtualMachine \"default/does-not-exist\"")))
+			Expect(out).To(BeEmpty())
+		})
+	})
+
+	Context("with output format kustomize", func() {
+		var outputDir string
+
+		BeforeEach(func() {
+			outputDir = filepath.Join(GinkgoT().TempDir(), "my-vm")
+		})
+
+		It("should write a base and patches resulting in the yaml output", func() {
+			args := []string{
+				setFlag(NameFlag, "my-vm"),
+				setFlag(InstancetypeFlag, "my-instancetype"),
+				setFlag(ContainerdiskVolumeFlag, "src:my.registry/my-image:my-tag"),
+				setFlag(NetworkFlag, "type:pod"),
+				setFlag(LabelFlag, "app=my-app"),
+				setFlag(UserFlag, "my-user"),
+			}
+
+			out, err := runCmd(args...)
+			Expect(err).ToNot(HaveOccurred())
+			expected, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			out, err = runCmd(append(args, setFlag(OutputFormatFlag, "kustomize"), setFlag(OutputDirFlag, outputDir))...)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring(filepath.Join(outputDir, "kustomization.yaml")))
+
+			data, err := os.ReadFile(filepath.Join(outputDir, "kustomization.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			k := struct {
+				Resources []string `json:"resources"`
+				Patches   []struct {
+					Path string `json:"path"`
+				} `json:"patches"`
+			}{}
+			Expect(yaml.Unmarshal(data, &k)).To(Succeed())
+			Expect(k.Resources).To(ConsistOf("vm.yaml"))
+
+			var patches []string
+			for _, patch := range k.Patches {
+				patches = append(patches, patch.Path)
+			}
+			Expect(patches).To(Equal([]string{
+				"patch-instancetype.yaml",
+				"patch-volume-containerdisk.yaml",
+				"patch-network.yaml",
+				"patch-label.yaml",
+				"patch-cloud-init.yaml",
+			}))
+
+			data, err = os.ReadFile(filepath.Join(outputDir, "vm.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			vmJSON, err := yaml.YAMLToJSON(data)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, patch := range patches {
+				data, err = os.ReadFile(filepath.Join(outputDir, patch))
+				Expect(err).ToNot(HaveOccurred())
+				patchJSON, err := yaml.YAMLToJSON(data)
+				Expect(err).ToNot(HaveOccurred())
+				vmJSON, err = jsonpatch.MergePatch(vmJSON, patchJSON)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			vm, err := decodeVM(vmJSON)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm).To(Equal(expected))
+		})
+
+		It("should not overwrite an existing kustomization", func() {
+			args := []string{
+				setFlag(OutputFormatFlag, "kustomize"),
+				setFlag(OutputDirFlag, outputDir),
+			}
+
+			_, err := runCmd(args...)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = runCmd(args...)
+			Expect(err).To(MatchError(ContainSubstring("failed to parse \"--output-dir\" flag: open " + filepath.Join(outputDir, "vm.yaml"))))
+		})
 	})
 })
 
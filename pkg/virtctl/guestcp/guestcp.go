@@ -0,0 +1,191 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUEST_CP = "guest-cp"
+
+	maxSizeFlag = "max-size"
+)
+
+type guestCP struct {
+	maxSize string
+}
+
+type remoteArgument struct {
+	namespace string
+	name      string
+	path      string
+}
+
+func NewCommand() *cobra.Command {
+	c := guestCP{}
+	cmd := &cobra.Command{
+		Use:     "guest-cp (VMI:PATH LOCAL|LOCAL VMI:PATH)",
+		Short:   "Copy a file from/to a virtual machine instance through the QEMU guest agent.",
+		Example: usage(),
+		Args:    cobra.ExactArgs(2),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVar(&c.maxSize, maxSizeFlag, resource.NewQuantity(v1.GuestFileDefaultMaxBytes, resource.BinarySI).String(),
+		fmt.Sprintf("Maximum size of the copied file, must not exceed %s", resource.NewQuantity(v1.GuestFileMaxBytes, resource.BinarySI).String()))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Copy the file /etc/hosts out of the VMI 'testvmi' into the local file hosts:
+  {{ProgramName}} guest-cp vmi/testvmi:/etc/hosts ./hosts
+
+  # Copy the local file config.ini into the VMI 'testvmi' in namespace 'mynamespace':
+  {{ProgramName}} guest-cp ./config.ini vmi/testvmi/mynamespace:/etc/app/config.ini
+
+  # Copy a file of up to 64Mi out of a Windows guest:
+  {{ProgramName}} guest-cp --max-size=64Mi vmi/testvmi:C:\Windows\Temp\dump.log ./dump.log`
+}
+
+func (c *guestCP) run(cmd *cobra.Command, args []string) error {
+	maxBytes, err := parseMaxSize(c.maxSize)
+	if err != nil {
+		return err
+	}
+
+	remote, local, toGuest, err := parseArguments(args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if remote.namespace == "" {
+		remote.namespace = namespace
+	}
+
+	options := &v1.GuestFileOptions{
+		Path:     remote.path,
+		MaxBytes: maxBytes,
+	}
+	vmiClient := virtClient.VirtualMachineInstance(remote.namespace)
+
+	if toGuest {
+		file, err := os.Open(local)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+		if info.Size() > maxBytes {
+			return fmt.Errorf("file %s has %d bytes which exceeds the maximum size of %d bytes", local, info.Size(), maxBytes)
+		}
+		if err := vmiClient.GuestFileWrite(context.Background(), remote.name, options, file); err != nil {
+			return fmt.Errorf("error copying %s to VirtualMachineInstance %s: %v", local, remote.name, err)
+		}
+		cmd.Printf("Successfully copied %s to %s:%s\n", local, remote.name, remote.path)
+		return nil
+	}
+
+	stream, err := vmiClient.GuestFileRead(context.Background(), remote.name, options)
+	if err != nil {
+		return fmt.Errorf("error copying %s from VirtualMachineInstance %s: %v", remote.path, remote.name, err)
+	}
+	defer stream.Close()
+
+	file, err := os.Create(local)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, stream)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Do not leave a truncated file behind
+		os.Remove(local)
+		return fmt.Errorf("error copying %s from VirtualMachineInstance %s: %v", remote.path, remote.name, err)
+	}
+	cmd.Printf("Successfully copied %s:%s to %s\n", remote.name, remote.path, local)
+	return nil
+}
+
+func parseMaxSize(maxSize string) (int64, error) {
+	quantity, err := resource.ParseQuantity(maxSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %s: %v", maxSizeFlag, maxSize, err)
+	}
+	value := quantity.Value()
+	if value <= 0 || value > v1.GuestFileMaxBytes {
+		return 0, fmt.Errorf("%s must be between 1 and %d bytes", maxSizeFlag, v1.GuestFileMaxBytes)
+	}
+	return value, nil
+}
+
+// parseArguments returns the remote and local argument and whether the file is copied into the guest
+func parseArguments(source, destination string) (*remoteArgument, string, bool, error) {
+	sourceRemote, destinationRemote := isRemote(source), isRemote(destination)
+	switch {
+	case sourceRemote && destinationRemote:
+		return nil, "", false, fmt.Errorf("copying between two virtual machine instances is not supported: %q to %q", source, destination)
+	case !sourceRemote && !destinationRemote:
+		return nil, "", false, fmt.Errorf("none of the two provided locations seems to be a virtual machine instance: %q to %q", source, destination)
+	}
+
+	toGuest := destinationRemote
+	remoteArg, local := source, destination
+	if toGuest {
+		remoteArg, local = destination, source
+	}
+
+	split := strings.SplitN(remoteArg, ":", 2)
+	_, namespace, name, err := portforward.ParseTarget(split[0])
+	if err != nil {
+		return nil, "", false, err
+	}
+	if split[1] == "" {
+		return nil, "", false, fmt.Errorf("missing path in the virtual machine instance in %q", remoteArg)
+	}
+	return &remoteArgument{namespace: namespace, name: name, path: split[1]}, local, toGuest, nil
+}
+
+func isRemote(arg string) bool {
+	idx := strings.Index(arg, ":")
+	return idx > 0 && strings.Contains(arg[:idx], "/")
+}
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestcp_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing/iotest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/guestcp"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("guest-cp command", func() {
+	const vmiName = "testvmi"
+
+	var (
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		localPath    string
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		localPath = filepath.Join(GinkgoT().TempDir(), "file")
+	})
+
+	expectVMIClient := func(namespace string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(namespace).Return(vmiInterface).Times(1)
+	}
+
+	It("should copy a file out of the guest", func() {
+		expectVMIClient(metav1.NamespaceDefault)
+		vmiInterface.EXPECT().GuestFileRead(context.Background(), vmiName, &v1.GuestFileOptions{
+			Path:     "/etc/hosts",
+			MaxBytes: v1.GuestFileDefaultMaxBytes,
+		}).Return(io.NopCloser(strings.NewReader("127.0.0.1 localhost")), nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUEST_CP, "vmi/"+vmiName+":/etc/hosts", localPath)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(localPath)).To(BeEquivalentTo("127.0.0.1 localhost"))
+	})
+
+	It("should copy a file into the guest in another namespace", func() {
+		Expect(os.WriteFile(localPath, []byte("content"), 0o600)).To(Succeed())
+		expectVMIClient("mynamespace")
+		vmiInterface.EXPECT().GuestFileWrite(context.Background(), vmiName, &v1.GuestFileOptions{
+			Path:     `C:\config.ini`,
+			MaxBytes: 1024,
+		}, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ *v1.GuestFileOptions, content io.Reader) error {
+			Expect(io.ReadAll(content)).To(BeEquivalentTo("content"))
+			return nil
+		})
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUEST_CP, "--max-size", "1Ki",
+			localPath, "vmi/"+vmiName+`/mynamespace:C:\config.ini`)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should remove the local file when the copy is interrupted", func() {
+		expectVMIClient(metav1.NamespaceDefault)
+		vmiInterface.EXPECT().GuestFileRead(context.Background(), vmiName, gomock.Any()).
+			Return(io.NopCloser(iotest.ErrReader(errors.New("connection reset"))), nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUEST_CP, "vmi/"+vmiName+":/etc/hosts", localPath)
+		Expect(cmd()).To(MatchError(ContainSubstring("connection reset")))
+		Expect(localPath).ToNot(BeAnExistingFile())
+	})
+
+	It("should refuse to copy a local file larger than the maximum size", func() {
+		Expect(os.WriteFile(localPath, []byte("content"), 0o600)).To(Succeed())
+		expectVMIClient(metav1.NamespaceDefault)
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUEST_CP, "--max-size", "4", localPath, "vmi/"+vmiName+":/tmp/file")
+		Expect(cmd()).To(MatchError(ContainSubstring("exceeds the maximum size of 4 bytes")))
+	})
+
+	DescribeTable("should fail with invalid arguments", func(expected string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{guestcp.COMMAND_GUEST_CP}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("with two local paths", "none of the two provided locations", "./a", "./b"),
+		Entry("with two guest paths", "copying between two virtual machine instances", "vmi/a:/a", "vmi/b:/b"),
+		Entry("with an unsupported resource type", "unsupported resource type", "pod/a:/a", "./b"),
+		Entry("without a guest path", "missing path", "vmi/a:", "./b"),
+		Entry("with a maximum size above the limit", "max-size must be between", "--max-size", "65Mi", "vmi/a:/a", "./b"),
+	)
+})
@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestexec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUEST_EXEC = "guest-exec"
+
+	timeoutFlag = "timeout"
+)
+
+// ExitCodeError is returned when the guest command exited with a non-zero exit code,
+// virtctl exits with the same code.
+type ExitCodeError struct {
+	ExitCode int
+}
+
+func (e ExitCodeError) Error() string {
+	return fmt.Sprintf("guest command exited with code %d", e.ExitCode)
+}
+
+type guestExec struct {
+	timeout time.Duration
+}
+
+func NewCommand() *cobra.Command {
+	c := guestExec{}
+	cmd := &cobra.Command{
+		Use:   "guest-exec (VMI) -- COMMAND [ARGS...]",
+		Short: "Execute a command in a virtual machine instance through the QEMU guest agent.",
+		Long: `Execute a command in a virtual machine instance through the QEMU guest agent.
+The guest agent only reports the output of the command once it exited, so the output is printed when the command exits.
+A command which does not exit within the timeout keeps running in the guest and its output is lost.`,
+		Example: usage(),
+		Args:    cobra.MinimumNArgs(2),
+		RunE:    c.run,
+	}
+	cmd.Flags().DurationVar(&c.timeout, timeoutFlag, time.Duration(v1.GuestExecDefaultTimeoutSeconds)*time.Second,
+		fmt.Sprintf("Time the command may run, must not exceed %s", time.Duration(v1.GuestExecMaxTimeoutSeconds)*time.Second))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Print the kernel version of the VMI 'testvmi':
+  {{ProgramName}} guest-exec vmi/testvmi -- /usr/bin/uname -r
+
+  # Run a long running script in the VMI 'testvmi' in namespace 'mynamespace':
+  {{ProgramName}} guest-exec --timeout=10m vmi/testvmi/mynamespace -- /usr/local/bin/backup.sh --full`
+}
+
+func (c *guestExec) run(cmd *cobra.Command, args []string) error {
+	if c.timeout < time.Second || c.timeout > time.Duration(v1.GuestExecMaxTimeoutSeconds)*time.Second {
+		return fmt.Errorf("%s must be between 1s and %s", timeoutFlag, time.Duration(v1.GuestExecMaxTimeoutSeconds)*time.Second)
+	}
+
+	_, namespace, name, err := portforward.ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+
+	virtClient, defaultNamespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	options := &v1.GuestExecOptions{
+		Command:        args[1],
+		Args:           args[2:],
+		TimeoutSeconds: int32(c.timeout / time.Second),
+	}
+	exitCode, err := virtClient.VirtualMachineInstance(namespace).GuestExec(context.Background(), name, options, cmd.OutOrStdout(), cmd.ErrOrStderr())
+	if err != nil {
+		return fmt.Errorf("error executing %s in VirtualMachineInstance %s: %v", options.Command, name, err)
+	}
+	if exitCode != 0 {
+		return ExitCodeError{ExitCode: int(exitCode)}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestexec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("guest-exec command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	expectGuestExec := func(namespace string, options interface{}, exitCode int32, err error) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(namespace).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, options, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ *v1.GuestExecOptions, stdout, _ io.Writer) (int32, error) {
+				_, writeErr := stdout.Write([]byte("output"))
+				Expect(writeErr).ToNot(HaveOccurred())
+				return exitCode, err
+			})
+	}
+
+	It("should execute the command with the default timeout", func() {
+		expectGuestExec(metav1.NamespaceDefault, &v1.GuestExecOptions{
+			Command:        "/usr/bin/uname",
+			Args:           []string{"-r"},
+			TimeoutSeconds: v1.GuestExecDefaultTimeoutSeconds,
+		}, 0, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, "vmi/"+vmiName, "--", "/usr/bin/uname", "-r")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should execute the command in another namespace with a custom timeout", func() {
+		expectGuestExec("mynamespace", &v1.GuestExecOptions{
+			Command:        "/usr/bin/true",
+			Args:           []string{},
+			TimeoutSeconds: 600,
+		}, 0, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, "--timeout", "10m", "vmi/"+vmiName+"/mynamespace", "--", "/usr/bin/true")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should return the exit code of the command", func() {
+		expectGuestExec(metav1.NamespaceDefault, &v1.GuestExecOptions{
+			Command:        "/usr/bin/false",
+			Args:           []string{},
+			TimeoutSeconds: v1.GuestExecDefaultTimeoutSeconds,
+		}, 3, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, "vmi/"+vmiName, "--", "/usr/bin/false")
+		err := cmd()
+		exitCodeErr := guestexec.ExitCodeError{}
+		Expect(errors.As(err, &exitCodeErr)).To(BeTrue())
+		Expect(exitCodeErr.ExitCode).To(Equal(3))
+	})
+
+	It("should fail when the command could not be executed", func() {
+		expectGuestExec(metav1.NamespaceDefault, gomock.Any(), -1, errors.New("timed out"))
+
+		cmd := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, "vmi/"+vmiName, "--", "/usr/bin/sleep", "100")
+		Expect(cmd()).To(MatchError(ContainSubstring("timed out")))
+	})
+
+	DescribeTable("should fail with invalid arguments", func(expected string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{guestexec.COMMAND_GUEST_EXEC}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("without a command", "requires at least 2 arg(s)", "vmi/"+vmiName),
+		Entry("with an unsupported resource type", "unsupported resource type", "pod/"+vmiName, "--", "/usr/bin/true"),
+		Entry("with a timeout above the limit", "timeout must be between", "--timeout", "2h", "vmi/"+vmiName, "--", "/usr/bin/true"),
+	)
+})
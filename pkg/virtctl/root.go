@@ -2,6 +2,7 @@ package virtctl
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -23,6 +24,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestcp"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
@@ -34,6 +37,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+	"kubevirt.io/kubevirt/pkg/virtctl/top"
 	"kubevirt.io/kubevirt/pkg/virtctl/unpause"
 	"kubevirt.io/kubevirt/pkg/virtctl/usbredir"
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
@@ -107,10 +111,13 @@ func NewVirtctlCommandFn() *cobra.Command {
 		usbredir.NewCommand(),
 		vnc.NewCommand(),
 		scp.NewCommand(),
+		guestcp.NewCommand(),
+		guestexec.NewCommand(),
 		ssh.NewCommand(),
 		portforward.NewCommand(),
 		vm.NewStartCommand(),
 		vm.NewStopCommand(),
+		vm.NewHibernateCommand(),
 		vm.NewRestartCommand(),
 		vm.NewMigrateCommand(),
 		vm.NewMigrateCancelCommand(),
@@ -120,6 +127,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
 		vm.NewExpandCommand(),
+		vm.NewVMCommand(),
 		memorydump.NewMemoryDumpCommand(),
 		pause.NewCommand(),
 		unpause.NewCommand(),
@@ -134,6 +142,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		credentials.NewCommand(),
 		adm.NewCommand(),
 		objectgraph.NewCommand(),
+		top.NewCommand(),
 		optionsCmd,
 	)
 
@@ -155,6 +164,10 @@ func Execute() int {
 	log.InitializeLogging(programName)
 	cmd := NewVirtctlCommand()
 	if err := cmd.Execute(); err != nil {
+		exitCodeErr := guestexec.ExitCodeError{}
+		if errors.As(err, &exitCodeErr) {
+			return exitCodeErr.ExitCode
+		}
 		if versionErr := checkClientServerVersion(cmd.Context()); versionErr != nil {
 			cmd.PrintErrln(versionErr)
 		}
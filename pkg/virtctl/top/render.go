@@ -0,0 +1,351 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package top
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const notAvailable = "-"
+
+// interval is the time between two samples of a VMI, the rates are computed over it
+type interval struct {
+	prev, cur *v1.VirtualMachineInstanceStats
+	seconds   float64
+}
+
+func newInterval(prev, cur *v1.VirtualMachineInstanceStats) *interval {
+	if prev == nil || cur == nil {
+		return nil
+	}
+	seconds := cur.Timestamp.Sub(prev.Timestamp.Time).Seconds()
+	if seconds <= 0 {
+		return nil
+	}
+	return &interval{prev: prev, cur: cur, seconds: seconds}
+}
+
+// delta returns the increase of a counter, a counter which went backwards was reset and did not increase
+func delta(prev, cur uint64) uint64 {
+	if cur < prev {
+		return 0
+	}
+	return cur - prev
+}
+
+// rate returns the increase of a counter per second
+func (i *interval) rate(prev, cur uint64) float64 {
+	return float64(delta(prev, cur)) / i.seconds
+}
+
+// percent returns the share of the interval a time counter in nanoseconds increased by
+func (i *interval) percent(prevNanoseconds, curNanoseconds uint64) float64 {
+	return float64(delta(prevNanoseconds, curNanoseconds)) / (i.seconds * 1e9) * 100
+}
+
+func (i *interval) cpuPercent() string {
+	if i == nil || i.prev.CPU == nil || i.cur.CPU == nil {
+		return notAvailable
+	}
+	return formatPercent(i.percent(i.prev.CPU.TimeNanoseconds, i.cur.CPU.TimeNanoseconds))
+}
+
+// stealPercent returns the share of time the vCPUs waited for a CPU of the node, averaged over the vCPUs
+func (i *interval) stealPercent() string {
+	if i == nil || len(i.cur.VCPUs) == 0 {
+		return notAvailable
+	}
+	var total float64
+	for _, cur := range i.cur.VCPUs {
+		if prev := findVCPU(i.prev, cur.ID); prev != nil {
+			total += i.percent(prev.DelayNanoseconds, cur.DelayNanoseconds)
+		}
+	}
+	return formatPercent(total / float64(len(i.cur.VCPUs)))
+}
+
+func (i *interval) diskRates() (read, write string) {
+	if i == nil {
+		return notAvailable, notAvailable
+	}
+	var readBytes, writeBytes float64
+	for _, cur := range i.cur.Disks {
+		if prev := findDisk(i.prev, cur.Name); prev != nil {
+			readBytes += i.rate(prev.ReadBytes, cur.ReadBytes)
+			writeBytes += i.rate(prev.WriteBytes, cur.WriteBytes)
+		}
+	}
+	return formatByteRate(readBytes), formatByteRate(writeBytes)
+}
+
+func (i *interval) networkRates() (rx, tx string) {
+	if i == nil {
+		return notAvailable, notAvailable
+	}
+	var rxBytes, txBytes float64
+	for _, cur := range i.cur.Interfaces {
+		if prev := findInterface(i.prev, cur.Name); prev != nil {
+			rxBytes += i.rate(prev.RxBytes, cur.RxBytes)
+			txBytes += i.rate(prev.TxBytes, cur.TxBytes)
+		}
+	}
+	return formatByteRate(rxBytes), formatByteRate(txBytes)
+}
+
+func renderVMs(out io.Writer, prev, cur sample, dirtyRate bool) {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	header := []string{"NAME", "CPU", "STEAL", "MEMORY", "RSS", "DISK READ", "DISK WRITE", "NET RX", "NET TX"}
+	if dirtyRate {
+		header = append(header, "DIRTY RATE")
+	}
+	writeRow(w, header...)
+
+	for _, name := range cur.names() {
+		vmiStats := cur[name]
+		i := newInterval(prev[name], vmiStats)
+		diskRead, diskWrite := i.diskRates()
+		netRx, netTx := i.networkRates()
+		row := []string{
+			name,
+			i.cpuPercent(),
+			i.stealPercent(),
+			guestMemory(vmiStats.Memory),
+			rssMemory(vmiStats.Memory),
+			diskRead,
+			diskWrite,
+			netRx,
+			netTx,
+		}
+		if dirtyRate {
+			row = append(row, formatDirtyRate(vmiStats.DirtyRateMegabytesPerSecond))
+		}
+		writeRow(w, row...)
+	}
+	w.Flush()
+}
+
+func renderVMI(out io.Writer, prev, cur *v1.VirtualMachineInstanceStats, dirtyRate bool) {
+	i := newInterval(prev, cur)
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	writeRow(w, "VCPU", "STATE", "USAGE", "WAIT", "STEAL")
+	for _, vcpu := range cur.VCPUs {
+		usage, wait, steal := notAvailable, notAvailable, notAvailable
+		if p := findVCPU(prev, vcpu.ID); i != nil && p != nil {
+			usage = formatPercent(i.percent(p.TimeNanoseconds, vcpu.TimeNanoseconds))
+			wait = formatPercent(i.percent(p.WaitNanoseconds, vcpu.WaitNanoseconds))
+			steal = formatPercent(i.percent(p.DelayNanoseconds, vcpu.DelayNanoseconds))
+		}
+		writeRow(w, fmt.Sprint(vcpu.ID), valueOrNotAvailable(vcpu.State), usage, wait, steal)
+	}
+	writeRow(w)
+
+	header := []string{"MEMORY", "BALLOON", "AVAILABLE", "USABLE", "UNUSED", "RSS", "SWAP IN", "SWAP OUT", "MAJOR FAULTS", "MINOR FAULTS"}
+	row := []string{guestMemory(cur.Memory)}
+	if mem := cur.Memory; mem != nil {
+		majorFaults, minorFaults := notAvailable, notAvailable
+		if i != nil && prev.Memory != nil {
+			majorFaults = formatCountRate(i.rate(prev.Memory.MajorFaults, mem.MajorFaults))
+			minorFaults = formatCountRate(i.rate(prev.Memory.MinorFaults, mem.MinorFaults))
+		}
+		row = append(row,
+			formatOptionalBytes(mem.ActualBalloonBytes),
+			formatOptionalBytes(mem.AvailableBytes),
+			formatOptionalBytes(mem.UsableBytes),
+			formatOptionalBytes(mem.UnusedBytes),
+			formatOptionalBytes(mem.RSSBytes),
+			formatOptionalBytes(mem.SwapInBytes),
+			formatOptionalBytes(mem.SwapOutBytes),
+			majorFaults,
+			minorFaults,
+		)
+	} else {
+		for range header[1:] {
+			row = append(row, notAvailable)
+		}
+	}
+	if dirtyRate {
+		header = append(header, "DIRTY RATE")
+		row = append(row, formatDirtyRate(cur.DirtyRateMegabytesPerSecond))
+	}
+	writeRow(w, header...)
+	writeRow(w, row...)
+	writeRow(w)
+
+	writeRow(w, "DISK", "READ IOPS", "WRITE IOPS", "READ", "WRITE", "READ LATENCY", "WRITE LATENCY", "FLUSH LATENCY")
+	for _, disk := range cur.Disks {
+		row := []string{disk.Name}
+		if p := findDisk(prev, disk.Name); i != nil && p != nil {
+			row = append(row,
+				formatCountRate(i.rate(p.ReadRequests, disk.ReadRequests)),
+				formatCountRate(i.rate(p.WriteRequests, disk.WriteRequests)),
+				formatByteRate(i.rate(p.ReadBytes, disk.ReadBytes)),
+				formatByteRate(i.rate(p.WriteBytes, disk.WriteBytes)),
+				latency(p.ReadRequests, disk.ReadRequests, p.ReadTimeNanoseconds, disk.ReadTimeNanoseconds),
+				latency(p.WriteRequests, disk.WriteRequests, p.WriteTimeNanoseconds, disk.WriteTimeNanoseconds),
+				latency(p.FlushRequests, disk.FlushRequests, p.FlushTimeNanoseconds, disk.FlushTimeNanoseconds),
+			)
+		} else {
+			row = append(row, notAvailable, notAvailable, notAvailable, notAvailable, notAvailable, notAvailable, notAvailable)
+		}
+		writeRow(w, row...)
+	}
+	writeRow(w)
+
+	writeRow(w, "INTERFACE", "RX", "TX", "RX PACKETS", "TX PACKETS", "RX ERRORS", "TX ERRORS", "RX DROPPED", "TX DROPPED")
+	for _, iface := range cur.Interfaces {
+		row := []string{iface.Name}
+		if p := findInterface(prev, iface.Name); i != nil && p != nil {
+			row = append(row,
+				formatByteRate(i.rate(p.RxBytes, iface.RxBytes)),
+				formatByteRate(i.rate(p.TxBytes, iface.TxBytes)),
+				formatCountRate(i.rate(p.RxPackets, iface.RxPackets)),
+				formatCountRate(i.rate(p.TxPackets, iface.TxPackets)),
+			)
+		} else {
+			row = append(row, notAvailable, notAvailable, notAvailable, notAvailable)
+		}
+		row = append(row,
+			fmt.Sprint(iface.RxErrors),
+			fmt.Sprint(iface.TxErrors),
+			fmt.Sprint(iface.RxDropped),
+			fmt.Sprint(iface.TxDropped),
+		)
+		writeRow(w, row...)
+	}
+	w.Flush()
+}
+
+func writeRow(w io.Writer, columns ...string) {
+	fmt.Fprintln(w, strings.Join(columns, "\t"))
+}
+
+// guestMemory returns the memory used by the guest out of the memory available to it, which the
+// guest only reports with the memory balloon driver
+func guestMemory(mem *v1.VirtualMachineInstanceMemoryStats) string {
+	if mem == nil || mem.AvailableBytes == 0 || mem.UsableBytes > mem.AvailableBytes {
+		return notAvailable
+	}
+	return fmt.Sprintf("%s/%s", formatBytes(float64(mem.AvailableBytes-mem.UsableBytes)), formatBytes(float64(mem.AvailableBytes)))
+}
+
+func rssMemory(mem *v1.VirtualMachineInstanceMemoryStats) string {
+	if mem == nil {
+		return notAvailable
+	}
+	return formatOptionalBytes(mem.RSSBytes)
+}
+
+// latency returns the average time the requests completed during the interval took
+func latency(prevRequests, curRequests, prevNanoseconds, curNanoseconds uint64) string {
+	requests := delta(prevRequests, curRequests)
+	if requests == 0 {
+		return notAvailable
+	}
+	return fmt.Sprintf("%.2fms", float64(delta(prevNanoseconds, curNanoseconds))/float64(requests)/1e6)
+}
+
+func findVCPU(vmiStats *v1.VirtualMachineInstanceStats, id int32) *v1.VirtualMachineInstanceVCPUStats {
+	if vmiStats == nil {
+		return nil
+	}
+	for i := range vmiStats.VCPUs {
+		if vmiStats.VCPUs[i].ID == id {
+			return &vmiStats.VCPUs[i]
+		}
+	}
+	return nil
+}
+
+func findDisk(vmiStats *v1.VirtualMachineInstanceStats, name string) *v1.VirtualMachineInstanceDiskStats {
+	if vmiStats == nil {
+		return nil
+	}
+	for i := range vmiStats.Disks {
+		if vmiStats.Disks[i].Name == name {
+			return &vmiStats.Disks[i]
+		}
+	}
+	return nil
+}
+
+func findInterface(vmiStats *v1.VirtualMachineInstanceStats, name string) *v1.VirtualMachineInstanceInterfaceStats {
+	if vmiStats == nil {
+		return nil
+	}
+	for i := range vmiStats.Interfaces {
+		if vmiStats.Interfaces[i].Name == name {
+			return &vmiStats.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+func valueOrNotAvailable(value string) string {
+	if value == "" {
+		return notAvailable
+	}
+	return value
+}
+
+func formatPercent(percent float64) string {
+	return fmt.Sprintf("%.1f%%", percent)
+}
+
+func formatCountRate(rate float64) string {
+	return fmt.Sprintf("%.1f/s", rate)
+}
+
+func formatByteRate(rate float64) string {
+	return formatBytes(rate) + "/s"
+}
+
+// formatOptionalBytes formats the memory stats the guest may not report
+func formatOptionalBytes(bytes uint64) string {
+	if bytes == 0 {
+		return notAvailable
+	}
+	return formatBytes(float64(bytes))
+}
+
+func formatDirtyRate(megabytesPerSecond *int64) string {
+	if megabytesPerSecond == nil {
+		return notAvailable
+	}
+	return fmt.Sprintf("%dMiB/s", *megabytesPerSecond)
+}
+
+func formatBytes(bytes float64) string {
+	const unit = 1024
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	i := 0
+	for ; bytes >= unit && i < len(units)-1; i++ {
+		bytes /= unit
+	}
+	if i == 0 {
+		return fmt.Sprintf("%.0f%s", bytes, units[i])
+	}
+	return fmt.Sprintf("%.1f%s", bytes, units[i])
+}
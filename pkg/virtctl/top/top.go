@@ -0,0 +1,231 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package top
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	intervalFlag  = "interval"
+	onceFlag      = "once"
+	dirtyRateFlag = "dirty-rate"
+
+	defaultInterval = 2 * time.Second
+
+	// ANSI sequence moving the cursor home and clearing the screen
+	clearScreen = "\033[H\033[2J"
+)
+
+type command struct {
+	interval  time.Duration
+	once      bool
+	dirtyRate bool
+}
+
+// sample holds the stats of VMIs collected at the same time, keyed by their name
+type sample map[string]*v1.VirtualMachineInstanceStats
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:     "top",
+		Short:   "Display the CPU, memory, disk and network usage of running VMIs.",
+		Example: usage(),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.PersistentFlags().DurationVar(&c.interval, intervalFlag, defaultInterval, "Time between two refreshes, rates are computed over this interval.")
+	cmd.PersistentFlags().BoolVar(&c.once, onceFlag, false, "Print the usage once and exit instead of refreshing it.")
+	cmd.PersistentFlags().BoolVar(&c.dirtyRate, dirtyRateFlag, false, "Also measure the rate the guests write to their memory at, which takes a second per sample.")
+
+	vmsCmd := &cobra.Command{
+		Use:     "vms",
+		Short:   "Display the usage of all running VMIs of the namespace.",
+		Example: usage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.runVMs,
+	}
+	vmsCmd.SetUsageTemplate(templates.UsageTemplate())
+
+	vmiCmd := &cobra.Command{
+		Use:     "vmi (VMI)",
+		Short:   "Display the usage of the vCPUs, memory, disks and network interfaces of a running VMI.",
+		Example: usage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.runVMI,
+	}
+	vmiCmd.SetUsageTemplate(templates.UsageTemplate())
+
+	cmd.AddCommand(vmsCmd, vmiCmd)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Display the usage of the running VMIs of the current namespace, refreshed every 2 seconds:
+  {{ProgramName}} top vms
+
+  # Display the usage of the running VMIs of the namespace 'mynamespace' once:
+  {{ProgramName}} top vms -n mynamespace --once
+
+  # Display the usage of the vCPUs, memory, disks and network interfaces of the VMI 'myvmi':
+  {{ProgramName}} top vmi myvmi
+
+  # Include the dirty rate of the guest memory, refreshed every 5 seconds:
+  {{ProgramName}} top vmi myvmi --dirty-rate --interval 5s`
+}
+
+func (c *command) runVMs(cmd *cobra.Command, _ []string) error {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	collect := func(ctx context.Context) (sample, error) {
+		vmis, err := virtClient.VirtualMachineInstance(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error listing VirtualMachineInstances: %v", err)
+		}
+		var names []string
+		for _, vmi := range vmis.Items {
+			if vmi.IsRunning() {
+				names = append(names, vmi.Name)
+			}
+		}
+		return c.collect(ctx, virtClient, namespace, names), nil
+	}
+
+	return c.run(cmd, collect, func(out io.Writer, prev, cur sample) {
+		if len(cur) == 0 {
+			fmt.Fprintf(out, "No running VirtualMachineInstances found in namespace %s.\n", namespace)
+			return
+		}
+		renderVMs(out, prev, cur, c.dirtyRate)
+	})
+}
+
+func (c *command) runVMI(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	collect := func(ctx context.Context) (sample, error) {
+		vmiStats, err := virtClient.VirtualMachineInstance(namespace).Stats(ctx, name, c.dirtyRate)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the stats of VirtualMachineInstance %s: %v", name, err)
+		}
+		return sample{name: &vmiStats}, nil
+	}
+
+	return c.run(cmd, collect, func(out io.Writer, prev, cur sample) {
+		renderVMI(out, prev[name], cur[name], c.dirtyRate)
+	})
+}
+
+// run collects a sample every interval and renders the rates between the last two samples, until the
+// context is cancelled or, with --once, after the first rendering
+func (c *command) run(cmd *cobra.Command, collect func(context.Context) (sample, error), render func(io.Writer, sample, sample)) error {
+	if c.interval <= 0 {
+		return fmt.Errorf("--%s must be positive", intervalFlag)
+	}
+
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+
+	prev, err := collect(ctx)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		cur, err := collect(ctx)
+		if err != nil {
+			return err
+		}
+		if !c.once {
+			fmt.Fprint(out, clearScreen)
+		}
+		render(out, prev, cur)
+		if c.once {
+			return nil
+		}
+		prev = cur
+	}
+}
+
+// collect fetches the stats of the VMIs concurrently, the VMIs whose stats could not be fetched are
+// left out of the sample, as they may have stopped since they were listed
+func (c *command) collect(ctx context.Context, virtClient kubecli.KubevirtClient, namespace string, names []string) sample {
+	s := sample{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			vmiStats, err := virtClient.VirtualMachineInstance(namespace).Stats(ctx, name, c.dirtyRate)
+			if err != nil {
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			s[name] = &vmiStats
+		}(name)
+	}
+	wg.Wait()
+	return s
+}
+
+func (s sample) names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
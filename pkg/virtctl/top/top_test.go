@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package top_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Top command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	// newStats returns the stats of a VMI which used half a CPU, with one vCPU which waited 10% of
+	// the time for a CPU of the node, and read and received 1MiB per second since the previous sample
+	newStats := func(second int) v1.VirtualMachineInstanceStats {
+		n := uint64(second)
+		return v1.VirtualMachineInstanceStats{
+			Timestamp: metav1.NewMicroTime(time.Date(2026, 1, 1, 0, 0, second, 0, time.UTC)),
+			CPU:       &v1.VirtualMachineInstanceCPUStats{TimeNanoseconds: n * 5e8},
+			VCPUs: []v1.VirtualMachineInstanceVCPUStats{
+				{ID: 0, State: "Running", TimeNanoseconds: n * 5e8, DelayNanoseconds: n * 1e8},
+			},
+			Memory: &v1.VirtualMachineInstanceMemoryStats{
+				AvailableBytes: 4 << 30,
+				UsableBytes:    3 << 30,
+				RSSBytes:       2 << 30,
+			},
+			Disks: []v1.VirtualMachineInstanceDiskStats{
+				{Name: "rootdisk", ReadRequests: n * 100, ReadBytes: n << 20, ReadTimeNanoseconds: n * 2e8},
+			},
+			Interfaces: []v1.VirtualMachineInstanceInterfaceStats{
+				{Name: "default", RxBytes: n << 20, RxPackets: n * 10},
+			},
+			DirtyRateMegabytesPerSecond: pointer.P(int64(12)),
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	Context("vms", func() {
+		BeforeEach(func() {
+			vmiList := &v1.VirtualMachineInstanceList{
+				Items: []v1.VirtualMachineInstance{
+					*libvmi.New(libvmi.WithName(vmiName), libvmi.WithNamespace(metav1.NamespaceDefault)),
+					*libvmi.New(libvmi.WithName("stopped"), libvmi.WithNamespace(metav1.NamespaceDefault)),
+				},
+			}
+			vmiList.Items[0].Status.Phase = v1.Running
+			vmiList.Items[1].Status.Phase = v1.Succeeded
+			vmiInterface.EXPECT().List(gomock.Any(), gomock.Any()).Return(vmiList, nil).Times(2)
+		})
+
+		It("should display the usage of the running VMIs", func() {
+			gomock.InOrder(
+				vmiInterface.EXPECT().Stats(gomock.Any(), vmiName, false).Return(newStats(1), nil),
+				vmiInterface.EXPECT().Stats(gomock.Any(), vmiName, false).Return(newStats(2), nil),
+			)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("top", "vms", "--once", "--interval", "1ms")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(MatchRegexp(`NAME\s+CPU\s+STEAL\s+MEMORY\s+RSS\s+DISK READ\s+DISK WRITE\s+NET RX\s+NET TX\n`))
+			Expect(string(out)).To(MatchRegexp(`testvmi\s+50.0%\s+10.0%\s+1.0GiB/4.0GiB\s+2.0GiB\s+1.0MiB/s\s+0B/s\s+1.0MiB/s\s+0B/s\n`))
+			Expect(string(out)).ToNot(ContainSubstring("stopped"))
+			Expect(string(out)).ToNot(ContainSubstring("\033[2J"))
+		})
+
+		It("should display the dirty rate when requested", func() {
+			vmiInterface.EXPECT().Stats(gomock.Any(), vmiName, true).Return(newStats(1), nil).Times(2)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("top", "vms", "--once", "--interval", "1ms", "--dirty-rate")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(MatchRegexp(`DIRTY RATE\n`))
+			Expect(string(out)).To(MatchRegexp(`testvmi\s+-\s+-\s+.*12MiB/s\n`))
+		})
+
+		It("should leave out the VMIs whose stats cannot be fetched", func() {
+			vmiInterface.EXPECT().Stats(gomock.Any(), vmiName, false).Return(v1.VirtualMachineInstanceStats{}, fmt.Errorf("test-error")).Times(2)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("top", "vms", "--once", "--interval", "1ms")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("No running VirtualMachineInstances found in namespace default."))
+		})
+	})
+
+	Context("vmi", func() {
+		It("should display the usage of the vCPUs, memory, disks and interfaces of the VMI", func() {
+			gomock.InOrder(
+				vmiInterface.EXPECT().Stats(gomock.Any(), vmiName, false).Return(newStats(1), nil),
+				vmiInterface.EXPECT().Stats(gomock.Any(), vmiName, false).Return(newStats(3), nil),
+			)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("top", "vmi", vmiName, "--once", "--interval", "1ms")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(MatchRegexp(`0\s+Running\s+50.0%\s+0.0%\s+10.0%\n`))
+			Expect(string(out)).To(MatchRegexp(`1.0GiB/4.0GiB\s+-\s+4.0GiB\s+3.0GiB\s+-\s+2.0GiB\s+`))
+			Expect(string(out)).To(MatchRegexp(`rootdisk\s+100.0/s\s+0.0/s\s+1.0MiB/s\s+0B/s\s+2.00ms\s+-\s+-\n`))
+			Expect(string(out)).To(MatchRegexp(`default\s+1.0MiB/s\s+0B/s\s+10.0/s\s+0.0/s\s+0\s+0\s+0\s+0\n`))
+		})
+
+		It("should fail when the stats cannot be fetched", func() {
+			vmiInterface.EXPECT().Stats(gomock.Any(), vmiName, false).Return(v1.VirtualMachineInstanceStats{}, fmt.Errorf("test-error"))
+
+			err := testing.NewRepeatableVirtctlCommand("top", "vmi", vmiName, "--once")()
+			Expect(err).To(MatchError("error getting the stats of VirtualMachineInstance testvmi: test-error"))
+		})
+
+		It("should fail without a VMI", func() {
+			err := testing.NewRepeatableVirtctlCommand("top", "vmi")()
+			Expect(err).To(MatchError("accepts 1 arg(s), received 0"))
+		})
+
+		It("should fail with an interval which is not positive", func() {
+			err := testing.NewRepeatableVirtctlCommand("top", "vmi", vmiName, "--interval", "0s")()
+			Expect(err).To(MatchError("--interval must be positive"))
+		})
+	})
+})
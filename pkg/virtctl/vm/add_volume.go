@@ -41,15 +41,19 @@ const (
 	cacheArg        = "cache"
 	diskTypeArg     = "disk-type"
 	busTypeArg      = "bus"
+	unitArg         = "unit"
+	pciAddressArg   = "pci-address"
 	concurrentError = "the server rejected our request due to an error in our request"
 	maxRetries      = 15
 )
 
 var (
-	serial   string
-	cache    string
-	diskType string
-	busType  string
+	serial     string
+	cache      string
+	diskType   string
+	busType    string
+	unit       int
+	pciAddress string
 )
 
 func NewAddVolumeCommand() *cobra.Command {
@@ -69,6 +73,8 @@ func NewAddVolumeCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
 	cmd.Flags().StringVar(&diskType, diskTypeArg, "disk", "specifies disk type to be hotplugged (disk/lun). Disk by default.")
 	cmd.Flags().StringVar(&busType, busTypeArg, string(v1.DiskBusSCSI), fmt.Sprintf("specifies disk bus. %s by default.", v1.DiskBusSCSI))
+	cmd.Flags().IntVar(&unit, unitArg, -1, "places the disk on the given unit of the SCSI controller to keep the order of the disks stable. Only valid with the scsi bus.")
+	cmd.Flags().StringVar(&pciAddress, pciAddressArg, "", "places the disk on the given guest PCI address, e.g. 0000:0a:00.0. Only valid for disks with the virtio bus.")
 
 	return cmd
 }
@@ -85,6 +91,12 @@ func usageAddVolume() string {
 
   #Dynamically attach a volume with 'none' cache attribute to a running VM.
   {{ProgramName}} addvolume fedora-dv --volume-name=example-dv --cache=none
+
+  #Dynamically attach a volume as the third LUN of the SCSI controller.
+  {{ProgramName}} addvolume fedora-dv --volume-name=example-dv --unit=2
+
+  #Dynamically attach a virtio volume to a fixed PCI slot of the guest.
+  {{ProgramName}} addvolume fedora-dv --volume-name=example-dv --bus=virtio --pci-address=0000:0a:00.0
   `
 }
 
@@ -160,6 +172,24 @@ func addVolume(vmiName, volumeName, namespace string, virtClient kubecli.Kubevir
 		return fmt.Errorf("Invalid disk type '%s'. Only LUN and Disk are supported.", diskType)
 	}
 
+	if unit >= 0 {
+		if bus != v1.DiskBusSCSI {
+			return fmt.Errorf("Invalid bus type '%s' for --%s. Only '%s' bus is supported.", busType, unitArg, v1.DiskBusSCSI)
+		}
+		diskUnit := uint(unit)
+		if hotplugRequest.Disk.DiskDevice.Disk != nil {
+			hotplugRequest.Disk.DiskDevice.Disk.Unit = &diskUnit
+		} else {
+			hotplugRequest.Disk.DiskDevice.LUN.Unit = &diskUnit
+		}
+	}
+	if pciAddress != "" {
+		if hotplugRequest.Disk.DiskDevice.Disk == nil || bus != v1.DiskBusVirtio {
+			return fmt.Errorf("--%s is only supported for disks with the '%s' bus", pciAddressArg, v1.DiskBusVirtio)
+		}
+		hotplugRequest.Disk.DiskDevice.Disk.PciAddress = pciAddress
+	}
+
 	if serial != "" {
 		hotplugRequest.Disk.Serial = serial
 	} else {
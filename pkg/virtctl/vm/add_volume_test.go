@@ -205,6 +205,9 @@ var _ = Describe("Add volume command", func() {
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
 				Entry("virtio bus", "--bus=virtio", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio)),
+				Entry("scsi unit", "--unit=2", verifyDiskSerial(volumeName), verifyDiskUnit(2)),
+				Entry("scsi unit on a lun", "--disk-type=lun --unit=0", verifyDiskSerial(volumeName), verifyLunUnit(0)),
+				Entry("pci address", "--bus=virtio --pci-address=0000:0a:00.0", verifyDiskSerial(volumeName), verifyPciAddress("0000:0a:00.0")),
 			)
 
 			DescribeTable("should call VM endpoint with persist and", func(arg string, verifyFns ...verifyFn) {
@@ -252,6 +255,14 @@ var _ = Describe("Add volume command", func() {
 				Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "addvolume")).To(HaveLen(15))
 			})
 
+			DescribeTable("should fail addvolume with", func(arg, expected string) {
+				Expect(runCmd(false, arg)).To(MatchError(ContainSubstring(expected)))
+			},
+				Entry("a unit on the virtio bus", "--bus=virtio --unit=1", "Invalid bus type 'virtio' for --unit. Only 'scsi' bus is supported."),
+				Entry("a pci address on the scsi bus", "--pci-address=0000:0a:00.0", "--pci-address is only supported for disks with the 'virtio' bus"),
+				Entry("a pci address on a lun", "--disk-type=lun --pci-address=0000:0a:00.0", "--pci-address is only supported for disks with the 'virtio' bus"),
+			)
+
 			DescribeTable("should fail addvolume with LUN and virtio bus", func(persist bool) {
 				Expect(runCmd(persist, "--disk-type=lun --bus=virtio")).To(
 					MatchError(ContainSubstring("Invalid bus type 'virtio' for LUN disk. Only 'scsi' bus is supported.")))
@@ -356,3 +367,21 @@ func verifyBus(bus v1.DiskBus) verifyFn {
 		Expect(volumeOptions.Disk.Disk.Bus).To(Equal(bus))
 	}
 }
+
+func verifyDiskUnit(unit uint) verifyFn {
+	return func(volumeOptions *v1.AddVolumeOptions) {
+		Expect(volumeOptions.Disk.Disk.Unit).To(HaveValue(Equal(unit)))
+	}
+}
+
+func verifyLunUnit(unit uint) verifyFn {
+	return func(volumeOptions *v1.AddVolumeOptions) {
+		Expect(volumeOptions.Disk.LUN.Unit).To(HaveValue(Equal(unit)))
+	}
+}
+
+func verifyPciAddress(address string) verifyFn {
+	return func(volumeOptions *v1.AddVolumeOptions) {
+		Expect(volumeOptions.Disk.Disk.PciAddress).To(Equal(address))
+	}
+}
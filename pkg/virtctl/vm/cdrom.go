@@ -0,0 +1,242 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_VM     = "vm"
+	COMMAND_CDROM  = "cdrom"
+	COMMAND_EJECT  = "eject"
+	COMMAND_INSERT = "insert"
+
+	diskArg = "disk"
+)
+
+type cdromCommand struct {
+	diskName   string
+	volumeName string
+	dryRun     bool
+}
+
+// NewVMCommand groups the subcommands which change devices of a VirtualMachine.
+func NewVMCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_VM,
+		Short: "Manage devices of a VirtualMachine.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.Printf(cmd.UsageString())
+			return nil
+		},
+	}
+	cmd.AddCommand(newCDRomCommand())
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newCDRomCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_CDROM,
+		Short: "Eject or insert the medium of a CD-ROM of a VirtualMachine.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.Printf(cmd.UsageString())
+			return nil
+		},
+	}
+	cmd.AddCommand(newEjectCommand(), newInsertCommand())
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newEjectCommand() *cobra.Command {
+	c := cdromCommand{}
+	cmd := &cobra.Command{
+		Use:     "eject VM",
+		Short:   "Remove the medium from a CD-ROM of a VirtualMachine.",
+		Example: usageEject(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.ejectRun,
+	}
+	cmd.Flags().StringVar(&c.diskName, diskArg, "", "name of the CD-ROM disk in the VM spec")
+	cmd.MarkFlagRequired(diskArg)
+	cmd.Flags().BoolVar(&c.dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newInsertCommand() *cobra.Command {
+	c := cdromCommand{}
+	cmd := &cobra.Command{
+		Use:     "insert VM",
+		Short:   "Insert a DataVolume or PersistentVolumeClaim as medium into an empty CD-ROM of a VirtualMachine.",
+		Example: usageInsert(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.insertRun,
+	}
+	cmd.Flags().StringVar(&c.diskName, diskArg, "", "name of the CD-ROM disk in the VM spec")
+	cmd.MarkFlagRequired(diskArg)
+	cmd.Flags().StringVar(&c.volumeName, volumeNameArg, "", "name of the DataVolume or PersistentVolumeClaim to insert")
+	cmd.MarkFlagRequired(volumeNameArg)
+	cmd.Flags().BoolVar(&c.dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageEject() string {
+	return `  # Eject the medium of the CD-ROM 'cdrom' of the VM 'myvm':
+  {{ProgramName}} vm cdrom eject myvm --disk=cdrom
+  `
+}
+
+func usageInsert() string {
+	return `  # Insert the PVC 'install-iso' into the empty CD-ROM 'cdrom' of the VM 'myvm':
+  {{ProgramName}} vm cdrom insert myvm --disk=cdrom --volume-name=install-iso
+  `
+}
+
+func (c *cdromCommand) ejectRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VirtualMachine %s: %v", vmName, err)
+	}
+	if err := checkCDRom(vm, c.diskName); err != nil {
+		return err
+	}
+
+	idx := findVolume(vm, c.diskName)
+	if idx < 0 {
+		return fmt.Errorf("CD-ROM %s of VirtualMachine %s has no medium to eject", c.diskName, vmName)
+	}
+	volumePath := fmt.Sprintf("/spec/template/spec/volumes/%d", idx)
+	payload, err := patch.New(
+		patch.WithTest(volumePath, vm.Spec.Template.Spec.Volumes[idx]),
+		patch.WithRemove(volumePath),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := virtClient.VirtualMachine(namespace).Patch(context.Background(), vmName, types.JSONPatchType, payload, metav1.PatchOptions{
+		DryRun: setDryRunOption(c.dryRun),
+	}); err != nil {
+		return fmt.Errorf("error ejecting CD-ROM %s: %v", c.diskName, err)
+	}
+
+	cmd.Printf("Successfully submitted eject request for CD-ROM %s of VM %s\n", c.diskName, vmName)
+	return nil
+}
+
+func (c *cdromCommand) insertRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VirtualMachine %s: %v", vmName, err)
+	}
+	if err := checkCDRom(vm, c.diskName); err != nil {
+		return err
+	}
+	if findVolume(vm, c.diskName) >= 0 {
+		return fmt.Errorf("CD-ROM %s of VirtualMachine %s already has a medium, eject it first", c.diskName, vmName)
+	}
+
+	volumeSource, err := getVolumeSourceFromVolume(c.volumeName, namespace, virtClient)
+	if err != nil {
+		return fmt.Errorf("error inserting CD-ROM %s: %v", c.diskName, err)
+	}
+	volume := v1.Volume{Name: c.diskName}
+	if volumeSource.DataVolume != nil {
+		volume.DataVolume = volumeSource.DataVolume
+	} else {
+		volume.PersistentVolumeClaim = volumeSource.PersistentVolumeClaim
+	}
+
+	opts := []patch.PatchOption{}
+	if len(vm.Spec.Template.Spec.Volumes) == 0 {
+		opts = append(opts, patch.WithAdd("/spec/template/spec/volumes", []v1.Volume{volume}))
+	} else {
+		opts = append(opts, patch.WithAdd("/spec/template/spec/volumes/-", volume))
+	}
+	payload, err := patch.New(opts...).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := virtClient.VirtualMachine(namespace).Patch(context.Background(), vmName, types.JSONPatchType, payload, metav1.PatchOptions{
+		DryRun: setDryRunOption(c.dryRun),
+	}); err != nil {
+		return fmt.Errorf("error inserting CD-ROM %s: %v", c.diskName, err)
+	}
+
+	cmd.Printf("Successfully submitted insert request for CD-ROM %s of VM %s with volume %s\n", c.diskName, vmName, c.volumeName)
+	return nil
+}
+
+func checkCDRom(vm *v1.VirtualMachine, diskName string) error {
+	if vm.Spec.Template == nil {
+		return fmt.Errorf("VirtualMachine %s has no template", vm.Name)
+	}
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		if disk.Name != diskName {
+			continue
+		}
+		if disk.CDRom == nil {
+			return fmt.Errorf("disk %s of VirtualMachine %s is not a CD-ROM", diskName, vm.Name)
+		}
+		return nil
+	}
+	return fmt.Errorf("VirtualMachine %s has no disk %s", vm.Name, diskName)
+}
+
+func findVolume(vm *v1.VirtualMachine, name string) int {
+	for i, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.Name == name {
+			return i
+		}
+	}
+	return -1
+}
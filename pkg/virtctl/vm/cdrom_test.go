@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+	virtctl "kubevirt.io/kubevirt/pkg/virtctl/vm"
+)
+
+var _ = Describe("CD-ROM command", func() {
+	const (
+		vmName   = "testvm"
+		diskName = "cdrom"
+		pvcName  = "install-iso"
+	)
+
+	var (
+		virtClient *kubevirtfake.Clientset
+		kubeClient *k8sfake.Clientset
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		kubeClient = k8sfake.NewSimpleClientset()
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdifake.NewSimpleClientset()).AnyTimes()
+	})
+
+	createVM := func(opts ...libvmi.Option) {
+		vm := libvmi.NewVirtualMachine(libvmi.New(append([]libvmi.Option{libvmi.WithNamespace(metav1.NamespaceDefault)}, opts...)...))
+		vm.Name = vmName
+		_, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getVolumes := func() []v1.Volume {
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm.Spec.Template.Spec.Volumes
+	}
+
+	emptyCDRom := func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+			Name:       diskName,
+			DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}},
+		})
+	}
+
+	It("should insert a PVC into an empty CD-ROM", func() {
+		createVM(libvmi.WithContainerDisk("disk0", "image"), emptyCDRom)
+		_, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Create(context.Background(),
+			&k8sv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: pvcName}}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd := testing.NewRepeatableVirtctlCommand(virtctl.COMMAND_VM, virtctl.COMMAND_CDROM, virtctl.COMMAND_INSERT, vmName,
+			"--disk", diskName, "--volume-name", pvcName)
+		Expect(cmd()).To(Succeed())
+
+		volumes := getVolumes()
+		Expect(volumes).To(HaveLen(2))
+		Expect(volumes[1].Name).To(Equal(diskName))
+		Expect(volumes[1].PersistentVolumeClaim).ToNot(BeNil())
+		Expect(volumes[1].PersistentVolumeClaim.ClaimName).To(Equal(pvcName))
+		Expect(volumes[1].PersistentVolumeClaim.Hotpluggable).To(BeTrue())
+	})
+
+	It("should eject the medium of a CD-ROM", func() {
+		createVM(libvmi.WithContainerDisk("disk0", "image"), libvmi.WithCDRom(diskName, v1.DiskBusSATA, pvcName))
+
+		cmd := testing.NewRepeatableVirtctlCommand(virtctl.COMMAND_VM, virtctl.COMMAND_CDROM, virtctl.COMMAND_EJECT, vmName,
+			"--disk", diskName)
+		Expect(cmd()).To(Succeed())
+
+		volumes := getVolumes()
+		Expect(volumes).To(HaveLen(1))
+		Expect(volumes[0].Name).To(Equal("disk0"))
+	})
+
+	DescribeTable("should fail", func(opts []libvmi.Option, expected string, args ...string) {
+		createVM(opts...)
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{virtctl.COMMAND_VM, virtctl.COMMAND_CDROM}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("to eject an empty CD-ROM", []libvmi.Option{emptyCDRom}, "has no medium to eject",
+			virtctl.COMMAND_EJECT, vmName, "--disk", diskName),
+		Entry("to eject a disk which is not a CD-ROM", []libvmi.Option{libvmi.WithContainerDisk(diskName, "image")}, "is not a CD-ROM",
+			virtctl.COMMAND_EJECT, vmName, "--disk", diskName),
+		Entry("to eject an unknown disk", []libvmi.Option{}, "has no disk cdrom",
+			virtctl.COMMAND_EJECT, vmName, "--disk", diskName),
+		Entry("to insert into a CD-ROM with a medium", []libvmi.Option{libvmi.WithCDRom(diskName, v1.DiskBusSATA, pvcName)}, "already has a medium",
+			virtctl.COMMAND_INSERT, vmName, "--disk", diskName, "--volume-name", pvcName),
+		Entry("to insert a volume which does not exist", []libvmi.Option{emptyCDRom}, "is not a DataVolume or PersistentVolumeClaim",
+			virtctl.COMMAND_INSERT, vmName, "--disk", diskName, "--volume-name", pvcName),
+	)
+})
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_HIBERNATE = "hibernate"
+
+func NewHibernateCommand() *cobra.Command {
+	c := Command{command: COMMAND_HIBERNATE}
+	cmd := &cobra.Command{
+		Use:     "hibernate (VM)",
+		Short:   "Save the state of a virtual machine to its hibernation PVC and stop it. The next start resumes it from the saved state.",
+		Example: usage(COMMAND_HIBERNATE),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.hibernateRun,
+	}
+
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (o *Command) hibernateRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	dryRunOption := setDryRunOption(dryRun)
+	err = virtClient.VirtualMachine(namespace).Hibernate(context.Background(), vmName, &v1.HibernateOptions{DryRun: dryRunOption})
+	if err != nil {
+		return fmt.Errorf("error hibernating VirtualMachine %v", err)
+	}
+
+	fmt.Printf("VM %s was scheduled to %s\n", vmName, o.command)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+* This file is part of the KubeVirt project
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+*     http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+*
+* Copyright The KubeVirt Authors.
+*
+ */
+
+package vm_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Hibernate command", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var ctrl *gomock.Controller
+	const vmName = "testvm"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("hibernate")
+		err := cmd()
+		Expect(err).To(HaveOccurred())
+		Expect(err).Should(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	DescribeTable("should hibernate VM", func(hibernateOptions *v1.HibernateOptions, args ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Hibernate(context.Background(), vmName, hibernateOptions).Return(nil).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"hibernate", vmName}, args...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("", &v1.HibernateOptions{DryRun: nil}),
+		Entry("with dry-run parameter", &v1.HibernateOptions{DryRun: []string{k8smetav1.DryRunAll}}, "--dry-run"),
+	)
+
+	It("should return the error of the request", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Hibernate(context.Background(), vmName, gomock.Any()).Return(fmt.Errorf("no hibernation PVC")).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("hibernate", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("no hibernation PVC")))
+	})
+})
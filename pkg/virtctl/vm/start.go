@@ -32,12 +32,14 @@ import (
 )
 
 const (
-	COMMAND_START = "start"
-	pausedArg     = "paused"
+	COMMAND_START              = "start"
+	pausedArg                  = "paused"
+	discardHibernationStateArg = "discard-hibernation-state"
 )
 
 var (
-	startPaused bool
+	startPaused             bool
+	discardHibernationState bool
 )
 
 func NewStartCommand() *cobra.Command {
@@ -50,6 +52,7 @@ func NewStartCommand() *cobra.Command {
 		RunE:    c.startRun,
 	}
 	cmd.Flags().BoolVar(&startPaused, pausedArg, false, "--paused=false: If set to true, start virtual machine in paused state")
+	cmd.Flags().BoolVar(&discardHibernationState, discardHibernationStateArg, false, "--discard-hibernation-state=false: If set to true, boot the virtual machine and delete the state saved by its last hibernation")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
@@ -65,7 +68,7 @@ func (o *Command) startRun(cmd *cobra.Command, args []string) error {
 
 	dryRunOption := setDryRunOption(dryRun)
 
-	err = virtClient.VirtualMachine(namespace).Start(context.Background(), vmiName, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
+	err = virtClient.VirtualMachine(namespace).Start(context.Background(), vmiName, &v1.StartOptions{Paused: startPaused, DiscardHibernationState: discardHibernationState, DryRun: dryRunOption})
 	if err != nil {
 		return fmt.Errorf("Error starting VirtualMachine %v", err)
 	}
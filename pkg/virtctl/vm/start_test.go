@@ -113,4 +113,14 @@ var _ = Describe("Start command", func() {
 		})
 	})
 
+	It("should discard the hibernation state with --discard-hibernation-state", func() {
+		vm := kubecli.NewMinimalVM(vmName)
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Start(context.Background(), vm.Name, &v1.StartOptions{DiscardHibernationState: true}).Return(nil).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("start", vmName, "--discard-hibernation-state")
+		Expect(cmd()).To(Succeed())
+	})
+
 })
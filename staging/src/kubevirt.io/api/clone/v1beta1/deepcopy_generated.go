@@ -46,6 +46,27 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveFork) DeepCopyInto(out *LiveFork) {
+	*out = *in
+	if in.IdentityResetCommand != nil {
+		in, out := &in.IdentityResetCommand, &out.IdentityResetCommand
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveFork.
+func (in *LiveFork) DeepCopy() *LiveFork {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveFork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClone) DeepCopyInto(out *VirtualMachineClone) {
 	*out = *in
@@ -148,6 +169,16 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PersistentState != nil {
+		in, out := &in.PersistentState, &out.PersistentState
+		*out = new(PersistentStatePolicy)
+		**out = **in
+	}
+	if in.LiveFork != nil {
+		in, out := &in.LiveFork, &out.LiveFork
+		*out = new(LiveFork)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
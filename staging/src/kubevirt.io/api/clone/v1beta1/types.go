@@ -93,8 +93,40 @@ type VirtualMachineCloneSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+	// PersistentState defines how the persistent state of the source, its vTPM and EFI NVRAM, is handled.
+	// Regenerate lets the target start with a new state, so that it does not share keys sealed by the vTPM
+	// of the source. Include copies the state of the source.
+	// Defaults to Regenerate
+	// +optional
+	PersistentState *PersistentStatePolicy `json:"persistentState,omitempty"`
+	// LiveFork clones the source including its memory, so that the target resumes where the source was
+	// hibernated. A running source is hibernated first and started again once its state is copied. The
+	// target keeps the MAC addresses of the source until the guest has resumed.
+	// Requires a VirtualMachine source with a hibernation PVC.
+	// +optional
+	LiveFork *LiveFork `json:"liveFork,omitempty"`
 }
 
+// LiveFork defines how the target of a live fork takes on its own identity
+type LiveFork struct {
+	// IdentityResetCommand is run in the guest via the guest agent once the target resumed and its
+	// interfaces got their own MAC addresses. Example: ["/usr/local/bin/reset-identity", "--hostname"]
+	// +optional
+	// +listType=atomic
+	IdentityResetCommand []string `json:"identityResetCommand,omitempty"`
+}
+
+// PersistentStatePolicy defines how to handle the persistent state of the source,
+// the vTPM and EFI NVRAM kept on its backend storage
+type PersistentStatePolicy string
+
+const (
+	// PersistentStateRegenerate lets the target start with a new persistent state. This is the default policy.
+	PersistentStateRegenerate PersistentStatePolicy = "Regenerate"
+	// PersistentStateInclude copies the persistent state of the source to the target
+	PersistentStateInclude PersistentStatePolicy = "Include"
+)
+
 type VirtualMachineClonePhase string
 
 const (
@@ -25,6 +25,15 @@ func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 		"newMacAddresses":   "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the\nvalue is the new mac address. If this field is not specified, a new MAC address will\nbe generated automatically, as for any interface that is not included in this map.\n+optional",
 		"newSMBiosSerial":   "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will\nbe generated automatically.\n+optional",
 		"patches":           "Patches holds JSON patches to apply to target. Patches should fit the target's Kind.\nExample: '{\"op\": \"add\", \"path\": \"/spec/template/metadata/labels/example\", \"value\": \"new-label\"}'\n+optional\n+listType=atomic",
+		"persistentState":   "PersistentState defines how the persistent state of the source, its vTPM and EFI NVRAM, is handled.\nRegenerate lets the target start with a new state, so that it does not share keys sealed by the vTPM\nof the source. Include copies the state of the source.\nDefaults to Regenerate\n+optional",
+		"liveFork":          "LiveFork clones the source including its memory, so that the target resumes where the source was\nhibernated. A running source is hibernated first and started again once its state is copied. The\ntarget keeps the MAC addresses of the source until the guest has resumed.\nRequires a VirtualMachine source with a hibernation PVC.\n+optional",
+	}
+}
+
+func (LiveFork) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "LiveFork defines how the target of a live fork takes on its own identity",
+		"identityResetCommand": "IdentityResetCommand is run in the guest via the guest agent once the target resumed and its\ninterfaces got their own MAC addresses. Example: [\"/usr/local/bin/reset-identity\", \"--hostname\"]\n+optional\n+listType=atomic",
 	}
 }
 
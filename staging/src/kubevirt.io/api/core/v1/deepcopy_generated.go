@@ -678,6 +678,48 @@ func (in *ConfigMapVolumeSource) DeepCopy() *ConfigMapVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleToken) DeepCopyInto(out *ConsoleToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleToken.
+func (in *ConsoleToken) DeepCopy() *ConsoleToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConsoleToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleTokenOptions) DeepCopyInto(out *ConsoleTokenOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleTokenOptions.
+func (in *ConsoleTokenOptions) DeepCopy() *ConsoleTokenOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleTokenOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskInfo) DeepCopyInto(out *ContainerDiskInfo) {
 	*out = *in
@@ -694,9 +736,30 @@ func (in *ContainerDiskInfo) DeepCopy() *ContainerDiskInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDiskOverlay) DeepCopyInto(out *ContainerDiskOverlay) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDiskOverlay.
+func (in *ContainerDiskOverlay) DeepCopy() *ContainerDiskOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDiskOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskSource) DeepCopyInto(out *ContainerDiskSource) {
 	*out = *in
+	if in.Overlay != nil {
+		in, out := &in.Overlay, &out.Overlay
+		*out = new(ContainerDiskOverlay)
+		**out = **in
+	}
 	return
 }
 
@@ -1154,6 +1217,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SerialConsoleRecording != nil {
+		in, out := &in.SerialConsoleRecording, &out.SerialConsoleRecording
+		*out = new(SerialConsoleRecording)
+		**out = **in
+	}
 	if in.AutoattachMemBalloon != nil {
 		in, out := &in.AutoattachMemBalloon, &out.AutoattachMemBalloon
 		*out = new(bool)
@@ -1194,7 +1262,7 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.DownwardMetrics != nil {
 		in, out := &in.DownwardMetrics, &out.DownwardMetrics
 		*out = new(DownwardMetrics)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.PanicDevices != nil {
 		in, out := &in.PanicDevices, &out.PanicDevices
@@ -1327,6 +1395,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.GrowFilesystem != nil {
+		in, out := &in.GrowFilesystem, &out.GrowFilesystem
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1346,12 +1419,12 @@ func (in *DiskDevice) DeepCopyInto(out *DiskDevice) {
 	if in.Disk != nil {
 		in, out := &in.Disk, &out.Disk
 		*out = new(DiskTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LUN != nil {
 		in, out := &in.LUN, &out.LUN
 		*out = new(LunTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CDRom != nil {
 		in, out := &in.CDRom, &out.CDRom
@@ -1395,6 +1468,11 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		*out = new(uint)
+		**out = **in
+	}
 	return
 }
 
@@ -1408,6 +1486,64 @@ func (in *DiskTarget) DeepCopy() *DiskTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskTaskCustomize) DeepCopyInto(out *DiskTaskCustomize) {
+	*out = *in
+	if in.Commands != nil {
+		in, out := &in.Commands, &out.Commands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskTaskCustomize.
+func (in *DiskTaskCustomize) DeepCopy() *DiskTaskCustomize {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskTaskCustomize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskTaskResizeFilesystem) DeepCopyInto(out *DiskTaskResizeFilesystem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskTaskResizeFilesystem.
+func (in *DiskTaskResizeFilesystem) DeepCopy() *DiskTaskResizeFilesystem {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskTaskResizeFilesystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskTaskSysprep) DeepCopyInto(out *DiskTaskSysprep) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskTaskSysprep.
+func (in *DiskTaskSysprep) DeepCopy() *DiskTaskSysprep {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskTaskSysprep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskVerification) DeepCopyInto(out *DiskVerification) {
 	*out = *in
@@ -1547,6 +1683,11 @@ func (in *DownwardAPIVolumeSource) DeepCopy() *DownwardAPIVolumeSource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownwardMetrics) DeepCopyInto(out *DownwardMetrics) {
 	*out = *in
+	if in.MetricGroups != nil {
+		in, out := &in.MetricGroups, &out.MetricGroups
+		*out = make([]DownwardMetricsGroup, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1563,6 +1704,11 @@ func (in *DownwardMetrics) DeepCopy() *DownwardMetrics {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownwardMetricsVolumeSource) DeepCopyInto(out *DownwardMetricsVolumeSource) {
 	*out = *in
+	if in.MetricGroups != nil {
+		in, out := &in.MetricGroups, &out.MetricGroups
+		*out = make([]DownwardMetricsGroup, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1589,6 +1735,11 @@ func (in *EFI) DeepCopyInto(out *EFI) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SecureBootKeys != nil {
+		in, out := &in.SecureBootKeys, &out.SecureBootKeys
+		*out = new(SecureBootKeysSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2078,6 +2229,130 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentPollingConfiguration) DeepCopyInto(out *GuestAgentPollingConfiguration) {
+	*out = *in
+	if in.SysInterval != nil {
+		in, out := &in.SysInterval, &out.SysInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.InterfacesInterval != nil {
+		in, out := &in.InterfacesInterval, &out.InterfacesInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FilesystemInterval != nil {
+		in, out := &in.FilesystemInterval, &out.FilesystemInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UserInterval != nil {
+		in, out := &in.UserInterval, &out.UserInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.VersionInterval != nil {
+		in, out := &in.VersionInterval, &out.VersionInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FSFreezeStatusInterval != nil {
+		in, out := &in.FSFreezeStatusInterval, &out.FSFreezeStatusInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DisksInterval != nil {
+		in, out := &in.DisksInterval, &out.DisksInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CPUStatsInterval != nil {
+		in, out := &in.CPUStatsInterval, &out.CPUStatsInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DiskStatsInterval != nil {
+		in, out := &in.DiskStatsInterval, &out.DiskStatsInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentPollingConfiguration.
+func (in *GuestAgentPollingConfiguration) DeepCopy() *GuestAgentPollingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentPollingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecOptions) DeepCopyInto(out *GuestExecOptions) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecOptions.
+func (in *GuestExecOptions) DeepCopy() *GuestExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecOutput) DeepCopyInto(out *GuestExecOutput) {
+	*out = *in
+	if in.Stdout != nil {
+		in, out := &in.Stdout, &out.Stdout
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stderr != nil {
+		in, out := &in.Stderr, &out.Stderr
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecOutput.
+func (in *GuestExecOutput) DeepCopy() *GuestExecOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestFileOptions) DeepCopyInto(out *GuestFileOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestFileOptions.
+func (in *GuestFileOptions) DeepCopy() *GuestFileOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestFileOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2135,6 +2410,68 @@ func (in *Handler) DeepCopy() *Handler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernateOptions) DeepCopyInto(out *HibernateOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernateOptions.
+func (in *HibernateOptions) DeepCopy() *HibernateOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernateOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(Hibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatus.
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -2575,6 +2912,11 @@ func (in *KSMConfiguration) DeepCopyInto(out *KSMConfiguration) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemoryMergeByDefault != nil {
+		in, out := &in.MemoryMergeByDefault, &out.MemoryMergeByDefault
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2877,6 +3219,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(VirtualMachineOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VMStateEncryption != nil {
+		in, out := &in.VMStateEncryption, &out.VMStateEncryption
+		*out = new(VMStateEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KSMConfiguration != nil {
 		in, out := &in.KSMConfiguration, &out.KSMConfiguration
 		*out = new(KSMConfiguration)
@@ -2907,6 +3254,21 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestAgentPolling != nil {
+		in, out := &in.GuestAgentPolling, &out.GuestAgentPolling
+		*out = new(GuestAgentPollingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemoryOvercommitController != nil {
+		in, out := &in.MemoryOvercommitController, &out.MemoryOvercommitController
+		*out = new(MemoryOvercommitControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SEVSNPAttestation != nil {
+		in, out := &in.SEVSNPAttestation, &out.SEVSNPAttestation
+		*out = new(SEVSNPAttestationConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -3111,6 +3473,11 @@ func (in *LaunchSecurity) DeepCopyInto(out *LaunchSecurity) {
 		*out = new(SEV)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TDX != nil {
+		in, out := &in.TDX, &out.TDX
+		*out = new(TDX)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3176,6 +3543,11 @@ func (in *LogVerbosity) DeepCopy() *LogVerbosity {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LunTarget) DeepCopyInto(out *LunTarget) {
 	*out = *in
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		*out = new(uint)
+		**out = **in
+	}
 	return
 }
 
@@ -3302,6 +3674,37 @@ func (in *MemoryDumpVolumeSource) DeepCopy() *MemoryDumpVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryOvercommitControllerConfiguration) DeepCopyInto(out *MemoryOvercommitControllerConfiguration) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HostMemoryPressureThreshold != nil {
+		in, out := &in.HostMemoryPressureThreshold, &out.HostMemoryPressureThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.GuaranteedMemoryPercent != nil {
+		in, out := &in.GuaranteedMemoryPercent, &out.GuaranteedMemoryPercent
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryOvercommitControllerConfiguration.
+func (in *MemoryOvercommitControllerConfiguration) DeepCopy() *MemoryOvercommitControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryOvercommitControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
 	*out = *in
@@ -3362,6 +3765,32 @@ func (in *MigrateOptions) DeepCopy() *MigrateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigratedSourceVolume) DeepCopyInto(out *MigratedSourceVolume) {
+	*out = *in
+	if in.HostDisk != nil {
+		in, out := &in.HostDisk, &out.HostDisk
+		*out = new(HostDisk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerDisk != nil {
+		in, out := &in.ContainerDisk, &out.ContainerDisk
+		*out = new(ContainerDiskSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigratedSourceVolume.
+func (in *MigratedSourceVolume) DeepCopy() *MigratedSourceVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(MigratedSourceVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 	*out = *in
@@ -3840,6 +4269,27 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentReservationStatus) DeepCopyInto(out *PersistentReservationStatus) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentReservationStatus.
+func (in *PersistentReservationStatus) DeepCopy() *PersistentReservationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeClaimInfo) DeepCopyInto(out *PersistentVolumeClaimInfo) {
 	*out = *in
@@ -4293,6 +4743,11 @@ func (in *SEV) DeepCopyInto(out *SEV) {
 		*out = new(SEVAttestation)
 		**out = **in
 	}
+	if in.AuthorKey != nil {
+		in, out := &in.AuthorKey, &out.AuthorKey
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -4380,6 +4835,16 @@ func (in *SEVPolicy) DeepCopyInto(out *SEVPolicy) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SecureNestedPaging != nil {
+		in, out := &in.SecureNestedPaging, &out.SecureNestedPaging
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SingleSocket != nil {
+		in, out := &in.SingleSocket, &out.SingleSocket
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -4393,6 +4858,47 @@ func (in *SEVPolicy) DeepCopy() *SEVPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SEVSNPAttestationConfiguration) DeepCopyInto(out *SEVSNPAttestationConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SEVSNPAttestationConfiguration.
+func (in *SEVSNPAttestationConfiguration) DeepCopy() *SEVSNPAttestationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SEVSNPAttestationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SEVSNPAttestationInfo) DeepCopyInto(out *SEVSNPAttestationInfo) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SEVSNPAttestationInfo.
+func (in *SEVSNPAttestationInfo) DeepCopy() *SEVSNPAttestationInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SEVSNPAttestationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SEVSNPAttestationInfo) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEVSecretOptions) DeepCopyInto(out *SEVSecretOptions) {
 	*out = *in
@@ -4569,6 +5075,84 @@ func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureBootKeysSource) DeepCopyInto(out *SecureBootKeysSource) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureBootKeysSource.
+func (in *SecureBootKeysSource) DeepCopy() *SecureBootKeysSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureBootKeysSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureBootKeysStatus) DeepCopyInto(out *SecureBootKeysStatus) {
+	*out = *in
+	if in.PK != nil {
+		in, out := &in.PK, &out.PK
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KEK != nil {
+		in, out := &in.KEK, &out.KEK
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DB != nil {
+		in, out := &in.DB, &out.DB
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBX != nil {
+		in, out := &in.DBX, &out.DBX
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureBootKeysStatus.
+func (in *SecureBootKeysStatus) DeepCopy() *SecureBootKeysStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureBootKeysStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialConsoleRecording) DeepCopyInto(out *SerialConsoleRecording) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialConsoleRecording.
+func (in *SerialConsoleRecording) DeepCopy() *SerialConsoleRecording {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialConsoleRecording)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountVolumeSource) DeepCopyInto(out *ServiceAccountVolumeSource) {
 	*out = *in
@@ -4658,11 +5242,21 @@ func (in *StorageMigratedVolumeInfo) DeepCopyInto(out *StorageMigratedVolumeInfo
 		*out = new(PersistentVolumeClaimInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SourceVolume != nil {
+		in, out := &in.SourceVolume, &out.SourceVolume
+		*out = new(MigratedSourceVolume)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DestinationPVCInfo != nil {
 		in, out := &in.DestinationPVCInfo, &out.DestinationPVCInfo
 		*out = new(PersistentVolumeClaimInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(StorageMigratedVolumeProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -4676,6 +5270,22 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigratedVolumeProgress) DeepCopyInto(out *StorageMigratedVolumeProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigratedVolumeProgress.
+func (in *StorageMigratedVolumeProgress) DeepCopy() *StorageMigratedVolumeProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigratedVolumeProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -4745,6 +5355,94 @@ func (in *SysprepSource) DeepCopy() *SysprepSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDX) DeepCopyInto(out *TDX) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(TDXPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attestation != nil {
+		in, out := &in.Attestation, &out.Attestation
+		*out = new(TDXAttestation)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDX.
+func (in *TDX) DeepCopy() *TDX {
+	if in == nil {
+		return nil
+	}
+	out := new(TDX)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDXAttestation) DeepCopyInto(out *TDXAttestation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDXAttestation.
+func (in *TDXAttestation) DeepCopy() *TDXAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(TDXAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDXPolicy) DeepCopyInto(out *TDXPolicy) {
+	*out = *in
+	if in.SEPTVEDisable != nil {
+		in, out := &in.SEPTVEDisable, &out.SEPTVEDisable
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDXPolicy.
+func (in *TDXPolicy) DeepCopy() *TDXPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TDXPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDXQuoteInfo) DeepCopyInto(out *TDXQuoteInfo) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDXQuoteInfo.
+func (in *TDXQuoteInfo) DeepCopy() *TDXQuoteInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(TDXQuoteInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TDXQuoteInfo) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSConfiguration) DeepCopyInto(out *TLSConfiguration) {
 	*out = *in
@@ -4989,6 +5687,22 @@ func (in *UserPasswordAccessCredentialSource) DeepCopy() *UserPasswordAccessCred
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VCPUStatus) DeepCopyInto(out *VCPUStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCPUStatus.
+func (in *VCPUStatus) DeepCopy() *VCPUStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VCPUStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VGPUDisplayOptions) DeepCopyInto(out *VGPUDisplayOptions) {
 	*out = *in
@@ -5053,11 +5767,48 @@ func (in *VMISelector) DeepCopy() *VMISelector {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VSOCKOptions) DeepCopyInto(out *VSOCKOptions) {
+func (in *VMStateEncryption) DeepCopyInto(out *VMStateEncryption) {
 	*out = *in
-	if in.UseTLS != nil {
-		in, out := &in.UseTLS, &out.UseTLS
-		*out = new(bool)
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(VMStateKMS)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStateEncryption.
+func (in *VMStateEncryption) DeepCopy() *VMStateEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStateEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStateKMS) DeepCopyInto(out *VMStateKMS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStateKMS.
+func (in *VMStateKMS) DeepCopy() *VMStateKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStateKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSOCKOptions) DeepCopyInto(out *VSOCKOptions) {
+	*out = *in
+	if in.UseTLS != nil {
+		in, out := &in.UseTLS, &out.UseTLS
+		*out = new(bool)
 		**out = **in
 	}
 	return
@@ -5135,6 +5886,127 @@ func (in *VirtualMachineCondition) DeepCopy() *VirtualMachineCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDiskTask) DeepCopyInto(out *VirtualMachineDiskTask) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDiskTask.
+func (in *VirtualMachineDiskTask) DeepCopy() *VirtualMachineDiskTask {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDiskTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDiskTask) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDiskTaskList) DeepCopyInto(out *VirtualMachineDiskTaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineDiskTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDiskTaskList.
+func (in *VirtualMachineDiskTaskList) DeepCopy() *VirtualMachineDiskTaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDiskTaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDiskTaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDiskTaskSpec) DeepCopyInto(out *VirtualMachineDiskTaskSpec) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(DiskTaskSysprep)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Customize != nil {
+		in, out := &in.Customize, &out.Customize
+		*out = new(DiskTaskCustomize)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResizeFilesystem != nil {
+		in, out := &in.ResizeFilesystem, &out.ResizeFilesystem
+		*out = new(DiskTaskResizeFilesystem)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDiskTaskSpec.
+func (in *VirtualMachineDiskTaskSpec) DeepCopy() *VirtualMachineDiskTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDiskTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDiskTaskStatus) DeepCopyInto(out *VirtualMachineDiskTaskStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDiskTaskStatus.
+func (in *VirtualMachineDiskTaskStatus) DeepCopy() *VirtualMachineDiskTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDiskTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstance) DeepCopyInto(out *VirtualMachineInstance) {
 	*out = *in
@@ -5163,6 +6035,22 @@ func (in *VirtualMachineInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceCPUStats) DeepCopyInto(out *VirtualMachineInstanceCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCPUStats.
+func (in *VirtualMachineInstanceCPUStats) DeepCopy() *VirtualMachineInstanceCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceCommonMigrationState) DeepCopyInto(out *VirtualMachineInstanceCommonMigrationState) {
 	*out = *in
@@ -5222,6 +6110,22 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDiskStats) DeepCopyInto(out *VirtualMachineInstanceDiskStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDiskStats.
+func (in *VirtualMachineInstanceDiskStats) DeepCopy() *VirtualMachineInstanceDiskStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDiskStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -5352,6 +6256,27 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestDisk) DeepCopyInto(out *VirtualMachineInstanceGuestDisk) {
+	*out = *in
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestDisk.
+func (in *VirtualMachineInstanceGuestDisk) DeepCopy() *VirtualMachineInstanceGuestDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5415,6 +6340,22 @@ func (in *VirtualMachineInstanceGuestOSUserList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceInterfaceStats) DeepCopyInto(out *VirtualMachineInstanceInterfaceStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceInterfaceStats.
+func (in *VirtualMachineInstanceInterfaceStats) DeepCopy() *VirtualMachineInstanceInterfaceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceInterfaceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceList) DeepCopyInto(out *VirtualMachineInstanceList) {
 	*out = *in
@@ -5448,6 +6389,22 @@ func (in *VirtualMachineInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopyInto(out *VirtualMachineInstanceMemoryStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMemoryStats.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopy() *VirtualMachineInstanceMemoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMemoryStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInstanceMigration) {
 	*out = *in
@@ -6095,6 +7052,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(StartStrategy)
 		**out = **in
 	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		**out = **in
+	}
 	if in.TerminationGracePeriodSeconds != nil {
 		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
 		*out = new(int64)
@@ -6156,6 +7118,62 @@ func (in *VirtualMachineInstanceSpec) DeepCopy() *VirtualMachineInstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceStats) DeepCopyInto(out *VirtualMachineInstanceStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(VirtualMachineInstanceCPUStats)
+		**out = **in
+	}
+	if in.VCPUs != nil {
+		in, out := &in.VCPUs, &out.VCPUs
+		*out = make([]VirtualMachineInstanceVCPUStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(VirtualMachineInstanceMemoryStats)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineInstanceDiskStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineInstanceInterfaceStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.DirtyRateMegabytesPerSecond != nil {
+		in, out := &in.DirtyRateMegabytesPerSecond, &out.DirtyRateMegabytesPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceStats.
+func (in *VirtualMachineInstanceStats) DeepCopy() *VirtualMachineInstanceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstanceStatus) {
 	*out = *in
@@ -6230,6 +7248,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(CPUTopology)
 		**out = **in
 	}
+	if in.Vcpus != nil {
+		in, out := &in.Vcpus, &out.Vcpus
+		*out = new(VCPUStatus)
+		**out = **in
+	}
 	if in.Memory != nil {
 		in, out := &in.Memory, &out.Memory
 		*out = new(MemoryStatus)
@@ -6247,6 +7270,23 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(DeviceStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestDisks != nil {
+		in, out := &in.GuestDisks, &out.GuestDisks
+		*out = make([]VirtualMachineInstanceGuestDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecureBootKeys != nil {
+		in, out := &in.SecureBootKeys, &out.SecureBootKeys
+		*out = new(SecureBootKeysStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6278,6 +7318,22 @@ func (in *VirtualMachineInstanceTemplateSpec) DeepCopy() *VirtualMachineInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceVCPUStats) DeepCopyInto(out *VirtualMachineInstanceVCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceVCPUStats.
+func (in *VirtualMachineInstanceVCPUStats) DeepCopy() *VirtualMachineInstanceVCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceVCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
 	*out = *in
@@ -6480,6 +7536,11 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DiskTaskInProgress != nil {
+		in, out := &in.DiskTaskInProgress, &out.DiskTaskInProgress
+		*out = new(string)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]VirtualMachineCondition, len(*in))
@@ -6531,6 +7592,23 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(InstancetypeStatusRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecureBootKeys != nil {
+		in, out := &in.SecureBootKeys, &out.SecureBootKeys
+		*out = new(SecureBootKeysStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentReservations != nil {
+		in, out := &in.PersistentReservations, &out.PersistentReservations
+		*out = make([]VolumePersistentReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6610,6 +7688,23 @@ func (in *VolumeMigrationState) DeepCopy() *VolumeMigrationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePersistentReservation) DeepCopyInto(out *VolumePersistentReservation) {
+	*out = *in
+	in.Reservation.DeepCopyInto(&out.Reservation)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePersistentReservation.
+func (in *VolumePersistentReservation) DeepCopy() *VolumePersistentReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePersistentReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
 	*out = *in
@@ -6657,7 +7752,7 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 	if in.ContainerDisk != nil {
 		in, out := &in.ContainerDisk, &out.ContainerDisk
 		*out = new(ContainerDiskSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Ephemeral != nil {
 		in, out := &in.Ephemeral, &out.Ephemeral
@@ -6697,7 +7792,7 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 	if in.DownwardMetrics != nil {
 		in, out := &in.DownwardMetrics, &out.DownwardMetrics
 		*out = new(DownwardMetricsVolumeSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MemoryDump != nil {
 		in, out := &in.MemoryDump, &out.MemoryDump
@@ -6740,6 +7835,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(ContainerDiskInfo)
 		**out = **in
 	}
+	if in.PersistentReservation != nil {
+		in, out := &in.PersistentReservation, &out.PersistentReservation
+		*out = new(PersistentReservationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
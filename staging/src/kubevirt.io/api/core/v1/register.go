@@ -78,6 +78,7 @@ var (
 	VirtualMachineInstancePresetGroupVersionKind     = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "VirtualMachineInstancePreset"}
 	VirtualMachineGroupVersionKind                   = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "VirtualMachine"}
 	VirtualMachineInstanceMigrationGroupVersionKind  = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "VirtualMachineInstanceMigration"}
+	VirtualMachineDiskTaskGroupVersionKind           = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "VirtualMachineDiskTask"}
 	KubeVirtGroupVersionKind                         = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "KubeVirt"}
 )
 
@@ -101,6 +102,8 @@ func AddKnownTypesGenerator(groupVersions []schema.GroupVersion) func(scheme *ru
 				&VirtualMachineInstancePresetList{},
 				&VirtualMachineInstanceMigration{},
 				&VirtualMachineInstanceMigrationList{},
+				&VirtualMachineDiskTask{},
+				&VirtualMachineDiskTaskList{},
 				&VirtualMachine{},
 				&VirtualMachineList{},
 				&KubeVirt{},
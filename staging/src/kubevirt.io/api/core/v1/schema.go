@@ -126,8 +126,33 @@ type ServiceAccountVolumeSource struct {
 // DownwardMetricsVolumeSource adds a very small disk to VMIs which contains a limited view of host and guest
 // metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
 type DownwardMetricsVolumeSource struct {
+	// MetricGroups selects the groups of metrics published to the guest.
+	// Defaults to the Host, CPU and Memory groups.
+	// +optional
+	// +listType=atomic
+	MetricGroups []DownwardMetricsGroup `json:"metricGroups,omitempty"`
 }
 
+// DownwardMetricsGroup is a group of metrics published to the guest through the downward metrics
+type DownwardMetricsGroup string
+
+const (
+	// DownwardMetricsGroupHost publishes the CPU and memory usage of the host
+	DownwardMetricsGroupHost DownwardMetricsGroup = "Host"
+	// DownwardMetricsGroupCPU publishes the CPU time and the vCPU count of the VM
+	DownwardMetricsGroupCPU DownwardMetricsGroup = "CPU"
+	// DownwardMetricsGroupMemory publishes the memory allocated to the VM
+	DownwardMetricsGroupMemory DownwardMetricsGroup = "Memory"
+	// DownwardMetricsGroupDisk publishes the throughput and the latency of each disk of the VM
+	DownwardMetricsGroupDisk DownwardMetricsGroup = "Disk"
+	// DownwardMetricsGroupNetwork publishes the throughput of each network interface of the VM
+	DownwardMetricsGroupNetwork DownwardMetricsGroup = "Network"
+	// DownwardMetricsGroupCPUSteal publishes the time the vCPUs were waiting for a host CPU
+	DownwardMetricsGroupCPUSteal DownwardMetricsGroup = "CPUSteal"
+	// DownwardMetricsGroupNUMA publishes the host NUMA nodes the VM is placed on
+	DownwardMetricsGroupNUMA DownwardMetricsGroup = "NUMA"
+)
+
 // Represents a Sysprep volume source.
 type SysprepSource struct {
 	// Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
@@ -262,6 +287,26 @@ type EFI struct {
 	// Defaults to false
 	// +optional
 	Persistent *bool `json:"persistent,omitempty"`
+	// SecureBootKeys references the certificates enrolled as the SecureBoot keys
+	// of the VM instead of the keys shipped with the firmware.
+	// They are enrolled when the EFI NVRAM is created at first boot.
+	// Requires SecureBoot and Persistent to be enabled.
+	// +optional
+	SecureBootKeys *SecureBootKeysSource `json:"secureBootKeys,omitempty"`
+}
+
+// SecureBootKeysSource references a Secret or ConfigMap holding the SecureBoot keys.
+// The keys PK, KEK, db and dbx contain the PEM encoded certificates of the
+// respective EFI variable. PK must contain exactly one certificate, KEK and db
+// at least one. dbx is optional and may also list the hex encoded SHA-256
+// hashes of revoked binaries, one per line.
+type SecureBootKeysSource struct {
+	// Secret references a k8s Secret that contains the SecureBoot keys.
+	// +optional
+	Secret *v1.LocalObjectReference `json:"secret,omitempty"`
+	// ConfigMap references a ConfigMap that contains the SecureBoot keys.
+	// +optional
+	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
 }
 
 // If set, the VM will be booted from the defined kernel / initrd.
@@ -403,6 +448,14 @@ type Memory struct {
 	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
 }
 
+// VCPUStatus shows the vCPUs requested from and plugged into the guest
+type VCPUStatus struct {
+	// Requested is the number of vCPUs the guest was asked to use.
+	Requested int64 `json:"requested"`
+	// Plugged is the number of vCPUs currently plugged into the guest.
+	Plugged int64 `json:"plugged"`
+}
+
 type MemoryStatus struct {
 	// GuestAtBoot specifies with how much memory the VirtualMachine intiallly booted with.
 	// +optional
@@ -483,6 +536,11 @@ type Devices struct {
 	// Not relevant if autoattachSerialConsole is disabled.
 	// Defaults to cluster wide setting on VirtualMachineOptions.
 	LogSerialConsole *bool `json:"logSerialConsole,omitempty"`
+	// SerialConsoleRecording records the output of the auto-attached default serial console,
+	// with the time it was written at, in the asciicast v2 format of asciinema.
+	// The serial console is logged when it is recorded.
+	// +optional
+	SerialConsoleRecording *SerialConsoleRecording `json:"serialConsoleRecording,omitempty"`
 	// Whether to attach the Memory balloon device with default period.
 	// Period can be adjusted in virt-config.
 	// Defaults to true.
@@ -564,6 +622,15 @@ type SoundDevice struct {
 	Model string `json:"model,omitempty"`
 }
 
+// SerialConsoleRecording configures where the serial console is recorded to.
+type SerialConsoleRecording struct {
+	// ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,
+	// in one file per VirtualMachineInstance named after its UID.
+	// When not set, the recording is written to the log of the `guest-console-log` container.
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
+}
+
 type TPMDevice struct {
 	// Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
 	// Defaults to True
@@ -614,7 +681,13 @@ type Filesystem struct {
 
 type FilesystemVirtiofs struct{}
 
-type DownwardMetrics struct{}
+type DownwardMetrics struct {
+	// MetricGroups selects the groups of metrics published to the guest.
+	// Defaults to the Host, CPU and Memory groups.
+	// +optional
+	// +listType=atomic
+	MetricGroups []DownwardMetricsGroup `json:"metricGroups,omitempty"`
+}
 
 type GPU struct {
 	// Name of the GPU device as exposed by a device plugin
@@ -725,6 +798,11 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent
+	// after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.
+	// Defaults to false.
+	// +optional
+	GrowFilesystem *bool `json:"growFilesystem,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -770,11 +848,48 @@ type DiskTarget struct {
 	// If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10
 	// +optional
 	PciAddress string `json:"pciAddress,omitempty"`
+	// Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.
+	// It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+	// +optional
+	Unit *uint `json:"unit,omitempty"`
 }
 
 type LaunchSecurity struct {
 	// AMD Secure Encrypted Virtualization (SEV).
 	SEV *SEV `json:"sev,omitempty"`
+	// Intel Trust Domain Extensions (TDX).
+	// +optional
+	TDX *TDX `json:"tdx,omitempty"`
+}
+
+type TDX struct {
+	// Guest policy flags as defined in the Intel TDX module specification.
+	// Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+	// +optional
+	Policy *TDXPolicy `json:"policy,omitempty"`
+	// Base64 encoded SHA384 digest identifying the guest owner defined configuration of the trust domain.
+	// +optional
+	MRConfigID string `json:"mrConfigID,omitempty"`
+	// Base64 encoded SHA384 digest identifying the owner of the trust domain.
+	// +optional
+	MROwner string `json:"mrOwner,omitempty"`
+	// Base64 encoded SHA384 digest of the owner defined configuration of the trust domain.
+	// +optional
+	MROwnerConfig string `json:"mrOwnerConfig,omitempty"`
+	// If specified, the quote generation service of the node is made available to the guest
+	// and quotes can be fetched for TD reports of the guest.
+	// +optional
+	Attestation *TDXAttestation `json:"attestation,omitempty"`
+}
+
+type TDXPolicy struct {
+	// Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.
+	// Defaults to true.
+	// +optional
+	SEPTVEDisable *bool `json:"septVEDisable,omitempty"`
+}
+
+type TDXAttestation struct {
 }
 
 type SEV struct {
@@ -788,6 +903,21 @@ type SEV struct {
 	Session string `json:"session,omitempty"`
 	// Base64 encoded guest owner's Diffie-Hellman key.
 	DHCert string `json:"dhCert,omitempty"`
+	// Base64 encoded SEV-SNP ID block of 96 bytes, holding the expected launch digest and guest policy.
+	// +optional
+	IDBlock string `json:"idBlock,omitempty"`
+	// Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.
+	// Requires an ID block.
+	// +optional
+	IDAuth string `json:"idAuth,omitempty"`
+	// The ID authentication information contains the author key signing the ID key.
+	// Requires an ID authentication information structure.
+	// Defaults to false.
+	// +optional
+	AuthorKey *bool `json:"authorKey,omitempty"`
+	// Base64 encoded 32 bytes of data provided by the host, which are included in the SEV-SNP attestation reports of the guest.
+	// +optional
+	HostData string `json:"hostData,omitempty"`
 }
 
 type SEVPolicy struct {
@@ -795,6 +925,15 @@ type SEVPolicy struct {
 	// Defaults to false.
 	// +optional
 	EncryptedState *bool `json:"encryptedState,omitempty"`
+	// SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.
+	// Defaults to false.
+	// +optional
+	SecureNestedPaging *bool `json:"secureNestedPaging,omitempty"`
+	// The SEV-SNP guest can only be activated on a single socket.
+	// Requires SEV-SNP.
+	// Defaults to false.
+	// +optional
+	SingleSocket *bool `json:"singleSocket,omitempty"`
 }
 
 type SEVAttestation struct {
@@ -809,6 +948,10 @@ type LunTarget struct {
 	ReadOnly bool `json:"readonly,omitempty"`
 	// Reservation indicates if the disk needs to support the persistent reservation for the SCSI disk
 	Reservation bool `json:"reservation,omitempty"`
+	// Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.
+	// It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.
+	// +optional
+	Unit *uint `json:"unit,omitempty"`
 }
 
 // TrayState indicates if a tray of a cdrom is open or closed.
@@ -977,6 +1120,31 @@ type ContainerDiskSource struct {
 	// More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
 	// +optional
 	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Mode defines how the disk is served from the image.
+	// Standalone expects a single self-contained disk file.
+	// Layered serves a qcow2 backing chain spread over the image layers in place,
+	// so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.
+	// Defaults to Standalone.
+	// +optional
+	Mode ContainerDiskMode `json:"mode,omitempty"`
+	// Overlay configures the writable layer on top of the image.
+	// By default the overlay is ephemeral and all writes are lost when the VMI stops.
+	// +optional
+	Overlay *ContainerDiskOverlay `json:"overlay,omitempty"`
+}
+
+type ContainerDiskMode string
+
+const (
+	ContainerDiskModeStandalone ContainerDiskMode = "Standalone"
+	ContainerDiskModeLayered    ContainerDiskMode = "Layered"
+)
+
+// ContainerDiskOverlay keeps the writable layer of a containerDisk on persistent storage.
+type ContainerDiskOverlay struct {
+	// ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,
+	// so that writes survive VMI restarts. The overlay is bound to the image it was created for.
+	ClaimName string `json:"claimName"`
 }
 
 // Exactly one of its members must be set.
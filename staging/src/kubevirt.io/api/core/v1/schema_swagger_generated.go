@@ -46,7 +46,8 @@ func (ServiceAccountVolumeSource) SwaggerDoc() map[string]string {
 
 func (DownwardMetricsVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "DownwardMetricsVolumeSource adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
+		"":             "DownwardMetricsVolumeSource adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
+		"metricGroups": "MetricGroups selects the groups of metrics published to the guest.\nDefaults to the Host, CPU and Memory groups.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -122,9 +123,18 @@ func (BIOS) SwaggerDoc() map[string]string {
 
 func (EFI) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":           "If set, EFI will be used instead of BIOS.",
-		"secureBoot": "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
-		"persistent": "If set to true, Persistent will persist the EFI NVRAM across reboots.\nDefaults to false\n+optional",
+		"":               "If set, EFI will be used instead of BIOS.",
+		"secureBoot":     "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
+		"persistent":     "If set to true, Persistent will persist the EFI NVRAM across reboots.\nDefaults to false\n+optional",
+		"secureBootKeys": "SecureBootKeys references the certificates enrolled as the SecureBoot keys\nof the VM instead of the keys shipped with the firmware.\nThey are enrolled when the EFI NVRAM is created at first boot.\nRequires SecureBoot and Persistent to be enabled.\n+optional",
+	}
+}
+
+func (SecureBootKeysSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "SecureBootKeysSource references a Secret or ConfigMap holding the SecureBoot keys.\nThe keys PK, KEK, db and dbx contain the PEM encoded certificates of the\nrespective EFI variable. PK must contain exactly one certificate, KEK and db\nat least one. dbx is optional and may also list the hex encoded SHA-256\nhashes of revoked binaries, one per line.",
+		"secret":    "Secret references a k8s Secret that contains the SecureBoot keys.\n+optional",
+		"configMap": "ConfigMap references a ConfigMap that contains the SecureBoot keys.\n+optional",
 	}
 }
 
@@ -207,6 +217,14 @@ func (Memory) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VCPUStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VCPUStatus shows the vCPUs requested from and plugged into the guest",
+		"requested": "Requested is the number of vCPUs the guest was asked to use.",
+		"plugged":   "Plugged is the number of vCPUs currently plugged into the guest.",
+	}
+}
+
 func (MemoryStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"guestAtBoot":    "GuestAtBoot specifies with how much memory the VirtualMachine intiallly booted with.\n+optional",
@@ -257,6 +275,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"autoattachGraphicsDevice":   "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
 		"autoattachSerialConsole":    "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
 		"logSerialConsole":           "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
+		"serialConsoleRecording":     "SerialConsoleRecording records the output of the auto-attached default serial console,\nwith the time it was written at, in the asciicast v2 format of asciinema.\nThe serial console is logged when it is recorded.\n+optional",
 		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
 		"autoattachInputDevice":      "Whether to attach an Input Device.\nDefaults to false.\n+optional",
 		"autoattachVSOCK":            "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
@@ -289,6 +308,13 @@ func (SoundDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SerialConsoleRecording) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "SerialConsoleRecording configures where the serial console is recorded to.",
+		"claimName": "ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to,\nin one file per VirtualMachineInstance named after its UID.\nWhen not set, the recording is written to the log of the `guest-console-log` container.\n+optional",
+	}
+}
+
 func (TPMDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"enabled":    "Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine\nDefaults to True",
@@ -322,7 +348,9 @@ func (FilesystemVirtiofs) SwaggerDoc() map[string]string {
 }
 
 func (DownwardMetrics) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"metricGroups": "MetricGroups selects the groups of metrics published to the guest.\nDefaults to the Host, CPU and Memory groups.\n+optional\n+listType=atomic",
+	}
 }
 
 func (GPU) SwaggerDoc() map[string]string {
@@ -376,6 +404,7 @@ func (Disk) SwaggerDoc() map[string]string {
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":       "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"growFilesystem":    "GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent\nafter the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest.\nDefaults to false.\n+optional",
 	}
 }
 
@@ -405,27 +434,55 @@ func (DiskTarget) SwaggerDoc() map[string]string {
 		"bus":        "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb.",
 		"readonly":   "ReadOnly.\nDefaults to false.",
 		"pciAddress": "If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"unit":       "Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees.\nIt keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.\n+optional",
 	}
 }
 
 func (LaunchSecurity) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"sev": "AMD Secure Encrypted Virtualization (SEV).",
+		"tdx": "Intel Trust Domain Extensions (TDX).\n+optional",
 	}
 }
 
+func (TDX) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"policy":        "Guest policy flags as defined in the Intel TDX module specification.\nNote: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.\n+optional",
+		"mrConfigID":    "Base64 encoded SHA384 digest identifying the guest owner defined configuration of the trust domain.\n+optional",
+		"mrOwner":       "Base64 encoded SHA384 digest identifying the owner of the trust domain.\n+optional",
+		"mrOwnerConfig": "Base64 encoded SHA384 digest of the owner defined configuration of the trust domain.\n+optional",
+		"attestation":   "If specified, the quote generation service of the node is made available to the guest\nand quotes can be fetched for TD reports of the guest.\n+optional",
+	}
+}
+
+func (TDXPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"septVEDisable": "Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages.\nDefaults to true.\n+optional",
+	}
+}
+
+func (TDXAttestation) SwaggerDoc() map[string]string {
+	return map[string]string{}
+}
+
 func (SEV) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"policy":      "Guest policy flags as defined in AMD SEV API specification.\nNote: due to security reasons it is not allowed to enable guest debugging. Therefore NoDebug flag is not exposed to users and is always true.",
 		"attestation": "If specified, run the attestation process for a vmi.\n+optional",
 		"session":     "Base64 encoded session blob.",
 		"dhCert":      "Base64 encoded guest owner's Diffie-Hellman key.",
+		"idBlock":     "Base64 encoded SEV-SNP ID block of 96 bytes, holding the expected launch digest and guest policy.\n+optional",
+		"idAuth":      "Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block.\nRequires an ID block.\n+optional",
+		"authorKey":   "The ID authentication information contains the author key signing the ID key.\nRequires an ID authentication information structure.\nDefaults to false.\n+optional",
+		"hostData":    "Base64 encoded 32 bytes of data provided by the host, which are included in the SEV-SNP attestation reports of the guest.\n+optional",
 	}
 }
 
 func (SEVPolicy) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"encryptedState": "SEV-ES is required.\nDefaults to false.\n+optional",
+		"encryptedState":     "SEV-ES is required.\nDefaults to false.\n+optional",
+		"secureNestedPaging": "SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy.\nDefaults to false.\n+optional",
+		"singleSocket":       "The SEV-SNP guest can only be activated on a single socket.\nRequires SEV-SNP.\nDefaults to false.\n+optional",
 	}
 }
 
@@ -438,6 +495,7 @@ func (LunTarget) SwaggerDoc() map[string]string {
 		"bus":         "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi.",
 		"readonly":    "ReadOnly.\nDefaults to false.",
 		"reservation": "Reservation indicates if the disk needs to support the persistent reservation for the SCSI disk",
+		"unit":        "Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees.\nIt keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.\n+optional",
 	}
 }
 
@@ -523,6 +581,15 @@ func (ContainerDiskSource) SwaggerDoc() map[string]string {
 		"imagePullSecret": "ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.",
 		"path":            "Path defines the path to disk file in the container",
 		"imagePullPolicy": "Image pull policy.\nOne of Always, Never, IfNotPresent.\nDefaults to Always if :latest tag is specified, or IfNotPresent otherwise.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n+optional",
+		"mode":            "Mode defines how the disk is served from the image.\nStandalone expects a single self-contained disk file.\nLayered serves a qcow2 backing chain spread over the image layers in place,\nso that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain.\nDefaults to Standalone.\n+optional",
+		"overlay":         "Overlay configures the writable layer on top of the image.\nBy default the overlay is ephemeral and all writes are lost when the VMI stops.\n+optional",
+	}
+}
+
+func (ContainerDiskOverlay) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "ContainerDiskOverlay keeps the writable layer of a containerDisk on persistent storage.",
+		"claimName": "ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay,\nso that writes survive VMI restarts. The overlay is bound to the image it was created for.",
 	}
 }
 
@@ -122,6 +122,10 @@ type VirtualMachineInstanceSpec struct {
 	//
 	// +optional
 	StartStrategy *StartStrategy `json:"startStrategy,omitempty"`
+	// Hibernation configures the PersistentVolumeClaim the memory and device state of the
+	// VirtualMachineInstance is saved to when its VirtualMachine is hibernated.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
 	// Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
 	// List of volumes that can be mounted by disks belonging to the vmi.
@@ -148,6 +152,12 @@ type VirtualMachineInstanceSpec struct {
 	// no matter if the vmi itself can pick up a hostname.
 	// +optional
 	Subdomain string `json:"subdomain,omitempty"`
+	// SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with
+	// SCSI persistent reservation, e.g. the nodes of a guest failover cluster.
+	// All members of a group must use the same shareable reservation LUNs and are
+	// scheduled on different nodes.
+	// +optional
+	SharedDiskGroup string `json:"sharedDiskGroup,omitempty"`
 	// List of networks that can be attached to a vm's virtual interface.
 	// +kubebuilder:validation:MaxItems:=256
 	Networks []Network `json:"networks,omitempty"`
@@ -308,6 +318,11 @@ type VirtualMachineInstanceStatus struct {
 	// takes place.
 	CurrentCPUTopology *CPUTopology `json:"currentCPUTopology,omitempty"`
 
+	// Vcpus shows how many vCPUs are requested from and plugged into the guest.
+	// Both differ while the guest releases hot-unplugged vCPUs.
+	// +optional
+	Vcpus *VCPUStatus `json:"vcpus,omitempty"`
+
 	// Memory shows various informations about the VirtualMachine memory.
 	// +optional
 	Memory *MemoryStatus `json:"memory,omitempty"`
@@ -322,6 +337,69 @@ type VirtualMachineInstanceStatus struct {
 	// This feature is in alpha.
 	// +optional
 	DeviceStatus *DeviceStatus `json:"deviceStatus,omitempty"`
+
+	// GuestDisks lists the disks reported by the guest agent.
+	// It is only populated when polling of the guest disks is enabled in the guest agent polling configuration.
+	// +listType=atomic
+	// +optional
+	GuestDisks []VirtualMachineInstanceGuestDisk `json:"guestDisks,omitempty"`
+
+	// SecureBootKeys reports the SecureBoot keys enrolled in the EFI NVRAM
+	// of the VMI when custom SecureBoot keys are requested.
+	// +optional
+	SecureBootKeys *SecureBootKeysStatus `json:"secureBootKeys,omitempty"`
+
+	// Hibernation reports the saving of the state of the VMI when its VirtualMachine
+	// is hibernated, or the restoring of the state the VMI was started from.
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+}
+
+// SecureBootKeysStatus lists the fingerprints of the SecureBoot keys enrolled in the EFI NVRAM.
+// Certificates are identified by the hex encoded SHA-256 hash of their DER encoding,
+// revoked binaries in dbx by their hex encoded SHA-256 hash.
+type SecureBootKeysStatus struct {
+	// PK is the fingerprint of the platform key
+	// +listType=atomic
+	// +optional
+	PK []string `json:"pk,omitempty"`
+	// KEK are the fingerprints of the key exchange keys
+	// +listType=atomic
+	// +optional
+	KEK []string `json:"kek,omitempty"`
+	// DB are the fingerprints of the allowed signature database
+	// +listType=atomic
+	// +optional
+	DB []string `json:"db,omitempty"`
+	// DBX are the fingerprints of the forbidden signature database
+	// +listType=atomic
+	// +optional
+	DBX []string `json:"dbx,omitempty"`
+}
+
+// VirtualMachineInstanceGuestDisk describes a disk as seen from inside the guest
+type VirtualMachineInstanceGuestDisk struct {
+	// Name is the device name of the disk in the guest
+	Name string `json:"name"`
+	// Partition is true when the device is a partition of another disk
+	// +optional
+	Partition bool `json:"partition,omitempty"`
+	// Serial is the serial number of the disk
+	// +optional
+	Serial string `json:"serial,omitempty"`
+	// Bus is the bus type of the disk as reported by the guest
+	// +optional
+	Bus string `json:"bus,omitempty"`
+	// Alias is the name the guest uses for the disk, like a LVM logical volume name
+	// +optional
+	Alias string `json:"alias,omitempty"`
+	// VolumeName is the name of the VMI volume backing the disk, if known
+	// +optional
+	VolumeName string `json:"volumeName,omitempty"`
+	// Dependencies lists the names of the devices this disk depends on
+	// +listType=atomic
+	// +optional
+	Dependencies []string `json:"dependencies,omitempty"`
 }
 
 // DeviceStatus has the information of all devices allocated spec.domain.devices
@@ -374,9 +452,41 @@ type StorageMigratedVolumeInfo struct {
 	// VolumeName is the name of the volume that is being migrated
 	VolumeName string `json:"volumeName"`
 	// SourcePVCInfo contains the information about the source PVC
-	SourcePVCInfo *PersistentVolumeClaimInfo `json:"sourcePVCInfo,omitempty" valid:"required"`
+	// +optional
+	SourcePVCInfo *PersistentVolumeClaimInfo `json:"sourcePVCInfo,omitempty"`
+	// SourceVolume contains the source of the migrated volume when it isn't backed by a PVC
+	// +optional
+	SourceVolume *MigratedSourceVolume `json:"sourceVolume,omitempty"`
 	// DestinationPVCInfo contains the information about the destination PVC
 	DestinationPVCInfo *PersistentVolumeClaimInfo `json:"destinationPVCInfo,omitempty" valid:"required"`
+	// Progress reports the progress of the copy of the volume to the destination
+	// +optional
+	Progress *StorageMigratedVolumeProgress `json:"progress,omitempty"`
+}
+
+// MigratedSourceVolume represents a source volume of the volume migration which isn't backed by a PVC.
+// Only one of its members may be specified.
+type MigratedSourceVolume struct {
+	// HostDisk is the hostDisk the volume is migrated from
+	// +optional
+	HostDisk *HostDisk `json:"hostDisk,omitempty"`
+	// ContainerDisk is the containerDisk the volume is migrated from. The writable overlay is copied
+	// together with its backing image, hence the destination contains the whole disk content.
+	// +optional
+	ContainerDisk *ContainerDiskSource `json:"containerDisk,omitempty"`
+}
+
+// StorageMigratedVolumeProgress reports the progress of the block copy of a migrated volume
+type StorageMigratedVolumeProgress struct {
+	// TotalBytes is the amount of data to copy to the destination
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+	// ProcessedBytes is the amount of data already copied to the destination
+	// +optional
+	ProcessedBytes int64 `json:"processedBytes,omitempty"`
+	// Completed indicates if the copy of the volume has completed
+	// +optional
+	Completed bool `json:"completed,omitempty"`
 }
 
 // PersistentVolumeClaimInfo contains the relavant information virt-handler needs cached about a PVC
@@ -437,6 +547,22 @@ type VolumeStatus struct {
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
+	// PersistentReservation shows the SCSI persistent reservation state of the volume, if the volume is a LUN with reservation
+	PersistentReservation *PersistentReservationStatus `json:"persistentReservation,omitempty"`
+}
+
+// PersistentReservationStatus shows the SCSI persistent reservation state of a LUN
+type PersistentReservationStatus struct {
+	// Keys are the reservation keys registered on the LUN
+	// +listType=atomic
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+	// Holder is the key of the current reservation holder, empty if the LUN is not reserved
+	// +optional
+	Holder string `json:"holder,omitempty"`
+	// Type is the type of the current reservation, e.g. WriteExclusiveRegistrantsOnly
+	// +optional
+	Type string `json:"type,omitempty"`
 }
 
 // KernelInfo show info about the kernel image
@@ -696,6 +822,9 @@ const (
 	// Reflects whether the QEMU guest agent updated access credentials successfully
 	VirtualMachineInstanceAccessCredentialsSynchronized VirtualMachineInstanceConditionType = "AccessCredentialsSynchronized"
 
+	// Reflects whether the QEMU guest agent grew the filesystems of the expanded disks successfully
+	VirtualMachineInstanceFilesystemGrown VirtualMachineInstanceConditionType = "FilesystemGrown"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceUnsupportedAgent VirtualMachineInstanceConditionType = "AgentVersionNotSupported"
 
@@ -708,6 +837,14 @@ const (
 	// Indicates that the VMI is hot(un)plugging memory
 	VirtualMachineInstanceMemoryChange VirtualMachineInstanceConditionType = "HotMemoryChange"
 
+	// Indicates that the guest is releasing hot-unplugged vCPUs, it turns false when the guest
+	// did not release them in time
+	VirtualMachineInstanceVCPUUnplug VirtualMachineInstanceConditionType = "HotVCPUUnplug"
+
+	// Indicates that the guest is releasing hot-unplugged memory, it turns false when the guest
+	// did not release it in time
+	VirtualMachineInstanceMemoryUnplug VirtualMachineInstanceConditionType = "HotMemoryUnplug"
+
 	// Indicates that the VMI has an updates in its volume set
 	VirtualMachineInstanceVolumesChange VirtualMachineInstanceConditionType = "VolumesChange"
 
@@ -719,6 +856,9 @@ const (
 
 	// VirtualMachineInstanceMigrationRequired Indicates that an automatic migration is required
 	VirtualMachineInstanceMigrationRequired VirtualMachineInstanceConditionType = "MigrationRequired"
+
+	// Indicates whether the host is tuned for the realtime vCPUs of the VMI
+	VirtualMachineInstanceRealtimeReady VirtualMachineInstanceConditionType = "RealtimeReady"
 )
 
 // These are valid reasons for VMI conditions.
@@ -737,6 +877,8 @@ const (
 	VirtualMachineInstanceReasonHostDeviceNotMigratable = "HostDeviceNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses Secure Encrypted Virtualization (SEV)
 	VirtualMachineInstanceReasonSEVNotMigratable = "SEVNotLiveMigratable"
+	// Reason means that VMI is not live migratable because it uses Intel Trust Domain Extensions (TDX)
+	VirtualMachineInstanceReasonTDXNotMigratable = "TDXNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses IBM Secure Execution
 	VirtualMachineInstanceReasonSecureExecutionNotMigratable = "SecureExecutionNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses HyperV Reenlightenment while TSC Frequency is not available
@@ -759,6 +901,11 @@ const (
 
 	// Indicates that automatic migration is pending
 	VirtualMachineInstanceReasonAutoMigrationPending = "AutoMigrationPending"
+
+	// Reason means that all the realtime tuning checks passed for the VMI
+	VirtualMachineInstanceReasonRealtimeTuned = "RealtimeTuned"
+	// Reason means that some realtime tuning checks failed for the VMI, the condition message lists them
+	VirtualMachineInstanceReasonRealtimeNotTuned = "RealtimeNotTuned"
 )
 
 const (
@@ -1227,6 +1374,8 @@ const (
 	MemfdMemoryBackend         string = "kubevirt.io/memfd"
 
 	MigrationSelectorLabel = "kubevirt.io/vmi-name"
+	// This label is set on the virt-launcher pods of VMIs belonging to a shared disk group
+	SharedDiskGroupLabel string = "kubevirt.io/shared-disk-group"
 	// RestoreRunStrategy is how to restore the run strategy of the VMI
 	RestoreRunStrategy = "kubevirt.io/restore-run-strategy"
 
@@ -1254,9 +1403,24 @@ const (
 	// For more info: https://libvirt.org/kbase/debuglogs.html
 	CustomLibvirtLogFiltersAnnotation string = "kubevirt.io/libvirt-log-filters"
 
+	// GuestAgentPollingAnnotation overrides the cluster wide guest agent polling configuration for a VMI.
+	// The value is a JSON encoded GuestAgentPollingConfiguration, for example '{"cpuStatsInterval":"30s"}'.
+	GuestAgentPollingAnnotation string = "kubevirt.io/guest-agent-polling"
+
+	// GuaranteedMemoryAnnotation sets the amount of guest memory, as a quantity, which the memory overcommit
+	// controller never reclaims from a VMI. It overrides the cluster wide guaranteedMemoryPercent.
+	GuaranteedMemoryAnnotation string = "kubevirt.io/guaranteed-memory"
+
 	// RealtimeLabel marks the node as capable of running realtime workloads
 	RealtimeLabel string = "kubevirt.io/realtime"
 
+	// RealtimeTunedLabel marks the node as tuned for realtime workloads: CPUs are isolated, the timer
+	// tick is disabled on them and interrupts are kept away from them
+	RealtimeTunedLabel string = "kubevirt.io/realtime-tuned"
+
+	// RealtimeTuningLabel is the prefix of the labels reporting the result of each realtime tuning check of the node
+	RealtimeTuningLabel string = "realtime-tuning.node.kubevirt.io/"
+
 	// VirtualMachineUnpaused is a custom pod condition set for the virt-launcher pod.
 	// It's used as a readiness gate to prevent paused VMs from being marked as ready.
 	VirtualMachineUnpaused k8sv1.PodConditionType = "kubevirt.io/virtual-machine-unpaused"
@@ -1267,6 +1431,12 @@ const (
 	// SEVESLabel marks the node as capable of running workloads with SEV-ES
 	SEVESLabel string = "kubevirt.io/sev-es"
 
+	// SEVSNPLabel marks the node as capable of running workloads with SEV-SNP
+	SEVSNPLabel string = "kubevirt.io/sev-snp"
+
+	// TDXLabel marks the node as capable of running workloads with Intel TDX
+	TDXLabel string = "kubevirt.io/tdx"
+
 	// SecureExecutionLabel marks the node as capable of running workloads with IBM Secure Execution
 	SecureExecutionLabel string = "kubevirt.io/s390-pv"
 
@@ -1285,6 +1455,22 @@ const (
 	KSMSleepMsBaselineOverride string = "kubevirt.io/ksm-sleep-ms-baseline-override"
 	KSMFreePercentOverride     string = "kubevirt.io/ksm-free-percent-override"
 
+	// KSMMergeAnnotation opts the guest memory of a VMI in ("true") or out ("false") of KSM merging.
+	// It takes precedence over the KSMMergeLabel of the namespace.
+	KSMMergeAnnotation string = "kubevirt.io/ksm-merge"
+	// KSMMergeLabel opts the guest memory of the VMIs of a namespace in ("true") or out ("false") of KSM merging.
+	KSMMergeLabel string = "kubevirt.io/ksm-merge"
+
+	// HibernationRestoreAnnotation is set by the VirtualMachine controller on a VMI which has to be
+	// started from the state saved when its VirtualMachine was hibernated.
+	HibernationRestoreAnnotation string = "kubevirt.io/hibernation-restore"
+	// HibernationDiscardStateAnnotation is set by the VirtualMachine controller on a VMI which has to
+	// boot and delete the state saved when its VirtualMachine was hibernated.
+	HibernationDiscardStateAnnotation string = "kubevirt.io/hibernation-discard-state"
+	// LiveForkIdentityResetCommandAnnotation holds the command, as a JSON array, which virt-launcher runs via the
+	// guest agent after a live fork resumed from the saved state of its source. Set by the clone controller.
+	LiveForkIdentityResetCommandAnnotation string = "kubevirt.io/live-fork-identity-reset-command"
+
 	// InstancetypeAnnotation is the name of a VirtualMachineInstancetype
 	InstancetypeAnnotation string = "kubevirt.io/instancetype-name"
 
@@ -1720,6 +1906,117 @@ func (m *VirtualMachineInstanceMigration) IsDecentralized() bool {
 		(m.Spec.SendTo == nil && m.Spec.Receive != nil)
 }
 
+// VirtualMachineDiskTask runs an offline libguestfs operation against the volumes of a stopped VirtualMachine.
+// The VirtualMachine can not be started while the task is running.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+type VirtualMachineDiskTask struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VirtualMachineDiskTaskSpec   `json:"spec" valid:"required"`
+	Status            VirtualMachineDiskTaskStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineDiskTaskList is a list of VirtualMachineDiskTasks
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineDiskTaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineDiskTask `json:"items"`
+}
+
+type VirtualMachineDiskTaskSpec struct {
+	// The name of the VirtualMachine whose volumes are processed. The VirtualMachine must exist in the
+	// namespace of the task and must be stopped.
+	VMName string `json:"vmName" valid:"required"`
+	// Volumes restricts the task to the named persistentVolumeClaim and dataVolume volumes of the VirtualMachine.
+	// All of them are processed if empty. Disks are attached in the order of the VirtualMachine volumes.
+	// +optional
+	// +listType=atomic
+	Volumes []string `json:"volumes,omitempty"`
+	// Operation is the libguestfs operation to run
+	Operation VirtualMachineDiskTaskOperation `json:"operation"`
+	// Sysprep configures the Sysprep operation
+	// +optional
+	Sysprep *DiskTaskSysprep `json:"sysprep,omitempty"`
+	// Customize configures the Customize operation
+	// +optional
+	Customize *DiskTaskCustomize `json:"customize,omitempty"`
+	// ResizeFilesystem configures the ResizeFilesystem operation
+	// +optional
+	ResizeFilesystem *DiskTaskResizeFilesystem `json:"resizeFilesystem,omitempty"`
+}
+
+// VirtualMachineDiskTaskOperation is the libguestfs operation run by a VirtualMachineDiskTask
+type VirtualMachineDiskTaskOperation string
+
+const (
+	// DiskTaskOperationSparsify makes the disks sparse in place with virt-sparsify
+	DiskTaskOperationSparsify VirtualMachineDiskTaskOperation = "Sparsify"
+	// DiskTaskOperationSysprep resets the guest with virt-sysprep
+	DiskTaskOperationSysprep VirtualMachineDiskTaskOperation = "Sysprep"
+	// DiskTaskOperationCustomize runs virt-customize commands against the guest
+	DiskTaskOperationCustomize VirtualMachineDiskTaskOperation = "Customize"
+	// DiskTaskOperationResizeFilesystem grows a partition and its filesystem to the end of the disk
+	DiskTaskOperationResizeFilesystem VirtualMachineDiskTaskOperation = "ResizeFilesystem"
+)
+
+type DiskTaskSysprep struct {
+	// Operations enables only the listed virt-sysprep operations, the default operations run if empty
+	// +optional
+	// +listType=atomic
+	Operations []string `json:"operations,omitempty"`
+}
+
+type DiskTaskCustomize struct {
+	// Commands are virt-customize commands, as accepted one per line by --commands-from-file
+	// +listType=atomic
+	Commands []string `json:"commands"`
+}
+
+type DiskTaskResizeFilesystem struct {
+	// Device is the filesystem to grow as seen by libguestfs, e.g. /dev/sda2.
+	// If it is a partition, the partition is first grown to the end of the disk.
+	Device string `json:"device"`
+}
+
+// VirtualMachineDiskTaskStatus reports the progress and the result of a VirtualMachineDiskTask
+type VirtualMachineDiskTaskStatus struct {
+	Phase VirtualMachineDiskTaskPhase `json:"phase,omitempty"`
+	// The pod running the libguestfs tools
+	PodName string `json:"podName,omitempty"`
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+	// Message describes the current state or the result of the task
+	Message string `json:"message,omitempty"`
+	// Logs holds the last lines of the output of the task once it finished
+	Logs string `json:"logs,omitempty"`
+}
+
+// VirtualMachineDiskTaskPhase is the phase of a VirtualMachineDiskTask
+type VirtualMachineDiskTaskPhase string
+
+const (
+	DiskTaskPhaseUnset VirtualMachineDiskTaskPhase = ""
+	// The task waits for the VirtualMachine and its volumes to be available
+	DiskTaskPending VirtualMachineDiskTaskPhase = "Pending"
+	// The VirtualMachine is locked and the libguestfs pod is running
+	DiskTaskRunning VirtualMachineDiskTaskPhase = "Running"
+	// The operation completed successfully
+	DiskTaskSucceeded VirtualMachineDiskTaskPhase = "Succeeded"
+	// The operation failed
+	DiskTaskFailed VirtualMachineDiskTaskPhase = "Failed"
+)
+
+// IsFinal returns true if the task will not change anymore
+func (t *VirtualMachineDiskTask) IsFinal() bool {
+	return t.Status.Phase == DiskTaskSucceeded || t.Status.Phase == DiskTaskFailed
+}
+
 // Deprecated for removal in v2, please use VirtualMachineInstanceType and VirtualMachinePreference instead.
 //
 // VirtualMachineInstancePreset defines a VMI spec.domain to be applied to all VMIs that match the provided label selector
@@ -1950,6 +2247,8 @@ type VirtualMachineStatus struct {
 	SnapshotInProgress *string `json:"snapshotInProgress,omitempty"`
 	// RestoreInProgress is the name of the VirtualMachineRestore currently executing
 	RestoreInProgress *string `json:"restoreInProgress,omitempty"`
+	// DiskTaskInProgress is the name of the VirtualMachineDiskTask currently processing the volumes of the VM
+	DiskTaskInProgress *string `json:"diskTaskInProgress,omitempty"`
 	// Created indicates if the virtual machine is created in the cluster
 	Created bool `json:"created,omitempty"`
 	// Ready indicates if the virtual machine is running and ready
@@ -2012,6 +2311,29 @@ type VirtualMachineStatus struct {
 	//+nullable
 	//+optional
 	PreferenceRef *InstancetypeStatusRef `json:"preferenceRef,omitempty"`
+
+	// SecureBootKeys reports the SecureBoot keys last enrolled in the persistent EFI NVRAM of the VM
+	// +nullable
+	// +optional
+	SecureBootKeys *SecureBootKeysStatus `json:"secureBootKeys,omitempty"`
+
+	// Hibernation reports the last hibernation of the VM and the restoring of its saved state
+	// +nullable
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty"`
+
+	// PersistentReservations reports the SCSI persistent reservation state of the LUNs with reservation of the running VMI
+	// +listType=atomic
+	// +optional
+	PersistentReservations []VolumePersistentReservation `json:"persistentReservations,omitempty"`
+}
+
+// VolumePersistentReservation shows the SCSI persistent reservation state of a volume
+type VolumePersistentReservation struct {
+	// Name is the name of the volume
+	Name string `json:"name"`
+	// Reservation is the persistent reservation state of the volume
+	Reservation PersistentReservationStatus `json:"reservation"`
 }
 
 type ControllerRevisionRef struct {
@@ -2544,6 +2866,10 @@ type StartOptions struct {
 	// Indicates that VM will be started in paused state.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+	// Indicates that the VM boots instead of resuming from the state saved by its last hibernation,
+	// and that the saved state is deleted.
+	// +optional
+	DiscardHibernationState bool `json:"discardHibernationState,omitempty"`
 	// When present, indicates that modifications should not be
 	// persisted. An invalid or unrecognized dryRun directive will
 	// result in an error response and no further processing of the
@@ -2585,6 +2911,9 @@ type UnpauseOptions struct {
 const (
 	StartRequestDataPausedKey  string = "paused"
 	StartRequestDataPausedTrue string = "true"
+
+	StartRequestDataDiscardHibernationStateKey  string = "discardHibernationState"
+	StartRequestDataDiscardHibernationStateTrue string = "true"
 )
 
 // StopOptions may be provided when deleting an API object.
@@ -2604,6 +2933,20 @@ type StopOptions struct {
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,2,rep,name=dryRun"`
 }
 
+// HibernateOptions may be provided on hibernate request.
+type HibernateOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+}
+
 // MigrateOptions may be provided on migrate request.
 type MigrateOptions struct {
 	metav1.TypeMeta `json:",inline"`
@@ -2749,6 +3092,51 @@ const (
 	MemoryDumpFailed MemoryDumpPhase = "Failed"
 )
 
+// Hibernation configures where the state of a hibernated VirtualMachineInstance is saved.
+type Hibernation struct {
+	// ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to.
+	// It must be large enough to hold the guest memory and must not be used by a volume of the VMI.
+	ClaimName string `json:"claimName"`
+}
+
+// HibernationStatus represents the saving of the state of a VirtualMachineInstance
+// and its restoring when the VirtualMachine is started again.
+type HibernationStatus struct {
+	// Phase represents the hibernation phase
+	Phase HibernationPhase `json:"phase"`
+	// QEMUVersion is the version of QEMU the state was saved with
+	// +optional
+	QEMUVersion string `json:"qemuVersion,omitempty"`
+	// MachineType is the machine type the state was saved with
+	// +optional
+	MachineType string `json:"machineType,omitempty"`
+	// StartTimestamp represents the time the current phase started
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp represents the time the state was saved or restored
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Message is a detailed message about failure of the saving or restoring
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type HibernationPhase string
+
+const (
+	// The state of the VMI is being saved
+	HibernationSaving HibernationPhase = "Saving"
+	// The state of the VMI is saved and the VMI is stopped
+	HibernationSaved HibernationPhase = "Saved"
+	// The VMI is being started from the saved state
+	HibernationRestoring HibernationPhase = "Restoring"
+	// The VMI was started from the saved state
+	HibernationRestored HibernationPhase = "Restored"
+	// Saving the state failed, or restoring the saved state failed. A state which is not compatible with
+	// the VMI is kept and the VMI does not boot until the state is discarded.
+	HibernationFailed HibernationPhase = "Failed"
+)
+
 // AddVolumeOptions is provided when dynamically hot plugging a volume and disk
 type AddVolumeOptions struct {
 	// Name represents the name that will be used to map the
@@ -2778,6 +3166,108 @@ type VSOCKOptions struct {
 	UseTLS     *bool  `json:"useTLS,omitempty"`
 }
 
+// GuestFileOptions selects the guest file which is read or written through the guest agent
+type GuestFileOptions struct {
+	// Path is the absolute path of the file in the guest
+	Path string `json:"path"`
+	// MaxBytes limits the size of the file which is copied.
+	// Defaults to 16MiB and must not exceed 64MiB.
+	// +optional
+	MaxBytes int64 `json:"maxBytes,omitempty"`
+}
+
+const (
+	// GuestFileDefaultMaxBytes is the size limit of a guest file copy when none is requested
+	GuestFileDefaultMaxBytes int64 = 16 * 1024 * 1024
+	// GuestFileMaxBytes is the largest guest file which can be copied through the guest agent
+	GuestFileMaxBytes int64 = 64 * 1024 * 1024
+)
+
+// GuestExecOptions describes a command which is executed in the guest through the guest agent
+type GuestExecOptions struct {
+	// Command is the path of the executable in the guest
+	Command string `json:"command"`
+	// Args are passed to the command
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the command may run before the request fails.
+	// Defaults to 60 and must not exceed 3600.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GuestExecOutput is one message of the result of a guest command.
+// The guest agent only reports the output of a command once it exited, so the output arrives
+// with the exit code in the last message, which either reports the exit code or an error.
+type GuestExecOutput struct {
+	// Stdout is the output the command wrote to its standard output
+	// +optional
+	Stdout []byte `json:"stdout,omitempty"`
+	// Stderr is the output the command wrote to its standard error
+	// +optional
+	Stderr []byte `json:"stderr,omitempty"`
+	// Exited is set on the last message once the command exited
+	// +optional
+	Exited bool `json:"exited,omitempty"`
+	// ExitCode is the exit code of the command
+	// +optional
+	ExitCode int32 `json:"exitCode,omitempty"`
+	// Error reports why the command could not be completed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+const (
+	// GuestExecDefaultTimeoutSeconds is the timeout of a guest command when none is requested
+	GuestExecDefaultTimeoutSeconds int32 = 60
+	// GuestExecMaxTimeoutSeconds is the longest timeout of a guest command
+	GuestExecMaxTimeoutSeconds int32 = 3600
+)
+
+// ConsoleType is a console of a VirtualMachineInstance which can be accessed through the console gateway
+type ConsoleType string
+
+const (
+	// ConsoleTypeVNC is the VNC console, to be used with noVNC
+	ConsoleTypeVNC ConsoleType = "vnc"
+	// ConsoleTypeSerial is the serial console, to be used with xterm.js
+	ConsoleTypeSerial ConsoleType = "serial"
+)
+
+// ConsoleTokenOptions requests a token for the console gateway of virt-api
+type ConsoleTokenOptions struct {
+	// Console is the console the token gives access to, either vnc or serial
+	Console ConsoleType `json:"console"`
+	// ExpirationSeconds is the time the token is valid for.
+	// Defaults to 300 and must be between 30 and 3600.
+	// +optional
+	ExpirationSeconds int64 `json:"expirationSeconds,omitempty"`
+}
+
+const (
+	// ConsoleTokenDefaultExpirationSeconds is the time a console token is valid for when none is requested
+	ConsoleTokenDefaultExpirationSeconds int64 = 300
+	// ConsoleTokenMinExpirationSeconds is the shortest time a console token can be valid for
+	ConsoleTokenMinExpirationSeconds int64 = 30
+	// ConsoleTokenMaxExpirationSeconds is the longest time a console token can be valid for
+	ConsoleTokenMaxExpirationSeconds int64 = 3600
+)
+
+// ConsoleToken gives access to a console of a single VirtualMachineInstance through the
+// console gateway of virt-api, without further authentication, until it expires
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ConsoleToken struct {
+	metav1.TypeMeta `json:",inline"`
+	// Token is the signed token
+	Token string `json:"token"`
+	// Path is the path of the console gateway endpoint on virt-api, including the token
+	Path string `json:"path"`
+	// ExpirationTimestamp is the time the token expires at
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp"`
+}
+
 // RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk
 type RemoveVolumeOptions struct {
 	// Name represents the name that maps to both the disk and volume that
@@ -2875,6 +3365,10 @@ type KubeVirtConfiguration struct {
 	VMStateStorageClass   string                 `json:"vmStateStorageClass,omitempty"`
 	VirtualMachineOptions *VirtualMachineOptions `json:"virtualMachineOptions,omitempty"`
 
+	// VMStateEncryption enables the encryption at rest of the PVCs created to preserve VM state, like TPM and EFI.
+	// +optional
+	VMStateEncryption *VMStateEncryption `json:"vmStateEncryption,omitempty"`
+
 	// KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).
 	KSMConfiguration *KSMConfiguration `json:"ksmConfiguration,omitempty"`
 
@@ -2899,6 +3393,84 @@ type KubeVirtConfiguration struct {
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
+
+	// GuestAgentPolling configures how often virt-launcher polls data from the guest agent.
+	// It can be overridden per VirtualMachineInstance with the kubevirt.io/guest-agent-polling annotation.
+	// +optional
+	GuestAgentPolling *GuestAgentPollingConfiguration `json:"guestAgentPolling,omitempty"`
+
+	// MemoryOvercommitController configures the virt-handler controller which reclaims unused guest memory
+	// through the memory balloon when a node is under memory pressure.
+	// It is only active when the MemoryOvercommitController feature gate is enabled.
+	// +optional
+	MemoryOvercommitController *MemoryOvercommitControllerConfiguration `json:"memoryOvercommitController,omitempty"`
+
+	// SEVSNPAttestation configures how virt-handler gathers the evidence for the attestation of SEV-SNP guests.
+	// +optional
+	SEVSNPAttestation *SEVSNPAttestationConfiguration `json:"sevSNPAttestation,omitempty"`
+}
+
+// MemoryOvercommitControllerConfiguration holds the settings of the memory overcommit controller.
+// Unset fields keep their defaults.
+type MemoryOvercommitControllerConfiguration struct {
+	// Interval is how often the guest memory targets are adjusted. Defaults to 10s.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// HostMemoryPressureThreshold is the percentage of used node memory above which guest memory is reclaimed.
+	// Reclaimed memory is given back to the guests once the node memory usage drops below it. Defaults to 80.
+	// +optional
+	HostMemoryPressureThreshold *int `json:"hostMemoryPressureThreshold,omitempty"`
+	// GuaranteedMemoryPercent is the percentage of the guest memory which is never reclaimed from a VMI. Defaults to 50.
+	// It can be overridden per VirtualMachineInstance with the kubevirt.io/guaranteed-memory annotation.
+	// +optional
+	GuaranteedMemoryPercent *int `json:"guaranteedMemoryPercent,omitempty"`
+}
+
+// SEVSNPAttestationConfiguration holds the settings of the SEV-SNP attestation info.
+type SEVSNPAttestationConfiguration struct {
+	// KeyDistributionServiceURL is the base URL of the AMD Key Distribution Service or of a mirror of it, which
+	// virt-handler fetches the VCEK certificates from. Defaults to https://kdsintf.amd.com.
+	// +optional
+	KeyDistributionServiceURL string `json:"keyDistributionServiceURL,omitempty"`
+	// DisableVCEKChainFetch keeps virt-handler from fetching the VCEK certificates, e.g. in air-gapped clusters.
+	// The verifier then fetches them itself, by the chip ID and TCB version of the attestation info.
+	// +optional
+	DisableVCEKChainFetch bool `json:"disableVCEKChainFetch,omitempty"`
+}
+
+// GuestAgentPollingConfiguration holds the intervals used to poll the guest agent.
+// Unset intervals keep their defaults.
+type GuestAgentPollingConfiguration struct {
+	// SysInterval is the interval to poll the guest OS info, hostname and timezone. Defaults to 120s.
+	// +optional
+	SysInterval *metav1.Duration `json:"sysInterval,omitempty"`
+	// InterfacesInterval is the interval to poll the guest network interfaces. Defaults to the SysInterval.
+	// +optional
+	InterfacesInterval *metav1.Duration `json:"interfacesInterval,omitempty"`
+	// FilesystemInterval is the interval to poll the guest filesystems. Defaults to 300s.
+	// +optional
+	FilesystemInterval *metav1.Duration `json:"filesystemInterval,omitempty"`
+	// UserInterval is the interval to poll the users logged into the guest. Defaults to 10s.
+	// +optional
+	UserInterval *metav1.Duration `json:"userInterval,omitempty"`
+	// VersionInterval is the interval to poll the guest agent version and supported commands. Defaults to 300s.
+	// +optional
+	VersionInterval *metav1.Duration `json:"versionInterval,omitempty"`
+	// FSFreezeStatusInterval is the interval to poll the guest filesystem freeze status. Defaults to 5s.
+	// +optional
+	FSFreezeStatusInterval *metav1.Duration `json:"fsFreezeStatusInterval,omitempty"`
+	// DisksInterval is the interval to poll the guest disks, reported in the VMI status.
+	// Disabled when unset or zero.
+	// +optional
+	DisksInterval *metav1.Duration `json:"disksInterval,omitempty"`
+	// CPUStatsInterval is the interval to poll the guest CPU statistics, exposed as metrics.
+	// Disabled when unset or zero.
+	// +optional
+	CPUStatsInterval *metav1.Duration `json:"cpuStatsInterval,omitempty"`
+	// DiskStatsInterval is the interval to poll the guest disk I/O statistics, exposed as metrics.
+	// Disabled when unset or zero.
+	// +optional
+	DiskStatsInterval *metav1.Duration `json:"diskStatsInterval,omitempty"`
 }
 
 type InstancetypeConfiguration struct {
@@ -3012,6 +3584,36 @@ type SeccompConfiguration struct {
 	VirtualMachineInstanceProfile *VirtualMachineInstanceProfile `json:"virtualMachineInstanceProfile,omitempty"`
 }
 
+// VMStateEncryption configures where the keys encrypting the VM state PVCs come from.
+// Exactly one of SecretName and KMS has to be set.
+type VMStateEncryption struct {
+	// SecretName is the name of a Secret, in the namespace of each VM, holding the keys which encrypt the VM state.
+	// Every entry of the Secret is a 32 byte key named by its key ID, except for the entry "active"
+	// which holds the ID of the key used to encrypt new state. To rotate the key, add a new key and
+	// point "active" to it; the previous keys are still used to decrypt existing state until it is rewritten.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// KMS wraps a data key per VM with a key management service instead of encrypting the state with the keys of a Secret.
+	// +optional
+	KMS *VMStateKMS `json:"kms,omitempty"`
+}
+
+type VMStateKMSProvider string
+
+const (
+	// VMStateKMSProviderLocal wraps the data keys with key encryption keys from a Secret, in place of an external KMS
+	VMStateKMSProviderLocal VMStateKMSProvider = "local"
+)
+
+// VMStateKMS configures the KMS plugin wrapping the data keys of the VM state.
+type VMStateKMS struct {
+	// Provider is the KMS plugin. Only "local" is supported.
+	Provider VMStateKMSProvider `json:"provider"`
+	// KeySecretName is the name of a Secret, in the namespace of each VM, holding the key encryption keys
+	// of the local provider, in the same format as VMStateEncryption.SecretName.
+	KeySecretName string `json:"keySecretName"`
+}
+
 // VirtualMachineOptions holds the cluster level information regarding the virtual machine.
 type VirtualMachineOptions struct {
 	// DisableFreePageReporting disable the free page reporting of
@@ -3245,6 +3847,11 @@ type KSMConfiguration struct {
 	// Empty NodeLabelSelector will enable ksm for every node.
 	// +optional
 	NodeLabelSelector *metav1.LabelSelector `json:"nodeLabelSelector,omitempty"`
+	// MemoryMergeByDefault defines whether KSM merges the guest memory of the VMIs which neither opt in nor out
+	// with the kubevirt.io/ksm-merge annotation, or the kubevirt.io/ksm-merge label of their namespace.
+	// Defaults to true
+	// +optional
+	MemoryMergeByDefault *bool `json:"memoryMergeByDefault,omitempty"`
 }
 
 // NetworkConfiguration holds network options
@@ -3543,6 +4150,167 @@ type SEVSecretOptions struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+// SEVSNPAttestationInfo contains the evidence needed to verify the attestation reports of a SEV-SNP guest.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SEVSNPAttestationInfo struct {
+	metav1.TypeMeta `json:",inline"`
+	// Hex encoded ID of the chip the guest runs on.
+	ChipID string `json:"chipID,omitempty"`
+	// Name of the processor product line as used by the AMD Key Distribution Service, e.g. Milan or Genoa.
+	Product string `json:"product,omitempty"`
+	// TCB version the platform firmware reports to the guest, which its VCEK is derived from.
+	ReportedTCB uint64 `json:"reportedTCB,omitempty"`
+	// SEV-SNP policy of the guest.
+	Policy uint64 `json:"policy,omitempty"`
+	// Base64 encoded data provided by the host, which is included in the attestation reports of the guest.
+	HostData string `json:"hostData,omitempty"`
+	// PEM encoded VCEK, ASK and ARK certificates verifying the attestation reports of the guest.
+	// Empty when they could not be fetched from the AMD Key Distribution Service.
+	VCEKChain string `json:"vcekChain,omitempty"`
+}
+
+// TDXQuoteInfo contains the quote of a trust domain, signed by the quote generation service of the node.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TDXQuoteInfo struct {
+	metav1.TypeMeta `json:",inline"`
+	// Base64 encoded quote of the TDX guest.
+	Quote string `json:"quote,omitempty"`
+}
+
+// VirtualMachineInstanceStats contains the resource usage of a running VirtualMachineInstance, as reported by libvirt.
+// The counters are cumulative since the VirtualMachineInstance started, rates are computed from two samples.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceStats struct {
+	metav1.TypeMeta `json:",inline"`
+	// Time the stats were collected at, precise enough to compute rates from two samples.
+	Timestamp metav1.MicroTime `json:"timestamp"`
+	// CPU time of the VirtualMachineInstance.
+	// +optional
+	CPU *VirtualMachineInstanceCPUStats `json:"cpu,omitempty"`
+	// Stats of the virtual CPUs.
+	// +optional
+	// +listType=atomic
+	VCPUs []VirtualMachineInstanceVCPUStats `json:"vcpus,omitempty"`
+	// Memory usage, most of it is reported by the memory balloon driver of the guest.
+	// +optional
+	Memory *VirtualMachineInstanceMemoryStats `json:"memory,omitempty"`
+	// I/O stats of the disks.
+	// +optional
+	// +listType=atomic
+	Disks []VirtualMachineInstanceDiskStats `json:"disks,omitempty"`
+	// Traffic stats of the network interfaces.
+	// +optional
+	// +listType=atomic
+	Interfaces []VirtualMachineInstanceInterfaceStats `json:"interfaces,omitempty"`
+	// Rate the guest writes to its memory at, in MiB/s. Only set when requested, as it is measured during a second.
+	// +optional
+	DirtyRateMegabytesPerSecond *int64 `json:"dirtyRateMegabytesPerSecond,omitempty"`
+}
+
+// VirtualMachineInstanceCPUStats contains the CPU time of a VirtualMachineInstance, in nanoseconds.
+type VirtualMachineInstanceCPUStats struct {
+	// CPU time of the VirtualMachineInstance.
+	TimeNanoseconds uint64 `json:"timeNanoseconds"`
+	// CPU time spent in user mode.
+	UserNanoseconds uint64 `json:"userNanoseconds"`
+	// CPU time spent in kernel mode.
+	SystemNanoseconds uint64 `json:"systemNanoseconds"`
+}
+
+// VirtualMachineInstanceVCPUStats contains the stats of a virtual CPU, in nanoseconds.
+type VirtualMachineInstanceVCPUStats struct {
+	// Index of the virtual CPU.
+	ID int32 `json:"id"`
+	// State of the virtual CPU, one of Running, Blocked or Offline.
+	// +optional
+	State string `json:"state,omitempty"`
+	// Time the virtual CPU ran on a CPU of the node.
+	TimeNanoseconds uint64 `json:"timeNanoseconds"`
+	// Time the virtual CPU waited on I/O.
+	WaitNanoseconds uint64 `json:"waitNanoseconds"`
+	// Time the virtual CPU was ready to run but waited for a CPU of the node, which the guest sees as steal time.
+	DelayNanoseconds uint64 `json:"delayNanoseconds"`
+}
+
+// VirtualMachineInstanceMemoryStats contains the memory usage of a VirtualMachineInstance, in bytes.
+// The stats the guest does not report are not set.
+type VirtualMachineInstanceMemoryStats struct {
+	// Memory the balloon leaves to the guest.
+	// +optional
+	ActualBalloonBytes uint64 `json:"actualBalloonBytes,omitempty"`
+	// Memory available to the guest.
+	// +optional
+	AvailableBytes uint64 `json:"availableBytes,omitempty"`
+	// Memory the guest can use without swapping.
+	// +optional
+	UsableBytes uint64 `json:"usableBytes,omitempty"`
+	// Memory the guest leaves unused.
+	// +optional
+	UnusedBytes uint64 `json:"unusedBytes,omitempty"`
+	// Resident set size of the QEMU process on the node.
+	// +optional
+	RSSBytes uint64 `json:"rssBytes,omitempty"`
+	// Memory swapped in by the guest.
+	// +optional
+	SwapInBytes uint64 `json:"swapInBytes,omitempty"`
+	// Memory swapped out by the guest.
+	// +optional
+	SwapOutBytes uint64 `json:"swapOutBytes,omitempty"`
+	// Page faults of the guest which needed disk I/O.
+	// +optional
+	MajorFaults uint64 `json:"majorFaults,omitempty"`
+	// Page faults of the guest which did not need disk I/O.
+	// +optional
+	MinorFaults uint64 `json:"minorFaults,omitempty"`
+}
+
+// VirtualMachineInstanceDiskStats contains the I/O stats of a disk of a VirtualMachineInstance.
+type VirtualMachineInstanceDiskStats struct {
+	// Name of the disk.
+	Name string `json:"name"`
+	// Number of read requests.
+	ReadRequests uint64 `json:"readRequests"`
+	// Number of bytes read.
+	ReadBytes uint64 `json:"readBytes"`
+	// Time spent on read requests, in nanoseconds.
+	ReadTimeNanoseconds uint64 `json:"readTimeNanoseconds"`
+	// Number of write requests.
+	WriteRequests uint64 `json:"writeRequests"`
+	// Number of bytes written.
+	WriteBytes uint64 `json:"writeBytes"`
+	// Time spent on write requests, in nanoseconds.
+	WriteTimeNanoseconds uint64 `json:"writeTimeNanoseconds"`
+	// Number of flush requests.
+	FlushRequests uint64 `json:"flushRequests"`
+	// Time spent on flush requests, in nanoseconds.
+	FlushTimeNanoseconds uint64 `json:"flushTimeNanoseconds"`
+}
+
+// VirtualMachineInstanceInterfaceStats contains the traffic stats of a network interface of a VirtualMachineInstance.
+type VirtualMachineInstanceInterfaceStats struct {
+	// Name of the interface.
+	Name string `json:"name"`
+	// Number of bytes received.
+	RxBytes uint64 `json:"rxBytes"`
+	// Number of packets received.
+	RxPackets uint64 `json:"rxPackets"`
+	// Number of receive errors.
+	RxErrors uint64 `json:"rxErrors"`
+	// Number of received packets dropped.
+	RxDropped uint64 `json:"rxDropped"`
+	// Number of bytes transmitted.
+	TxBytes uint64 `json:"txBytes"`
+	// Number of packets transmitted.
+	TxPackets uint64 `json:"txPackets"`
+	// Number of transmit errors.
+	TxErrors uint64 `json:"txErrors"`
+	// Number of transmitted packets dropped.
+	TxDropped uint64 `json:"txDropped"`
+}
+
 // ObjectGraphNode represents an individual node in the graph.
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
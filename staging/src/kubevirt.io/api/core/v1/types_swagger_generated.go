@@ -28,12 +28,14 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"topologySpreadConstraints":     "TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology\ndomains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.\n+optional\n+patchMergeKey=topologyKey\n+patchStrategy=merge\n+listType=map\n+listMapKey=topologyKey\n+listMapKey=whenUnsatisfiable",
 		"evictionStrategy":              "EvictionStrategy describes the strategy to follow when a node drain occurs.\nThe possible options are:\n- \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.\n- \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown.\n- \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\".\n- \"External\": the VirtualMachineInstance will be protected and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.\n+optional",
 		"startStrategy":                 "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.\n\n+optional",
+		"hibernation":                   "Hibernation configures the PersistentVolumeClaim the memory and device state of the\nVirtualMachineInstance is saved to when its VirtualMachine is hibernated.\n+optional",
 		"terminationGracePeriodSeconds": "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
 		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
 		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"hostname":                      "Specifies the hostname of the vmi\nIf not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.\n+optional",
 		"subdomain":                     "If specified, the fully qualified vmi hostname will be \"<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>\".\nIf not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,\nno matter if the vmi itself can pick up a hostname.\n+optional",
+		"sharedDiskGroup":               "SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with\nSCSI persistent reservation, e.g. the nodes of a guest failover cluster.\nAll members of a group must use the same shareable reservation LUNs and are\nscheduled on different nodes.\n+optional",
 		"networks":                      "List of networks that can be attached to a vm's virtual interface.\n+kubebuilder:validation:MaxItems:=256",
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
@@ -82,9 +84,36 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"selinuxContext":                "SELinuxContext is the actual SELinux context of the virt-launcher pod\n+optional",
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
+		"vcpus":                         "Vcpus shows how many vCPUs are requested from and plugged into the guest.\nBoth differ while the guest releases hot-unplugged vCPUs.\n+optional",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
 		"deviceStatus":                  "DeviceStatus reflects the state of devices requested in spec.domain.devices. This is an optional field available\nonly when DRA feature gate is enabled\nThis field will only be populated if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n+optional",
+		"guestDisks":                    "GuestDisks lists the disks reported by the guest agent.\nIt is only populated when polling of the guest disks is enabled in the guest agent polling configuration.\n+listType=atomic\n+optional",
+		"secureBootKeys":                "SecureBootKeys reports the SecureBoot keys enrolled in the EFI NVRAM\nof the VMI when custom SecureBoot keys are requested.\n+optional",
+		"hibernation":                   "Hibernation reports the saving of the state of the VMI when its VirtualMachine\nis hibernated, or the restoring of the state the VMI was started from.\n+optional",
+	}
+}
+
+func (SecureBootKeysStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":    "SecureBootKeysStatus lists the fingerprints of the SecureBoot keys enrolled in the EFI NVRAM.\nCertificates are identified by the hex encoded SHA-256 hash of their DER encoding,\nrevoked binaries in dbx by their hex encoded SHA-256 hash.",
+		"pk":  "PK is the fingerprint of the platform key\n+listType=atomic\n+optional",
+		"kek": "KEK are the fingerprints of the key exchange keys\n+listType=atomic\n+optional",
+		"db":  "DB are the fingerprints of the allowed signature database\n+listType=atomic\n+optional",
+		"dbx": "DBX are the fingerprints of the forbidden signature database\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestDisk) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineInstanceGuestDisk describes a disk as seen from inside the guest",
+		"name":         "Name is the device name of the disk in the guest",
+		"partition":    "Partition is true when the device is a partition of another disk\n+optional",
+		"serial":       "Serial is the serial number of the disk\n+optional",
+		"bus":          "Bus is the bus type of the disk as reported by the guest\n+optional",
+		"alias":        "Alias is the name the guest uses for the disk, like a LVM logical volume name\n+optional",
+		"volumeName":   "VolumeName is the name of the VMI volume backing the disk, if known\n+optional",
+		"dependencies": "Dependencies lists the names of the devices this disk depends on\n+listType=atomic\n+optional",
 	}
 }
 
@@ -125,7 +154,26 @@ func (StorageMigratedVolumeInfo) SwaggerDoc() map[string]string {
 		"":                   "StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration",
 		"volumeName":         "VolumeName is the name of the volume that is being migrated",
 		"sourcePVCInfo":      "SourcePVCInfo contains the information about the source PVC",
+		"sourceVolume":       "SourceVolume contains the source of the migrated volume when it isn't backed by a PVC\n+optional",
 		"destinationPVCInfo": "DestinationPVCInfo contains the information about the destination PVC",
+		"progress":           "Progress reports the progress of the copy of the volume to the destination\n+optional",
+	}
+}
+
+func (MigratedSourceVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "MigratedSourceVolume represents a source volume of the volume migration which isn't backed by a PVC.\nOnly one of its members may be specified.",
+		"hostDisk":      "HostDisk is the hostDisk the volume is migrated from\n+optional",
+		"containerDisk": "ContainerDisk is the containerDisk the volume is migrated from. The writable overlay is copied\ntogether with its backing image, hence the destination contains the whole disk content.\n+optional",
+	}
+}
+
+func (StorageMigratedVolumeProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "StorageMigratedVolumeProgress reports the progress of the block copy of a migrated volume",
+		"totalBytes":     "TotalBytes is the amount of data to copy to the destination\n+optional",
+		"processedBytes": "ProcessedBytes is the amount of data already copied to the destination\n+optional",
+		"completed":      "Completed indicates if the copy of the volume has completed\n+optional",
 	}
 }
 
@@ -155,6 +203,16 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
+		"persistentReservation":     "PersistentReservation shows the SCSI persistent reservation state of the volume, if the volume is a LUN with reservation",
+	}
+}
+
+func (PersistentReservationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "PersistentReservationStatus shows the SCSI persistent reservation state of a LUN",
+		"keys":   "Keys are the reservation keys registered on the LUN\n+listType=atomic\n+optional",
+		"holder": "Holder is the key of the current reservation holder, empty if the LUN is not reserved\n+optional",
+		"type":   "Type is the type of the current reservation, e.g. WriteExclusiveRegistrantsOnly\n+optional",
 	}
 }
 
@@ -425,6 +483,58 @@ func (VirtualMachineInstanceMigrationStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineDiskTask) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineDiskTask runs an offline libguestfs operation against the volumes of a stopped VirtualMachine.\nThe VirtualMachine can not be started while the task is running.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+genclient",
+	}
+}
+
+func (VirtualMachineDiskTaskList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineDiskTaskList is a list of VirtualMachineDiskTasks\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineDiskTaskSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"vmName":           "The name of the VirtualMachine whose volumes are processed. The VirtualMachine must exist in the\nnamespace of the task and must be stopped.",
+		"volumes":          "Volumes restricts the task to the named persistentVolumeClaim and dataVolume volumes of the VirtualMachine.\nAll of them are processed if empty. Disks are attached in the order of the VirtualMachine volumes.\n+optional\n+listType=atomic",
+		"operation":        "Operation is the libguestfs operation to run",
+		"sysprep":          "Sysprep configures the Sysprep operation\n+optional",
+		"customize":        "Customize configures the Customize operation\n+optional",
+		"resizeFilesystem": "ResizeFilesystem configures the ResizeFilesystem operation\n+optional",
+	}
+}
+
+func (DiskTaskSysprep) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"operations": "Operations enables only the listed virt-sysprep operations, the default operations run if empty\n+optional\n+listType=atomic",
+	}
+}
+
+func (DiskTaskCustomize) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"commands": "Commands are virt-customize commands, as accepted one per line by --commands-from-file\n+listType=atomic",
+	}
+}
+
+func (DiskTaskResizeFilesystem) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"device": "Device is the filesystem to grow as seen by libguestfs, e.g. /dev/sda2.\nIf it is a partition, the partition is first grown to the end of the disk.",
+	}
+}
+
+func (VirtualMachineDiskTaskStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VirtualMachineDiskTaskStatus reports the progress and the result of a VirtualMachineDiskTask",
+		"podName":             "The pod running the libguestfs tools",
+		"startTimestamp":      "+nullable",
+		"completionTimestamp": "+nullable",
+		"message":             "Message describes the current state or the result of the task",
+		"logs":                "Logs holds the last lines of the output of the task once it finished",
+	}
+}
+
 func (VirtualMachineInstancePreset) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":     "Deprecated for removal in v2, please use VirtualMachineInstanceType and VirtualMachinePreference instead.\n\nVirtualMachineInstancePreset defines a VMI spec.domain to be applied to all VMIs that match the provided label selector\nMore info: https://kubevirt.io/user-guide/virtual_machines/presets/#overrides\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+genclient",
@@ -483,6 +593,7 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"":                       "VirtualMachineStatus represents the status returned by the\ncontroller to describe how the VirtualMachine is doing",
 		"snapshotInProgress":     "SnapshotInProgress is the name of the VirtualMachineSnapshot currently executing",
 		"restoreInProgress":      "RestoreInProgress is the name of the VirtualMachineRestore currently executing",
+		"diskTaskInProgress":     "DiskTaskInProgress is the name of the VirtualMachineDiskTask currently processing the volumes of the VM",
 		"created":                "Created indicates if the virtual machine is created in the cluster",
 		"ready":                  "Ready indicates if the virtual machine is running and ready",
 		"printableStatus":        "PrintableStatus is a human readable, high-level representation of the status of the virtual machine\n+kubebuilder:default=Stopped",
@@ -498,6 +609,17 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"volumeUpdateState":      "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
+		"secureBootKeys":         "SecureBootKeys reports the SecureBoot keys last enrolled in the persistent EFI NVRAM of the VM\n+nullable\n+optional",
+		"hibernation":            "Hibernation reports the last hibernation of the VM and the restoring of its saved state\n+nullable\n+optional",
+		"persistentReservations": "PersistentReservations reports the SCSI persistent reservation state of the LUNs with reservation of the running VMI\n+listType=atomic\n+optional",
+	}
+}
+
+func (VolumePersistentReservation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VolumePersistentReservation shows the SCSI persistent reservation state of a volume",
+		"name":        "Name is the name of the volume",
+		"reservation": "Reservation is the persistent reservation state of the volume",
 	}
 }
 
@@ -703,9 +825,10 @@ func (RestartOptions) SwaggerDoc() map[string]string {
 
 func (StartOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "StartOptions may be provided on start request.",
-		"paused": "Indicates that VM will be started in paused state.\n+optional",
-		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"":                        "StartOptions may be provided on start request.",
+		"paused":                  "Indicates that VM will be started in paused state.\n+optional",
+		"discardHibernationState": "Indicates that the VM boots instead of resuming from the state saved by its last hibernation,\nand that the saved state is deleted.\n+optional",
+		"dryRun":                  "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
 	}
 }
 
@@ -731,6 +854,13 @@ func (StopOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (HibernateOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "HibernateOptions may be provided on hibernate request.",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (MigrateOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "MigrateOptions may be provided on migrate request.",
@@ -814,6 +944,25 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	}
 }
 
+func (Hibernation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "Hibernation configures where the state of a hibernated VirtualMachineInstance is saved.",
+		"claimName": "ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to.\nIt must be large enough to hold the guest memory and must not be used by a volume of the VMI.",
+	}
+}
+
+func (HibernationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "HibernationStatus represents the saving of the state of a VirtualMachineInstance\nand its restoring when the VirtualMachine is started again.",
+		"phase":          "Phase represents the hibernation phase",
+		"qemuVersion":    "QEMUVersion is the version of QEMU the state was saved with\n+optional",
+		"machineType":    "MachineType is the machine type the state was saved with\n+optional",
+		"startTimestamp": "StartTimestamp represents the time the current phase started\n+optional",
+		"endTimestamp":   "EndTimestamp represents the time the state was saved or restored\n+optional",
+		"message":        "Message is a detailed message about failure of the saving or restoring\n+optional",
+	}
+}
+
 func (AddVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":             "AddVolumeOptions is provided when dynamically hot plugging a volume and disk",
@@ -832,6 +981,51 @@ func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (GuestFileOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "GuestFileOptions selects the guest file which is read or written through the guest agent",
+		"path":     "Path is the absolute path of the file in the guest",
+		"maxBytes": "MaxBytes limits the size of the file which is copied.\nDefaults to 16MiB and must not exceed 64MiB.\n+optional",
+	}
+}
+
+func (GuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestExecOptions describes a command which is executed in the guest through the guest agent",
+		"command":        "Command is the path of the executable in the guest",
+		"args":           "Args are passed to the command\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time the command may run before the request fails.\nDefaults to 60 and must not exceed 3600.\n+optional",
+	}
+}
+
+func (GuestExecOutput) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "GuestExecOutput is one message of the result of a guest command.\nThe guest agent only reports the output of a command once it exited, so the output arrives\nwith the exit code in the last message, which either reports the exit code or an error.",
+		"stdout":   "Stdout is the output the command wrote to its standard output\n+optional",
+		"stderr":   "Stderr is the output the command wrote to its standard error\n+optional",
+		"exited":   "Exited is set on the last message once the command exited\n+optional",
+		"exitCode": "ExitCode is the exit code of the command\n+optional",
+		"error":    "Error reports why the command could not be completed\n+optional",
+	}
+}
+
+func (ConsoleTokenOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "ConsoleTokenOptions requests a token for the console gateway of virt-api",
+		"console":           "Console is the console the token gives access to, either vnc or serial",
+		"expirationSeconds": "ExpirationSeconds is the time the token is valid for.\nDefaults to 300 and must be between 30 and 3600.\n+optional",
+	}
+}
+
+func (ConsoleToken) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "ConsoleToken gives access to a console of a single VirtualMachineInstance through the\nconsole gateway of virt-api, without further authentication, until it expires\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"token":               "Token is the signed token",
+		"path":                "Path is the path of the console gateway endpoint on virt-api, including the token",
+		"expirationTimestamp": "ExpirationTimestamp is the time the token expires at",
+	}
+}
+
 func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
@@ -876,12 +1070,48 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"supportContainerResources":          "+listType=map\n+listMapKey=type\nSupportContainerResources specifies the resource requirements for various types of supporting containers such as container disks/virtiofs/sidecars and hotplug attachment pods. If omitted a sensible default will be supplied.",
 		"supportedGuestAgentVersions":        "deprecated",
 		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.",
+		"vmStateEncryption":                  "VMStateEncryption enables the encryption at rest of the PVCs created to preserve VM state, like TPM and EFI.\n+optional",
 		"ksmConfiguration":                   "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
 		"liveUpdateConfiguration":            "LiveUpdateConfiguration holds defaults for live update features",
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how live-updatable fields, like CPU sockets, memory,\ntolerations, and affinity, are propagated from a VM to its VMI.\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
+		"guestAgentPolling":                  "GuestAgentPolling configures how often virt-launcher polls data from the guest agent.\nIt can be overridden per VirtualMachineInstance with the kubevirt.io/guest-agent-polling annotation.\n+optional",
+		"memoryOvercommitController":         "MemoryOvercommitController configures the virt-handler controller which reclaims unused guest memory\nthrough the memory balloon when a node is under memory pressure.\nIt is only active when the MemoryOvercommitController feature gate is enabled.\n+optional",
+		"sevSNPAttestation":                  "SEVSNPAttestation configures how virt-handler gathers the evidence for the attestation of SEV-SNP guests.\n+optional",
+	}
+}
+
+func (MemoryOvercommitControllerConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "MemoryOvercommitControllerConfiguration holds the settings of the memory overcommit controller.\nUnset fields keep their defaults.",
+		"interval":                    "Interval is how often the guest memory targets are adjusted. Defaults to 10s.\n+optional",
+		"hostMemoryPressureThreshold": "HostMemoryPressureThreshold is the percentage of used node memory above which guest memory is reclaimed.\nReclaimed memory is given back to the guests once the node memory usage drops below it. Defaults to 80.\n+optional",
+		"guaranteedMemoryPercent":     "GuaranteedMemoryPercent is the percentage of the guest memory which is never reclaimed from a VMI. Defaults to 50.\nIt can be overridden per VirtualMachineInstance with the kubevirt.io/guaranteed-memory annotation.\n+optional",
+	}
+}
+
+func (SEVSNPAttestationConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "SEVSNPAttestationConfiguration holds the settings of the SEV-SNP attestation info.",
+		"keyDistributionServiceURL": "KeyDistributionServiceURL is the base URL of the AMD Key Distribution Service or of a mirror of it, which\nvirt-handler fetches the VCEK certificates from. Defaults to https://kdsintf.amd.com.\n+optional",
+		"disableVCEKChainFetch":     "DisableVCEKChainFetch keeps virt-handler from fetching the VCEK certificates, e.g. in air-gapped clusters.\nThe verifier then fetches them itself, by the chip ID and TCB version of the attestation info.\n+optional",
+	}
+}
+
+func (GuestAgentPollingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "GuestAgentPollingConfiguration holds the intervals used to poll the guest agent.\nUnset intervals keep their defaults.",
+		"sysInterval":            "SysInterval is the interval to poll the guest OS info, hostname and timezone. Defaults to 120s.\n+optional",
+		"interfacesInterval":     "InterfacesInterval is the interval to poll the guest network interfaces. Defaults to the SysInterval.\n+optional",
+		"filesystemInterval":     "FilesystemInterval is the interval to poll the guest filesystems. Defaults to 300s.\n+optional",
+		"userInterval":           "UserInterval is the interval to poll the users logged into the guest. Defaults to 10s.\n+optional",
+		"versionInterval":        "VersionInterval is the interval to poll the guest agent version and supported commands. Defaults to 300s.\n+optional",
+		"fsFreezeStatusInterval": "FSFreezeStatusInterval is the interval to poll the guest filesystem freeze status. Defaults to 5s.\n+optional",
+		"disksInterval":          "DisksInterval is the interval to poll the guest disks, reported in the VMI status.\nDisabled when unset or zero.\n+optional",
+		"cpuStatsInterval":       "CPUStatsInterval is the interval to poll the guest CPU statistics, exposed as metrics.\nDisabled when unset or zero.\n+optional",
+		"diskStatsInterval":      "DiskStatsInterval is the interval to poll the guest disk I/O statistics, exposed as metrics.\nDisabled when unset or zero.\n+optional",
 	}
 }
 
@@ -934,6 +1164,22 @@ func (SeccompConfiguration) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VMStateEncryption) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VMStateEncryption configures where the keys encrypting the VM state PVCs come from.\nExactly one of SecretName and KMS has to be set.",
+		"secretName": "SecretName is the name of a Secret, in the namespace of each VM, holding the keys which encrypt the VM state.\nEvery entry of the Secret is a 32 byte key named by its key ID, except for the entry \"active\"\nwhich holds the ID of the key used to encrypt new state. To rotate the key, add a new key and\npoint \"active\" to it; the previous keys are still used to decrypt existing state until it is rewritten.\n+optional",
+		"kms":        "KMS wraps a data key per VM with a key management service instead of encrypting the state with the keys of a Secret.\n+optional",
+	}
+}
+
+func (VMStateKMS) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VMStateKMS configures the KMS plugin wrapping the data keys of the VM state.",
+		"provider":      "Provider is the KMS plugin. Only \"local\" is supported.",
+		"keySecretName": "KeySecretName is the name of a Secret, in the namespace of each VM, holding the key encryption keys\nof the local provider, in the same format as VMStateEncryption.SecretName.",
+	}
+}
+
 func (VirtualMachineOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                         "VirtualMachineOptions holds the cluster level information regarding the virtual machine.",
@@ -1061,8 +1307,9 @@ func (NodeMediatedDeviceTypesConfig) SwaggerDoc() map[string]string {
 
 func (KSMConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                  "KSMConfiguration holds information about KSM.\n+k8s:openapi-gen=true",
-		"nodeLabelSelector": "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\n+optional",
+		"":                     "KSMConfiguration holds information about KSM.\n+k8s:openapi-gen=true",
+		"nodeLabelSelector":    "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\n+optional",
+		"memoryMergeByDefault": "MemoryMergeByDefault defines whether KSM merges the guest memory of the VMIs which neither opt in nor out\nwith the kubevirt.io/ksm-merge annotation, or the kubevirt.io/ksm-merge label of their namespace.\nDefaults to true\n+optional",
 	}
 }
 
@@ -1182,6 +1429,103 @@ func (SEVSecretOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SEVSNPAttestationInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "SEVSNPAttestationInfo contains the evidence needed to verify the attestation reports of a SEV-SNP guest.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"chipID":      "Hex encoded ID of the chip the guest runs on.",
+		"product":     "Name of the processor product line as used by the AMD Key Distribution Service, e.g. Milan or Genoa.",
+		"reportedTCB": "TCB version the platform firmware reports to the guest, which its VCEK is derived from.",
+		"policy":      "SEV-SNP policy of the guest.",
+		"hostData":    "Base64 encoded data provided by the host, which is included in the attestation reports of the guest.",
+		"vcekChain":   "PEM encoded VCEK, ASK and ARK certificates verifying the attestation reports of the guest.\nEmpty when they could not be fetched from the AMD Key Distribution Service.",
+	}
+}
+
+func (TDXQuoteInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "TDXQuoteInfo contains the quote of a trust domain, signed by the quote generation service of the node.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"quote": "Base64 encoded quote of the TDX guest.",
+	}
+}
+
+func (VirtualMachineInstanceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "VirtualMachineInstanceStats contains the resource usage of a running VirtualMachineInstance, as reported by libvirt.\nThe counters are cumulative since the VirtualMachineInstance started, rates are computed from two samples.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"timestamp":                   "Time the stats were collected at, precise enough to compute rates from two samples.",
+		"cpu":                         "CPU time of the VirtualMachineInstance.\n+optional",
+		"vcpus":                       "Stats of the virtual CPUs.\n+optional\n+listType=atomic",
+		"memory":                      "Memory usage, most of it is reported by the memory balloon driver of the guest.\n+optional",
+		"disks":                       "I/O stats of the disks.\n+optional\n+listType=atomic",
+		"interfaces":                  "Traffic stats of the network interfaces.\n+optional\n+listType=atomic",
+		"dirtyRateMegabytesPerSecond": "Rate the guest writes to its memory at, in MiB/s. Only set when requested, as it is measured during a second.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceCPUStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "VirtualMachineInstanceCPUStats contains the CPU time of a VirtualMachineInstance, in nanoseconds.",
+		"timeNanoseconds":   "CPU time of the VirtualMachineInstance.",
+		"userNanoseconds":   "CPU time spent in user mode.",
+		"systemNanoseconds": "CPU time spent in kernel mode.",
+	}
+}
+
+func (VirtualMachineInstanceVCPUStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineInstanceVCPUStats contains the stats of a virtual CPU, in nanoseconds.",
+		"id":               "Index of the virtual CPU.",
+		"state":            "State of the virtual CPU, one of Running, Blocked or Offline.\n+optional",
+		"timeNanoseconds":  "Time the virtual CPU ran on a CPU of the node.",
+		"waitNanoseconds":  "Time the virtual CPU waited on I/O.",
+		"delayNanoseconds": "Time the virtual CPU was ready to run but waited for a CPU of the node, which the guest sees as steal time.",
+	}
+}
+
+func (VirtualMachineInstanceMemoryStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineInstanceMemoryStats contains the memory usage of a VirtualMachineInstance, in bytes.\nThe stats the guest does not report are not set.",
+		"actualBalloonBytes": "Memory the balloon leaves to the guest.\n+optional",
+		"availableBytes":     "Memory available to the guest.\n+optional",
+		"usableBytes":        "Memory the guest can use without swapping.\n+optional",
+		"unusedBytes":        "Memory the guest leaves unused.\n+optional",
+		"rssBytes":           "Resident set size of the QEMU process on the node.\n+optional",
+		"swapInBytes":        "Memory swapped in by the guest.\n+optional",
+		"swapOutBytes":       "Memory swapped out by the guest.\n+optional",
+		"majorFaults":        "Page faults of the guest which needed disk I/O.\n+optional",
+		"minorFaults":        "Page faults of the guest which did not need disk I/O.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceDiskStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "VirtualMachineInstanceDiskStats contains the I/O stats of a disk of a VirtualMachineInstance.",
+		"name":                 "Name of the disk.",
+		"readRequests":         "Number of read requests.",
+		"readBytes":            "Number of bytes read.",
+		"readTimeNanoseconds":  "Time spent on read requests, in nanoseconds.",
+		"writeRequests":        "Number of write requests.",
+		"writeBytes":           "Number of bytes written.",
+		"writeTimeNanoseconds": "Time spent on write requests, in nanoseconds.",
+		"flushRequests":        "Number of flush requests.",
+		"flushTimeNanoseconds": "Time spent on flush requests, in nanoseconds.",
+	}
+}
+
+func (VirtualMachineInstanceInterfaceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceInterfaceStats contains the traffic stats of a network interface of a VirtualMachineInstance.",
+		"name":      "Name of the interface.",
+		"rxBytes":   "Number of bytes received.",
+		"rxPackets": "Number of packets received.",
+		"rxErrors":  "Number of receive errors.",
+		"rxDropped": "Number of received packets dropped.",
+		"txBytes":   "Number of bytes transmitted.",
+		"txPackets": "Number of packets transmitted.",
+		"txErrors":  "Number of transmit errors.",
+		"txDropped": "Number of transmitted packets dropped.",
+	}
+}
+
 func (ObjectGraphNode) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "ObjectGraphNode represents an individual node in the graph.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -195,6 +195,11 @@ func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.PersistentState != nil {
+		in, out := &in.PersistentState, &out.PersistentState
+		*out = new(PersistentStatePolicy)
+		**out = **in
+	}
 	return
 }
 
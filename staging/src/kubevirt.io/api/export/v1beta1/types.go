@@ -67,8 +67,27 @@ type VirtualMachineExportSpec struct {
 	// If this field is omitted, a reasonable default is applied.
 	// +optional
 	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+
+	// PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,
+	// is exported along with the volumes.
+	// Defaults to Include
+	// +optional
+	PersistentState *PersistentStatePolicy `json:"persistentState,omitempty"`
 }
 
+// PersistentStatePolicy defines how to handle the persistent state of a VM,
+// the vTPM and EFI NVRAM kept on its backend storage
+type PersistentStatePolicy string
+
+const (
+	// PersistentStateInclude defines a PersistentStatePolicy which exports the persistent state.
+	// This is the default policy.
+	PersistentStateInclude PersistentStatePolicy = "Include"
+
+	// PersistentStateExclude defines a PersistentStatePolicy which leaves the persistent state out
+	PersistentStateExclude PersistentStatePolicy = "Exclude"
+)
+
 // VirtualMachineExportPhase is the current phase of the VirtualMachineExport
 type VirtualMachineExportPhase string
 
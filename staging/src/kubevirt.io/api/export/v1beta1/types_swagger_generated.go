@@ -18,9 +18,10 @@ func (VirtualMachineExportList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
-		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
-		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"":                "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
+		"tokenSecretRef":  "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
+		"ttlDuration":     "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"persistentState": "PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,\nis exported along with the volumes.\nDefaults to Include\n+optional",
 	}
 }
 
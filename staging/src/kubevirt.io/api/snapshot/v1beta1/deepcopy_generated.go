@@ -247,6 +247,11 @@ func (in *VirtualMachineRestoreSpec) DeepCopyInto(out *VirtualMachineRestoreSpec
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PersistentState != nil {
+		in, out := &in.PersistentState, &out.PersistentState
+		*out = new(PersistentStatePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -514,6 +519,11 @@ func (in *VirtualMachineSnapshotSpec) DeepCopyInto(out *VirtualMachineSnapshotSp
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.PersistentState != nil {
+		in, out := &in.PersistentState, &out.PersistentState
+		*out = new(PersistentStatePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -72,8 +72,27 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,
+	// is part of the snapshot.
+	// Defaults to Include
+	// +optional
+	PersistentState *PersistentStatePolicy `json:"persistentState,omitempty"`
 }
 
+// PersistentStatePolicy defines how to handle the persistent state of a VM,
+// the vTPM and EFI NVRAM kept on its backend storage
+type PersistentStatePolicy string
+
+const (
+	// PersistentStateInclude defines a PersistentStatePolicy which includes the persistent state.
+	// This is the default policy.
+	PersistentStateInclude PersistentStatePolicy = "Include"
+
+	// PersistentStateExclude defines a PersistentStatePolicy which leaves the persistent state out
+	PersistentStateExclude PersistentStatePolicy = "Exclude"
+)
+
 // Indication is a way to indicate the state of the vm when taking the snapshot
 type Indication string
 
@@ -376,6 +395,13 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,
+	// is restored. When excluded, an existing target keeps its current state and a new target
+	// starts with a new state.
+	// Defaults to Include
+	// +optional
+	PersistentState *PersistentStatePolicy `json:"persistentState,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the status for a VirtualMachineRestore resource
@@ -14,6 +14,7 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"persistentState": "PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,\nis part of the snapshot.\nDefaults to Include\n+optional",
 	}
 }
 
@@ -145,6 +146,7 @@ func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 		"volumeRestorePolicy":    "+optional",
 		"volumeRestoreOverrides": "VolumeRestoreOverrides gives the option to change properties of each restored volume\nFor example, specifying the name of the restored volume, or adding labels/annotations to it\n+optional\n+listType=atomic",
 		"patches":                "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"persistentState":        "PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM,\nis restored. When excluded, an existing target keeps its current state and a new target\nstarts with a new state.\nDefaults to Include\n+optional",
 	}
 }
 
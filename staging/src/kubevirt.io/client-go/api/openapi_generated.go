@@ -325,6 +325,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/clone/v1alpha1.VirtualMachineCloneStatus":                                   schema_kubevirtio_api_clone_v1alpha1_VirtualMachineCloneStatus(ref),
 		"kubevirt.io/api/clone/v1alpha1.VirtualMachineCloneTemplateFilters":                          schema_kubevirtio_api_clone_v1alpha1_VirtualMachineCloneTemplateFilters(ref),
 		"kubevirt.io/api/clone/v1beta1.Condition":                                                    schema_kubevirtio_api_clone_v1beta1_Condition(ref),
+		"kubevirt.io/api/clone/v1beta1.LiveFork":                                                     schema_kubevirtio_api_clone_v1beta1_LiveFork(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineClone":                                          schema_kubevirtio_api_clone_v1beta1_VirtualMachineClone(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineCloneList":                                      schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneList(ref),
 		"kubevirt.io/api/clone/v1beta1.VirtualMachineCloneSpec":                                      schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneSpec(ref),
@@ -359,7 +360,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ComponentConfig":                                                    schema_kubevirtio_api_core_v1_ComponentConfig(ref),
 		"kubevirt.io/api/core/v1.ConfigDriveSSHPublicKeyAccessCredentialPropagation":                 schema_kubevirtio_api_core_v1_ConfigDriveSSHPublicKeyAccessCredentialPropagation(ref),
 		"kubevirt.io/api/core/v1.ConfigMapVolumeSource":                                              schema_kubevirtio_api_core_v1_ConfigMapVolumeSource(ref),
+		"kubevirt.io/api/core/v1.ConsoleToken":                                                       schema_kubevirtio_api_core_v1_ConsoleToken(ref),
+		"kubevirt.io/api/core/v1.ConsoleTokenOptions":                                                schema_kubevirtio_api_core_v1_ConsoleTokenOptions(ref),
 		"kubevirt.io/api/core/v1.ContainerDiskInfo":                                                  schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref),
+		"kubevirt.io/api/core/v1.ContainerDiskOverlay":                                               schema_kubevirtio_api_core_v1_ContainerDiskOverlay(ref),
 		"kubevirt.io/api/core/v1.ContainerDiskSource":                                                schema_kubevirtio_api_core_v1_ContainerDiskSource(ref),
 		"kubevirt.io/api/core/v1.ControllerRevisionRef":                                              schema_kubevirtio_api_core_v1_ControllerRevisionRef(ref),
 		"kubevirt.io/api/core/v1.CustomBlockSize":                                                    schema_kubevirtio_api_core_v1_CustomBlockSize(ref),
@@ -387,6 +391,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.DiskDevice":                                                         schema_kubevirtio_api_core_v1_DiskDevice(ref),
 		"kubevirt.io/api/core/v1.DiskIOThreads":                                                      schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                         schema_kubevirtio_api_core_v1_DiskTarget(ref),
+		"kubevirt.io/api/core/v1.DiskTaskCustomize":                                                  schema_kubevirtio_api_core_v1_DiskTaskCustomize(ref),
+		"kubevirt.io/api/core/v1.DiskTaskResizeFilesystem":                                           schema_kubevirtio_api_core_v1_DiskTaskResizeFilesystem(ref),
+		"kubevirt.io/api/core/v1.DiskTaskSysprep":                                                    schema_kubevirtio_api_core_v1_DiskTaskSysprep(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                   schema_kubevirtio_api_core_v1_DiskVerification(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                               schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
 		"kubevirt.io/api/core/v1.DomainSpec":                                                         schema_kubevirtio_api_core_v1_DomainSpec(ref),
@@ -412,8 +419,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                   schema_kubevirtio_api_core_v1_GenerationStatus(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                              schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                     schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestAgentPollingConfiguration":                                     schema_kubevirtio_api_core_v1_GuestAgentPollingConfiguration(ref),
+		"kubevirt.io/api/core/v1.GuestExecOptions":                                                   schema_kubevirtio_api_core_v1_GuestExecOptions(ref),
+		"kubevirt.io/api/core/v1.GuestExecOutput":                                                    schema_kubevirtio_api_core_v1_GuestExecOutput(ref),
+		"kubevirt.io/api/core/v1.GuestFileOptions":                                                   schema_kubevirtio_api_core_v1_GuestFileOptions(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                          schema_kubevirtio_api_core_v1_HPETTimer(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                            schema_kubevirtio_api_core_v1_Handler(ref),
+		"kubevirt.io/api/core/v1.HibernateOptions":                                                   schema_kubevirtio_api_core_v1_HibernateOptions(ref),
+		"kubevirt.io/api/core/v1.Hibernation":                                                        schema_kubevirtio_api_core_v1_Hibernation(ref),
+		"kubevirt.io/api/core/v1.HibernationStatus":                                                  schema_kubevirtio_api_core_v1_HibernationStatus(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                         schema_kubevirtio_api_core_v1_HostDevice(ref),
 		"kubevirt.io/api/core/v1.HostDisk":                                                           schema_kubevirtio_api_core_v1_HostDisk(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
@@ -458,8 +472,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                 schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
 		"kubevirt.io/api/core/v1.Memory":                                                             schema_kubevirtio_api_core_v1_Memory(ref),
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                             schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
+		"kubevirt.io/api/core/v1.MemoryOvercommitControllerConfiguration":                            schema_kubevirtio_api_core_v1_MemoryOvercommitControllerConfiguration(ref),
 		"kubevirt.io/api/core/v1.MemoryStatus":                                                       schema_kubevirtio_api_core_v1_MemoryStatus(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                     schema_kubevirtio_api_core_v1_MigrateOptions(ref),
+		"kubevirt.io/api/core/v1.MigratedSourceVolume":                                               schema_kubevirtio_api_core_v1_MigratedSourceVolume(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                             schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                      schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                               schema_kubevirtio_api_core_v1_NUMA(ref),
@@ -477,6 +493,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.PauseOptions":                                                       schema_kubevirtio_api_core_v1_PauseOptions(ref),
 		"kubevirt.io/api/core/v1.PciHostDevice":                                                      schema_kubevirtio_api_core_v1_PciHostDevice(ref),
 		"kubevirt.io/api/core/v1.PermittedHostDevices":                                               schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
+		"kubevirt.io/api/core/v1.PersistentReservationStatus":                                        schema_kubevirtio_api_core_v1_PersistentReservationStatus(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo":                                          schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                  schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
 		"kubevirt.io/api/core/v1.PluginBinding":                                                      schema_kubevirtio_api_core_v1_PluginBinding(ref),
@@ -502,6 +519,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SEVMeasurementInfo":                                                 schema_kubevirtio_api_core_v1_SEVMeasurementInfo(ref),
 		"kubevirt.io/api/core/v1.SEVPlatformInfo":                                                    schema_kubevirtio_api_core_v1_SEVPlatformInfo(ref),
 		"kubevirt.io/api/core/v1.SEVPolicy":                                                          schema_kubevirtio_api_core_v1_SEVPolicy(ref),
+		"kubevirt.io/api/core/v1.SEVSNPAttestationConfiguration":                                     schema_kubevirtio_api_core_v1_SEVSNPAttestationConfiguration(ref),
+		"kubevirt.io/api/core/v1.SEVSNPAttestationInfo":                                              schema_kubevirtio_api_core_v1_SEVSNPAttestationInfo(ref),
 		"kubevirt.io/api/core/v1.SEVSecretOptions":                                                   schema_kubevirtio_api_core_v1_SEVSecretOptions(ref),
 		"kubevirt.io/api/core/v1.SEVSessionOptions":                                                  schema_kubevirtio_api_core_v1_SEVSessionOptions(ref),
 		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
@@ -511,14 +530,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ScreenshotOptions":                                                  schema_kubevirtio_api_core_v1_ScreenshotOptions(ref),
 		"kubevirt.io/api/core/v1.SeccompConfiguration":                                               schema_kubevirtio_api_core_v1_SeccompConfiguration(ref),
 		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                 schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
+		"kubevirt.io/api/core/v1.SecureBootKeysSource":                                               schema_kubevirtio_api_core_v1_SecureBootKeysSource(ref),
+		"kubevirt.io/api/core/v1.SecureBootKeysStatus":                                               schema_kubevirtio_api_core_v1_SecureBootKeysStatus(ref),
+		"kubevirt.io/api/core/v1.SerialConsoleRecording":                                             schema_kubevirtio_api_core_v1_SerialConsoleRecording(ref),
 		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                         schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
 		"kubevirt.io/api/core/v1.SoundDevice":                                                        schema_kubevirtio_api_core_v1_SoundDevice(ref),
 		"kubevirt.io/api/core/v1.StartOptions":                                                       schema_kubevirtio_api_core_v1_StartOptions(ref),
 		"kubevirt.io/api/core/v1.StopOptions":                                                        schema_kubevirtio_api_core_v1_StopOptions(ref),
 		"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo":                                          schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref),
+		"kubevirt.io/api/core/v1.StorageMigratedVolumeProgress":                                      schema_kubevirtio_api_core_v1_StorageMigratedVolumeProgress(ref),
 		"kubevirt.io/api/core/v1.SupportContainerResources":                                          schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
 		"kubevirt.io/api/core/v1.SyNICTimer":                                                         schema_kubevirtio_api_core_v1_SyNICTimer(ref),
 		"kubevirt.io/api/core/v1.SysprepSource":                                                      schema_kubevirtio_api_core_v1_SysprepSource(ref),
+		"kubevirt.io/api/core/v1.TDX":                                                                schema_kubevirtio_api_core_v1_TDX(ref),
+		"kubevirt.io/api/core/v1.TDXAttestation":                                                     schema_kubevirtio_api_core_v1_TDXAttestation(ref),
+		"kubevirt.io/api/core/v1.TDXPolicy":                                                          schema_kubevirtio_api_core_v1_TDXPolicy(ref),
+		"kubevirt.io/api/core/v1.TDXQuoteInfo":                                                       schema_kubevirtio_api_core_v1_TDXQuoteInfo(ref),
 		"kubevirt.io/api/core/v1.TLSConfiguration":                                                   schema_kubevirtio_api_core_v1_TLSConfiguration(ref),
 		"kubevirt.io/api/core/v1.TPMDevice":                                                          schema_kubevirtio_api_core_v1_TPMDevice(ref),
 		"kubevirt.io/api/core/v1.Timer":                                                              schema_kubevirtio_api_core_v1_Timer(ref),
@@ -530,25 +557,37 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredential":                                       schema_kubevirtio_api_core_v1_UserPasswordAccessCredential(ref),
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialPropagationMethod":                      schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialPropagationMethod(ref),
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialSource":                                 schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialSource(ref),
+		"kubevirt.io/api/core/v1.VCPUStatus":                                                         schema_kubevirtio_api_core_v1_VCPUStatus(ref),
 		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                 schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
 		"kubevirt.io/api/core/v1.VGPUOptions":                                                        schema_kubevirtio_api_core_v1_VGPUOptions(ref),
 		"kubevirt.io/api/core/v1.VMISelector":                                                        schema_kubevirtio_api_core_v1_VMISelector(ref),
+		"kubevirt.io/api/core/v1.VMStateEncryption":                                                  schema_kubevirtio_api_core_v1_VMStateEncryption(ref),
+		"kubevirt.io/api/core/v1.VMStateKMS":                                                         schema_kubevirtio_api_core_v1_VMStateKMS(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                       schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
 		"kubevirt.io/api/core/v1.VideoDevice":                                                        schema_kubevirtio_api_core_v1_VideoDevice(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                     schema_kubevirtio_api_core_v1_VirtualMachine(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                            schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineDiskTask":                                             schema_kubevirtio_api_core_v1_VirtualMachineDiskTask(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineDiskTaskList":                                         schema_kubevirtio_api_core_v1_VirtualMachineDiskTaskList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineDiskTaskSpec":                                         schema_kubevirtio_api_core_v1_VirtualMachineDiskTaskSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineDiskTaskStatus":                                       schema_kubevirtio_api_core_v1_VirtualMachineDiskTaskStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceCPUStats":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceCPUStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCommonMigrationState":                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceDiskStats":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceDiskStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemDisk":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemList":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestAgentInfo":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestDisk":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestDisk(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUser(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUserList":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceInterfaceStats":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceInterfaceStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceList":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMemoryStats":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceMemoryStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigration":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition":                           schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationList":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref),
@@ -572,8 +611,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetSpec":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetStatus":                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceSpec":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceStats":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceStatus":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec":                                 schema_kubevirtio_api_core_v1_VirtualMachineInstanceTemplateSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceVCPUStats":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceVCPUStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineList":                                                 schema_kubevirtio_api_core_v1_VirtualMachineList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest":                                    schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineOptions":                                              schema_kubevirtio_api_core_v1_VirtualMachineOptions(ref),
@@ -584,6 +625,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineVolumeRequest":                                        schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref),
 		"kubevirt.io/api/core/v1.Volume":                                                             schema_kubevirtio_api_core_v1_Volume(ref),
 		"kubevirt.io/api/core/v1.VolumeMigrationState":                                               schema_kubevirtio_api_core_v1_VolumeMigrationState(ref),
+		"kubevirt.io/api/core/v1.VolumePersistentReservation":                                        schema_kubevirtio_api_core_v1_VolumePersistentReservation(ref),
 		"kubevirt.io/api/core/v1.VolumeSnapshotStatus":                                               schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref),
 		"kubevirt.io/api/core/v1.VolumeSource":                                                       schema_kubevirtio_api_core_v1_VolumeSource(ref),
 		"kubevirt.io/api/core/v1.VolumeStatus":                                                       schema_kubevirtio_api_core_v1_VolumeStatus(ref),
@@ -17240,6 +17282,39 @@ func schema_kubevirtio_api_clone_v1beta1_Condition(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_clone_v1beta1_LiveFork(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LiveFork defines how the target of a live fork takes on its own identity",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"identityResetCommand": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IdentityResetCommand is run in the guest via the guest agent once the target resumed and its interfaces got their own MAC addresses. Example: [\"/usr/local/bin/reset-identity\", \"--hostname\"]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_clone_v1beta1_VirtualMachineClone(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -17450,12 +17525,25 @@ func schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneSpec(ref common.Refe
 							},
 						},
 					},
+					"persistentState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentState defines how the persistent state of the source, its vTPM and EFI NVRAM, is handled. Regenerate lets the target start with a new state, so that it does not share keys sealed by the vTPM of the source. Include copies the state of the source. Defaults to Regenerate",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"liveFork": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LiveFork clones the source including its memory, so that the target resumes where the source was hibernated. A running source is hibernated first and started again once its state is copied. The target keeps the MAC addresses of the source until the guest has resumed. Requires a VirtualMachine source with a hibernation PVC.",
+							Ref:         ref("kubevirt.io/api/clone/v1beta1.LiveFork"),
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference", "kubevirt.io/api/clone/v1beta1.VirtualMachineCloneTemplateFilters"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "kubevirt.io/api/clone/v1beta1.LiveFork", "kubevirt.io/api/clone/v1beta1.VirtualMachineCloneTemplateFilters"},
 	}
 }
 
@@ -18522,6 +18610,87 @@ func schema_kubevirtio_api_core_v1_ConfigMapVolumeSource(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ConsoleToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsoleToken gives access to a console of a single VirtualMachineInstance through the console gateway of virt-api, without further authentication, until it expires",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"token": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Token is the signed token",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the console gateway endpoint on virt-api, including the token",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time the token expires at",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"token", "path", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ConsoleTokenOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsoleTokenOptions requests a token for the console gateway of virt-api",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"console": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Console is the console the token gives access to, either vnc or serial",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the time the token is valid for. Defaults to 300 and must be between 30 and 3600.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"console"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18542,6 +18711,28 @@ func schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref common.ReferenceCallbac
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ContainerDiskOverlay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerDiskOverlay keeps the writable layer of a containerDisk on persistent storage.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of a filesystem PVC in the namespace of the VMI which keeps the overlay, so that writes survive VMI restarts. The overlay is bound to the image it was created for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ContainerDiskSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18579,10 +18770,25 @@ func schema_kubevirtio_api_core_v1_ContainerDiskSource(ref common.ReferenceCallb
 							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
 						},
 					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode defines how the disk is served from the image. Standalone expects a single self-contained disk file. Layered serves a qcow2 backing chain spread over the image layers in place, so that blocks are only read when the guest accesses them. Path, if set, points to the top of the chain. Defaults to Standalone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overlay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overlay configures the writable layer on top of the image. By default the overlay is ephemeral and all writes are lost when the VMI stops.",
+							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskOverlay"),
+						},
+					},
 				},
 				Required: []string{"image"},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.ContainerDiskOverlay"},
 	}
 }
 
@@ -19288,6 +19494,12 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"serialConsoleRecording": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SerialConsoleRecording records the output of the auto-attached default serial console, with the time it was written at, in the asciicast v2 format of asciinema. The serial console is logged when it is recorded.",
+							Ref:         ref("kubevirt.io/api/core/v1.SerialConsoleRecording"),
+						},
+					},
 					"autoattachMemBalloon": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.",
@@ -19434,7 +19646,7 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
+			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SerialConsoleRecording", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
 	}
 }
 
@@ -19572,6 +19784,13 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"growFilesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GrowFilesystem specifies if the partition and the filesystem on the disk are grown through the guest agent after the disk has been expanded. It requires the ExpandDisks feature gate and a disk serial to identify the disk in the guest. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -19660,6 +19879,99 @@ func schema_kubevirtio_api_core_v1_DiskTarget(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit places the disk on the given unit of the SCSI controller, which is the LUN the guest sees. It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DiskTaskCustomize(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"commands": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Commands are virt-customize commands, as accepted one per line by --commands-from-file",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"commands"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DiskTaskResizeFilesystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"device": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Device is the filesystem to grow as seen by libguestfs, e.g. /dev/sda2. If it is a partition, the partition is first grown to the end of the disk.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"device"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DiskTaskSysprep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"operations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations enables only the listed virt-sysprep operations, the default operations run if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -19859,6 +20171,28 @@ func schema_kubevirtio_api_core_v1_DownwardMetrics(ref common.ReferenceCallback)
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metricGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricGroups selects the groups of metrics published to the guest. Defaults to the Host, CPU and Memory groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -19870,6 +20204,28 @@ func schema_kubevirtio_api_core_v1_DownwardMetricsVolumeSource(ref common.Refere
 			SchemaProps: spec.SchemaProps{
 				Description: "DownwardMetricsVolumeSource adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metricGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricGroups selects the groups of metrics published to the guest. Defaults to the Host, CPU and Memory groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -19896,9 +20252,17 @@ func schema_kubevirtio_api_core_v1_EFI(ref common.ReferenceCallback) common.Open
 							Format:      "",
 						},
 					},
+					"secureBootKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecureBootKeys references the certificates enrolled as the SecureBoot keys of the VM instead of the keys shipped with the firmware. They are enrolled when the EFI NVRAM is created at first boot. Requires SecureBoot and Persistent to be enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.SecureBootKeysSource"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.SecureBootKeysSource"},
 	}
 }
 
@@ -20535,6 +20899,201 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestAgentPollingConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgentPollingConfiguration holds the intervals used to poll the guest agent. Unset intervals keep their defaults.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sysInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SysInterval is the interval to poll the guest OS info, hostname and timezone. Defaults to 120s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"interfacesInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfacesInterval is the interval to poll the guest network interfaces. Defaults to the SysInterval.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"filesystemInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FilesystemInterval is the interval to poll the guest filesystems. Defaults to 300s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"userInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserInterval is the interval to poll the users logged into the guest. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"versionInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionInterval is the interval to poll the guest agent version and supported commands. Defaults to 300s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"fsFreezeStatusInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FSFreezeStatusInterval is the interval to poll the guest filesystem freeze status. Defaults to 5s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"disksInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisksInterval is the interval to poll the guest disks, reported in the VMI status. Disabled when unset or zero.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cpuStatsInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUStatsInterval is the interval to poll the guest CPU statistics, exposed as metrics. Disabled when unset or zero.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"diskStatsInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskStatsInterval is the interval to poll the guest disk I/O statistics, exposed as metrics. Disabled when unset or zero.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestExecOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestExecOptions describes a command which is executed in the guest through the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the path of the executable in the guest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are passed to the command",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the time the command may run before the request fails. Defaults to 60 and must not exceed 3600.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"command"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestExecOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestExecOutput is one message of the result of a guest command. The guest agent only reports the output of a command once it exited, so the output arrives with the exit code in the last message, which either reports the exit code or an error.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stdout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stdout is the output the command wrote to its standard output",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"stderr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stderr is the output the command wrote to its standard error",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"exited": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exited is set on the last message once the command exited",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"exitCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExitCode is the exit code of the command",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error reports why the command could not be completed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestFileOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestFileOptions selects the guest file which is read or written through the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the absolute path of the file in the guest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBytes limits the size of the file which is copied. Defaults to 16MiB and must not exceed 64MiB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20600,6 +21159,132 @@ func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_HibernateOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernateOptions may be provided on hibernate request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_Hibernation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Hibernation configures where the state of a hibernated VirtualMachineInstance is saved.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the filesystem PersistentVolumeClaim the state is saved to. It must be large enough to hold the guest memory and must not be used by a volume of the VMI.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HibernationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationStatus represents the saving of the state of a VirtualMachineInstance and its restoring when the VirtualMachine is started again.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase represents the hibernation phase",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"qemuVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QEMUVersion is the version of QEMU the state was saved with",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type the state was saved with",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTimestamp represents the time the current phase started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTimestamp represents the time the state was saved or restored",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a detailed message about failure of the saving or restoring",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21293,6 +21978,13 @@ func schema_kubevirtio_api_core_v1_KSMConfiguration(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"memoryMergeByDefault": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryMergeByDefault defines whether KSM merges the guest memory of the VMIs which neither opt in nor out with the kubevirt.io/ksm-merge annotation, or the kubevirt.io/ksm-merge label of their namespace. Defaults to true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -21793,6 +22485,12 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref: ref("kubevirt.io/api/core/v1.VirtualMachineOptions"),
 						},
 					},
+					"vmStateEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMStateEncryption enables the encryption at rest of the PVCs created to preserve VM state, like TPM and EFI.",
+							Ref:         ref("kubevirt.io/api/core/v1.VMStateEncryption"),
+						},
+					},
 					"ksmConfiguration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
@@ -21830,11 +22528,29 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeConfiguration"),
 						},
 					},
+					"guestAgentPolling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentPolling configures how often virt-launcher polls data from the guest agent. It can be overridden per VirtualMachineInstance with the kubevirt.io/guest-agent-polling annotation.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentPollingConfiguration"),
+						},
+					},
+					"memoryOvercommitController": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryOvercommitController configures the virt-handler controller which reclaims unused guest memory through the memory balloon when a node is under memory pressure. It is only active when the MemoryOvercommitController feature gate is enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryOvercommitControllerConfiguration"),
+						},
+					},
+					"sevSNPAttestation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SEVSNPAttestation configures how virt-handler gathers the evidence for the attestation of SEV-SNP guests.",
+							Ref:         ref("kubevirt.io/api/core/v1.SEVSNPAttestationConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.GuestAgentPollingConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MemoryOvercommitControllerConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SEVSNPAttestationConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VMStateEncryption", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
 	}
 }
 
@@ -22283,11 +22999,17 @@ func schema_kubevirtio_api_core_v1_LaunchSecurity(ref common.ReferenceCallback)
 							Ref:         ref("kubevirt.io/api/core/v1.SEV"),
 						},
 					},
+					"tdx": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Intel Trust Domain Extensions (TDX).",
+							Ref:         ref("kubevirt.io/api/core/v1.TDX"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.SEV"},
+			"kubevirt.io/api/core/v1.SEV", "kubevirt.io/api/core/v1.TDX"},
 	}
 }
 
@@ -22417,6 +23139,13 @@ func schema_kubevirtio_api_core_v1_LunTarget(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit places the LUN on the given unit of the SCSI controller, which is the LUN the guest sees. It keeps the order of the disks stable across restarts and hotplug. Only allowed with bus type scsi.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -22617,6 +23346,41 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MemoryOvercommitControllerConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryOvercommitControllerConfiguration holds the settings of the memory overcommit controller. Unset fields keep their defaults.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is how often the guest memory targets are adjusted. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"hostMemoryPressureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostMemoryPressureThreshold is the percentage of used node memory above which guest memory is reclaimed. Reclaimed memory is given back to the guests once the node memory usage drops below it. Defaults to 80.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"guaranteedMemoryPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuaranteedMemoryPercent is the percentage of the guest memory which is never reclaimed from a VMI. Defaults to 50. It can be overridden per VirtualMachineInstance with the kubevirt.io/guaranteed-memory annotation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22712,6 +23476,33 @@ func schema_kubevirtio_api_core_v1_MigrateOptions(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MigratedSourceVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigratedSourceVolume represents a source volume of the volume migration which isn't backed by a PVC. Only one of its members may be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostDisk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostDisk is the hostDisk the volume is migrated from",
+							Ref:         ref("kubevirt.io/api/core/v1.HostDisk"),
+						},
+					},
+					"containerDisk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerDisk is the containerDisk the volume is migrated from. The writable overlay is copied together with its backing image, hence the destination contains the whole disk content.",
+							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskSource"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.HostDisk"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23415,6 +24206,53 @@ func schema_kubevirtio_api_core_v1_PermittedHostDevices(ref common.ReferenceCall
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PersistentReservationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentReservationStatus shows the SCSI persistent reservation state of a LUN",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Keys are the reservation keys registered on the LUN",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"holder": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Holder is the key of the current reservation holder, empty if the LUN is not reserved",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the current reservation, e.g. WriteExclusiveRegistrantsOnly",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24165,6 +25003,34 @@ func schema_kubevirtio_api_core_v1_SEV(ref common.ReferenceCallback) common.Open
 							Format:      "",
 						},
 					},
+					"idBlock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded SEV-SNP ID block of 96 bytes, holding the expected launch digest and guest policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded SEV-SNP ID authentication information structure of 4096 bytes, signing the ID block. Requires an ID block.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authorKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ID authentication information contains the author key signing the ID key. Requires an ID authentication information structure. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hostData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded 32 bytes of data provided by the host, which are included in the SEV-SNP attestation reports of the guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -24306,6 +25172,116 @@ func schema_kubevirtio_api_core_v1_SEVPolicy(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"secureNestedPaging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SEV-SNP is required. SEV-SNP implies SEV-ES and uses the SEV-SNP guest policy. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"singleSocket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The SEV-SNP guest can only be activated on a single socket. Requires SEV-SNP. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SEVSNPAttestationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SEVSNPAttestationConfiguration holds the settings of the SEV-SNP attestation info.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyDistributionServiceURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyDistributionServiceURL is the base URL of the AMD Key Distribution Service or of a mirror of it, which virt-handler fetches the VCEK certificates from. Defaults to https://kdsintf.amd.com.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"disableVCEKChainFetch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableVCEKChainFetch keeps virt-handler from fetching the VCEK certificates, e.g. in air-gapped clusters. The verifier then fetches them itself, by the chip ID and TCB version of the attestation info.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SEVSNPAttestationInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SEVSNPAttestationInfo contains the evidence needed to verify the attestation reports of a SEV-SNP guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chipID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hex encoded ID of the chip the guest runs on.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"product": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the processor product line as used by the AMD Key Distribution Service, e.g. Milan or Genoa.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reportedTCB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TCB version the platform firmware reports to the guest, which its VCEK is derived from.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SEV-SNP policy of the guest.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"hostData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded data provided by the host, which is included in the attestation reports of the guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vcekChain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PEM encoded VCEK, ASK and ARK certificates verifying the attestation reports of the guest. Empty when they could not be fetched from the AMD Key Distribution Service.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -24567,6 +25543,146 @@ func schema_kubevirtio_api_core_v1_SecretVolumeSource(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SecureBootKeysSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecureBootKeysSource references a Secret or ConfigMap holding the SecureBoot keys. The keys PK, KEK, db and dbx contain the PEM encoded certificates of the respective EFI variable. PK must contain exactly one certificate, KEK and db at least one. dbx is optional and may also list the hex encoded SHA-256 hashes of revoked binaries, one per line.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret references a k8s Secret that contains the SecureBoot keys.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap references a ConfigMap that contains the SecureBoot keys.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SecureBootKeysStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecureBootKeysStatus lists the fingerprints of the SecureBoot keys enrolled in the EFI NVRAM. Certificates are identified by the hex encoded SHA-256 hash of their DER encoding, revoked binaries in dbx by their hex encoded SHA-256 hash.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pk": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PK is the fingerprint of the platform key",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"kek": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "KEK are the fingerprints of the key exchange keys",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"db": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DB are the fingerprints of the allowed signature database",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dbx": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DBX are the fingerprints of the forbidden signature database",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SerialConsoleRecording(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SerialConsoleRecording configures where the serial console is recorded to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of a filesystem PersistentVolumeClaim the recording is written to, in one file per VirtualMachineInstance named after its UID. When not set, the recording is written to the log of the `guest-console-log` container.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24644,6 +25760,13 @@ func schema_kubevirtio_api_core_v1_StartOptions(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"discardHibernationState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the VM boots instead of resuming from the state saved by its last hibernation, and that the saved state is deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"dryRun": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -24745,18 +25868,64 @@ func schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref common.Referenc
 							Ref:         ref("kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"),
 						},
 					},
+					"sourceVolume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceVolume contains the source of the migrated volume when it isn't backed by a PVC",
+							Ref:         ref("kubevirt.io/api/core/v1.MigratedSourceVolume"),
+						},
+					},
 					"destinationPVCInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DestinationPVCInfo contains the information about the destination PVC",
 							Ref:         ref("kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"),
 						},
 					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress reports the progress of the copy of the volume to the destination",
+							Ref:         ref("kubevirt.io/api/core/v1.StorageMigratedVolumeProgress"),
+						},
+					},
 				},
 				Required: []string{"volumeName"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
+			"kubevirt.io/api/core/v1.MigratedSourceVolume", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo", "kubevirt.io/api/core/v1.StorageMigratedVolumeProgress"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_StorageMigratedVolumeProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageMigratedVolumeProgress reports the progress of the block copy of a migrated volume",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytes is the amount of data to copy to the destination",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"processedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProcessedBytes is the amount of data already copied to the destination",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"completed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Completed indicates if the copy of the volume has completed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -24841,6 +26010,116 @@ func schema_kubevirtio_api_core_v1_SysprepSource(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_TDX(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Guest policy flags as defined in the Intel TDX module specification. Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.",
+							Ref:         ref("kubevirt.io/api/core/v1.TDXPolicy"),
+						},
+					},
+					"mrConfigID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded SHA384 digest identifying the guest owner defined configuration of the trust domain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mrOwner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded SHA384 digest identifying the owner of the trust domain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mrOwnerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded SHA384 digest of the owner defined configuration of the trust domain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attestation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the quote generation service of the node is made available to the guest and quotes can be fetched for TD reports of the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.TDXAttestation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.TDXAttestation", "kubevirt.io/api/core/v1.TDXPolicy"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_TDXAttestation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_TDXPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"septVEDisable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable the conversion of EPT violations into #VE exceptions in the guest for accesses to pending private pages. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_TDXQuoteInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TDXQuoteInfo contains the quote of a trust domain, signed by the quote generation service of the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"quote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded quote of the TDX guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_TLSConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25192,6 +26471,36 @@ func schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialSource(ref common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VCPUStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VCPUStatus shows the vCPUs requested from and plugged into the guest",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requested": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requested is the number of vCPUs the guest was asked to use.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"plugged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugged is the number of vCPUs currently plugged into the guest.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"requested", "plugged"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25259,6 +26568,64 @@ func schema_kubevirtio_api_core_v1_VMISelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VMStateEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMStateEncryption configures where the keys encrypting the VM state PVCs come from. Exactly one of SecretName and KMS has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a Secret, in the namespace of each VM, holding the keys which encrypt the VM state. Every entry of the Secret is a 32 byte key named by its key ID, except for the entry \"active\" which holds the ID of the key used to encrypt new state. To rotate the key, add a new key and point \"active\" to it; the previous keys are still used to decrypt existing state until it is rewritten.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMS wraps a data key per VM with a key management service instead of encrypting the state with the keys of a Secret.",
+							Ref:         ref("kubevirt.io/api/core/v1.VMStateKMS"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VMStateKMS"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VMStateKMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VMStateKMS configures the KMS plugin wrapping the data keys of the VM state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider is the KMS plugin. Only \"local\" is supported.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keySecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeySecretName is the name of a Secret, in the namespace of each VM, holding the key encryption keys of the local provider, in the same format as VMStateEncryption.SecretName.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"provider", "keySecretName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25406,178 +26773,520 @@ func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceC
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineDiskTask(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDiskTask runs an offline libguestfs operation against the volumes of a stopped VirtualMachine. The VirtualMachine can not be started while the task is running.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineDiskTaskSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineDiskTaskStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineDiskTaskSpec", "kubevirt.io/api/core/v1.VirtualMachineDiskTaskStatus"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineDiskTaskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDiskTaskList is a list of VirtualMachineDiskTasks",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineDiskTask"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/core/v1.VirtualMachineDiskTask"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineDiskTaskSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vmName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the VirtualMachine whose volumes are processed. The VirtualMachine must exist in the namespace of the task and must be stopped.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes restricts the task to the named persistentVolumeClaim and dataVolume volumes of the VirtualMachine. All of them are processed if empty. Disks are attached in the order of the VirtualMachine volumes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the libguestfs operation to run",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sysprep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sysprep configures the Sysprep operation",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskTaskSysprep"),
+						},
+					},
+					"customize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Customize configures the Customize operation",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskTaskCustomize"),
+						},
+					},
+					"resizeFilesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResizeFilesystem configures the ResizeFilesystem operation",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskTaskResizeFilesystem"),
+						},
+					},
+				},
+				Required: []string{"vmName", "operation"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.DiskTaskCustomize", "kubevirt.io/api/core/v1.DiskTaskResizeFilesystem", "kubevirt.io/api/core/v1.DiskTaskSysprep"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineDiskTaskStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineDiskTaskStatus reports the progress and the result of a VirtualMachineDiskTask",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The pod running the libguestfs tools",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the current state or the result of the task",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logs holds the last lines of the output of the task once it finished",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstance Spec contains the VirtualMachineInstance specification.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineInstanceSpec", "kubevirt.io/api/core/v1.VirtualMachineInstanceStatus"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCPUStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceCPUStats contains the CPU time of a VirtualMachineInstance, in nanoseconds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeNanoseconds": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "CPU time of the VirtualMachineInstance.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"userNanoseconds": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "CPU time spent in user mode.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"systemNanoseconds": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "CPU time spent in kernel mode.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"timeNanoseconds", "userNanoseconds", "systemNanoseconds"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The source node that the VMI originated on",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The source pod that the VMI is originated on",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migrationUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Source VirtualMachineInstanceMigration object associated with this migration",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domainName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the domain on the source libvirt domain",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domainNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace used in the name of the source libvirt domain. Can be used to find and modify paths in the domain",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ip address/fqdn:port combination to use to synchronize the VMI with the target.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"persistentStatePVCName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selinuxContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SELinuxContext is the actual SELinux context of the pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachineInstanceUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstanceUID is the UID of the target virtual machine instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceDiskStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.",
+				Description: "VirtualMachineInstanceDiskStats contains the I/O stats of a disk of a VirtualMachineInstance.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachineInstance Spec contains the VirtualMachineInstance specification.",
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceStatus"),
-						},
-					},
-				},
-				Required: []string{"spec"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineInstanceSpec", "kubevirt.io/api/core/v1.VirtualMachineInstanceStatus"},
-	}
-}
-
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"node": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The source node that the VMI originated on",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"pod": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The source pod that the VMI is originated on",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"migrationUID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The Source VirtualMachineInstanceMigration object associated with this migration",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"domainName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The name of the domain on the source libvirt domain",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"domainNamespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace used in the name of the source libvirt domain. Can be used to find and modify paths in the domain",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"syncAddress": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The ip address/fqdn:port combination to use to synchronize the VMI with the target.",
+							Default:     "",
+							Description: "Name of the disk.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"persistentStatePVCName": {
+					"readRequests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
-							Type:        []string{"string"},
-							Format:      "",
+							Default:     0,
+							Description: "Number of read requests.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"selinuxContext": {
+					"readBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SELinuxContext is the actual SELinux context of the pod",
-							Type:        []string{"string"},
-							Format:      "",
+							Default:     0,
+							Description: "Number of bytes read.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"virtualMachineInstanceUID": {
+					"readTimeNanoseconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachineInstanceUID is the UID of the target virtual machine instance",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default:     0,
+							Description: "Time spent on read requests, in nanoseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"status": {
+					"writeRequests": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default:     0,
+							Description: "Number of write requests.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"lastProbeTime": {
+					"writeBytes": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default:     0,
+							Description: "Number of bytes written.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"lastTransitionTime": {
+					"writeTimeNanoseconds": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default:     0,
+							Description: "Time spent on write requests, in nanoseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"reason": {
+					"flushRequests": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default:     0,
+							Description: "Number of flush requests.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"message": {
+					"flushTimeNanoseconds": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default:     0,
+							Description: "Time spent on flush requests, in nanoseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"name", "readRequests", "readBytes", "readTimeNanoseconds", "writeRequests", "writeBytes", "writeTimeNanoseconds", "flushRequests", "flushTimeNanoseconds"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -25854,6 +27563,83 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestDisk(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceGuestDisk describes a disk as seen from inside the guest",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the device name of the disk in the guest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"partition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Partition is true when the device is a partition of another disk",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"serial": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Serial is the serial number of the disk",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bus is the bus type of the disk as reported by the guest",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alias is the name the guest uses for the disk, like a LVM logical volume name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the VMI volume backing the disk, if known",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dependencies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies lists the names of the devices this disk depends on",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26004,6 +27790,92 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceInterfaceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceInterfaceStats contains the traffic stats of a network interface of a VirtualMachineInstance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default:     "",
+							Description: "Name of the interface.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of bytes received.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rxPackets": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of packets received.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rxErrors": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of receive errors.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rxDropped": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of received packets dropped.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"txBytes": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of bytes transmitted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"txPackets": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of packets transmitted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"txErrors": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of transmit errors.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"txDropped": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Number of transmitted packets dropped.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name", "rxBytes", "rxPackets", "rxErrors", "rxDropped", "txBytes", "txPackets", "txErrors", "txDropped"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26053,6 +27925,82 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref common.Referen
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMemoryStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMemoryStats contains the memory usage of a VirtualMachineInstance, in bytes. The stats the guest does not report are not set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"actualBalloonBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory the balloon leaves to the guest.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"availableBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory available to the guest.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"usableBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory the guest can use without swapping.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"unusedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory the guest leaves unused.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rssBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resident set size of the QEMU process on the node.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"swapInBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory swapped in by the guest.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"swapOutBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory swapped out by the guest.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"majorFaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Page faults of the guest which needed disk I/O.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minorFaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Page faults of the guest which did not need disk I/O.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -27455,6 +29403,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							Format:      "",
 						},
 					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation configures the PersistentVolumeClaim the memory and device state of the VirtualMachineInstance is saved to when its VirtualMachine is hibernated.",
+							Ref:         ref("kubevirt.io/api/core/v1.Hibernation"),
+						},
+					},
 					"terminationGracePeriodSeconds": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
@@ -27502,6 +29456,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							Format:      "",
 						},
 					},
+					"sharedDiskGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedDiskGroup adds the vmi to a group of VMIs sharing the same LUN disks with SCSI persistent reservation, e.g. the nodes of a guest failover cluster. All members of a group must use the same shareable reservation LUNs and are scheduled on different nodes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"networks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of networks that can be attached to a vm's virtual interface.",
@@ -27583,7 +29544,120 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.Volume"},
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.Hibernation", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.Volume"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceStats contains the resource usage of a running VirtualMachineInstance, as reported by libvirt. The counters are cumulative since the VirtualMachineInstance started, rates are computed from two samples.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Default:     map[string]interface{}{},
+							Description: "Time the stats were collected at, precise enough to compute rates from two samples.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"),
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU time of the VirtualMachineInstance.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceCPUStats"),
+						},
+					},
+					"vcpus": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Stats of the virtual CPUs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceVCPUStats"),
+									},
+								},
+							},
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory usage, most of it is reported by the memory balloon driver of the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMemoryStats"),
+						},
+					},
+					"disks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "I/O stats of the disks.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceDiskStats"),
+									},
+								},
+							},
+						},
+					},
+					"interfaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Traffic stats of the network interfaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceInterfaceStats"),
+									},
+								},
+							},
+						},
+					},
+					"dirtyRateMegabytesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate the guest writes to its memory at, in MiB/s. Only set when requested, as it is measured during a second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime", "kubevirt.io/api/core/v1.VirtualMachineInstanceCPUStats", "kubevirt.io/api/core/v1.VirtualMachineInstanceDiskStats", "kubevirt.io/api/core/v1.VirtualMachineInstanceInterfaceStats", "kubevirt.io/api/core/v1.VirtualMachineInstanceMemoryStats", "kubevirt.io/api/core/v1.VirtualMachineInstanceVCPUStats"},
 	}
 }
 
@@ -27805,6 +29879,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.CPUTopology"),
 						},
 					},
+					"vcpus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vcpus shows how many vCPUs are requested from and plugged into the guest. Both differ while the guest releases hot-unplugged vCPUs.",
+							Ref:         ref("kubevirt.io/api/core/v1.VCPUStatus"),
+						},
+					},
 					"memory": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Memory shows various informations about the VirtualMachine memory.",
@@ -27836,11 +29916,42 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.DeviceStatus"),
 						},
 					},
+					"guestDisks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestDisks lists the disks reported by the guest agent. It is only populated when polling of the guest disks is enabled in the guest agent polling configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceGuestDisk"),
+									},
+								},
+							},
+						},
+					},
+					"secureBootKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecureBootKeys reports the SecureBoot keys enrolled in the EFI NVRAM of the VMI when custom SecureBoot keys are requested.",
+							Ref:         ref("kubevirt.io/api/core/v1.SecureBootKeysStatus"),
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation reports the saving of the state of the VMI when its VirtualMachine is hibernated, or the restoring of the state the VMI was started from.",
+							Ref:         ref("kubevirt.io/api/core/v1.HibernationStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.DeviceStatus", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
+			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.DeviceStatus", "kubevirt.io/api/core/v1.HibernationStatus", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.SecureBootKeysStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VCPUStatus", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestDisk", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
 	}
 }
 
@@ -27870,6 +29981,59 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceTemplateSpec(ref common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceVCPUStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceVCPUStats contains the stats of a virtual CPU, in nanoseconds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Index of the virtual CPU.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State of the virtual CPU, one of Running, Blocked or Offline.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeNanoseconds": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Time the virtual CPU ran on a CPU of the node.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"waitNanoseconds": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Time the virtual CPU waited on I/O.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"delayNanoseconds": {
+						SchemaProps: spec.SchemaProps{
+							Default:     0,
+							Description: "Time the virtual CPU was ready to run but waited for a CPU of the node, which the guest sees as steal time.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"id", "timeNanoseconds", "waitNanoseconds", "delayNanoseconds"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -28177,6 +30341,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"diskTaskInProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskTaskInProgress is the name of the VirtualMachineDiskTask currently processing the volumes of the VM",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"created": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Created indicates if the virtual machine is created in the cluster",
@@ -28310,11 +30481,42 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeStatusRef"),
 						},
 					},
+					"secureBootKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecureBootKeys reports the SecureBoot keys last enrolled in the persistent EFI NVRAM of the VM",
+							Ref:         ref("kubevirt.io/api/core/v1.SecureBootKeysStatus"),
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation reports the last hibernation of the VM and the restoring of its saved state",
+							Ref:         ref("kubevirt.io/api/core/v1.HibernationStatus"),
+						},
+					},
+					"persistentReservations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentReservations reports the SCSI persistent reservation state of the LUNs with reservation of the running VMI",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VolumePersistentReservation"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
+			"kubevirt.io/api/core/v1.HibernationStatus", "kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.SecureBootKeysStatus", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumePersistentReservation", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
 	}
 }
 
@@ -28491,6 +30693,37 @@ func schema_kubevirtio_api_core_v1_VolumeMigrationState(ref common.ReferenceCall
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VolumePersistentReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumePersistentReservation shows the SCSI persistent reservation state of a volume",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the volume",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reservation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reservation is the persistent reservation state of the volume",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.PersistentReservationStatus"),
+						},
+					},
+				},
+				Required: []string{"name", "reservation"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.PersistentReservationStatus"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -28707,12 +30940,18 @@ func schema_kubevirtio_api_core_v1_VolumeStatus(ref common.ReferenceCallback) co
 							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskInfo"),
 						},
 					},
+					"persistentReservation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentReservation shows the SCSI persistent reservation state of the volume, if the volume is a LUN with reservation",
+							Ref:         ref("kubevirt.io/api/core/v1.PersistentReservationStatus"),
+						},
+					},
 				},
 				Required: []string{"name", "target"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
+			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentReservationStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
 	}
 }
 
@@ -29558,6 +31797,13 @@ func schema_kubevirtio_api_export_v1beta1_VirtualMachineExportSpec(ref common.Re
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"persistentState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM, is exported along with the volumes. Defaults to Include",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
@@ -34735,6 +36981,13 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref common
 							},
 						},
 					},
+					"persistentState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM, is restored. When excluded, an existing target keeps its current state and a new target starts with a new state. Defaults to Include",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"target", "virtualMachineSnapshotName"},
 			},
@@ -35137,6 +37390,13 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSpec(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"persistentState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentState defines whether the persistent state of the VM, its vTPM and EFI NVRAM, is part of the snapshot. Defaults to Include",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
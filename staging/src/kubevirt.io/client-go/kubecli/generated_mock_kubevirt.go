@@ -11,6 +11,7 @@ package kubecli
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -1418,6 +1419,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) AddVolume(ctx, name,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVolume", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).AddVolume), ctx, name, addVolumeOptions)
 }
 
+// ConsoleToken mocks base method.
+func (m *MockVirtualMachineInstanceInterface) ConsoleToken(ctx context.Context, name string, options *v121.ConsoleTokenOptions) (v121.ConsoleToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsoleToken", ctx, name, options)
+	ret0, _ := ret[0].(v121.ConsoleToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsoleToken indicates an expected call of ConsoleToken.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) ConsoleToken(ctx, name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsoleToken", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).ConsoleToken), ctx, name, options)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Create(ctx context.Context, virtualMachineInstance *v121.VirtualMachineInstance, opts v12.CreateOptions) (*v121.VirtualMachineInstance, error) {
 	m.ctrl.T.Helper()
@@ -1505,6 +1521,50 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Get(ctx, name, opts a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Get), ctx, name, opts)
 }
 
+// GuestExec mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestExec(ctx context.Context, name string, options *v121.GuestExecOptions, stdout, stderr io.Writer) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestExec", ctx, name, options, stdout, stderr)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestExec indicates an expected call of GuestExec.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestExec(ctx, name, options, stdout, stderr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestExec", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestExec), ctx, name, options, stdout, stderr)
+}
+
+// GuestFileRead mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestFileRead(ctx context.Context, name string, options *v121.GuestFileOptions) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", ctx, name, options)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestFileRead(ctx, name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestFileRead), ctx, name, options)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestFileWrite(ctx context.Context, name string, options *v121.GuestFileOptions, content io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", ctx, name, options, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestFileWrite(ctx, name, options, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestFileWrite), ctx, name, options, content)
+}
+
 // GuestOsInfo mocks base method.
 func (m *MockVirtualMachineInstanceInterface) GuestOsInfo(ctx context.Context, name string) (v121.VirtualMachineInstanceGuestAgentInfo, error) {
 	m.ctrl.T.Helper()
@@ -1642,6 +1702,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SEVFetchCertChain(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SEVFetchCertChain", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SEVFetchCertChain), ctx, name)
 }
 
+// SEVFetchSNPAttestationInfo mocks base method.
+func (m *MockVirtualMachineInstanceInterface) SEVFetchSNPAttestationInfo(ctx context.Context, name string) (v121.SEVSNPAttestationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SEVFetchSNPAttestationInfo", ctx, name)
+	ret0, _ := ret[0].(v121.SEVSNPAttestationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SEVFetchSNPAttestationInfo indicates an expected call of SEVFetchSNPAttestationInfo.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SEVFetchSNPAttestationInfo(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SEVFetchSNPAttestationInfo", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SEVFetchSNPAttestationInfo), ctx, name)
+}
+
 // SEVInjectLaunchSecret mocks base method.
 func (m *MockVirtualMachineInstanceInterface) SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v121.SEVSecretOptions) error {
 	m.ctrl.T.Helper()
@@ -1729,6 +1804,36 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SoftReboot(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftReboot", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SoftReboot), ctx, name)
 }
 
+// Stats mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Stats(ctx context.Context, name string, dirtyRate bool) (v121.VirtualMachineInstanceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, name, dirtyRate)
+	ret0, _ := ret[0].(v121.VirtualMachineInstanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Stats(ctx, name, dirtyRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Stats), ctx, name, dirtyRate)
+}
+
+// TDXFetchQuote mocks base method.
+func (m *MockVirtualMachineInstanceInterface) TDXFetchQuote(ctx context.Context, name, report string) (v121.TDXQuoteInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TDXFetchQuote", ctx, name, report)
+	ret0, _ := ret[0].(v121.TDXQuoteInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TDXFetchQuote indicates an expected call of TDXFetchQuote.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) TDXFetchQuote(ctx, name, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TDXFetchQuote", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).TDXFetchQuote), ctx, name, report)
+}
+
 // USBRedir mocks base method.
 func (m *MockVirtualMachineInstanceInterface) USBRedir(vmiName string) (v122.StreamInterface, error) {
 	m.ctrl.T.Helper()
@@ -2312,6 +2417,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) GetWithExpandedSpec(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithExpandedSpec", reflect.TypeOf((*MockVirtualMachineInterface)(nil).GetWithExpandedSpec), ctx, name)
 }
 
+// Hibernate mocks base method.
+func (m *MockVirtualMachineInterface) Hibernate(ctx context.Context, name string, hibernateOptions *v121.HibernateOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hibernate", ctx, name, hibernateOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hibernate indicates an expected call of Hibernate.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Hibernate(ctx, name, hibernateOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hibernate", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Hibernate), ctx, name, hibernateOptions)
+}
+
 // List mocks base method.
 func (m *MockVirtualMachineInterface) List(ctx context.Context, opts v12.ListOptions) (*v121.VirtualMachineList, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	v1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,13 +30,20 @@ const (
 	unfreezeTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
 	resetTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/reset"
 	softRebootTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
+	hibernateTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/hibernate"
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	guestFileTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfile"
+	guestExecTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestexec"
+	statsTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/stats"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
 	sevInjectLaunchSecretTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/injectlaunchsecret"
+	sevFetchSNPAttestationInfoURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchsnpattestationinfo"
+
+	tdxFetchQuoteTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/tdx/fetchquote"
 )
 
 func NewVirtHandlerClient(virtCli KubevirtClient, httpCli *http.Client) VirtHandlerClient {
@@ -54,7 +63,7 @@ type VirtHandlerClient interface {
 
 type VirtHandlerConn interface {
 	ConnectionDetails() (ip string, port int, err error)
-	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	ConsoleURI(vmi *virtv1.VirtualMachineInstance, readOnly string) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
@@ -64,15 +73,23 @@ type VirtHandlerConn interface {
 	UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	ResetURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	HibernateURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SEVFetchSNPAttestationInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	TDXFetchQuoteURI(vmi *virtv1.VirtualMachineInstance, report string) (string, error)
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
 	Get(url string) (string, error)
+	GetStream(url string) (io.ReadCloser, error)
+	PutStream(url string, body io.ReadCloser) (io.ReadCloser, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestFileURI(vmi *virtv1.VirtualMachineInstance, options *virtv1.GuestFileOptions) (string, error)
+	GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	StatsURI(vmi *virtv1.VirtualMachineInstance, dirtyRate string) (string, error)
 }
 
 type virtHandler struct {
@@ -175,8 +192,12 @@ func (v *virtHandlerConn) formatURI(template string, vmi *virtv1.VirtualMachineI
 }
 
 // TODO move the actual ws handling in here, and work with channels
-func (v *virtHandlerConn) ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
-	return v.formatURI(consoleTemplateURI, vmi)
+func (v *virtHandlerConn) ConsoleURI(vmi *virtv1.VirtualMachineInstance, readOnly string) (string, error) {
+	baseURI, err := v.formatURI(consoleTemplateURI, vmi)
+	if err != nil || readOnly == "" {
+		return baseURI, err
+	}
+	return fmt.Sprintf("%s?readOnly=%s", baseURI, readOnly), nil
 }
 
 func (v *virtHandlerConn) USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
@@ -211,6 +232,10 @@ func (v *virtHandlerConn) SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (str
 	return v.formatURI(softRebootTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) HibernateURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(hibernateTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(pauseTemplateURI, vmi)
 }
@@ -264,6 +289,46 @@ func (v *virtHandlerConn) Put(url string, body io.ReadCloser) error {
 	return nil
 }
 
+// GetStream returns the body of a successful response for the caller to consume and close
+func (v *virtHandlerConn) GetStream(url string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", "application/octet-stream")
+	return v.doStreamRequest(req)
+}
+
+// PutStream sends the JSON encoded body and returns the body of a successful response for the caller to consume and close
+func (v *virtHandlerConn) PutStream(url string, body io.ReadCloser) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	return v.doStreamRequest(req)
+}
+
+func (v *virtHandlerConn) doStreamRequest(req *http.Request) (io.ReadCloser, error) {
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		responseBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected return code %d (%s)", resp.StatusCode, resp.Status)
+		}
+		return nil, fmt.Errorf("unexpected return code %d (%s), message: %s", resp.StatusCode, resp.Status, string(responseBytes))
+	}
+
+	return resp.Body, nil
+}
+
 func (v *virtHandlerConn) Get(url string) (string, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -298,6 +363,29 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestFileURI(vmi *virtv1.VirtualMachineInstance, options *virtv1.GuestFileOptions) (string, error) {
+	baseURI, err := v.formatURI(guestFileTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("path", options.Path)
+	query.Set("maxBytes", strconv.FormatInt(options.MaxBytes, 10))
+	return fmt.Sprintf("%s?%s", baseURI, query.Encode()), nil
+}
+
+func (v *virtHandlerConn) GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestExecTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) StatsURI(vmi *virtv1.VirtualMachineInstance, dirtyRate string) (string, error) {
+	baseURI, err := v.formatURI(statsTemplateURI, vmi)
+	if err != nil || dirtyRate == "" {
+		return baseURI, err
+	}
+	return fmt.Sprintf("%s?dirtyRate=%s", baseURI, dirtyRate), nil
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
@@ -309,3 +397,17 @@ func (v *virtHandlerConn) SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachin
 func (v *virtHandlerConn) SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevInjectLaunchSecretTemplateURI, vmi)
 }
+
+func (v *virtHandlerConn) SEVFetchSNPAttestationInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(sevFetchSNPAttestationInfoURI, vmi)
+}
+
+func (v *virtHandlerConn) TDXFetchQuoteURI(vmi *virtv1.VirtualMachineInstance, report string) (string, error) {
+	baseURI, err := v.formatURI(tdxFetchQuoteTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("report", report)
+	return fmt.Sprintf("%s?%s", baseURI, query.Encode()), nil
+}
@@ -32,6 +32,7 @@ type KubevirtV1Interface interface {
 	RESTClient() rest.Interface
 	KubeVirtsGetter
 	VirtualMachinesGetter
+	VirtualMachineDiskTasksGetter
 	VirtualMachineInstancesGetter
 	VirtualMachineInstanceMigrationsGetter
 	VirtualMachineInstancePresetsGetter
@@ -51,6 +52,10 @@ func (c *KubevirtV1Client) VirtualMachines(namespace string) VirtualMachineInter
 	return newVirtualMachines(c, namespace)
 }
 
+func (c *KubevirtV1Client) VirtualMachineDiskTasks(namespace string) VirtualMachineDiskTaskInterface {
+	return newVirtualMachineDiskTasks(c, namespace)
+}
+
 func (c *KubevirtV1Client) VirtualMachineInstances(namespace string) VirtualMachineInstanceInterface {
 	return newVirtualMachineInstances(c, namespace)
 }
@@ -38,6 +38,10 @@ func (c *FakeKubevirtV1) VirtualMachines(namespace string) v1.VirtualMachineInte
 	return &FakeVirtualMachines{c, namespace}
 }
 
+func (c *FakeKubevirtV1) VirtualMachineDiskTasks(namespace string) v1.VirtualMachineDiskTaskInterface {
+	return &FakeVirtualMachineDiskTasks{c, namespace}
+}
+
 func (c *FakeKubevirtV1) VirtualMachineInstances(namespace string) v1.VirtualMachineInstanceInterface {
 	return &FakeVirtualMachineInstances{c, namespace}
 }
@@ -66,6 +66,13 @@ func (c *FakeVirtualMachines) Stop(ctx context.Context, name string, stopOptions
 	return err
 }
 
+func (c *FakeVirtualMachines) Hibernate(ctx context.Context, name string, hibernateOptions *v1.HibernateOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "hibernate", name, hibernateOptions), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachines) Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "migrate", name, migrateOptions), nil)
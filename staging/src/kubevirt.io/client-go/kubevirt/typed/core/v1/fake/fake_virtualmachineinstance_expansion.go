@@ -21,6 +21,8 @@ package fake
 
 import (
 	"context"
+	"io"
+	"strings"
 	"time"
 
 	"k8s.io/client-go/testing"
@@ -114,6 +116,47 @@ func (c *FakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *FakeVirtualMachineInstances) Stats(ctx context.Context, name string, dirtyRate bool) (v1.VirtualMachineInstanceStats, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "stats", name), &v1.VirtualMachineInstanceStats{})
+
+	if obj == nil {
+		return v1.VirtualMachineInstanceStats{}, err
+	}
+	return *obj.(*v1.VirtualMachineInstanceStats), err
+}
+
+func (c *FakeVirtualMachineInstances) GuestFileRead(ctx context.Context, name string, options *v1.GuestFileOptions) (io.ReadCloser, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "guestfile", name, options), nil)
+
+	return io.NopCloser(strings.NewReader("")), err
+}
+
+func (c *FakeVirtualMachineInstances) GuestFileWrite(ctx context.Context, name string, options *v1.GuestFileOptions, content io.Reader) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "guestfile", name, options), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachineInstances) GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions, stdout, stderr io.Writer) (int32, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "guestexec", name, options), nil)
+
+	return 0, err
+}
+
+func (c *FakeVirtualMachineInstances) ConsoleToken(ctx context.Context, name string, options *v1.ConsoleTokenOptions) (v1.ConsoleToken, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "consoletoken", name, options), &v1.ConsoleToken{})
+
+	if obj == nil {
+		return v1.ConsoleToken{}, err
+	}
+	return *obj.(*v1.ConsoleToken), err
+}
+
 func (c *FakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
@@ -160,6 +203,20 @@ func (c *FakeVirtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context,
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) SEVFetchSNPAttestationInfo(ctx context.Context, name string) (v1.SEVSNPAttestationInfo, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "sev/fetchsnpattestationinfo", name), &v1.SEVSNPAttestationInfo{})
+
+	return v1.SEVSNPAttestationInfo{}, err
+}
+
+func (c *FakeVirtualMachineInstances) TDXFetchQuote(ctx context.Context, name string, report string) (v1.TDXQuoteInfo, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "tdx/fetchquote", name), &v1.TDXQuoteInfo{})
+
+	return v1.TDXQuoteInfo{}, err
+}
+
 func (c *FakeVirtualMachineInstances) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	obj, err := c.Fake.
 		Invokes(fake2.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "objectgraph", name, objectGraphOptions), nil)
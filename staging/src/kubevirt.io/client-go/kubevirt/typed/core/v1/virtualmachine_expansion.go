@@ -40,6 +40,7 @@ type VirtualMachineExpansion interface {
 	Restart(ctx context.Context, name string, restartOptions *v1.RestartOptions) error
 	Start(ctx context.Context, name string, startOptions *v1.StartOptions) error
 	Stop(ctx context.Context, name string, stopOptions *v1.StopOptions) error
+	Hibernate(ctx context.Context, name string, hibernateOptions *v1.HibernateOptions) error
 	Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
@@ -115,6 +116,22 @@ func (c *virtualMachines) Stop(ctx context.Context, name string, stopOptions *v1
 		Error()
 }
 
+func (c *virtualMachines) Hibernate(ctx context.Context, name string, hibernateOptions *v1.HibernateOptions) error {
+	optsJson, err := json.Marshal(hibernateOptions)
+	if err != nil {
+		return err
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("hibernate").
+		Body(optsJson).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachines) Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error {
 	optsJson, err := json.Marshal(migrateOptions)
 	if err != nil {
@@ -23,6 +23,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,6 +37,8 @@ const vmiSubresourceURL = "/apis/subresources.kubevirt.io/%s"
 
 type SerialConsoleOptions struct {
 	ConnectionTimeout time.Duration
+	// ReadOnly attaches to the serial console as a viewer, which only receives its output
+	ReadOnly bool
 }
 
 type VirtualMachineInstanceExpansion interface {
@@ -52,6 +56,11 @@ type VirtualMachineInstanceExpansion interface {
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	Stats(ctx context.Context, name string, dirtyRate bool) (v1.VirtualMachineInstanceStats, error)
+	GuestFileRead(ctx context.Context, name string, options *v1.GuestFileOptions) (io.ReadCloser, error)
+	GuestFileWrite(ctx context.Context, name string, options *v1.GuestFileOptions, content io.Reader) error
+	GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions, stdout, stderr io.Writer) (int32, error)
+	ConsoleToken(ctx context.Context, name string, options *v1.ConsoleTokenOptions) (v1.ConsoleToken, error)
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
@@ -60,6 +69,8 @@ type VirtualMachineInstanceExpansion interface {
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
 	SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v1.SEVSecretOptions) error
+	SEVFetchSNPAttestationInfo(ctx context.Context, name string) (v1.SEVSNPAttestationInfo, error)
+	TDXFetchQuote(ctx context.Context, name string, report string) (v1.TDXQuoteInfo, error)
 }
 
 func (c *virtualMachineInstances) SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error) {
@@ -276,6 +287,115 @@ func (c *virtualMachineInstances) FilesystemList(ctx context.Context, name strin
 	return fsList, err
 }
 
+// Stats returns the CPU, memory, disk and network usage of a running VMI, the dirty rate of its memory is measured during a second when requested
+func (c *virtualMachineInstances) Stats(ctx context.Context, name string, dirtyRate bool) (v1.VirtualMachineInstanceStats, error) {
+	vmiStats := v1.VirtualMachineInstanceStats{}
+	request := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("stats")
+	if dirtyRate {
+		request = request.Param("dirtyRate", "true")
+	}
+	err := request.Do(ctx).Into(&vmiStats)
+
+	return vmiStats, err
+}
+
+func (c *virtualMachineInstances) GuestFileRead(ctx context.Context, name string, options *v1.GuestFileOptions) (io.ReadCloser, error) {
+	return c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile").
+		Param("path", options.Path).
+		Param("maxBytes", strconv.FormatInt(options.MaxBytes, 10)).
+		SetHeader("Accept", "application/octet-stream").
+		Stream(ctx)
+}
+
+func (c *virtualMachineInstances) GuestFileWrite(ctx context.Context, name string, options *v1.GuestFileOptions, content io.Reader) error {
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile").
+		Param("path", options.Path).
+		Param("maxBytes", strconv.FormatInt(options.MaxBytes, 10)).
+		SetHeader("Content-Type", "application/octet-stream").
+		Body(content).
+		Do(ctx).
+		Error()
+}
+
+// GuestExec runs a command in the guest, copies its output to stdout and stderr while it runs and returns its exit code
+func (c *virtualMachineInstances) GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions, stdout, stderr io.Writer) (int32, error) {
+	body, err := json.Marshal(options)
+	if err != nil {
+		return -1, fmt.Errorf("cannot Marshal to json: %s", err)
+	}
+
+	stream, err := c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestexec").
+		Body(body).
+		Stream(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer stream.Close()
+
+	decoder := json.NewDecoder(stream)
+	for {
+		output := v1.GuestExecOutput{}
+		if err := decoder.Decode(&output); err != nil {
+			if err == io.EOF {
+				return -1, fmt.Errorf("guest command output ended before the command exited")
+			}
+			return -1, err
+		}
+		if _, err := stdout.Write(output.Stdout); err != nil {
+			return -1, err
+		}
+		if _, err := stderr.Write(output.Stderr); err != nil {
+			return -1, err
+		}
+		if output.Error != "" {
+			return -1, fmt.Errorf("%s", output.Error)
+		}
+		if output.Exited {
+			return output.ExitCode, nil
+		}
+	}
+}
+
+// ConsoleToken returns a short-lived token to connect a browser to a console of the VMI through the console gateway of virt-api
+func (c *virtualMachineInstances) ConsoleToken(ctx context.Context, name string, options *v1.ConsoleTokenOptions) (v1.ConsoleToken, error) {
+	consoleToken := v1.ConsoleToken{}
+	body, err := json.Marshal(options)
+	if err != nil {
+		return consoleToken, fmt.Errorf("cannot Marshal to json: %s", err)
+	}
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("consoletoken").
+		Body(body).
+		Do(ctx).
+		Into(&consoleToken)
+
+	return consoleToken, err
+}
+
 func (c *virtualMachineInstances) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	objectGraph := v1.ObjectGraphNode{}
 
@@ -397,3 +517,32 @@ func (c *virtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context, nam
 		Do(context.Background()).
 		Error()
 }
+
+func (c *virtualMachineInstances) SEVFetchSNPAttestationInfo(ctx context.Context, name string) (v1.SEVSNPAttestationInfo, error) {
+	sevSNPAttestationInfo := v1.SEVSNPAttestationInfo{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("sev", "fetchsnpattestationinfo").
+		Do(ctx).
+		Into(&sevSNPAttestationInfo)
+
+	return sevSNPAttestationInfo, err
+}
+
+func (c *virtualMachineInstances) TDXFetchQuote(ctx context.Context, name string, report string) (v1.TDXQuoteInfo, error) {
+	tdxQuoteInfo := v1.TDXQuoteInfo{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("tdx", "fetchquote").
+		Param("report", report).
+		Do(ctx).
+		Into(&tdxQuoteInfo)
+
+	return tdxQuoteInfo, err
+}
@@ -41,6 +41,7 @@ var _ = Describe(SIG("CRDs", func() {
 			crds.VIRTUALMACHINESNAPSHOT, crds.VIRTUALMACHINESNAPSHOTCONTENT,
 			crds.VIRTUALMACHINECLONE,
 			crds.VIRTUALMACHINEEXPORT,
+			crds.VIRTUALMACHINEDISKTASK,
 		}
 
 		for _, name := range ourCRDs {